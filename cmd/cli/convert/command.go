@@ -0,0 +1,106 @@
+package convert
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/grafana/cog/internal/codegen"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	ConfigPath      string
+	ExtraParameters map[string]string
+	Language        string
+	Object          string
+}
+
+func Command() *cobra.Command {
+	opts := options{}
+
+	cmd := &cobra.Command{
+		Use:   "convert [input.json...]",
+		Short: "Converts JSON documents into code using the generated builders.",
+		Long: `Converts JSON documents into code using the generated builders.
+
+Each input is a JSON document describing an instance of the object given by
+--object. The code reproducing it is printed on the standard output. Parts of
+the documents that can not be reproduced using builders are reported on the
+standard error.
+
+When no input file is given, the document is read from the standard input.
+
+Example:
+  cog convert --config config.yaml --language go --object dashboard.Dashboard dashboard.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doConvert(cmd.InOrStdin(), cmd.OutOrStdout(), cmd.ErrOrStderr(), opts, args)
+		},
+	}
+
+	cmd.Flags().StringToStringVar(&opts.ExtraParameters, "parameters", nil, "Sets or overrides parameters used in the config file.")
+
+	cmd.Flags().StringVar(&opts.ConfigPath, "config", "", "Codegen pipeline configuration file.")
+	_ = cmd.MarkFlagFilename("config")
+	_ = cmd.MarkFlagRequired("config")
+
+	cmd.Flags().StringVar(&opts.Language, "language", "", "Language in which the code is generated.")
+	_ = cmd.MarkFlagRequired("language")
+
+	cmd.Flags().StringVar(&opts.Object, "object", "", "Object described by the inputs, as 'pkg.Name'.")
+	_ = cmd.MarkFlagRequired("object")
+
+	return cmd
+}
+
+func doConvert(stdin io.Reader, stdout io.Writer, stderr io.Writer, opts options, inputFiles []string) error {
+	ctx := context.Background()
+
+	pipeline, err := codegen.PipelineFromFile(opts.ConfigPath, codegen.Parameters(opts.ExtraParameters))
+	if err != nil {
+		return err
+	}
+
+	converter, err := pipeline.Converter(ctx, opts.Language)
+	if err != nil {
+		return err
+	}
+
+	inputs := make(map[string][]byte, len(inputFiles))
+	if len(inputFiles) == 0 {
+		content, err := io.ReadAll(stdin)
+		if err != nil {
+			return err
+		}
+
+		inputFiles = append(inputFiles, "<stdin>")
+		inputs["<stdin>"] = content
+	}
+
+	for i, inputFile := range inputFiles {
+		input, found := inputs[inputFile]
+		if !found {
+			input, err = os.ReadFile(inputFile)
+			if err != nil {
+				return err
+			}
+		}
+
+		code, warnings, err := converter.Convert(opts.Object, input)
+		if err != nil {
+			return fmt.Errorf("%s: %w", inputFile, err)
+		}
+
+		for _, warning := range warnings {
+			fmt.Fprintf(stderr, "%s: %s\n", inputFile, warning)
+		}
+
+		if i != 0 {
+			fmt.Fprintln(stdout)
+		}
+		fmt.Fprint(stdout, code)
+	}
+
+	return nil
+}
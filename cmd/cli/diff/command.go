@@ -0,0 +1,160 @@
+package diff
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/codegen"
+	"github.com/grafana/cog/internal/diff"
+	"github.com/spf13/cobra"
+)
+
+const (
+	formatText = "text"
+	formatJSON = "json"
+)
+
+type options struct {
+	ConfigPath      string
+	NewConfigPath   string
+	ExtraParameters map[string]string
+	OldParameters   map[string]string
+	NewParameters   map[string]string
+	Format          string
+}
+
+func Command() *cobra.Command {
+	opts := options{}
+
+	cmd := &cobra.Command{
+		Use:   "diff",
+		Short: "Compares the intermediate representation of two versions of a set of schemas.",
+		Long: `Compares the intermediate representation of two versions of a set of schemas.
+
+Both versions are described by codegen pipeline configuration files, or by a
+single configuration file used with two different sets of parameters.
+
+Example:
+  cog diff --config config.yaml --old-parameters kind_registry_version=v10.3.x --new-parameters kind_registry_version=v10.4.x`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doDiff(cmd.OutOrStdout(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ConfigPath, "config", "", "Codegen pipeline configuration file describing the old version.")
+	_ = cmd.MarkFlagFilename("config")
+	_ = cmd.MarkFlagRequired("config")
+
+	cmd.Flags().StringVar(&opts.NewConfigPath, "new-config", "", "Codegen pipeline configuration file describing the new version. Defaults to the value of --config.")
+	_ = cmd.MarkFlagFilename("new-config")
+
+	cmd.Flags().StringToStringVar(&opts.ExtraParameters, "parameters", nil, "Sets or overrides parameters used in both config files.")
+	cmd.Flags().StringToStringVar(&opts.OldParameters, "old-parameters", nil, "Sets or overrides parameters used to load the old version.")
+	cmd.Flags().StringToStringVar(&opts.NewParameters, "new-parameters", nil, "Sets or overrides parameters used to load the new version.")
+
+	cmd.Flags().StringVar(&opts.Format, "format", formatText, "Output format. Valid values: text, json.")
+
+	return cmd
+}
+
+func doDiff(output io.Writer, opts options) error {
+	if opts.Format != formatText && opts.Format != formatJSON {
+		return fmt.Errorf("invalid output format '%s': expected one of text, json", opts.Format)
+	}
+
+	newConfigPath := opts.NewConfigPath
+	if newConfigPath == "" {
+		newConfigPath = opts.ConfigPath
+	}
+
+	oldSchemas, err := loadSchemas(opts.ConfigPath, mergeParameters(opts.ExtraParameters, opts.OldParameters))
+	if err != nil {
+		return fmt.Errorf("could not load old schemas: %w", err)
+	}
+
+	newSchemas, err := loadSchemas(newConfigPath, mergeParameters(opts.ExtraParameters, opts.NewParameters))
+	if err != nil {
+		return fmt.Errorf("could not load new schemas: %w", err)
+	}
+
+	report := diff.Schemas(oldSchemas, newSchemas)
+
+	if opts.Format == formatJSON {
+		return printJSON(output, report)
+	}
+
+	printText(output, report)
+
+	return nil
+}
+
+func loadSchemas(configPath string, parameters map[string]string) (ast.Schemas, error) {
+	pipeline, err := codegen.PipelineFromFile(configPath, codegen.Parameters(parameters))
+	if err != nil {
+		return nil, err
+	}
+
+	return pipeline.LoadSchemas(context.Background())
+}
+
+func mergeParameters(base map[string]string, overrides map[string]string) map[string]string {
+	merged := make(map[string]string, len(base)+len(overrides))
+	for key, value := range base {
+		merged[key] = value
+	}
+	for key, value := range overrides {
+		merged[key] = value
+	}
+
+	return merged
+}
+
+func printJSON(output io.Writer, report diff.Report) error {
+	if report.Changes == nil {
+		report.Changes = []diff.Change{}
+	}
+
+	encoder := json.NewEncoder(output)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
+
+func printText(output io.Writer, report diff.Report) {
+	if len(report.Changes) == 0 {
+		fmt.Fprintln(output, "No changes.")
+		return
+	}
+
+	printChanges(output, "Breaking changes", report.Breaking())
+	printChanges(output, "Non-breaking changes", report.NonBreaking())
+}
+
+func printChanges(output io.Writer, title string, changes []diff.Change) {
+	if len(changes) == 0 {
+		return
+	}
+
+	fmt.Fprintf(output, "%s (%d):\n", title, len(changes))
+
+	for _, change := range changes {
+		line := fmt.Sprintf("  [%s] %s: %s", change.Kind, change.Path, change.Message)
+
+		switch {
+		case change.Old != "" && change.New != "":
+			line += fmt.Sprintf(" (%s -> %s)", change.Old, change.New)
+		case change.Old != "":
+			line += fmt.Sprintf(" (%s)", change.Old)
+		case change.New != "":
+			line += fmt.Sprintf(" (%s)", change.New)
+		}
+
+		fmt.Fprintln(output, line)
+	}
+
+	fmt.Fprintln(output)
+}
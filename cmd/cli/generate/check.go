@@ -0,0 +1,40 @@
+package generate
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/grafana/cog/internal/codegen"
+)
+
+// doCheck generates code and compares it with what is on disk, without
+// writing anything.
+func doCheck(opts options) error {
+	pipeline, generatedFS, err := runPipeline(context.Background(), opts)
+	if err != nil {
+		return err
+	}
+
+	outputDirectories, err := pipeline.OutputDirectories()
+	if err != nil {
+		return err
+	}
+
+	drifts, err := codegen.Drift(generatedFS, "", outputDirectories)
+	if err != nil {
+		return err
+	}
+
+	for _, drift := range drifts {
+		fmt.Printf("%s: %s\n", drift.Status, drift.Path)
+		fmt.Print(drift.Diff)
+	}
+
+	if len(drifts) != 0 {
+		return fmt.Errorf("generated code is out of date: %d file(s) differ", len(drifts))
+	}
+
+	fmt.Println("Generated code is up to date.")
+
+	return nil
+}
@@ -2,14 +2,30 @@ package generate
 
 import (
 	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"time"
 
+	"github.com/grafana/codejen"
 	"github.com/grafana/cog/internal/codegen"
+	"github.com/grafana/cog/internal/trace"
 	"github.com/spf13/cobra"
 )
 
 type options struct {
 	ConfigPath      string
 	ExtraParameters map[string]string
+	TraceReportPath string
+	Concurrency     int
+	CacheDir        string
+	NoCache         bool
+	Check           bool
+	Strict          bool
+	Watch           bool
+	WatchInterval   time.Duration
 }
 
 func Command() *cobra.Command {
@@ -20,6 +36,13 @@ func Command() *cobra.Command {
 		Short: "Generates code from schemas.", // TODO: better descriptions
 		Long:  `Generates code from schemas.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
+			if opts.Check {
+				return doCheck(opts)
+			}
+			if opts.Watch {
+				return doWatch(cmd.Context(), opts)
+			}
+
 			return doGenerate(opts)
 		},
 	}
@@ -30,21 +53,143 @@ func Command() *cobra.Command {
 
 	cmd.Flags().StringToStringVar(&opts.ExtraParameters, "parameters", nil, "Sets or overrides parameters used in the config file.")
 
+	cmd.Flags().StringVar(&opts.TraceReportPath, "trace-report", "", "Writes a JSON report of the changes made by every compiler pass and veneer to the given file. A markdown rendering of the report is written next to it.")
+	_ = cmd.MarkFlagFilename("trace-report")
+
+	cmd.Flags().IntVar(&opts.Concurrency, "concurrency", runtime.NumCPU(), "Maximum number of languages generated in parallel.")
+
+	defaultCacheDir := ""
+	if userCacheDir, err := os.UserCacheDir(); err == nil {
+		defaultCacheDir = filepath.Join(userCacheDir, "cog")
+	}
+	cmd.Flags().StringVar(&opts.CacheDir, "cache-dir", defaultCacheDir, "Directory in which parsed inputs and the result of common compiler passes are cached.")
+	_ = cmd.MarkFlagDirname("cache-dir")
+	cmd.Flags().BoolVar(&opts.NoCache, "no-cache", false, "Disables the cache.")
+
+	cmd.Flags().BoolVar(&opts.Check, "check", false, "Compares the generated code with what is on disk instead of writing it. Exits with a non-zero status if anything differs.")
+	cmd.Flags().BoolVar(&opts.Watch, "watch", false, "Watches the config file, inputs, transformations and templates, and re-generates code when they change.")
+	cmd.Flags().DurationVar(&opts.WatchInterval, "watch-interval", 500*time.Millisecond, "Interval at which watched files are checked for changes.")
+
+	cmd.Flags().BoolVar(&opts.Strict, "strict", false, "Fails if object or field references used by compiler passes, or veneer rules, matched nothing.")
+
+	cmd.MarkFlagsMutuallyExclusive("check", "watch")
+
 	return cmd
 }
 
 func doGenerate(opts options) error {
 	ctx := context.Background()
 
-	ppipeline, err := codegen.PipelineFromFile(opts.ConfigPath, codegen.Parameters(opts.ExtraParameters), codegen.Reporter(codegen.StdoutReporter))
+	_, generatedFS, err := runPipeline(ctx, opts)
 	if err != nil {
 		return err
 	}
 
+	if err := pruneStaleFiles(generatedFS); err != nil {
+		return err
+	}
+
+	return generatedFS.Write(ctx, "")
+}
+
+// pruneStaleFiles removes previously generated files that aren't generated
+// anymore. It must be called before writing the generated files.
+func pruneStaleFiles(generatedFS *codejen.FS) error {
+	report, err := codegen.PruneStaleFiles(generatedFS, "")
+
+	for _, file := range report.Removed {
+		fmt.Printf("Removed stale file: %s\n", file)
+	}
+	for _, file := range report.Edited {
+		fmt.Fprintf(os.Stderr, "Warning: %s isn't generated anymore but was edited since its generation, leaving it in place\n", file)
+	}
+
+	return err
+}
+
+// runPipeline loads the pipeline described by the config file and runs it.
+// The pipeline is returned as long as it could be loaded, even if running it
+// failed.
+func runPipeline(ctx context.Context, opts options) (*codegen.Pipeline, *codejen.FS, error) {
+	pipelineOpts := []codegen.PipelineOption{
+		codegen.Parameters(opts.ExtraParameters),
+		codegen.Reporter(codegen.StdoutReporter),
+		codegen.Concurrency(opts.Concurrency),
+	}
+
+	if !opts.NoCache && opts.CacheDir != "" {
+		pipelineOpts = append(pipelineOpts, codegen.CacheDirectory(opts.CacheDir))
+	}
+
+	var tracer *trace.Recorder
+	if opts.TraceReportPath != "" || opts.Strict {
+		tracer = trace.NewRecorder()
+		pipelineOpts = append(pipelineOpts, codegen.Tracer(tracer))
+	}
+
+	ppipeline, err := codegen.PipelineFromFile(opts.ConfigPath, pipelineOpts...)
+	if err != nil {
+		return nil, nil, err
+	}
+
 	generatedFS, err := ppipeline.Run(ctx)
+
+	// the trace is written even if the codegen failed: it might help
+	// understanding why.
+	if opts.TraceReportPath != "" {
+		if traceErr := writeTraceReport(opts.TraceReportPath, tracer.Report()); traceErr != nil {
+			return ppipeline, nil, traceErr
+		}
+	}
+
+	if err != nil {
+		return ppipeline, nil, err
+	}
+
+	if opts.Strict {
+		if err := checkUnmatched(tracer.Report()); err != nil {
+			return ppipeline, nil, err
+		}
+	}
+
+	return ppipeline, generatedFS, nil
+}
+
+// checkUnmatched reports references and veneer rules that matched nothing,
+// and fails if there are any.
+func checkUnmatched(report trace.Report) error {
+	unmatched := report.Unmatched()
+	for _, target := range unmatched {
+		fmt.Fprintln(os.Stderr, target.String())
+	}
+
+	if len(unmatched) != 0 {
+		return fmt.Errorf("strict mode: %d references or rules matched nothing", len(unmatched))
+	}
+
+	return nil
+}
+
+func writeTraceReport(path string, report trace.Report) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	jsonFile, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	defer func() { _ = jsonFile.Close() }()
+
+	if err := report.WriteJSON(jsonFile); err != nil {
+		return err
+	}
+
+	markdownFile, err := os.Create(strings.TrimSuffix(path, filepath.Ext(path)) + ".md")
 	if err != nil {
 		return err
 	}
+	defer func() { _ = markdownFile.Close() }()
 
-	return generatedFS.Write(context.Background(), "")
+	return report.WriteMarkdown(markdownFile)
 }
@@ -0,0 +1,91 @@
+package generate
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/grafana/cog/internal/codegen"
+)
+
+// doWatch generates code, then re-generates it every time the pipeline's
+// config file, inputs, transformations or templates change.
+// Errors are reported without stopping the watch.
+func doWatch(ctx context.Context, opts options) error {
+	ctx, stop := signal.NotifyContext(ctx, os.Interrupt)
+	defer stop()
+
+	watchedPaths := []string{opts.ConfigPath}
+	regenerate := func() {
+		start := time.Now()
+
+		ppipeline, generatedFS, err := runPipeline(ctx, opts)
+		if ppipeline != nil {
+			// the config file might have changed: the watched paths too.
+			watchedPaths = append(ppipeline.WatchedPaths(), opts.ConfigPath)
+		}
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			return
+		}
+
+		if err := pruneStaleFiles(generatedFS); err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			return
+		}
+
+		written, err := codegen.WriteChangedFiles(ctx, generatedFS, "")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			return
+		}
+
+		fmt.Printf("Generated in %s: %d file(s) updated\n", time.Since(start).Round(time.Millisecond), len(written))
+	}
+
+	regenerate()
+
+	snapshot, err := codegen.SnapshotFiles(watchedPaths)
+	if err != nil {
+		return err
+	}
+
+	fmt.Println("Watching for changes...")
+
+	ticker := time.NewTicker(opts.WatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		current, err := codegen.SnapshotFiles(watchedPaths)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			continue
+		}
+
+		changed := current.ChangedSince(snapshot)
+		if len(changed) == 0 {
+			continue
+		}
+
+		for _, file := range changed {
+			fmt.Printf("Changed: %s\n", file)
+		}
+
+		regenerate()
+
+		// the watched paths might have changed with the config file.
+		snapshot, err = codegen.SnapshotFiles(watchedPaths)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error: %s\n", err)
+			snapshot = current
+		}
+	}
+}
@@ -15,17 +15,21 @@ type options struct {
 	BuilderIR       bool
 	ConfigPath      string
 	ExtraParameters map[string]string
+	Language        string
+	Stage           string
 }
 
 func Command() *cobra.Command {
 	opts := options{}
 
-	// TODO:
-	//  - support inspecting "transformed" IRs: common & language-specific compiler passes, veneers, ...
 	cmd := &cobra.Command{
 		Use:   "inspect",
 		Short: "Inspects the intermediate representation.", // TODO: better descriptions
-		Long:  `Inspects the intermediate representation.`,
+		Long: `Inspects the intermediate representation.
+
+By default, the IR is inspected as it is parsed from the inputs. The --stage
+and --language flags allow inspecting it as it is transformed by compiler
+passes and veneers, up to the input received by the jennies of a language.`,
 		RunE: func(cmd *cobra.Command, args []string) error {
 			return doInspect(opts)
 		},
@@ -38,27 +42,60 @@ func Command() *cobra.Command {
 	_ = cmd.MarkFlagFilename("config")
 	_ = cmd.MarkFlagRequired("config")
 
+	cmd.Flags().StringVar(&opts.Language, "language", "", "Language for which the IR is inspected. Required by every stage but \"raw\".")
+	cmd.Flags().StringVar(&opts.Stage, "stage", string(codegen.StageRaw), "Stage after which the IR is inspected. Valid values: raw, common-passes, language-passes, veneers, nilchecks.")
+
 	return cmd
 }
 
 func doInspect(opts options) error {
 	ctx := context.Background()
 
+	stage, err := codegen.ParseStage(opts.Stage)
+	if err != nil {
+		return err
+	}
+
+	if stage != codegen.StageRaw && opts.Language == "" {
+		return fmt.Errorf("inspecting the '%s' stage requires a language", stage)
+	}
+
 	pipeline, err := codegen.PipelineFromFile(opts.ConfigPath, codegen.Parameters(opts.ExtraParameters))
 	if err != nil {
 		return err
 	}
 
-	schemas, err := pipeline.LoadSchemas(ctx)
+	if stage == codegen.StageRaw {
+		schemas, err := pipeline.LoadSchemas(ctx)
+		if err != nil {
+			return err
+		}
+
+		if opts.BuilderIR {
+			return inspectBuilderIR(schemas)
+		}
+
+		return prettyPrintJSON(schemas)
+	}
+
+	jenniesInput, err := pipeline.Inspect(ctx, opts.Language, stage)
 	if err != nil {
 		return err
 	}
 
+	if stage == codegen.StageVeneers || stage == codegen.StageNilChecks {
+		return prettyPrintJSON(jenniesInput)
+	}
+
+	// builders are derived from the schemas only once they are fully
+	// transformed: the ones printed here don't have any veneer applied.
 	if opts.BuilderIR {
-		return inspectBuilderIR(schemas)
+		jenniesInput.Builders = (&ast.BuilderGenerator{}).FromAST(jenniesInput.Schemas)
+
+		return prettyPrintJSON(jenniesInput)
 	}
 
-	return prettyPrintJSON(schemas)
+	return prettyPrintJSON(jenniesInput.Schemas)
 }
 
 func inspectBuilderIR(schemas []*ast.Schema) error {
@@ -0,0 +1,72 @@
+package lint
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/grafana/cog/internal/codegen"
+	"github.com/spf13/cobra"
+)
+
+type options struct {
+	ConfigPath      string
+	ExtraParameters map[string]string
+	Strict          bool
+}
+
+func Command() *cobra.Command {
+	opts := options{}
+
+	cmd := &cobra.Command{
+		Use:   "lint",
+		Short: "Reports compiler passes and veneers that matched nothing.",
+		Long: `Reports compiler passes and veneers that matched nothing.
+
+Object and field references used by compiler passes (fields_set_default,
+retype_field, omit, …) and veneer rules are checked against the schemas of
+every output language. The ones that matched nothing are reported with the
+file and line at which they are defined: they usually are leftovers of
+renamed or removed objects and fields.
+
+Example:
+  cog lint --config config.yaml --strict`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return doLint(cmd.OutOrStdout(), opts)
+		},
+	}
+
+	cmd.Flags().StringVar(&opts.ConfigPath, "config", "", "Codegen pipeline configuration file.")
+	_ = cmd.MarkFlagFilename("config")
+	_ = cmd.MarkFlagRequired("config")
+
+	cmd.Flags().StringToStringVar(&opts.ExtraParameters, "parameters", nil, "Sets or overrides parameters used in the config file.")
+
+	cmd.Flags().BoolVar(&opts.Strict, "strict", false, "Exits with a non-zero status if anything matched nothing.")
+
+	return cmd
+}
+
+func doLint(stdout io.Writer, opts options) error {
+	ctx := context.Background()
+
+	pipeline, err := codegen.PipelineFromFile(opts.ConfigPath, codegen.Parameters(opts.ExtraParameters))
+	if err != nil {
+		return err
+	}
+
+	unmatched, err := pipeline.Lint(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, target := range unmatched {
+		fmt.Fprintln(stdout, target.String())
+	}
+
+	if opts.Strict && len(unmatched) != 0 {
+		return fmt.Errorf("%d references or rules matched nothing", len(unmatched))
+	}
+
+	return nil
+}
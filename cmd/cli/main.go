@@ -3,8 +3,11 @@ package main
 import (
 	"os"
 
+	"github.com/grafana/cog/cmd/cli/convert"
+	"github.com/grafana/cog/cmd/cli/diff"
 	"github.com/grafana/cog/cmd/cli/generate"
 	"github.com/grafana/cog/cmd/cli/inspect"
+	"github.com/grafana/cog/cmd/cli/lint"
 	"github.com/spf13/cobra"
 )
 
@@ -15,8 +18,11 @@ func main() {
 		SilenceUsage: true,
 	}
 
+	rootCmd.AddCommand(convert.Command())
+	rootCmd.AddCommand(diff.Command())
 	rootCmd.AddCommand(generate.Command())
 	rootCmd.AddCommand(inspect.Command())
+	rootCmd.AddCommand(lint.Command())
 
 	if err := rootCmd.Execute(); err != nil {
 		os.Exit(1)
@@ -0,0 +1,56 @@
+package main
+
+import (
+	"context"
+	"fmt"
+
+	"cuelang.org/go/cue/cuecontext"
+	"github.com/grafana/cog"
+)
+
+const val = `
+Dashboard: {
+    title: string
+    tags: [...string]
+    refresh?: string
+}
+`
+
+const goVeneers = `
+language: go
+package: dashboard
+options:
+  - array_to_append:
+      by_name: Dashboard.tags
+`
+
+func main() {
+	v := cuecontext.New().CompileString(val)
+	if v.Err() != nil {
+		panic(v.Err())
+	}
+
+	generatedFS, err := cog.NewPipeline().
+		CUEValue("dashboard", v).
+		Veneers(cog.VeneerRules{
+			Language: cog.AllLanguages,
+			OptionRules: []cog.OptionRule{
+				cog.RenameOption(cog.OptionByName("dashboard", "Dashboard", "refresh"), "refreshInterval"),
+			},
+		}).
+		VeneersFromYAML([]byte(goVeneers)).
+		Languages(
+			cog.OutputLanguage{Go: &cog.GoConfig{PackageRoot: "github.com/example/sdk"}},
+			cog.OutputLanguage{Typescript: &cog.TypescriptConfig{}},
+		).
+		Types().
+		Builders().
+		Run(context.Background())
+	if err != nil {
+		panic(err)
+	}
+
+	for _, file := range generatedFS.AsFiles() {
+		fmt.Println(file.RelativePath)
+	}
+}
@@ -36,6 +36,16 @@ func PrefixObjectsNames(prefix string) compiler.Pass {
 	}
 }
 
+// ExternalTransformation delegates the transformation of schemas to the
+// given executable. Schemas are given as JSON on its standard input, and
+// expected as JSON on its standard output.
+func ExternalTransformation(command string, args ...string) compiler.Pass {
+	return &compiler.External{
+		Command: command,
+		Args:    args,
+	}
+}
+
 type CUEOption func(*codegen.CueInput)
 
 // ForceEnvelope decorates the parsed cue Value with an envelope whose
@@ -89,8 +99,9 @@ func (pipeline *SchemaToTypesPipeline) Run(ctx context.Context) ([]byte, error)
 		FinalPasses: pipeline.finalPasses,
 	}
 	codegenPipeline.Output = codegen.Output{
-		Types:     true,
-		Languages: []*codegen.OutputLanguage{pipeline.output},
+		Types:        true,
+		Languages:    []*codegen.OutputLanguage{pipeline.output},
+		SkipManifest: true,
 	}
 
 	// Run the codegen pipeline and return the generated file's content.
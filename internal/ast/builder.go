@@ -142,8 +142,9 @@ func (builders Builders) LocateByObject(pkg string, name string) (Builder, bool)
 
 type Option struct {
 	Name        string
-	Comments    []string `json:",omitempty"`
-	VeneerTrail []string `json:",omitempty"`
+	Comments    []string     `json:",omitempty"`
+	Deprecated  *Deprecation `json:",omitempty"`
+	VeneerTrail []string     `json:",omitempty"`
 	Args        []Argument
 	Assignments []Assignment
 	Default     *OptionDefault `json:",omitempty"`
@@ -153,6 +154,7 @@ func (opt *Option) DeepCopy() Option {
 	clone := Option{
 		Name:        opt.Name,
 		Comments:    make([]string, 0, len(opt.Comments)),
+		Deprecated:  opt.Deprecated.DeepCopy(),
 		VeneerTrail: make([]string, 0, len(opt.VeneerTrail)),
 		Args:        make([]Argument, 0, len(opt.Args)),
 		Assignments: make([]Assignment, 0, len(opt.Assignments)),
@@ -166,6 +168,11 @@ func (opt *Option) DeepCopy() Option {
 	for _, assignment := range opt.Assignments {
 		clone.Assignments = append(clone.Assignments, assignment.DeepCopy())
 	}
+	if opt.Default != nil {
+		clone.Default = &OptionDefault{
+			ArgsValues: append([]any{}, opt.Default.ArgsValues...),
+		}
+	}
 
 	return clone
 }
@@ -310,6 +317,7 @@ type AssignmentMethod string
 const (
 	DirectAssignment AssignmentMethod = "direct" // `foo = bar`
 	AppendAssignment AssignmentMethod = "append" // `foo = append(foo, bar)`
+	IndexAssignment  AssignmentMethod = "index"  // `foo[key] = bar`
 )
 
 type AssignmentNilCheck struct {
@@ -335,13 +343,16 @@ type Assignment struct {
 	// How
 	Method AssignmentMethod
 
+	// Key under which the value is set, for index assignments
+	Index *AssignmentValue `json:",omitempty"`
+
 	Constraints []AssignmentConstraint `json:",omitempty"`
 
 	NilChecks []AssignmentNilCheck `json:",omitempty"`
 }
 
 func (assignment *Assignment) DeepCopy() Assignment {
-	return Assignment{
+	clone := Assignment{
 		Path:   assignment.Path.DeepCopy(),
 		Value:  assignment.Value.DeepCopy(),
 		Method: assignment.Method,
@@ -352,6 +363,13 @@ func (assignment *Assignment) DeepCopy() Assignment {
 			return check.DeepCopy()
 		}),
 	}
+
+	if assignment.Index != nil {
+		index := assignment.Index.DeepCopy()
+		clone.Index = &index
+	}
+
+	return clone
 }
 
 type AssignmentConstraint struct {
@@ -388,6 +406,15 @@ func Method(method AssignmentMethod) AssignmentOpt {
 	}
 }
 
+// Index turns the assignment into an index assignment, setting the value
+// under the given key.
+func Index(key AssignmentValue) AssignmentOpt {
+	return func(assignment *Assignment) {
+		assignment.Method = IndexAssignment
+		assignment.Index = &key
+	}
+}
+
 func ConstantAssignment(path Path, value any, opts ...AssignmentOpt) Assignment {
 	assignment := Assignment{
 		Path: path,
@@ -517,8 +544,9 @@ func (generator *BuilderGenerator) fieldIsRefToConcrete(schemas Schemas, field S
 
 func (generator *BuilderGenerator) structFieldToOption(field StructField) Option {
 	opt := Option{
-		Name:     field.Name,
-		Comments: field.Comments,
+		Name:       field.Name,
+		Comments:   field.Comments,
+		Deprecated: field.Deprecated,
 		Args: []Argument{
 			{Name: field.Name, Type: field.Type},
 		},
@@ -6,7 +6,7 @@ import (
 	"github.com/grafana/cog/internal/ast"
 )
 
-var _ Pass = (*AddFields)(nil)
+var _ ReferencingPass = (*AddFields)(nil)
 
 // AddFields rewrites the definition of an object to add new fields.
 // Note: existing fields will not be overwritten.
@@ -23,6 +23,10 @@ func (pass *AddFields) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
 	return visitor.VisitSchemas(schemas)
 }
 
+func (pass *AddFields) References() []Reference {
+	return []Reference{pass.Object}
+}
+
 func (pass *AddFields) processObject(_ *Visitor, _ *ast.Schema, object ast.Object) (ast.Object, error) {
 	if !pass.Object.Matches(object) {
 		return object, nil
@@ -35,6 +35,12 @@ type AnonymousEnumToExplicitType struct {
 }
 
 func (pass *AnonymousEnumToExplicitType) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
+	// passes can be shared by languages generated concurrently: the state
+	// needed while processing schemas lives in a fresh copy of the pass.
+	return (&AnonymousEnumToExplicitType{}).process(schemas)
+}
+
+func (pass *AnonymousEnumToExplicitType) process(schemas []*ast.Schema) ([]*ast.Schema, error) {
 	for i, schema := range schemas {
 		newSchema, err := pass.processSchema(schema)
 		if err != nil {
@@ -35,6 +35,12 @@ type AnonymousStructsToNamed struct {
 }
 
 func (pass *AnonymousStructsToNamed) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
+	// passes can be shared by languages generated concurrently: the state
+	// needed while processing schemas lives in a fresh copy of the pass.
+	return (&AnonymousStructsToNamed{}).process(schemas)
+}
+
+func (pass *AnonymousStructsToNamed) process(schemas []*ast.Schema) ([]*ast.Schema, error) {
 	newSchemas := make([]*ast.Schema, 0, len(schemas))
 	for _, schema := range schemas {
 		newSchemas = append(newSchemas, pass.processSchema(schema))
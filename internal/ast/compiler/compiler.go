@@ -2,6 +2,7 @@ package compiler
 
 import (
 	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/trace"
 )
 
 type Passes []Pass
@@ -16,19 +17,54 @@ func (passes Passes) Concat(other Passes) Passes {
 }
 
 func (passes Passes) Process(schemas ast.Schemas) (ast.Schemas, error) {
+	return passes.ProcessWithTrace(schemas, nil)
+}
+
+// ProcessWithTrace behaves like Process, and records the changes made by
+// each pass with the given recorder.
+func (passes Passes) ProcessWithTrace(schemas ast.Schemas, recorder *trace.Recorder) (ast.Schemas, error) {
 	var err error
 	processedSchemas := schemas.DeepCopy()
 
 	for _, compilerPass := range passes {
+		// passes are free to modify the schemas they're given: a snapshot
+		// is needed to know what changed.
+		var before ast.Schemas
+		var unmatched []string
+		if recorder.Enabled() {
+			before = ast.Schemas(processedSchemas).DeepCopy()
+			unmatched = unmatchedReferences(compilerPass, before)
+		}
+
 		processedSchemas, err = compilerPass.Process(processedSchemas)
 		if err != nil {
 			return nil, err
 		}
+
+		recorder.RecordSchemas(trace.Name(compilerPass), before, processedSchemas, unmatched...)
 	}
 
 	return processedSchemas, nil
 }
 
+// unmatchedReferences lists the references of the given pass that point to
+// nothing in the given schemas.
+func unmatchedReferences(pass Pass, schemas ast.Schemas) []string {
+	referencing, ok := pass.(ReferencingPass)
+	if !ok {
+		return nil
+	}
+
+	var unmatched []string
+	for _, ref := range referencing.References() {
+		if !ref.FoundIn(schemas) {
+			unmatched = append(unmatched, ref.String())
+		}
+	}
+
+	return unmatched
+}
+
 type Pass interface {
 	Process(schemas []*ast.Schema) ([]*ast.Schema, error)
 }
@@ -0,0 +1,48 @@
+package compiler
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/testutils"
+	"github.com/grafana/cog/internal/trace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPasses_ProcessWithTrace_recordsUnmatchedReferences(t *testing.T) {
+	req := require.New(t)
+
+	schema := &ast.Schema{
+		Package: "pkg",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("pkg", "SomeObject", ast.NewStruct(
+				ast.NewStructField("AString", ast.String()),
+			)),
+		),
+	}
+
+	passes := Passes{
+		WithSource(&FieldsSetRequired{
+			Fields: []FieldReference{
+				{Package: "pkg", Object: "SomeObject", Field: "AString"},
+				{Package: "pkg", Object: "SomeObject", Field: "DoesNotExist"},
+			},
+		}, "passes.yaml:3"),
+		&Omit{
+			Objects: []ObjectReference{
+				{Package: "pkg", Object: "DoesNotExist"},
+			},
+		},
+	}
+
+	recorder := trace.NewRecorder()
+	_, err := passes.ProcessWithTrace(ast.Schemas{schema}, recorder)
+	req.NoError(err)
+
+	steps := recorder.Report().Steps
+	req.Len(steps, 2)
+	req.Equal("passes.yaml:3: FieldsSetRequired", steps[0].Name)
+	req.Equal([]string{"pkg.SomeObject.DoesNotExist"}, steps[0].Unmatched)
+	req.Equal("Omit", steps[1].Name)
+	req.Equal([]string{"pkg.DoesNotExist"}, steps[1].Unmatched)
+}
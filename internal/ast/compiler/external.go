@@ -0,0 +1,55 @@
+package compiler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/tools"
+)
+
+var _ Pass = (*External)(nil)
+
+// External delegates the transformation of schemas to an external
+// executable.
+// The schemas are written as JSON on the executable's standard input, and
+// the transformed schemas are read as JSON from its standard output.
+// Schemas returned by the executable are validated before being used.
+type External struct {
+	Command string
+	Args    []string
+
+	// Timeout optionally limits how long the command can run.
+	Timeout time.Duration
+
+	// Context optionally cancels the command, when the pipeline running
+	// the pass is cancelled for example.
+	Context context.Context
+}
+
+func (pass *External) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
+	ctx := pass.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
+
+	if pass.Timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, pass.Timeout)
+		defer cancel()
+	}
+
+	var processed ast.Schemas
+	if err := tools.RunJSONCommand(ctx, pass.Command, pass.Args, schemas, &processed); err != nil {
+		return nil, err
+	}
+
+	if err := processed.Validate(); err != nil {
+		return nil, fmt.Errorf("command '%s' returned invalid schemas: %w", pass.Command, err)
+	}
+
+	// deep copies initialize fields left empty by the JSON encoding, like
+	// hints maps.
+	return processed.DeepCopy(), nil
+}
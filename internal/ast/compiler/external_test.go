@@ -0,0 +1,99 @@
+package compiler
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func writeScript(t *testing.T, content string) string {
+	t.Helper()
+
+	script := filepath.Join(t.TempDir(), "pass.sh")
+	require.NoError(t, os.WriteFile(script, []byte("#!/bin/sh\n"+content+"\n"), 0700))
+
+	return script
+}
+
+func TestExternal(t *testing.T) {
+	// Prepare test input
+	schema := &ast.Schema{
+		Package: "external",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("external", "SomeObject", ast.NewStruct(
+				ast.NewStructField("Foo", ast.String()),
+			)),
+		),
+	}
+	expected := &ast.Schema{
+		Package: "external",
+		Objects: testutils.ObjectsMap(
+			ast.NewObject("external", "SomeObject", ast.NewStruct(
+				ast.NewStructField("Bar", ast.String()),
+			)),
+		),
+	}
+
+	pass := &External{
+		Command: writeScript(t, `sed 's/"Foo"/"Bar"/g'`),
+	}
+
+	// Run the compiler pass
+	runPassOnSchema(t, pass, schema, expected)
+}
+
+func TestExternal_withFailingCommand(t *testing.T) {
+	req := require.New(t)
+
+	pass := &External{
+		Command: writeScript(t, `echo "something went wrong" >&2; exit 1`),
+	}
+
+	_, err := pass.Process(ast.Schemas{ast.NewSchema("external", ast.SchemaMeta{})})
+	req.ErrorContains(err, "something went wrong")
+}
+
+func TestExternal_withTimeout(t *testing.T) {
+	req := require.New(t)
+
+	pass := &External{
+		Command: writeScript(t, `exec sleep 5`),
+		Timeout: 50 * time.Millisecond,
+	}
+
+	_, err := pass.Process(ast.Schemas{ast.NewSchema("external", ast.SchemaMeta{})})
+	req.ErrorIs(err, context.DeadlineExceeded)
+}
+
+func TestExternal_withCancelledContext(t *testing.T) {
+	req := require.New(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	pass := &External{
+		Command: writeScript(t, `exec sleep 5`),
+		Context: ctx,
+	}
+
+	_, err := pass.Process(ast.Schemas{ast.NewSchema("external", ast.SchemaMeta{})})
+	req.ErrorIs(err, context.Canceled)
+}
+
+func TestExternal_withInvalidSchemas(t *testing.T) {
+	req := require.New(t)
+
+	pass := &External{
+		Command: writeScript(t, `echo '[{"Package": "external", "Objects": {"Foo": {"Name": "Foo", "Type": {"Kind": "ref"}}}}]'`),
+	}
+
+	_, err := pass.Process(ast.Schemas{ast.NewSchema("external", ast.SchemaMeta{})})
+	req.ErrorContains(err, "returned invalid schemas")
+	req.ErrorContains(err, "external.Foo: missing definition for type of kind 'ref'")
+}
@@ -2,11 +2,12 @@ package compiler
 
 import (
 	"fmt"
+	"sort"
 
 	"github.com/grafana/cog/internal/ast"
 )
 
-var _ Pass = (*FieldsSetDefault)(nil)
+var _ ReferencingPass = (*FieldsSetDefault)(nil)
 
 // FieldsSetDefault sets the default value for the given fields.
 type FieldsSetDefault struct {
@@ -21,6 +22,19 @@ func (pass *FieldsSetDefault) Process(schemas []*ast.Schema) ([]*ast.Schema, err
 	return visitor.VisitSchemas(schemas)
 }
 
+func (pass *FieldsSetDefault) References() []Reference {
+	refs := make([]Reference, 0, len(pass.DefaultValues))
+	for ref := range pass.DefaultValues {
+		refs = append(refs, ref)
+	}
+
+	sort.Slice(refs, func(i, j int) bool {
+		return refs[i].String() < refs[j].String()
+	})
+
+	return refs
+}
+
 func (pass *FieldsSetDefault) processObject(_ *Visitor, _ *ast.Schema, object ast.Object) (ast.Object, error) {
 	if !object.Type.IsStruct() {
 		return object, nil
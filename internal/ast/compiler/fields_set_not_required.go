@@ -4,7 +4,7 @@ import (
 	"github.com/grafana/cog/internal/ast"
 )
 
-var _ Pass = (*FieldsSetNotRequired)(nil)
+var _ ReferencingPass = (*FieldsSetNotRequired)(nil)
 
 // FieldsSetNotRequired rewrites the definition of given fields to mark them as nullable and not required.
 type FieldsSetNotRequired struct {
@@ -19,6 +19,10 @@ func (pass *FieldsSetNotRequired) Process(schemas []*ast.Schema) ([]*ast.Schema,
 	return visitor.VisitSchemas(schemas)
 }
 
+func (pass *FieldsSetNotRequired) References() []Reference {
+	return asReferences(pass.Fields)
+}
+
 func (pass *FieldsSetNotRequired) processObject(_ *Visitor, _ *ast.Schema, object ast.Object) (ast.Object, error) {
 	if !object.Type.IsStruct() {
 		return object, nil
@@ -4,7 +4,7 @@ import (
 	"github.com/grafana/cog/internal/ast"
 )
 
-var _ Pass = (*FieldsSetRequired)(nil)
+var _ ReferencingPass = (*FieldsSetRequired)(nil)
 
 // FieldsSetRequired rewrites the definition of given fields to mark them as not nullable and required.
 type FieldsSetRequired struct {
@@ -19,6 +19,10 @@ func (pass *FieldsSetRequired) Process(schemas []*ast.Schema) ([]*ast.Schema, er
 	return visitor.VisitSchemas(schemas)
 }
 
+func (pass *FieldsSetRequired) References() []Reference {
+	return asReferences(pass.Fields)
+}
+
 func (pass *FieldsSetRequired) processObject(_ *Visitor, _ *ast.Schema, object ast.Object) (ast.Object, error) {
 	if !object.Type.IsStruct() {
 		return object, nil
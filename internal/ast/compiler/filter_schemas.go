@@ -6,7 +6,7 @@ import (
 	"github.com/grafana/cog/internal/tools"
 )
 
-var _ Pass = (*FilterSchemas)(nil)
+var _ ReferencingPass = (*FilterSchemas)(nil)
 
 // FilterSchemas filters a schema to only include the allowed objects and their
 // dependencies.
@@ -22,6 +22,10 @@ func (pass *FilterSchemas) Process(schemas []*ast.Schema) ([]*ast.Schema, error)
 	}), nil
 }
 
+func (pass *FilterSchemas) References() []Reference {
+	return asReferences(pass.AllowedObjects)
+}
+
 func (pass *FilterSchemas) processSchema(schema *ast.Schema, allowList *orderedmap.Map[string, struct{}]) *ast.Schema {
 	schema.Objects = schema.Objects.Filter(func(_ string, object ast.Object) bool {
 		return allowList.Has(object.SelfRef.String())
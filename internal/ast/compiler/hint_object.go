@@ -7,7 +7,7 @@ import (
 	"github.com/grafana/cog/internal/ast"
 )
 
-var _ Pass = (*HintObject)(nil)
+var _ ReferencingPass = (*HintObject)(nil)
 
 type HintObject struct {
 	Object ObjectReference
@@ -22,6 +22,10 @@ func (pass *HintObject) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
 	return visitor.VisitSchemas(schemas)
 }
 
+func (pass *HintObject) References() []Reference {
+	return []Reference{pass.Object}
+}
+
 func (pass *HintObject) processObject(_ *Visitor, _ *ast.Schema, object ast.Object) (ast.Object, error) {
 	if !pass.Object.Matches(object) {
 		return object, nil
@@ -44,6 +44,12 @@ type InlineObjectsWithTypes struct {
 }
 
 func (pass *InlineObjectsWithTypes) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
+	// passes can be shared by languages generated concurrently: the state
+	// needed while processing schemas lives in a fresh copy of the pass.
+	return (&InlineObjectsWithTypes{InlineTypes: pass.InlineTypes}).process(schemas)
+}
+
+func (pass *InlineObjectsWithTypes) process(schemas []*ast.Schema) ([]*ast.Schema, error) {
 	pass.objectsToInline = orderedmap.New[string, ast.Type]()
 
 	for _, schema := range schemas {
@@ -4,7 +4,7 @@ import (
 	"github.com/grafana/cog/internal/ast"
 )
 
-var _ Pass = (*NameAnonymousStruct)(nil)
+var _ ReferencingPass = (*NameAnonymousStruct)(nil)
 
 // NameAnonymousStruct rewrites the definition of a struct field typed as an
 // anonymous struct to instead refer to a named type.
@@ -21,6 +21,10 @@ func (pass *NameAnonymousStruct) Process(schemas []*ast.Schema) ([]*ast.Schema,
 	return schemas, nil
 }
 
+func (pass *NameAnonymousStruct) References() []Reference {
+	return []Reference{pass.Field}
+}
+
 func (pass *NameAnonymousStruct) processSchema(schema *ast.Schema) *ast.Schema {
 	var newObject ast.Object
 
@@ -4,7 +4,7 @@ import (
 	"github.com/grafana/cog/internal/ast"
 )
 
-var _ Pass = (*Omit)(nil)
+var _ ReferencingPass = (*Omit)(nil)
 
 // Omit rewrites schemas to omit the configured objects.
 type Omit struct {
@@ -19,6 +19,10 @@ func (pass *Omit) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
 	return schemas, nil
 }
 
+func (pass *Omit) References() []Reference {
+	return asReferences(pass.Objects)
+}
+
 func (pass *Omit) processSchema(schema *ast.Schema) *ast.Schema {
 	schema.Objects = schema.Objects.Filter(func(_ string, object ast.Object) bool {
 		// if any reference matches the current object, we filter it out
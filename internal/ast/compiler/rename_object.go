@@ -6,7 +6,7 @@ import (
 	"github.com/grafana/cog/internal/ast"
 )
 
-var _ Pass = (*RenameObject)(nil)
+var _ ReferencingPass = (*RenameObject)(nil)
 
 type RenameObject struct {
 	From ObjectReference
@@ -22,6 +22,10 @@ func (pass *RenameObject) Process(schemas []*ast.Schema) ([]*ast.Schema, error)
 	return visitor.VisitSchemas(schemas)
 }
 
+func (pass *RenameObject) References() []Reference {
+	return []Reference{pass.From}
+}
+
 func (pass *RenameObject) processObject(visitor *Visitor, schema *ast.Schema, object ast.Object) (ast.Object, error) {
 	var err error
 
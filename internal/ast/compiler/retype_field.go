@@ -6,7 +6,7 @@ import (
 	"github.com/grafana/cog/internal/ast"
 )
 
-var _ Pass = (*RetypeField)(nil)
+var _ ReferencingPass = (*RetypeField)(nil)
 
 type RetypeField struct {
 	Field    FieldReference
@@ -22,6 +22,10 @@ func (pass *RetypeField) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
 	return visitor.VisitSchemas(schemas)
 }
 
+func (pass *RetypeField) References() []Reference {
+	return []Reference{pass.Field}
+}
+
 func (pass *RetypeField) processObject(_ *Visitor, _ *ast.Schema, object ast.Object) (ast.Object, error) {
 	if !object.Type.IsStruct() {
 		return object, nil
@@ -6,7 +6,7 @@ import (
 	"github.com/grafana/cog/internal/ast"
 )
 
-var _ Pass = (*RetypeObject)(nil)
+var _ ReferencingPass = (*RetypeObject)(nil)
 
 type RetypeObject struct {
 	Object   ObjectReference
@@ -22,6 +22,10 @@ func (pass *RetypeObject) Process(schemas []*ast.Schema) ([]*ast.Schema, error)
 	return visitor.VisitSchemas(schemas)
 }
 
+func (pass *RetypeObject) References() []Reference {
+	return []Reference{pass.Object}
+}
+
 func (pass *RetypeObject) processObject(_ *Visitor, _ *ast.Schema, object ast.Object) (ast.Object, error) {
 	if !pass.Object.Matches(object) {
 		return object, nil
@@ -0,0 +1,45 @@
+package compiler
+
+import (
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/trace"
+)
+
+var _ ReferencingPass = (*sourcedPass)(nil)
+
+// WithSource decorates a pass with a description of where it was defined.
+// ie: the path to a configuration file and a line number.
+func WithSource(pass Pass, source string) Pass {
+	return &sourcedPass{pass: pass, source: source}
+}
+
+// Unwrap returns the pass decorated by WithSource, if any.
+func Unwrap(pass Pass) Pass {
+	if sourced, ok := pass.(*sourcedPass); ok {
+		return Unwrap(sourced.pass)
+	}
+
+	return pass
+}
+
+type sourcedPass struct {
+	pass   Pass
+	source string
+}
+
+func (pass *sourcedPass) Process(schemas []*ast.Schema) ([]*ast.Schema, error) {
+	return pass.pass.Process(schemas)
+}
+
+func (pass *sourcedPass) References() []Reference {
+	if referencing, ok := pass.pass.(ReferencingPass); ok {
+		return referencing.References()
+	}
+
+	return nil
+}
+
+// TraceName describes the pass in traces.
+func (pass *sourcedPass) TraceName() string {
+	return pass.source + ": " + trace.Name(pass.pass)
+}
@@ -7,6 +7,23 @@ import (
 	"github.com/grafana/cog/internal/ast"
 )
 
+// Reference points to a part of the schemas targeted by a compiler pass.
+type Reference interface {
+	fmt.Stringer
+
+	// FoundIn tells whether the reference points to something defined in
+	// the given schemas.
+	FoundIn(schemas ast.Schemas) bool
+}
+
+// ReferencingPass is implemented by passes targeting objects or fields
+// given by reference.
+type ReferencingPass interface {
+	Pass
+
+	References() []Reference
+}
+
 type ObjectReference struct {
 	Package string
 	Object  string
@@ -16,6 +33,10 @@ func (ref ObjectReference) Matches(object ast.Object) bool {
 	return object.SelfRef.ReferredPkg == ref.Package && strings.EqualFold(object.Name, ref.Object)
 }
 
+func (ref ObjectReference) FoundIn(schemas ast.Schemas) bool {
+	return anyObject(schemas, ref.Matches)
+}
+
 func (ref ObjectReference) String() string {
 	return fmt.Sprintf("%s.%s", ref.Package, ref.Object)
 }
@@ -44,6 +65,26 @@ func (ref FieldReference) Matches(object ast.Object, field ast.StructField) bool
 		strings.EqualFold(field.Name, ref.Field)
 }
 
+func (ref FieldReference) FoundIn(schemas ast.Schemas) bool {
+	return anyObject(schemas, func(object ast.Object) bool {
+		if !object.Type.IsStruct() {
+			return false
+		}
+
+		for _, field := range object.Type.AsStruct().Fields {
+			if ref.Matches(object, field) {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+func (ref FieldReference) String() string {
+	return fmt.Sprintf("%s.%s.%s", ref.Package, ref.Object, ref.Field)
+}
+
 func FieldReferenceFromString(ref string) (FieldReference, error) {
 	parts := strings.Split(ref, ".")
 	if len(parts) != 3 {
@@ -56,3 +97,27 @@ func FieldReferenceFromString(ref string) (FieldReference, error) {
 		Field:   parts[2],
 	}, nil
 }
+
+func anyObject(schemas ast.Schemas, predicate func(object ast.Object) bool) bool {
+	for _, schema := range schemas {
+		found := false
+		schema.Objects.Iterate(func(_ string, object ast.Object) {
+			found = found || predicate(object)
+		})
+
+		if found {
+			return true
+		}
+	}
+
+	return false
+}
+
+func asReferences[T Reference](refs []T) []Reference {
+	result := make([]Reference, 0, len(refs))
+	for _, ref := range refs {
+		result = append(result, ref)
+	}
+
+	return result
+}
@@ -88,8 +88,56 @@ func (visitor *Visitor) VisitSchema(schema *ast.Schema) (*ast.Schema, error) {
 
 		newSchema.AddObject(obj)
 	})
+	if err != nil {
+		return &newSchema, err
+	}
+
+	for i, operation := range schema.Operations {
+		newSchema.Operations[i], err = visitor.VisitOperation(schema, operation)
+		if err != nil {
+			return &newSchema, errors.Join(
+				fmt.Errorf("could not process operation '%s'", operation.Name),
+				err,
+			)
+		}
+	}
+
+	return &newSchema, nil
+}
+
+// VisitOperation visits the types used by the parameters, request body and
+// responses of the given operation.
+func (visitor *Visitor) VisitOperation(schema *ast.Schema, operation ast.Operation) (ast.Operation, error) {
+	var err error
+
+	operation = operation.DeepCopy()
+
+	for i, param := range operation.Params {
+		operation.Params[i].Type, err = visitor.VisitType(schema, param.Type)
+		if err != nil {
+			return ast.Operation{}, err
+		}
+	}
+
+	if operation.RequestBody != nil {
+		operation.RequestBody.Type, err = visitor.VisitType(schema, operation.RequestBody.Type)
+		if err != nil {
+			return ast.Operation{}, err
+		}
+	}
+
+	for _, response := range operation.Responses {
+		if response.Type == nil {
+			continue
+		}
+
+		*response.Type, err = visitor.VisitType(schema, *response.Type)
+		if err != nil {
+			return ast.Operation{}, err
+		}
+	}
 
-	return &newSchema, err
+	return operation, nil
 }
 
 func (visitor *Visitor) VisitObject(schema *ast.Schema, object ast.Object) (ast.Object, error) {
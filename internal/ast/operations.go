@@ -0,0 +1,160 @@
+package ast
+
+import (
+	"strings"
+)
+
+type ParamLocation string
+
+const (
+	ParamInPath   ParamLocation = "path"
+	ParamInQuery  ParamLocation = "query"
+	ParamInHeader ParamLocation = "header"
+)
+
+// Operation describes an HTTP operation exposed by an API.
+// ie: `GET /api/dashboards/uid/{uid}`
+type Operation struct {
+	Name       string
+	Method     string
+	Path       string
+	Comments   []string     `json:",omitempty"`
+	Deprecated *Deprecation `json:",omitempty"`
+
+	Params      []OperationParam    `json:",omitempty"`
+	RequestBody *OperationBody      `json:",omitempty"`
+	Responses   []OperationResponse `json:",omitempty"`
+}
+
+type OperationParam struct {
+	Name     string
+	In       ParamLocation
+	Type     Type
+	Required bool
+	Comments []string `json:",omitempty"`
+}
+
+type OperationBody struct {
+	ContentType string
+	Type        Type
+	Required    bool
+}
+
+type OperationResponse struct {
+	// StatusCode is 0 for the default response.
+	StatusCode  int
+	ContentType string   `json:",omitempty"`
+	Comments    []string `json:",omitempty"`
+	// Type is nil if the response has no body, or a body that isn't JSON.
+	Type *Type `json:",omitempty"`
+}
+
+// ParamsIn returns the operation's parameters defined in the given location.
+func (operation Operation) ParamsIn(location ParamLocation) []OperationParam {
+	params := make([]OperationParam, 0, len(operation.Params))
+	for _, param := range operation.Params {
+		if param.In == location {
+			params = append(params, param)
+		}
+	}
+
+	return params
+}
+
+// SuccessResponse returns the first response with a 2XX status code.
+func (operation Operation) SuccessResponse() (OperationResponse, bool) {
+	for _, response := range operation.Responses {
+		if response.StatusCode >= 200 && response.StatusCode < 300 {
+			return response, true
+		}
+	}
+
+	return OperationResponse{}, false
+}
+
+func (operation Operation) DeepCopy() Operation {
+	newOperation := Operation{
+		Name:       operation.Name,
+		Method:     operation.Method,
+		Path:       operation.Path,
+		Comments:   append([]string(nil), operation.Comments...),
+		Deprecated: operation.Deprecated.DeepCopy(),
+	}
+
+	for _, param := range operation.Params {
+		newOperation.Params = append(newOperation.Params, OperationParam{
+			Name:     param.Name,
+			In:       param.In,
+			Type:     param.Type.DeepCopy(),
+			Required: param.Required,
+			Comments: append([]string(nil), param.Comments...),
+		})
+	}
+
+	if operation.RequestBody != nil {
+		newOperation.RequestBody = &OperationBody{
+			ContentType: operation.RequestBody.ContentType,
+			Type:        operation.RequestBody.Type.DeepCopy(),
+			Required:    operation.RequestBody.Required,
+		}
+	}
+
+	for _, response := range operation.Responses {
+		newResponse := OperationResponse{
+			StatusCode:  response.StatusCode,
+			ContentType: response.ContentType,
+			Comments:    append([]string(nil), response.Comments...),
+		}
+
+		if response.Type != nil {
+			responseType := response.Type.DeepCopy()
+			newResponse.Type = &responseType
+		}
+
+		newOperation.Responses = append(newOperation.Responses, newResponse)
+	}
+
+	return newOperation
+}
+
+// OperationPathSegment is a segment of an operation's path: either a
+// literal string, or a reference to a path parameter.
+type OperationPathSegment struct {
+	Literal string
+	Param   string
+}
+
+// PathSegments splits the operation's path into literal segments and
+// references to path parameters.
+// ie: `/dashboards/uid/{uid}` → [`/dashboards/uid/`, {uid}]
+func (operation Operation) PathSegments() []OperationPathSegment {
+	var segments []OperationPathSegment
+
+	path := operation.Path
+	for path != "" {
+		start := strings.Index(path, "{")
+		end := strings.Index(path, "}")
+		if start == -1 || end < start {
+			segments = append(segments, OperationPathSegment{Literal: path})
+			break
+		}
+
+		if start > 0 {
+			segments = append(segments, OperationPathSegment{Literal: path[:start]})
+		}
+
+		segments = append(segments, OperationPathSegment{Param: path[start+1 : end]})
+		path = path[end+1:]
+	}
+
+	return segments
+}
+
+// IsJSONContentType tells whether the given content type describes JSON documents.
+// ie: `application/json`, `application/problem+json`
+func IsJSONContentType(contentType string) bool {
+	mediaType, _, _ := strings.Cut(contentType, ";")
+	mediaType = strings.TrimSpace(strings.ToLower(mediaType))
+
+	return mediaType == "application/json" || strings.HasSuffix(mediaType, "+json")
+}
@@ -0,0 +1,56 @@
+package ast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestOperation_PathSegments(t *testing.T) {
+	testCases := []struct {
+		path     string
+		expected []OperationPathSegment
+	}{
+		{
+			path:     "/dashboards",
+			expected: []OperationPathSegment{{Literal: "/dashboards"}},
+		},
+		{
+			path: "/dashboards/uid/{uid}",
+			expected: []OperationPathSegment{
+				{Literal: "/dashboards/uid/"},
+				{Param: "uid"},
+			},
+		},
+		{
+			path: "/orgs/{orgId}/users/{userId}/roles",
+			expected: []OperationPathSegment{
+				{Literal: "/orgs/"},
+				{Param: "orgId"},
+				{Literal: "/users/"},
+				{Param: "userId"},
+				{Literal: "/roles"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+
+		t.Run(tc.path, func(t *testing.T) {
+			operation := Operation{Path: tc.path}
+
+			require.Equal(t, tc.expected, operation.PathSegments())
+		})
+	}
+}
+
+func TestIsJSONContentType(t *testing.T) {
+	req := require.New(t)
+
+	req.True(IsJSONContentType("application/json"))
+	req.True(IsJSONContentType("application/json; charset=utf-8"))
+	req.True(IsJSONContentType("application/problem+json"))
+	req.False(IsJSONContentType("text/plain"))
+	req.False(IsJSONContentType("application/octet-stream"))
+}
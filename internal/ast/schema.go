@@ -66,13 +66,20 @@ func (schemas Schemas) LocateObjectByRef(ref RefType) (Object, bool) {
 
 func (schemas Schemas) Consolidate() (Schemas, error) {
 	byPackage := make(map[string]Schemas, len(schemas))
+	packages := make([]string, 0, len(schemas))
 
 	for _, schema := range schemas {
+		if _, found := byPackage[schema.Package]; !found {
+			packages = append(packages, schema.Package)
+		}
+
 		byPackage[schema.Package] = append(byPackage[schema.Package], schema)
 	}
 
+	// packages are consolidated in the order they first appear in
 	newSchemas := make([]*Schema, 0, len(schemas))
-	for pkg, groupedSchemas := range byPackage {
+	for _, pkg := range packages {
+		groupedSchemas := byPackage[pkg]
 		newSchema := NewSchema(pkg, groupedSchemas[0].Metadata)
 		for _, schema := range groupedSchemas {
 			if err := newSchema.Merge(schema); err != nil {
@@ -103,6 +110,9 @@ type Schema struct { //nolint: musttag
 	EntryPoint     string     `json:",omitempty"`
 	EntryPointType Type       `json:",omitempty"`
 	Objects        *orderedmap.Map[string, Object]
+
+	// Operations lists the HTTP operations described by the schema, if any.
+	Operations []Operation `json:",omitempty"`
 }
 
 func NewSchema(pkg string, metadata SchemaMeta) *Schema {
@@ -160,6 +170,14 @@ func (schema *Schema) Merge(other *Schema) error {
 		return err
 	}
 
+	for _, operation := range other.Operations {
+		if _, found := schema.LocateOperation(operation.Name); found {
+			continue
+		}
+
+		schema.Operations = append(schema.Operations, operation)
+	}
+
 	return nil
 }
 
@@ -172,9 +190,33 @@ func (schema *Schema) DeepCopy() Schema {
 		Objects: schema.Objects.Map(func(_ string, object Object) Object {
 			return object.DeepCopy()
 		}),
+		Operations: copyOperations(schema.Operations),
 	}
 }
 
+func copyOperations(operations []Operation) []Operation {
+	if operations == nil {
+		return nil
+	}
+
+	newOperations := make([]Operation, 0, len(operations))
+	for _, operation := range operations {
+		newOperations = append(newOperations, operation.DeepCopy())
+	}
+
+	return newOperations
+}
+
+func (schema *Schema) LocateOperation(name string) (Operation, bool) {
+	for _, operation := range schema.Operations {
+		if operation.Name == name {
+			return operation, true
+		}
+	}
+
+	return Operation{}, false
+}
+
 func (schema *Schema) LocateObject(name string) (Object, bool) {
 	if !schema.Objects.Has(name) {
 		return Object{}, false
@@ -59,6 +59,8 @@ const (
 	LessThanEqualOp    Op = "<="
 	GreaterThanOp      Op = ">"
 	GreaterThanEqualOp Op = ">="
+	PatternOp          Op = "=~"
+	NotPatternOp       Op = "!~"
 )
 
 type TypeConstraint struct {
@@ -484,7 +486,8 @@ func (t Type) AsComposableSlot() ComposableSlotType {
 // named declaration of a type
 type Object struct {
 	Name        string
-	Comments    []string `json:",omitempty"`
+	Comments    []string     `json:",omitempty"`
+	Deprecated  *Deprecation `json:",omitempty"`
 	Type        Type
 	SelfRef     RefType
 	PassesTrail []string `json:",omitempty"`
@@ -509,6 +512,7 @@ func (object *Object) AddToPassesTrail(trail string) {
 func (object Object) Equal(other Object) bool {
 	return object.Name == other.Name &&
 		cmp.Equal(object.Comments, other.Comments) &&
+		cmp.Equal(object.Deprecated, other.Deprecated) &&
 		cmp.Equal(object.Type, other.Type) &&
 		cmp.Equal(object.SelfRef, other.SelfRef) &&
 		cmp.Equal(object.PassesTrail, other.PassesTrail)
@@ -516,9 +520,10 @@ func (object Object) Equal(other Object) bool {
 
 func (object Object) DeepCopy() Object {
 	newObject := Object{
-		Name:    object.Name,
-		Type:    object.Type.DeepCopy(),
-		SelfRef: object.SelfRef.DeepCopy(),
+		Name:       object.Name,
+		Deprecated: object.Deprecated.DeepCopy(),
+		Type:       object.Type.DeepCopy(),
+		SelfRef:    object.SelfRef.DeepCopy(),
 	}
 
 	newObject.PassesTrail = append(newObject.PassesTrail, object.PassesTrail...)
@@ -527,6 +532,31 @@ func (object Object) DeepCopy() Object {
 	return newObject
 }
 
+// Deprecation marks an object, a field or an option as deprecated.
+type Deprecation struct {
+	// Message optionally explains why the element is deprecated, and what
+	// should be used instead.
+	Message string `json:",omitempty"`
+}
+
+func (deprecation *Deprecation) DeepCopy() *Deprecation {
+	if deprecation == nil {
+		return nil
+	}
+
+	return &Deprecation{Message: deprecation.Message}
+}
+
+// Notice returns a human-readable deprecation notice, falling back to a
+// generic one when no message was given.
+func (deprecation *Deprecation) Notice() string {
+	if deprecation.Message != "" {
+		return deprecation.Message
+	}
+
+	return "this element is deprecated and might be removed in a future version."
+}
+
 type Types []Type
 
 func (types Types) HasOnlyScalarOrArrayOrMap() bool {
@@ -703,7 +733,8 @@ func (structType StructType) FieldByName(name string) (StructField, bool) {
 
 type StructField struct {
 	Name        string
-	Comments    []string `json:",omitempty"`
+	Comments    []string     `json:",omitempty"`
+	Deprecated  *Deprecation `json:",omitempty"`
 	Type        Type
 	Required    bool
 	PassesTrail []string `json:",omitempty"`
@@ -715,9 +746,10 @@ func (structField *StructField) AddToPassesTrail(trail string) {
 
 func (structField StructField) DeepCopy() StructField {
 	newT := StructField{
-		Name:     structField.Name,
-		Type:     structField.Type.DeepCopy(),
-		Required: structField.Required,
+		Name:       structField.Name,
+		Deprecated: structField.Deprecated.DeepCopy(),
+		Type:       structField.Type.DeepCopy(),
+		Required:   structField.Required,
 	}
 
 	newT.Comments = append(newT.Comments, structField.Comments...)
@@ -740,6 +772,12 @@ func Comments(comments []string) StructFieldOption {
 	}
 }
 
+func Deprecated(message string) StructFieldOption {
+	return func(field *StructField) {
+		field.Deprecated = &Deprecation{Message: message}
+	}
+}
+
 func PassesTrail(trail string) StructFieldOption {
 	return func(field *StructField) {
 		field.PassesTrail = append(field.PassesTrail, trail)
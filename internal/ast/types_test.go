@@ -160,3 +160,20 @@ func TestArrayType_IsArrayOfScalars(t *testing.T) {
 		})
 	}
 }
+
+func TestObject_Equal_deprecation(t *testing.T) {
+	req := require.New(t)
+
+	object := NewObject("pkg", "Foo", NewStruct(NewStructField("bar", NewScalar(KindString))))
+
+	deprecatedObject := object.DeepCopy()
+	deprecatedObject.Deprecated = &Deprecation{Message: "use Baz instead"}
+
+	deprecatedField := object.DeepCopy()
+	deprecatedField.Type.Struct.Fields[0].Deprecated = &Deprecation{}
+
+	req.True(object.Equal(object.DeepCopy()))
+	req.True(deprecatedObject.Equal(deprecatedObject.DeepCopy()))
+	req.False(object.Equal(deprecatedObject))
+	req.False(object.Equal(deprecatedField))
+}
@@ -0,0 +1,230 @@
+package ast
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Validate checks that the schemas are well-formed: every type has a known
+// kind and the definition matching it, objects are consistently named and
+// references to objects from known packages can be resolved.
+// It is meant to be used on schemas that weren't built by cog itself.
+func (schemas Schemas) Validate() error {
+	var errs []error
+
+	packages := make(map[string]bool, len(schemas))
+	for i, schema := range schemas {
+		if schema == nil {
+			errs = append(errs, fmt.Errorf("schemas[%d]: schema is null", i))
+			continue
+		}
+		if schema.Package == "" {
+			errs = append(errs, fmt.Errorf("schemas[%d]: missing package", i))
+		}
+		if packages[schema.Package] {
+			errs = append(errs, fmt.Errorf("schemas[%d]: duplicate package '%s'", i, schema.Package))
+		}
+
+		packages[schema.Package] = true
+	}
+
+	for _, schema := range schemas {
+		if schema == nil {
+			continue
+		}
+
+		if schema.Objects == nil {
+			errs = append(errs, fmt.Errorf("%s: missing objects", schema.Package))
+			continue
+		}
+
+		schema.Objects.Iterate(func(key string, object Object) {
+			location := schema.Package + "." + key
+
+			if object.Name != key {
+				errs = append(errs, fmt.Errorf("%s: object name '%s' does not match its key", location, object.Name))
+			}
+			if object.SelfRef != (RefType{}) && (object.SelfRef.ReferredPkg != schema.Package || object.SelfRef.ReferredType != object.Name) {
+				errs = append(errs, fmt.Errorf("%s: self reference '%s' does not match the object", location, object.SelfRef))
+			}
+
+			errs = append(errs, schemas.validateType(location, object.Type)...)
+		})
+	}
+
+	return errors.Join(errs...)
+}
+
+// Validate checks that the builders are well-formed: they are named, and
+// the types of their arguments and properties are valid.
+// See Schemas.Validate.
+func (builders Builders) Validate(schemas Schemas) error {
+	var errs []error
+
+	for i, builder := range builders {
+		location := fmt.Sprintf("builders[%d]", i)
+		if builder.Name != "" {
+			location = builder.Package + "." + builder.Name
+		}
+
+		if builder.Package == "" {
+			errs = append(errs, fmt.Errorf("%s: missing package", location))
+		}
+		if builder.Name == "" {
+			errs = append(errs, fmt.Errorf("%s: missing name", location))
+		}
+
+		errs = append(errs, schemas.validateType(location+".For", builder.For.Type)...)
+
+		for _, property := range builder.Properties {
+			errs = append(errs, schemas.validateType(location+".Properties."+property.Name, property.Type)...)
+		}
+
+		errs = append(errs, schemas.validateArguments(location+".Constructor", builder.Constructor.Args)...)
+		errs = append(errs, validateAssignments(location+".Constructor", builder.Constructor.Assignments)...)
+
+		for j, option := range builder.Options {
+			optionLocation := location + "." + option.Name
+			if option.Name == "" {
+				errs = append(errs, fmt.Errorf("%s.Options[%d]: missing name", location, j))
+				optionLocation = fmt.Sprintf("%s.Options[%d]", location, j)
+			}
+
+			errs = append(errs, schemas.validateArguments(optionLocation, option.Args)...)
+			errs = append(errs, validateAssignments(optionLocation, option.Assignments)...)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func (schemas Schemas) validateArguments(location string, args []Argument) []error {
+	var errs []error
+
+	for i, arg := range args {
+		if arg.Name == "" {
+			errs = append(errs, fmt.Errorf("%s.Args[%d]: missing name", location, i))
+		}
+
+		errs = append(errs, schemas.validateType(fmt.Sprintf("%s.Args[%d]", location, i), arg.Type)...)
+	}
+
+	return errs
+}
+
+func validateAssignments(location string, assignments []Assignment) []error {
+	var errs []error
+
+	for i, assignment := range assignments {
+		if len(assignment.Path) == 0 {
+			errs = append(errs, fmt.Errorf("%s.Assignments[%d]: missing path", location, i))
+		}
+	}
+
+	return errs
+}
+
+//nolint:gocyclo,cyclop
+func (schemas Schemas) validateType(location string, def Type) []error {
+	var errs []error
+
+	missingDefinition := func() []error {
+		return []error{fmt.Errorf("%s: missing definition for type of kind '%s'", location, def.Kind)}
+	}
+
+	switch def.Kind {
+	case KindScalar:
+		if def.Scalar == nil {
+			return missingDefinition()
+		}
+
+		if !isKnownScalarKind(def.Scalar.ScalarKind) {
+			errs = append(errs, fmt.Errorf("%s: unknown scalar kind '%s'", location, def.Scalar.ScalarKind))
+		}
+	case KindRef:
+		if def.Ref == nil {
+			return missingDefinition()
+		}
+
+		// references to packages that aren't part of the schemas can't
+		// be checked.
+		if _, found := schemas.Locate(def.Ref.ReferredPkg); !found {
+			break
+		}
+
+		if _, found := schemas.LocateObjectByRef(*def.Ref); !found {
+			errs = append(errs, fmt.Errorf("%s: unresolvable reference to '%s'", location, def.Ref))
+		}
+	case KindStruct:
+		if def.Struct == nil {
+			return missingDefinition()
+		}
+
+		for i, field := range def.Struct.Fields {
+			fieldLocation := location + "." + field.Name
+			if field.Name == "" {
+				fieldLocation = fmt.Sprintf("%s.Fields[%d]", location, i)
+				errs = append(errs, fmt.Errorf("%s: missing name", fieldLocation))
+			}
+
+			errs = append(errs, schemas.validateType(fieldLocation, field.Type)...)
+		}
+	case KindEnum:
+		if def.Enum == nil {
+			return missingDefinition()
+		}
+
+		if len(def.Enum.Values) == 0 {
+			errs = append(errs, fmt.Errorf("%s: enum without values", location))
+		}
+	case KindMap:
+		if def.Map == nil {
+			return missingDefinition()
+		}
+
+		errs = append(errs, schemas.validateType(location+"[index]", def.Map.IndexType)...)
+		errs = append(errs, schemas.validateType(location+"[value]", def.Map.ValueType)...)
+	case KindArray:
+		if def.Array == nil {
+			return missingDefinition()
+		}
+
+		errs = append(errs, schemas.validateType(location+"[]", def.Array.ValueType)...)
+	case KindDisjunction:
+		if def.Disjunction == nil {
+			return missingDefinition()
+		}
+
+		for i, branch := range def.Disjunction.Branches {
+			errs = append(errs, schemas.validateType(fmt.Sprintf("%s|%d", location, i), branch)...)
+		}
+	case KindIntersection:
+		if def.Intersection == nil {
+			return missingDefinition()
+		}
+
+		for i, branch := range def.Intersection.Branches {
+			errs = append(errs, schemas.validateType(fmt.Sprintf("%s&%d", location, i), branch)...)
+		}
+	case KindComposableSlot:
+		if def.ComposableSlot == nil {
+			return missingDefinition()
+		}
+	default:
+		errs = append(errs, fmt.Errorf("%s: unknown kind '%s'", location, def.Kind))
+	}
+
+	return errs
+}
+
+func isKnownScalarKind(kind ScalarKind) bool {
+	switch kind {
+	case KindNull, KindAny, KindBytes, KindString, KindBool,
+		KindFloat32, KindFloat64,
+		KindUint8, KindUint16, KindUint32, KindUint64,
+		KindInt8, KindInt16, KindInt32, KindInt64:
+		return true
+	default:
+		return false
+	}
+}
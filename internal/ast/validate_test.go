@@ -0,0 +1,71 @@
+package ast
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/orderedmap"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSchemas_Validate(t *testing.T) {
+	objects := orderedmap.New[string, Object]()
+	objects.Set("Dashboard", NewObject("pkg", "Dashboard", NewStruct(
+		NewStructField("panels", NewArray(NewRef("pkg", "Panel"))),
+		NewStructField("owner", NewRef("other", "User")),
+	)))
+	objects.Set("Panel", NewObject("pkg", "Panel", NewStruct(
+		NewStructField("id", NewScalar(KindInt64)),
+	)))
+
+	require.NoError(t, Schemas{&Schema{Package: "pkg", Objects: objects}}.Validate())
+}
+
+func TestSchemas_Validate_withInvalidSchemas(t *testing.T) {
+	req := require.New(t)
+
+	objects := orderedmap.New[string, Object]()
+	objects.Set("Dashboard", NewObject("pkg", "Dashboard", NewStruct(
+		NewStructField("panels", NewArray(NewRef("pkg", "Panel"))),
+		NewStructField("title", Type{Kind: KindScalar}),
+		NewStructField("", NewScalar("text")),
+	)))
+	objects.Set("Renamed", NewObject("pkg", "Panel", Type{Kind: "unknown"}))
+
+	err := Schemas{&Schema{Package: "pkg", Objects: objects}, &Schema{Package: "pkg"}}.Validate()
+	req.Error(err)
+	req.Equal(`schemas[1]: duplicate package 'pkg'
+pkg.Dashboard.panels[]: unresolvable reference to 'pkg.Panel'
+pkg.Dashboard.title: missing definition for type of kind 'scalar'
+pkg.Dashboard.Fields[2]: missing name
+pkg.Dashboard.Fields[2]: unknown scalar kind 'text'
+pkg.Renamed: object name 'Panel' does not match its key
+pkg.Renamed: unknown kind 'unknown'
+pkg: missing objects`, err.Error())
+}
+
+func TestBuilders_Validate(t *testing.T) {
+	req := require.New(t)
+
+	object := NewObject("pkg", "Dashboard", NewStruct(NewStructField("title", String())))
+	schemas := Schemas{&Schema{Package: "pkg", Objects: orderedmap.New[string, Object]()}}
+	schemas[0].AddObject(object)
+
+	builders := Builders{
+		{
+			For:     object,
+			Package: "pkg",
+			Name:    "Dashboard",
+			Options: []Option{
+				{Name: "title", Args: []Argument{{Name: "title", Type: String()}}, Assignments: []Assignment{{}}},
+				{Args: []Argument{{Type: NewRef("pkg", "Unknown")}}},
+			},
+		},
+	}
+
+	err := builders.Validate(schemas)
+	req.Error(err)
+	req.Equal(`pkg.Dashboard.title.Assignments[0]: missing path
+pkg.Dashboard.Options[1]: missing name
+pkg.Dashboard.Options[1].Args[0]: missing name
+pkg.Dashboard.Options[1].Args[0]: unresolvable reference to 'pkg.Unknown'`, err.Error())
+}
@@ -0,0 +1,333 @@
+package codegen
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/gob"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"hash"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"runtime/debug"
+	"sort"
+	"sync"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/ast/compiler"
+	"gopkg.in/yaml.v3"
+)
+
+//nolint:gochecknoinits
+func init() {
+	// types that can be found in `any` fields of the AST: default and
+	// constant values, constraint arguments or jennies hints.
+	gob.Register([]any{})
+	gob.Register(map[string]any{})
+	gob.Register(json.Number(""))
+	gob.Register(ast.DisjunctionType{})
+}
+
+// cacheable is implemented by inputs whose parsed schemas can be cached.
+type cacheable interface {
+	watchable
+
+	// cacheable tells whether the parsed schemas only depend on the input's
+	// configuration and on the files it reads: the ones returned by
+	// watchedPaths() and the ones recorded while loading.
+	cacheable() bool
+}
+
+func (input *JSONSchemaInput) cacheable() bool {
+	return input.URL == ""
+}
+
+func (input *KindRegistryInput) cacheable() bool {
+	return true
+}
+
+func (input *CueInput) cacheable() bool {
+	return input.Value == nil && input.NameFunc == nil
+}
+
+// schemasCache stores parsed inputs and the result of compiler passes on
+// disk. Entries are addressed by a hash of everything known to affect them
+// beforehand: the cog version, the configuration, and the content of the
+// files it references. Files only discovered while loading (referenced
+// schemas, libraries, …) are stored with the entry and checked when it is
+// loaded.
+// A nil schemasCache is valid and doesn't store anything.
+type schemasCache struct {
+	directory string
+}
+
+func (cache *schemasCache) enabled() bool {
+	// without a way to identify the version of cog, entries could be
+	// reused by another version.
+	return cache != nil && cogVersion() != ""
+}
+
+// cacheEntry is what the cache stores under a key.
+type cacheEntry struct {
+	Schemas ast.Schemas
+
+	// Files maps the files read to produce the schemas to a hash of their
+	// content. The entry is stale as soon as one of them changes.
+	Files map[string]string
+}
+
+func (cache *schemasCache) entryPath(key string) string {
+	return filepath.Join(cache.directory, "schemas", key[:2], key+".gob")
+}
+
+// load returns the entry stored under the given key, if any.
+// Unreadable or stale entries are considered as missing.
+func (cache *schemasCache) load(key string) (cacheEntry, bool) {
+	if !cache.enabled() || key == "" {
+		return cacheEntry{}, false
+	}
+
+	content, err := os.ReadFile(cache.entryPath(key))
+	if err != nil {
+		return cacheEntry{}, false
+	}
+
+	var entry cacheEntry
+	if err := gob.NewDecoder(bytes.NewReader(content)).Decode(&entry); err != nil {
+		return cacheEntry{}, false
+	}
+
+	if !unchangedFiles(entry.Files) {
+		return cacheEntry{}, false
+	}
+
+	return entry, true
+}
+
+// store saves the given entry under the given key. Failing to do so isn't
+// an error: the cache is only an optimization.
+func (cache *schemasCache) store(key string, entry cacheEntry) {
+	if !cache.enabled() || key == "" {
+		return
+	}
+
+	buffer := bytes.Buffer{}
+	if err := gob.NewEncoder(&buffer).Encode(entry); err != nil {
+		return
+	}
+
+	path := cache.entryPath(key)
+	if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+		return
+	}
+
+	// entries are written atomically: several languages can store the
+	// same one concurrently.
+	tmpFile, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+	if err != nil {
+		return
+	}
+	defer func() { _ = os.Remove(tmpFile.Name()) }()
+
+	_, err = tmpFile.Write(buffer.Bytes())
+	if closeErr := tmpFile.Close(); err != nil || closeErr != nil {
+		return
+	}
+
+	_ = os.Rename(tmpFile.Name(), path)
+}
+
+// inputCacheKey returns the key under which the schemas parsed by the given
+// input are cached, or an empty string if they can't be.
+func (cache *schemasCache) inputCacheKey(input *Input) (string, error) {
+	if !cache.enabled() {
+		return "", nil
+	}
+
+	loader, err := input.loader()
+	if err != nil {
+		return "", err
+	}
+
+	cacheableLoader, ok := loader.(cacheable)
+	if !ok || !cacheableLoader.cacheable() {
+		return "", nil
+	}
+
+	if transformableLoader, ok := loader.(transformable); ok {
+		// the passes are only inspected, not run
+		passes, err := transformableLoader.commonPasses(context.Background())
+		if err != nil {
+			return "", err
+		}
+
+		if hasExternalPasses(passes) {
+			return "", nil
+		}
+	}
+
+	config, err := yaml.Marshal(input)
+	if err != nil {
+		return "", err
+	}
+
+	hasher := newCacheKeyHasher("input")
+	hasher.write(config)
+	if err := hasher.writeFiles(cacheableLoader.watchedPaths()); err != nil {
+		return "", err
+	}
+
+	return hasher.sum(), nil
+}
+
+// commonPassesCacheKey returns the key under which the result of the common
+// compiler passes is cached, or an empty string if it can't be.
+func (cache *schemasCache) commonPassesCacheKey(pipeline *Pipeline, schemasKey string) (string, error) {
+	// passes given as code can't be hashed
+	if !cache.enabled() || schemasKey == "" || pipeline.Transforms.CommonPasses != nil {
+		return "", nil
+	}
+
+	// the passes are only inspected, not run
+	passes, err := pipeline.commonPasses(context.Background())
+	if err != nil {
+		return "", err
+	}
+
+	if hasExternalPasses(passes) {
+		return "", nil
+	}
+
+	hasher := newCacheKeyHasher("common_passes")
+	hasher.write([]byte(schemasKey))
+	if err := hasher.writeFiles(pipeline.Transforms.CommonPassesFiles); err != nil {
+		return "", err
+	}
+
+	return hasher.sum(), nil
+}
+
+// hasExternalPasses tells whether some of the given passes delegate to
+// external executables, whose behavior can't be hashed.
+func hasExternalPasses(passes compiler.Passes) bool {
+	for _, pass := range passes {
+		if _, ok := compiler.Unwrap(pass).(*compiler.External); ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+type cacheKeyHasher struct {
+	hash hash.Hash
+}
+
+func newCacheKeyHasher(kind string) *cacheKeyHasher {
+	hasher := &cacheKeyHasher{hash: sha256.New()}
+	hasher.write([]byte(cogVersion()))
+	hasher.write([]byte(kind))
+
+	return hasher
+}
+
+// write adds the given value to the hash, prefixed by its length to avoid
+// collisions between consecutive values.
+func (hasher *cacheKeyHasher) write(value []byte) {
+	_, _ = fmt.Fprintf(hasher.hash, "%d:", len(value))
+	_, _ = hasher.hash.Write(value)
+}
+
+// writeFiles adds the path and content of the given files to the hash.
+// Directories are walked recursively.
+func (hasher *cacheKeyHasher) writeFiles(paths []string) error {
+	var files []string
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !entry.IsDir() {
+				files = append(files, file)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	sort.Strings(files)
+
+	for _, file := range files {
+		content, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+
+		hasher.write([]byte(file))
+		hasher.write(content)
+	}
+
+	return nil
+}
+
+func (hasher *cacheKeyHasher) sum() string {
+	return hex.EncodeToString(hasher.hash.Sum(nil))
+}
+
+//nolint:gochecknoglobals
+var cogVersion = sync.OnceValue(func() string {
+	buildInfo, ok := debug.ReadBuildInfo()
+	if !ok {
+		return executableHash()
+	}
+
+	// development builds can't be identified by their version alone
+	if buildInfo.Main.Version == "" || buildInfo.Main.Version == "(devel)" || isModified(buildInfo) {
+		hash := executableHash()
+		if hash == "" {
+			return ""
+		}
+
+		return buildInfo.Main.Version + "+" + hash
+	}
+
+	return buildInfo.Main.Version
+})
+
+func isModified(buildInfo *debug.BuildInfo) bool {
+	for _, setting := range buildInfo.Settings {
+		if setting.Key == "vcs.modified" {
+			return setting.Value == "true"
+		}
+	}
+
+	return false
+}
+
+func executableHash() string {
+	executable, err := os.Executable()
+	if err != nil {
+		return ""
+	}
+
+	file, err := os.Open(executable)
+	if err != nil {
+		return ""
+	}
+	defer func() { _ = file.Close() }()
+
+	hasher := sha256.New()
+	if _, err := io.Copy(hasher, file); err != nil {
+		return ""
+	}
+
+	return hex.EncodeToString(hasher.Sum(nil))
+}
@@ -0,0 +1,211 @@
+package codegen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"cuelang.org/go/cue"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func cacheEntries(t *testing.T, cacheDir string) []string {
+	t.Helper()
+
+	entries, err := filepath.Glob(filepath.Join(cacheDir, "schemas", "*", "*.gob"))
+	require.NoError(t, err)
+
+	return entries
+}
+
+func TestPipeline_loadSchemas_cachesInputs(t *testing.T) {
+	req := require.New(t)
+
+	dir := writeFiles(t, map[string]string{
+		"dashboard.json": `{"type": "object", "properties": {"refresh": {"type": "integer", "default": 30}}}`,
+	})
+	cacheDir := t.TempDir()
+
+	pipeline, err := NewPipeline()
+	req.NoError(err)
+	CacheDirectory(cacheDir)(pipeline)
+	pipeline.Inputs = []*Input{
+		{JSONSchema: &JSONSchemaInput{Path: filepath.Join(dir, "dashboard.json"), Package: "dashboard"}},
+	}
+
+	// cache miss
+	parsed, key, err := pipeline.loadSchemas(context.Background(), nil)
+	req.NoError(err)
+	req.NotEmpty(key)
+	req.Len(cacheEntries(t, cacheDir), 1)
+
+	// cache hit: the schemas are the same, down to the type of values
+	cached, cachedKey, err := pipeline.loadSchemas(context.Background(), nil)
+	req.NoError(err)
+	req.Equal(key, cachedKey)
+	req.Equal(parsed[0].Objects.Values(), cached[0].Objects.Values())
+
+	parsedField, _ := parsed[0].Objects.At(0).Type.AsStruct().FieldByName("refresh")
+	cachedField, _ := cached[0].Objects.At(0).Type.AsStruct().FieldByName("refresh")
+	req.NotNil(cachedField.Type.Default)
+	req.IsType(parsedField.Type.Default, cachedField.Type.Default)
+
+	// modifying the input invalidates the cache
+	req.NoError(os.WriteFile(filepath.Join(dir, "dashboard.json"), []byte(`{"type": "object", "properties": {"title": {"type": "string"}}}`), 0600))
+
+	updated, updatedKey, err := pipeline.loadSchemas(context.Background(), nil)
+	req.NoError(err)
+	req.NotEqual(key, updatedKey)
+	req.Len(cacheEntries(t, cacheDir), 2)
+
+	_, found := updated[0].Objects.At(0).Type.AsStruct().FieldByName("title")
+	req.True(found)
+}
+
+func TestPipeline_loadSchemas_invalidatesReferencedFiles(t *testing.T) {
+	req := require.New(t)
+
+	dir := writeFiles(t, map[string]string{
+		"main/panel.json":   `{"type": "object", "properties": {"unit": {"$ref": "../common/types.json#/$defs/Unit"}}}`,
+		"common/types.json": `{"$defs": {"Unit": {"type": "string"}}}`,
+	})
+
+	pipeline, err := NewPipeline()
+	req.NoError(err)
+	CacheDirectory(t.TempDir())(pipeline)
+	pipeline.Inputs = []*Input{
+		{JSONSchema: &JSONSchemaInput{Path: filepath.Join(dir, "main", "panel.json"), Package: "panel"}},
+	}
+
+	parsed, key, err := pipeline.loadSchemas(context.Background(), nil)
+	req.NoError(err)
+	unit, found := parsed.LocateObject("common", "Unit")
+	req.True(found)
+	req.Equal(ast.KindString, unit.Type.AsScalar().ScalarKind)
+
+	// the referenced file isn't part of the input's configuration
+	req.NoError(os.WriteFile(filepath.Join(dir, "common", "types.json"), []byte(`{"$defs": {"Unit": {"type": "integer"}}}`), 0600))
+
+	updated, updatedKey, err := pipeline.loadSchemas(context.Background(), nil)
+	req.NoError(err)
+	req.NotEqual(key, updatedKey)
+	unit, found = updated.LocateObject("common", "Unit")
+	req.True(found)
+	req.Equal(ast.KindInt64, unit.Type.AsScalar().ScalarKind)
+}
+
+func TestPipeline_loadSchemas_invalidatesCueImports(t *testing.T) {
+	req := require.New(t)
+
+	dir := writeFiles(t, map[string]string{
+		"dashboard/dashboard.cue": "package dashboard\n\nimport \"example.com/common\"\n\nDashboard: {\n\tunit: common.Unit\n}\n",
+		"common/common.cue":       "package common\n\nUnit: string\n",
+	})
+
+	pipeline, err := NewPipeline()
+	req.NoError(err)
+	CacheDirectory(t.TempDir())(pipeline)
+	pipeline.Inputs = []*Input{
+		{Cue: &CueInput{
+			Entrypoint: filepath.Join(dir, "dashboard"),
+			CueImports: []string{filepath.Join(dir, "common") + ":example.com/common"},
+		}},
+	}
+
+	_, key, err := pipeline.loadSchemas(context.Background(), nil)
+	req.NoError(err)
+
+	req.NoError(os.WriteFile(filepath.Join(dir, "common", "common.cue"), []byte("package common\n\nUnit: int64\n"), 0600))
+
+	_, updatedKey, err := pipeline.loadSchemas(context.Background(), nil)
+	req.NoError(err)
+	req.NotEqual(key, updatedKey)
+}
+
+func TestPipeline_loadSchemas_skipsUncacheableInputs(t *testing.T) {
+	req := require.New(t)
+
+	cacheDir := t.TempDir()
+
+	pipeline, err := NewPipeline()
+	req.NoError(err)
+	CacheDirectory(cacheDir)(pipeline)
+	pipeline.Inputs = []*Input{
+		{Cue: &CueInput{Package: "dashboard", NameFunc: func(_ cue.Value, _ cue.Path) string { return "" }}},
+	}
+
+	key, err := pipeline.cache.inputCacheKey(pipeline.Inputs[0])
+	req.NoError(err)
+	req.Empty(key)
+}
+
+func TestPipeline_loadSchemas_skipsInputsWithExternalPasses(t *testing.T) {
+	req := require.New(t)
+
+	dir := writeFiles(t, map[string]string{
+		"dashboard.json": `{"type": "object", "properties": {"refresh": {"type": "integer"}}}`,
+		"passes.yaml":    "passes:\n  - external: { command: ./pass }\n",
+	})
+
+	pipeline, err := NewPipeline()
+	req.NoError(err)
+	CacheDirectory(t.TempDir())(pipeline)
+	pipeline.Inputs = []*Input{
+		{JSONSchema: &JSONSchemaInput{
+			InputBase: InputBase{Transforms: []string{filepath.Join(dir, "passes.yaml")}},
+			Path:      filepath.Join(dir, "dashboard.json"),
+			Package:   "dashboard",
+		}},
+	}
+
+	key, err := pipeline.cache.inputCacheKey(pipeline.Inputs[0])
+	req.NoError(err)
+	req.Empty(key)
+}
+
+func TestSchemasCache_storeAndLoad(t *testing.T) {
+	req := require.New(t)
+
+	cache := &schemasCache{directory: t.TempDir()}
+
+	schema := ast.NewSchema("pkg", ast.SchemaMeta{})
+	schema.AddObject(ast.NewObject("pkg", "Limit", ast.NewScalar(ast.KindInt64, ast.Default(int64(1000000)))))
+	schema.AddObject(ast.NewObject("pkg", "Tags", ast.NewArray(ast.String(), ast.Default([]any{"a", "b"}))))
+
+	_, found := cache.load("abcdef")
+	req.False(found)
+
+	cache.store("abcdef", cacheEntry{Schemas: ast.Schemas{schema}})
+
+	entry, found := cache.load("abcdef")
+	req.True(found)
+
+	loaded := entry.Schemas
+	req.Len(loaded, 1)
+	req.Equal(int64(1000000), loaded[0].Objects.Get("Limit").Type.Default)
+	req.Equal([]any{"a", "b"}, loaded[0].Objects.Get("Tags").Type.Default)
+	req.Equal([]string{"Limit", "Tags"}, []string{loaded[0].Objects.At(0).Name, loaded[0].Objects.At(1).Name})
+}
+
+func TestSchemasCache_loadIgnoresStaleEntries(t *testing.T) {
+	req := require.New(t)
+
+	dir := writeFiles(t, map[string]string{
+		"types.json": `{}`,
+	})
+	cache := &schemasCache{directory: t.TempDir()}
+
+	cache.store("abcdef", cacheEntry{Files: map[string]string{
+		filepath.Join(dir, "types.json"): contentHash([]byte(`{}`)),
+	}})
+
+	_, found := cache.load("abcdef")
+	req.True(found)
+
+	req.NoError(os.WriteFile(filepath.Join(dir, "types.json"), []byte(`{"type": "string"}`), 0600))
+
+	_, found = cache.load("abcdef")
+	req.False(found)
+}
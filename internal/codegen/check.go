@@ -0,0 +1,279 @@
+package codegen
+
+import (
+	"bytes"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+type DriftStatus string
+
+const (
+	// DriftModified means that the file on disk differs from the generated one.
+	DriftModified DriftStatus = "modified"
+
+	// DriftMissing means that a generated file doesn't exist on disk.
+	DriftMissing DriftStatus = "missing"
+
+	// DriftExtra means that a file exists on disk, but isn't generated
+	// anymore.
+	DriftExtra DriftStatus = "extra"
+)
+
+// FileDrift describes a difference between generated code and what is
+// on disk.
+type FileDrift struct {
+	Path   string
+	Status DriftStatus
+
+	// Diff is a unified diff going from the file on disk to the
+	// generated one.
+	Diff string
+}
+
+// OutputDirectories lists the directories in which code is generated for
+// each language.
+func (pipeline *Pipeline) OutputDirectories() ([]string, error) {
+	targetsByLanguage, err := pipeline.outputLanguages()
+	if err != nil {
+		return nil, err
+	}
+
+	directories := make([]string, 0, len(targetsByLanguage))
+	for language := range targetsByLanguage {
+		directory, err := pipeline.languageOutputDir(pipeline.currentDirectory, language)
+		if err != nil {
+			return nil, err
+		}
+
+		directories = append(directories, directory)
+	}
+
+	return uniquePaths(directories), nil
+}
+
+// Drift compares the given generated files with what is on disk, under the
+// given prefix. In output directories holding a manifest, files existing on
+// disk but not generated are only reported if they are listed in the
+// manifest previously written next to them. Without a manifest to rely on,
+// every file found on disk in an output directory but not generated is
+// reported.
+func Drift(generatedFS *codejen.FS, prefix string, outputDirectories []string) ([]FileDrift, error) {
+	var drifts []FileDrift
+	generated := make(map[string]struct{})
+
+	for _, file := range generatedFS.AsFiles() {
+		path := filepath.Clean(file.RelativePath)
+		generated[path] = struct{}{}
+
+		existing, err := os.ReadFile(filepath.Join(prefix, path))
+		if os.IsNotExist(err) {
+			drifts = append(drifts, FileDrift{
+				Path:   path,
+				Status: DriftMissing,
+				Diff:   unifiedDiff("/dev/null", "b/"+path, nil, file.Data),
+			})
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		if bytes.Equal(existing, file.Data) {
+			continue
+		}
+
+		drifts = append(drifts, FileDrift{
+			Path:   path,
+			Status: DriftModified,
+			Diff:   unifiedDiff("a/"+path, "b/"+path, existing, file.Data),
+		})
+	}
+
+	// files that aren't generated anymore are found using the manifests
+	// written by previous runs: files written by hand next to generated
+	// code are left alone.
+	for _, file := range generatedFS.AsFiles() {
+		if filepath.Base(file.RelativePath) != ManifestFile {
+			continue
+		}
+
+		extraDrifts, err := manifestExtraFiles(prefix, filepath.Clean(file.RelativePath), generated)
+		if err != nil {
+			return nil, err
+		}
+
+		drifts = append(drifts, extraDrifts...)
+	}
+
+	// without manifests (see Output.SkipManifest), the output directories
+	// are expected to only contain generated code.
+	for _, directory := range outputDirectories {
+		if _, found := generated[filepath.Join(filepath.Clean(directory), ManifestFile)]; found {
+			continue
+		}
+
+		extraDrifts, err := unlistedExtraFiles(prefix, filepath.Clean(directory), generated)
+		if err != nil {
+			return nil, err
+		}
+
+		drifts = append(drifts, extraDrifts...)
+	}
+
+	sort.SliceStable(drifts, func(i, j int) bool {
+		return drifts[i].Path < drifts[j].Path
+	})
+
+	return drifts, nil
+}
+
+// manifestExtraFiles reports the files listed in the manifest found on disk
+// at the given path that aren't generated anymore. Reported files are added
+// to the generated ones: manifests can be nested, and each file must only be
+// reported once.
+func manifestExtraFiles(prefix string, manifestPath string, generated map[string]struct{}) ([]FileDrift, error) {
+	content, err := os.ReadFile(filepath.Join(prefix, manifestPath))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	previous, err := unmarshalManifest(content)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", manifestPath, err)
+	}
+
+	listedPaths := make([]string, 0, len(previous.Files))
+	for listedPath := range previous.Files {
+		// don't trust manifests pointing outside of their directory
+		if !filepath.IsLocal(filepath.FromSlash(listedPath)) {
+			continue
+		}
+
+		listedPaths = append(listedPaths, listedPath)
+	}
+	sort.Strings(listedPaths)
+
+	var drifts []FileDrift
+	for _, listedPath := range listedPaths {
+		path := filepath.Join(filepath.Dir(manifestPath), filepath.FromSlash(listedPath))
+		if _, found := generated[path]; found {
+			continue
+		}
+
+		existing, err := os.ReadFile(filepath.Join(prefix, path))
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		generated[path] = struct{}{}
+		drifts = append(drifts, FileDrift{
+			Path:   path,
+			Status: DriftExtra,
+			Diff:   unifiedDiff("a/"+path, "/dev/null", existing, nil),
+		})
+	}
+
+	return drifts, nil
+}
+
+// unlistedExtraFiles reports the files found on disk in the given directory
+// that aren't generated. Reported files are added to the generated ones:
+// output directories can be nested, and each file must only be reported once.
+func unlistedExtraFiles(prefix string, directory string, generated map[string]struct{}) ([]FileDrift, error) {
+	var drifts []FileDrift
+
+	root := filepath.Join(prefix, directory)
+
+	err := filepath.WalkDir(root, func(fullPath string, entry fs.DirEntry, err error) error {
+		if os.IsNotExist(err) {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			if entry.Name() == ".git" {
+				return filepath.SkipDir
+			}
+
+			return nil
+		}
+
+		relativePath, err := filepath.Rel(root, fullPath)
+		if err != nil {
+			return err
+		}
+
+		path := filepath.Join(directory, relativePath)
+
+		if _, found := generated[path]; found {
+			return nil
+		}
+
+		existing, err := os.ReadFile(fullPath)
+		if err != nil {
+			return err
+		}
+
+		generated[path] = struct{}{}
+		drifts = append(drifts, FileDrift{
+			Path:   path,
+			Status: DriftExtra,
+			Diff:   unifiedDiff("a/"+path, "/dev/null", existing, nil),
+		})
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return drifts, nil
+}
+
+func unifiedDiff(fromFile string, toFile string, from []byte, to []byte) string {
+	diff, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        splitLines(from),
+		B:        splitLines(to),
+		FromFile: fromFile,
+		ToFile:   toFile,
+		Context:  3,
+	})
+	if err != nil {
+		// can only happen when writing to the underlying buffer fails
+		return ""
+	}
+
+	return diff
+}
+
+func splitLines(content []byte) []string {
+	if len(content) == 0 {
+		return nil
+	}
+
+	lines := strings.SplitAfter(string(content), "\n")
+	if lines[len(lines)-1] == "" {
+		return lines[:len(lines)-1]
+	}
+
+	// keep the diff readable when the file doesn't end with a newline
+	lines[len(lines)-1] += "\n"
+
+	return lines
+}
@@ -0,0 +1,129 @@
+package codegen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grafana/codejen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDrift(t *testing.T) {
+	req := require.New(t)
+
+	dir := writeFiles(t, map[string]string{
+		"go/unchanged.go": "package unchanged\n",
+		"go/modified.go":  "package modified\n\nconst Value = 1\n",
+		"go/extra.go":     "package extra\n",
+		"go/helpers.go":   "package handwritten\n",
+		"go/" + ManifestFile: `{"files": {
+  "unchanged.go": "",
+  "modified.go": "",
+  "extra.go": "",
+  "extra_and_removed.go": "",
+  "../README.md": ""
+}}`,
+		"README.md": "not in an output directory\n",
+	})
+
+	manifest, err := Manifest{Files: map[string]string{
+		"unchanged.go": "",
+		"modified.go":  "",
+		"missing.go":   "",
+	}}.marshal()
+	req.NoError(err)
+
+	generatedFS := codejen.NewFS()
+	req.NoError(generatedFS.Add(
+		*codejen.NewFile("go/unchanged.go", []byte("package unchanged\n"), nil),
+		*codejen.NewFile("go/modified.go", []byte("package modified\n\nconst Value = 2\n"), nil),
+		*codejen.NewFile("go/missing.go", []byte("package missing\n"), nil),
+		*codejen.NewFile("go/"+ManifestFile, manifest, nil),
+	))
+
+	drifts, err := Drift(generatedFS, dir, []string{"go"})
+	req.NoError(err)
+	req.Len(drifts, 4)
+
+	// helpers.go is written by hand: only files listed in the previous manifest are reported
+	req.Equal(filepath.Join("go", ManifestFile), drifts[0].Path)
+	req.Equal(DriftModified, drifts[0].Status)
+	drifts = drifts[1:]
+
+	extraPath := filepath.Join("go", "extra.go")
+	req.Equal(FileDrift{
+		Path:   extraPath,
+		Status: DriftExtra,
+		Diff: `--- a/` + extraPath + `
++++ /dev/null
+@@ -1 +0,0 @@
+-package extra
+`,
+	}, drifts[0])
+
+	missingPath := filepath.Join("go", "missing.go")
+	req.Equal(FileDrift{
+		Path:   missingPath,
+		Status: DriftMissing,
+		Diff: `--- /dev/null
++++ b/` + missingPath + `
+@@ -0,0 +1 @@
++package missing
+`,
+	}, drifts[1])
+
+	modifiedPath := filepath.Join("go", "modified.go")
+	req.Equal(FileDrift{
+		Path:   modifiedPath,
+		Status: DriftModified,
+		Diff: "--- a/" + modifiedPath + "\n" +
+			"+++ b/" + modifiedPath + "\n" +
+			"@@ -1,3 +1,3 @@\n" +
+			" package modified\n" +
+			" \n" +
+			"-const Value = 1\n" +
+			"+const Value = 2\n",
+	}, drifts[2])
+
+	// nothing was written
+	_, err = os.Stat(filepath.Join(dir, missingPath))
+	req.True(os.IsNotExist(err))
+}
+
+func TestDrift_withoutManifest(t *testing.T) {
+	req := require.New(t)
+
+	dir := writeFiles(t, map[string]string{
+		"go/unchanged.go":         "package unchanged\n",
+		"go/extra.go":             "package extra\n",
+		"go/nested/extra.go":      "package nested\n",
+		"go/.git/HEAD":            "ref: refs/heads/main\n",
+		"typescript/unchanged.ts": "export {};\n",
+		"README.md":               "not in an output directory\n",
+	})
+
+	generatedFS := codejen.NewFS()
+	req.NoError(generatedFS.Add(
+		*codejen.NewFile("go/unchanged.go", []byte("package unchanged\n"), nil),
+		*codejen.NewFile("typescript/unchanged.ts", []byte("export {};\n"), nil),
+	))
+
+	drifts, err := Drift(generatedFS, dir, []string{"go", "typescript", "python"})
+	req.NoError(err)
+
+	// no manifest to rely on: every file in the output directories that isn't generated is extra
+	paths := make([]string, 0, len(drifts))
+	for _, drift := range drifts {
+		req.Equal(DriftExtra, drift.Status)
+		paths = append(paths, drift.Path)
+	}
+	req.Equal([]string{filepath.Join("go", "extra.go"), filepath.Join("go", "nested", "extra.go")}, paths)
+
+	extraPath := filepath.Join("go", "extra.go")
+	req.Equal(`--- a/`+extraPath+`
++++ /dev/null
+@@ -1 +0,0 @@
+-package extra
+`, drifts[0].Diff)
+}
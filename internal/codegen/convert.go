@@ -0,0 +1,89 @@
+package codegen
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/languages"
+)
+
+// Converter turns JSON documents into code that reproduces them using the
+// builders generated for a language.
+type Converter struct {
+	language languages.CodeConverter
+	context  languages.Context
+}
+
+// Converter returns a Converter for the given language, relying on the
+// builders as they are once veneers have been applied.
+func (pipeline *Pipeline) Converter(ctx context.Context, language string) (*Converter, error) {
+	targetsByLanguage, err := pipeline.outputLanguages()
+	if err != nil {
+		return nil, err
+	}
+
+	target, found := targetsByLanguage[language]
+	if !found {
+		return nil, fmt.Errorf("language '%s' is not configured as an output of the pipeline", language)
+	}
+
+	codeConverter, ok := target.(languages.CodeConverter)
+	if !ok {
+		return nil, fmt.Errorf("language '%s' does not support converting values to code", language)
+	}
+
+	jenniesInput, err := pipeline.Inspect(ctx, language, StageVeneers)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Converter{
+		language: codeConverter,
+		context:  jenniesInput,
+	}, nil
+}
+
+// Convert returns code reproducing the given JSON document, expected to
+// describe an instance of the given object.
+// Parts of the document that can not be reproduced using builders are
+// reported as warnings.
+func (converter *Converter) Convert(object string, input []byte) (string, []string, error) {
+	objectRef, err := parseObjectRef(object)
+	if err != nil {
+		return "", nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(input))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return "", nil, fmt.Errorf("could not decode input: %w", err)
+	}
+
+	call, warnings, err := languages.NewConverter(converter.context).Convert(objectRef, value)
+	if err != nil {
+		return "", nil, err
+	}
+
+	code, err := converter.language.ToCode(converter.context, call)
+	if err != nil {
+		return "", nil, err
+	}
+
+	return code, warnings, nil
+}
+
+// parseObjectRef parses references to objects written as `pkg.Name`.
+func parseObjectRef(object string) (ast.RefType, error) {
+	pkg, name, found := strings.Cut(object, ".")
+	if !found || pkg == "" || name == "" {
+		return ast.RefType{}, fmt.Errorf("invalid object reference '%s': expected 'pkg.Name'", object)
+	}
+
+	return ast.RefType{ReferredPkg: pkg, ReferredType: name}, nil
+}
@@ -20,11 +20,11 @@ import (
 
 type genericCueLoader struct {
 	*CueInput
-	loader func(input CueInput) (ast.Schemas, error)
+	loader func(ctx context.Context, input CueInput) (ast.Schemas, error)
 }
 
-func (loader *genericCueLoader) LoadSchemas(_ context.Context) (ast.Schemas, error) {
-	return loader.loader(*loader.CueInput)
+func (loader *genericCueLoader) LoadSchemas(ctx context.Context) (ast.Schemas, error) {
+	return loader.loader(ctx, *loader.CueInput)
 }
 
 type CueInput struct {
@@ -61,7 +61,7 @@ func (input *CueInput) packageName() string {
 	return filepath.Base(input.Entrypoint)
 }
 
-func (input *CueInput) schemaRootValue(cuePkgName string) (cue.Value, []simplecue.LibraryInclude, error) {
+func (input *CueInput) schemaRootValue(ctx context.Context, cuePkgName string) (cue.Value, []simplecue.LibraryInclude, error) {
 	if input.Value != nil {
 		return *input.Value, nil, nil
 	}
@@ -75,7 +75,7 @@ func (input *CueInput) schemaRootValue(cuePkgName string) (cue.Value, []simplecu
 		cuePkgName = filepath.Base(input.Entrypoint)
 	}
 
-	value, err := parseCueEntrypoint(input.Entrypoint, libraries, cuePkgName)
+	value, err := parseCueEntrypoint(ctx, input.Entrypoint, libraries, cuePkgName)
 	if err != nil {
 		return cue.Value{}, nil, err
 	}
@@ -90,8 +90,8 @@ func (input *CueInput) interpolateParameters(interpolator ParametersInterpolator
 	input.CueImports = tools.Map(input.CueImports, interpolator)
 }
 
-func cueLoader(input CueInput) (ast.Schemas, error) {
-	schemaRootValue, libraries, err := input.schemaRootValue("")
+func cueLoader(ctx context.Context, input CueInput) (ast.Schemas, error) {
+	schemaRootValue, libraries, err := input.schemaRootValue(ctx, "")
 	if err != nil {
 		return nil, err
 	}
@@ -110,8 +110,8 @@ func cueLoader(input CueInput) (ast.Schemas, error) {
 	return input.filterSchema(schema)
 }
 
-func parseCueEntrypoint(entrypoint string, imports []simplecue.LibraryInclude, expectedCuePkgName string) (cue.Value, error) {
-	cueFsOverlay, err := buildCueOverlay(imports, entrypoint, expectedCuePkgName)
+func parseCueEntrypoint(ctx context.Context, entrypoint string, imports []simplecue.LibraryInclude, expectedCuePkgName string) (cue.Value, error) {
+	cueFsOverlay, err := buildCueOverlay(ctx, imports, entrypoint, expectedCuePkgName)
 	if err != nil {
 		return cue.Value{}, err
 	}
@@ -130,14 +130,14 @@ func parseCueEntrypoint(entrypoint string, imports []simplecue.LibraryInclude, e
 	return value, nil
 }
 
-func buildCueOverlay(imports []simplecue.LibraryInclude, entrypoint string, expectedCuePkgName string) (map[string]load.Source, error) {
+func buildCueOverlay(ctx context.Context, imports []simplecue.LibraryInclude, entrypoint string, expectedCuePkgName string) (map[string]load.Source, error) {
 	mockKindsysFS := buildMockKindsysFS()
-	libFs, err := buildBaseFSWithLibraries(imports)
+	libFs, err := buildBaseFSWithLibraries(ctx, imports)
 	if err != nil {
 		return nil, err
 	}
 
-	entrypointFS, err := dirToPrefixedFS(entrypoint, expectedCuePkgName)
+	entrypointFS, err := dirToPrefixedFS(ctx, entrypoint, expectedCuePkgName)
 	if err != nil {
 		return nil, err
 	}
@@ -175,7 +175,7 @@ Custom: {
 	}
 }
 
-func buildBaseFSWithLibraries(imports []simplecue.LibraryInclude) ([]fs.FS, error) {
+func buildBaseFSWithLibraries(ctx context.Context, imports []simplecue.LibraryInclude) ([]fs.FS, error) {
 	var librariesFS []fs.FS
 	for _, importDefinition := range imports {
 		absPath, err := filepath.Abs(importDefinition.FSPath)
@@ -183,7 +183,7 @@ func buildBaseFSWithLibraries(imports []simplecue.LibraryInclude) ([]fs.FS, erro
 			return nil, err
 		}
 
-		libraryFS, err := dirToPrefixedFS(absPath, "cue.mod/pkg/"+importDefinition.ImportPath)
+		libraryFS, err := dirToPrefixedFS(ctx, absPath, "cue.mod/pkg/"+importDefinition.ImportPath)
 		if err != nil {
 			return nil, err
 		}
@@ -194,7 +194,7 @@ func buildBaseFSWithLibraries(imports []simplecue.LibraryInclude) ([]fs.FS, erro
 	return librariesFS, nil
 }
 
-func dirToPrefixedFS(directory string, prefix string) (fs.FS, error) {
+func dirToPrefixedFS(ctx context.Context, directory string, prefix string) (fs.FS, error) {
 	dirHandle, err := os.ReadDir(directory)
 	if err != nil {
 		return nil, err
@@ -206,7 +206,7 @@ func dirToPrefixedFS(directory string, prefix string) (fs.FS, error) {
 			continue
 		}
 
-		content, err := os.ReadFile(filepath.Join(directory, file.Name()))
+		content, err := readLoadedFile(ctx, filepath.Join(directory, file.Name()))
 		if err != nil {
 			return nil, err
 		}
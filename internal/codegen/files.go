@@ -0,0 +1,228 @@
+package codegen
+
+import (
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/grafana/cog/internal/tools"
+)
+
+// PackageAnnotation is the root-level schema annotation used to
+// name a file's package with the `annotation` package naming strategy.
+const PackageAnnotation = "x-cog-package"
+
+type PackageNaming string
+
+const (
+	// PackageFromFilename uses the name of each file, without its extension.
+	PackageFromFilename PackageNaming = "filename"
+
+	// PackageFromDirectory uses the name of the directory containing each file.
+	PackageFromDirectory PackageNaming = "directory"
+
+	// PackageFromAnnotation uses the `x-cog-package` annotation found at the
+	// root of each file, falling back to the file name if it isn't set.
+	PackageFromAnnotation PackageNaming = "annotation"
+)
+
+// FilesInput describes a set of files to load as a single input.
+type FilesInput struct {
+	// Paths to files or directories. Directories are searched recursively.
+	Paths []string `yaml:"paths"`
+
+	// Glob pattern matching files to load. `**` matches any number of
+	// directories.
+	// Ex: schemas/**/*.json
+	Glob string `yaml:"glob"`
+
+	// PackageNaming defines how the package of each file is named, unless a
+	// package is explicitly set on the input.
+	// Possible values: filename, directory, annotation. Defaults to filename.
+	PackageNaming PackageNaming `yaml:"package_naming"`
+}
+
+func (input *FilesInput) interpolateParameters(interpolator ParametersInterpolator) {
+	input.Paths = tools.Map(input.Paths, interpolator)
+	input.Glob = interpolator(input.Glob)
+}
+
+func (input *FilesInput) isEmpty() bool {
+	return len(input.Paths) == 0 && input.Glob == ""
+}
+
+// files lists the files described by the input, having one of the given
+// extensions when found in directories.
+func (input *FilesInput) files(extensions []string) ([]string, error) {
+	seen := make(map[string]struct{})
+	var files []string
+
+	addFile := func(file string) {
+		if _, found := seen[file]; found {
+			return
+		}
+
+		seen[file] = struct{}{}
+		files = append(files, file)
+	}
+
+	for _, path := range input.Paths {
+		stat, err := os.Stat(path)
+		if err != nil {
+			return nil, err
+		}
+
+		if !stat.IsDir() {
+			addFile(path)
+			continue
+		}
+
+		err = filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				return err
+			}
+
+			if !entry.IsDir() && tools.ItemInList(filepath.Ext(file), extensions) {
+				addFile(file)
+			}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if input.Glob != "" {
+		matches, err := globFiles(input.Glob)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(matches) == 0 {
+			return nil, fmt.Errorf("no file matching '%s'", input.Glob)
+		}
+
+		for _, match := range matches {
+			addFile(match)
+		}
+	}
+
+	return files, nil
+}
+
+// packageForFile names the package of the given file. The annotation
+// function is only called with the `annotation` strategy.
+func (input *FilesInput) packageForFile(file string, annotation func(file string) (string, error)) (string, error) {
+	filename := strings.TrimSuffix(filepath.Base(file), filepath.Ext(file))
+
+	switch input.PackageNaming {
+	case "", PackageFromFilename:
+		return filename, nil
+	case PackageFromDirectory:
+		return filepath.Base(filepath.Dir(file)), nil
+	case PackageFromAnnotation:
+		pkg, err := annotation(file)
+		if err != nil {
+			return "", fmt.Errorf("%s: %w", file, err)
+		}
+
+		if pkg == "" {
+			return filename, nil
+		}
+
+		return pkg, nil
+	default:
+		return "", fmt.Errorf("unknown package naming strategy '%s'", input.PackageNaming)
+	}
+}
+
+// globFiles returns the files matching the given pattern, sorted.
+// Unlike filepath.Glob, it supports `**` to match any number of directories.
+func globFiles(pattern string) ([]string, error) {
+	pattern = filepath.Clean(pattern)
+	if !strings.Contains(pattern, "**") {
+		return filepath.Glob(pattern)
+	}
+
+	segments := strings.Split(pattern, string(filepath.Separator))
+
+	var matches []string
+	err := filepath.WalkDir(globRoot(pattern), func(file string, entry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+
+		if entry.IsDir() {
+			return nil
+		}
+
+		matched, err := matchGlob(segments, strings.Split(filepath.Clean(file), string(filepath.Separator)))
+		if err != nil {
+			return err
+		}
+		if matched {
+			matches = append(matches, file)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Strings(matches)
+
+	return matches, nil
+}
+
+// globRoot returns the longest directory of the given pattern that doesn't
+// contain any wildcard.
+func globRoot(pattern string) string {
+	root := "."
+	if filepath.IsAbs(pattern) {
+		root = string(filepath.Separator)
+	}
+
+	segments := strings.Split(filepath.Clean(pattern), string(filepath.Separator))
+	for i, segment := range segments {
+		if strings.ContainsAny(segment, "*?[") {
+			return filepath.Join(append([]string{root}, segments[:i]...)...)
+		}
+	}
+
+	return filepath.Join(append([]string{root}, segments...)...)
+}
+
+func matchGlob(pattern []string, path []string) (bool, error) {
+	for len(pattern) != 0 {
+		if pattern[0] == "**" {
+			// `**` matches zero or more directories
+			for i := 0; i <= len(path); i++ {
+				matched, err := matchGlob(pattern[1:], path[i:])
+				if err != nil || matched {
+					return matched, err
+				}
+			}
+
+			return false, nil
+		}
+
+		if len(path) == 0 {
+			return false, nil
+		}
+
+		matched, err := filepath.Match(pattern[0], path[0])
+		if err != nil || !matched {
+			return false, err
+		}
+
+		pattern = pattern[1:]
+		path = path[1:]
+	}
+
+	return len(path) == 0, nil
+}
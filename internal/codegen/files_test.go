@@ -0,0 +1,136 @@
+package codegen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFilesInput_files(t *testing.T) {
+	req := require.New(t)
+	root := writeFiles(t, map[string]string{
+		"panels/timeseries.json":     `{}`,
+		"panels/nested/table.json":   `{}`,
+		"panels/nested/README.md":    ``,
+		"datasources/loki.json":      `{}`,
+		"datasources/loki.yaml":      ``,
+		"datasources/prometheus.yml": ``,
+	})
+
+	input := FilesInput{
+		Paths: []string{filepath.Join(root, "panels")},
+		Glob:  filepath.Join(root, "**", "*.json"),
+	}
+
+	files, err := input.files([]string{".json"})
+	req.NoError(err)
+	req.Equal([]string{
+		filepath.Join(root, "panels", "nested", "table.json"),
+		filepath.Join(root, "panels", "timeseries.json"),
+		filepath.Join(root, "datasources", "loki.json"),
+	}, files)
+}
+
+func TestFilesInput_filesWithoutMatch(t *testing.T) {
+	input := FilesInput{Glob: filepath.Join(t.TempDir(), "*.json")}
+
+	_, err := input.files([]string{".json"})
+	require.ErrorContains(t, err, "no file matching")
+}
+
+func TestFilesInput_packageForFile(t *testing.T) {
+	annotation := func(file string) (string, error) {
+		if filepath.Base(file) == "annotated.json" {
+			return "fromannotation", nil
+		}
+
+		return "", nil
+	}
+
+	testCases := []struct {
+		naming   PackageNaming
+		file     string
+		expected string
+	}{
+		{naming: "", file: "panels/timeseries.json", expected: "timeseries"},
+		{naming: PackageFromFilename, file: "panels/timeseries.json", expected: "timeseries"},
+		{naming: PackageFromDirectory, file: "panels/timeseries.json", expected: "panels"},
+		{naming: PackageFromAnnotation, file: "panels/annotated.json", expected: "fromannotation"},
+		{naming: PackageFromAnnotation, file: "panels/timeseries.json", expected: "timeseries"},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+
+		t.Run(string(tc.naming)+"/"+tc.file, func(t *testing.T) {
+			input := FilesInput{PackageNaming: tc.naming}
+
+			pkg, err := input.packageForFile(tc.file, annotation)
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, pkg)
+		})
+	}
+
+	_, err := (&FilesInput{PackageNaming: "unknown"}).packageForFile("foo.json", annotation)
+	require.Error(t, err)
+}
+
+func TestJSONSchemaInput_loadsSeveralFiles(t *testing.T) {
+	req := require.New(t)
+	root := writeFiles(t, map[string]string{
+		"common/unit.json": `{"type": "string"}`,
+		"panels/timeseries.json": `{
+  "x-cog-package": "timeseries",
+  "type": "object",
+  "properties": {"unit": {"$ref": "../common/unit.json"}}
+}`,
+		"panels/gauge.json": `{
+  "type": "object",
+  "properties": {"unit": {"$ref": "../common/unit.json"}}
+}`,
+	})
+
+	input := JSONSchemaInput{
+		FilesInput: FilesInput{
+			Glob:          filepath.Join(root, "*", "*.json"),
+			PackageNaming: PackageFromAnnotation,
+		},
+	}
+
+	schemas, err := input.LoadSchemas(context.Background())
+	req.NoError(err)
+
+	packages := make([]string, 0, len(schemas))
+	for _, schema := range schemas {
+		packages = append(packages, schema.Package)
+	}
+	req.Equal([]string{"unit", "gauge", "timeseries"}, packages)
+
+	// `Unit` is referenced by several files, but only declared once.
+	_, found := schemas.LocateObject("unit", "Unit")
+	req.True(found)
+
+	for _, pkg := range []string{"gauge", "timeseries"} {
+		object, found := schemas.LocateObject(pkg, pkg)
+		req.True(found)
+		req.Equal(ast.NewRef("unit", "Unit"), object.Type.AsStruct().Fields[0].Type)
+	}
+}
+
+func writeFiles(t *testing.T, files map[string]string) string {
+	t.Helper()
+
+	root := t.TempDir()
+	for file, content := range files {
+		path := filepath.Join(root, file)
+
+		require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o755))
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+
+	return root
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/grafana/cog/internal/ast/compiler"
 	"github.com/grafana/cog/internal/semver"
 	"github.com/grafana/cog/internal/tools"
+	"github.com/grafana/cog/internal/trace"
 	cogyaml "github.com/grafana/cog/internal/yaml"
 )
 
@@ -17,7 +18,7 @@ type interpolable interface {
 }
 
 type transformable interface {
-	commonPasses() (compiler.Passes, error)
+	commonPasses(ctx context.Context) (compiler.Passes, error)
 }
 
 type schemaLoader interface {
@@ -48,8 +49,8 @@ func (input *InputBase) schemaMetadata() ast.SchemaMeta {
 	return ast.SchemaMeta{}
 }
 
-func (input *InputBase) commonPasses() (compiler.Passes, error) {
-	return cogyaml.NewCompilerLoader().PassesFrom(input.Transforms)
+func (input *InputBase) commonPasses(ctx context.Context) (compiler.Passes, error) {
+	return cogyaml.NewCompilerLoader().WithContext(ctx).PassesFrom(input.Transforms)
 }
 
 func (input *InputBase) interpolateParameters(interpolator ParametersInterpolator) {
@@ -57,18 +58,35 @@ func (input *InputBase) interpolateParameters(interpolator ParametersInterpolato
 	input.Transforms = tools.Map(input.Transforms, interpolator)
 }
 
-func (input *InputBase) filterSchema(schema *ast.Schema) (ast.Schemas, error) {
+// filterSchema only keeps the allowed objects (and their dependencies) from
+// the given schemas. Allowed objects are looked up in the first schema.
+func (input *InputBase) filterSchema(schemas ...*ast.Schema) (ast.Schemas, error) {
 	if len(input.AllowedObjects) == 0 {
-		return ast.Schemas{schema}, nil
+		return schemas, nil
+	}
+
+	return input.filterSchemaIn([]string{schemas[0].Package}, schemas)
+}
+
+// filterSchemaIn only keeps the allowed objects (and their dependencies) from
+// the given schemas. Allowed objects are looked up in the given packages.
+func (input *InputBase) filterSchemaIn(packages []string, schemas ast.Schemas) (ast.Schemas, error) {
+	if len(input.AllowedObjects) == 0 {
+		return schemas, nil
+	}
+
+	var allowedObjects []compiler.ObjectReference
+	for _, pkg := range packages {
+		for _, objectName := range input.AllowedObjects {
+			allowedObjects = append(allowedObjects, compiler.ObjectReference{Package: pkg, Object: objectName})
+		}
 	}
 
 	filterPass := compiler.FilterSchemas{
-		AllowedObjects: tools.Map(input.AllowedObjects, func(objectName string) compiler.ObjectReference {
-			return compiler.ObjectReference{Package: schema.Package, Object: objectName}
-		}),
+		AllowedObjects: allowedObjects,
 	}
 
-	return filterPass.Process(ast.Schemas{schema})
+	return filterPass.Process(schemas)
 }
 
 type Input struct {
@@ -76,6 +94,7 @@ type Input struct {
 
 	JSONSchema *JSONSchemaInput `yaml:"jsonschema"`
 	OpenAPI    *OpenAPIInput    `yaml:"openapi"`
+	Protobuf   *ProtobufInput   `yaml:"protobuf"`
 
 	KindRegistry      *KindRegistryInput `yaml:"kind_registry"`
 	KindsysCore       *CueInput          `yaml:"kindsys_core"`
@@ -105,6 +124,9 @@ func (input *Input) loader() (schemaLoader, error) {
 	if input.OpenAPI != nil {
 		return input.OpenAPI, nil
 	}
+	if input.Protobuf != nil {
+		return input.Protobuf, nil
+	}
 	if input.KindRegistry != nil {
 		return input.KindRegistry, nil
 	}
@@ -149,6 +171,10 @@ func (input *Input) shouldLoadSchemas() (bool, error) {
 }
 
 func (input *Input) LoadSchemas(ctx context.Context) (ast.Schemas, error) {
+	return input.loadSchemas(ctx, nil)
+}
+
+func (input *Input) loadSchemas(ctx context.Context, recorder *trace.Recorder) (ast.Schemas, error) {
 	var err error
 
 	shouldLoad, err := input.shouldLoadSchemas()
@@ -170,12 +196,12 @@ func (input *Input) LoadSchemas(ctx context.Context) (ast.Schemas, error) {
 	}
 
 	if transformableLoader, ok := loader.(transformable); ok {
-		passes, err := transformableLoader.commonPasses()
+		passes, err := transformableLoader.commonPasses(ctx)
 		if err != nil {
 			return nil, err
 		}
 
-		return passes.Process(schemas)
+		return passes.ProcessWithTrace(schemas, recorder)
 	}
 
 	return schemas, nil
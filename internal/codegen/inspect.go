@@ -0,0 +1,83 @@
+package codegen
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/grafana/cog/internal/languages"
+)
+
+// Stage identifies a step of the transformations applied to schemas before
+// they are handed to the jennies.
+type Stage string
+
+const (
+	// StageRaw describes schemas as they are parsed from the inputs.
+	StageRaw Stage = "raw"
+	// StageCommonPasses describes schemas once the compiler passes defined
+	// by inputs and in the pipeline's configuration have been applied.
+	StageCommonPasses Stage = "common-passes"
+	// StageLanguagePasses describes schemas once the language-specific
+	// compiler passes have been applied.
+	StageLanguagePasses Stage = "language-passes"
+	// StageVeneers describes builders once veneers have been applied.
+	StageVeneers Stage = "veneers"
+	// StageNilChecks describes builders once nil-checks have been generated.
+	// This is the input received by jennies.
+	StageNilChecks Stage = "nilchecks"
+)
+
+func Stages() []Stage {
+	return []Stage{StageRaw, StageCommonPasses, StageLanguagePasses, StageVeneers, StageNilChecks}
+}
+
+func ParseStage(input string) (Stage, error) {
+	for _, stage := range Stages() {
+		if string(stage) == input {
+			return stage, nil
+		}
+	}
+
+	validStages := make([]string, 0, len(Stages()))
+	for _, stage := range Stages() {
+		validStages = append(validStages, string(stage))
+	}
+
+	return "", fmt.Errorf("unknown stage '%s': expected one of %s", input, strings.Join(validStages, ", "))
+}
+
+// Inspect returns the input that the jennies for the given language would
+// receive, as it is after the given stage.
+func (pipeline *Pipeline) Inspect(ctx context.Context, language string, stage Stage) (languages.Context, error) {
+	schemas, err := pipeline.LoadSchemas(ctx)
+	if err != nil {
+		return languages.Context{}, err
+	}
+
+	if stage == StageRaw {
+		return languages.Context{Schemas: schemas}, nil
+	}
+
+	targetsByLanguage, err := pipeline.outputLanguages()
+	if err != nil {
+		return languages.Context{}, err
+	}
+
+	target, found := targetsByLanguage[language]
+	if !found {
+		return languages.Context{}, fmt.Errorf("language '%s' is not configured as an output of the pipeline", language)
+	}
+
+	veneers, err := pipeline.veneers(ctx)
+	if err != nil {
+		return languages.Context{}, err
+	}
+
+	commonPasses, err := pipeline.commonPasses(ctx)
+	if err != nil {
+		return languages.Context{}, err
+	}
+
+	return pipeline.jenniesInputForLanguage(target, schemas, commonPasses, "", pipeline.finalPasses(), veneers, stage, pipeline.tracer)
+}
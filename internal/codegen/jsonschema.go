@@ -1,16 +1,21 @@
 package codegen
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
 	"io"
 	"os"
 
 	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/jsonschema"
+	"github.com/grafana/cog/internal/tools"
 )
 
 type JSONSchemaInput struct {
-	InputBase `yaml:",inline"`
+	InputBase  `yaml:",inline"`
+	FilesInput `yaml:",inline"`
 
 	// Path to a JSONSchema file.
 	Path string `yaml:"path"`
@@ -20,20 +25,40 @@ type JSONSchemaInput struct {
 
 	// Package name to use for the input schema. If empty, it will be guessed
 	// from the input file name.
+	// When loading several files, all of them are generated in this package.
 	Package string `yaml:"package"`
+
+	// References maps paths of files referenced by the input schema to the
+	// package in which their definitions will be generated.
+	// These files can also be referenced by their `$id`.
+	// If a referenced file isn't listed, its package will be guessed from
+	// its path.
+	References map[string]string `yaml:"references"`
 }
 
 func (input *JSONSchemaInput) interpolateParameters(interpolator ParametersInterpolator) {
 	input.InputBase.interpolateParameters(interpolator)
+	input.FilesInput.interpolateParameters(interpolator)
 
 	input.Path = interpolator(input.Path)
 	input.URL = interpolator(input.URL)
 	input.Package = interpolator(input.Package)
+
+	references := make(map[string]string, len(input.References))
+	for path, pkg := range input.References {
+		references[interpolator(path)] = interpolator(pkg)
+	}
+	input.References = references
 }
 
 func (input *JSONSchemaInput) schemaReader(ctx context.Context) (io.ReadCloser, error) {
 	if input.Path != "" {
-		return os.Open(input.Path)
+		content, err := readLoadedFile(ctx, input.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		return io.NopCloser(bytes.NewReader(content)), nil
 	}
 
 	return loadURL(ctx, input.URL)
@@ -48,19 +73,110 @@ func (input *JSONSchemaInput) packageName() string {
 }
 
 func (input *JSONSchemaInput) LoadSchemas(ctx context.Context) (ast.Schemas, error) {
+	if !input.FilesInput.isEmpty() {
+		return input.loadFiles(ctx)
+	}
+
 	schemaReader, err := input.schemaReader(ctx)
 	if err != nil {
 		return nil, err
 	}
 	defer func() { _ = schemaReader.Close() }()
 
-	schema, err := jsonschema.GenerateAST(schemaReader, jsonschema.Config{
-		Package:        input.packageName(),
-		SchemaMetadata: input.schemaMetadata(),
+	schemas, err := jsonschema.GenerateAST(schemaReader, jsonschema.Config{
+		Package:           input.packageName(),
+		Path:              input.Path,
+		ReferencePackages: input.References,
+		FileLoaded:        fileLoadedRecorder(ctx),
+		SchemaMetadata:    input.schemaMetadata(),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return input.filterSchema(schema)
+	return input.filterSchema(schemas...)
+}
+
+func (input *JSONSchemaInput) loadFiles(ctx context.Context) (ast.Schemas, error) {
+	files, err := input.FilesInput.files([]string{".json"})
+	if err != nil {
+		return nil, err
+	}
+
+	packages := make(map[string]string, len(files))
+	for _, file := range files {
+		packages[file] = input.Package
+		if packages[file] != "" {
+			continue
+		}
+
+		packages[file], err = input.FilesInput.packageForFile(file, jsonSchemaPackageAnnotation)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var allSchemas ast.Schemas
+	for _, file := range files {
+		// files of the set referencing each other must agree on their packages
+		references := make(map[string]string, len(input.References)+len(files))
+		for otherFile, pkg := range packages {
+			if otherFile != file {
+				references[otherFile] = pkg
+			}
+		}
+		for path, pkg := range input.References {
+			references[path] = pkg
+		}
+
+		schemas, err := input.loadFile(ctx, file, packages[file], references)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		allSchemas = append(allSchemas, schemas...)
+	}
+
+	consolidated, err := allSchemas.Consolidate()
+	if err != nil {
+		return nil, err
+	}
+
+	return input.filterSchemaIn(tools.Map(files, func(file string) string {
+		return packages[file]
+	}), consolidated)
+}
+
+func (input *JSONSchemaInput) loadFile(ctx context.Context, file string, pkg string, references map[string]string) (ast.Schemas, error) {
+	content, err := readLoadedFile(ctx, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return jsonschema.GenerateAST(bytes.NewReader(content), jsonschema.Config{
+		Package:           pkg,
+		Path:              file,
+		ReferencePackages: references,
+		FileLoaded:        fileLoadedRecorder(ctx),
+		SchemaMetadata:    input.schemaMetadata(),
+	})
+}
+
+func jsonSchemaPackageAnnotation(file string) (string, error) {
+	contents, err := os.ReadFile(file)
+	if err != nil {
+		return "", err
+	}
+
+	annotations := make(map[string]any)
+	if err := json.Unmarshal(contents, &annotations); err != nil {
+		return "", err
+	}
+
+	pkg, ok := annotations[PackageAnnotation].(string)
+	if !ok && annotations[PackageAnnotation] != nil {
+		return "", fmt.Errorf("'%s' annotation must be a string", PackageAnnotation)
+	}
+
+	return pkg, nil
 }
@@ -23,7 +23,7 @@ func (input *KindRegistryInput) interpolateParameters(interpolator ParametersInt
 	input.Version = interpolator(input.Version)
 }
 
-func (input *KindRegistryInput) LoadSchemas(_ context.Context) (ast.Schemas, error) {
+func (input *KindRegistryInput) LoadSchemas(ctx context.Context) (ast.Schemas, error) {
 	var allSchemas ast.Schemas
 	var cueImports []string
 	var cueEntrypoints []string
@@ -52,9 +52,9 @@ func (input *KindRegistryInput) LoadSchemas(_ context.Context) (ast.Schemas, err
 		cueImports = append(cueImports, fmt.Sprintf("%s:%s", commonPkgPath, "github.com/grafana/grafana/packages/grafana-schema/src/common"))
 	}
 
-	kindLoader := func(loader func(input CueInput) (ast.Schemas, error), entrypoints []string) error {
+	kindLoader := func(loader func(ctx context.Context, input CueInput) (ast.Schemas, error), entrypoints []string) error {
 		for _, entrypoint := range entrypoints {
-			schemas, err := loader(CueInput{
+			schemas, err := loader(ctx, CueInput{
 				InputBase:  input.InputBase,
 				Entrypoint: entrypoint,
 				CueImports: cueImports,
@@ -1,6 +1,7 @@
 package codegen
 
 import (
+	"context"
 	"fmt"
 	"strings"
 
@@ -9,8 +10,8 @@ import (
 	"github.com/grafana/cog/internal/simplecue"
 )
 
-func kindsysComposableLoader(input CueInput) (ast.Schemas, error) {
-	schemaRootValue, libraries, err := input.schemaRootValue("grafanaplugin")
+func kindsysComposableLoader(ctx context.Context, input CueInput) (ast.Schemas, error) {
+	schemaRootValue, libraries, err := input.schemaRootValue(ctx, "grafanaplugin")
 	if err != nil {
 		return nil, err
 	}
@@ -1,13 +1,14 @@
 package codegen
 
 import (
+	"context"
 	"cuelang.org/go/cue"
 	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/simplecue"
 )
 
-func kindsysCoreLoader(input CueInput) (ast.Schemas, error) {
-	schemaRootValue, libraries, err := input.schemaRootValue("kind")
+func kindsysCoreLoader(ctx context.Context, input CueInput) (ast.Schemas, error) {
+	schemaRootValue, libraries, err := input.schemaRootValue(ctx, "kind")
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,49 @@
+package codegen
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/grafana/cog/internal/trace"
+)
+
+// Lint runs the compiler passes and veneers of every output language, and
+// returns the object and field references or veneer rules that matched
+// nothing.
+// These usually are leftovers of renamed or removed objects and fields.
+func (pipeline *Pipeline) Lint(ctx context.Context) ([]trace.Unmatched, error) {
+	tracer := trace.NewRecorder()
+
+	veneers, err := pipeline.veneers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	commonPasses, err := pipeline.commonPasses(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	targetsByLanguage, err := pipeline.outputLanguages()
+	if err != nil {
+		return nil, err
+	}
+
+	schemas, _, err := pipeline.loadSchemas(ctx, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	languageRefs := targetsByLanguage.AsLanguageRefs()
+	sort.Strings(languageRefs)
+
+	for _, language := range languageRefs {
+		_, err := pipeline.jenniesInputForLanguage(targetsByLanguage[language], schemas, commonPasses, "", pipeline.finalPasses(), veneers, StageVeneers, tracer)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", language, err)
+		}
+	}
+
+	return tracer.Report().Unmatched(), nil
+}
@@ -0,0 +1,63 @@
+package codegen
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/grafana/cog/internal/trace"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipeline_Lint(t *testing.T) {
+	req := require.New(t)
+
+	dir := writeFiles(t, map[string]string{
+		"compiler/passes.yaml": `passes:
+  - fields_set_default:
+      defaults:
+        dashboard.dashboard.title: Dashboard
+        dashboard.dashboard.gridPos: {}
+  - omit:
+      objects: [dashboard.Legacy]
+`,
+		"veneers/dashboard.yaml": `language: all
+package: dashboard
+
+builders:
+  - rename:
+      by_object: dashboard
+      as: Dashboard
+  - rename:
+      by_object: RowPanel
+      as: Row
+  # matches, even though it doesn't change anything
+  - rename:
+      by_object: dashboard
+      as: Dashboard
+
+options:
+  - rename:
+      by_name: dashboard.title
+      as: withTitle
+  - rename:
+      by_name: dashboard.uid
+      as: withUid
+`,
+	})
+
+	pipeline := testPipeline(t)
+	pipeline.configFile = filepath.Join(dir, "config.yaml")
+	pipeline.Transforms.CommonPassesFiles = []string{filepath.Join(dir, "compiler", "passes.yaml")}
+	pipeline.Transforms.VeneersDirectories = []string{filepath.Join(dir, "veneers")}
+
+	unmatched, err := pipeline.Lint(context.Background())
+	req.NoError(err)
+
+	req.Equal([]trace.Unmatched{
+		{Step: "compiler/passes.yaml:2: FieldsSetDefault", Target: "dashboard.dashboard.gridPos"},
+		{Step: "compiler/passes.yaml:6: Omit", Target: "dashboard.Legacy"},
+		{Step: "veneers/dashboard.yaml:8: builders[1]", Target: "selector"},
+		{Step: "veneers/dashboard.yaml:20: options[1]", Target: "selector"},
+	}, unmatched)
+}
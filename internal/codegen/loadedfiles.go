@@ -0,0 +1,107 @@
+package codegen
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"os"
+	"sort"
+	"sync"
+)
+
+type loadedFilesKey struct{}
+
+// loadedFiles records the files read by inputs while loading their schemas,
+// with a hash of the content that was read.
+// Unlike the paths known from the configuration, it includes the files
+// reached while loading: referenced JSON schemas, CUE libraries, …
+type loadedFiles struct {
+	lock   sync.Mutex
+	hashes map[string]string
+}
+
+// withLoadedFiles returns a context in which the files read by loaders are
+// recorded in the returned loadedFiles.
+func withLoadedFiles(ctx context.Context) (context.Context, *loadedFiles) {
+	files := &loadedFiles{hashes: make(map[string]string)}
+
+	return context.WithValue(ctx, loadedFilesKey{}, files), files
+}
+
+// recordLoadedFile records that the given file was read with the given
+// content. It does nothing if the context doesn't record loaded files.
+func recordLoadedFile(ctx context.Context, path string, content []byte) {
+	files, ok := ctx.Value(loadedFilesKey{}).(*loadedFiles)
+	if !ok {
+		return
+	}
+
+	files.lock.Lock()
+	defer files.lock.Unlock()
+
+	files.hashes[path] = contentHash(content)
+}
+
+// fileLoadedRecorder returns a callback recording the files it is given as
+// loaded.
+func fileLoadedRecorder(ctx context.Context) func(path string, content []byte) {
+	return func(path string, content []byte) {
+		recordLoadedFile(ctx, path, content)
+	}
+}
+
+// readLoadedFile reads the given file and records it as loaded.
+func readLoadedFile(ctx context.Context, path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	recordLoadedFile(ctx, path, content)
+
+	return content, nil
+}
+
+// contentHashes returns the recorded files, mapped to the hash of their
+// content.
+func (files *loadedFiles) contentHashes() map[string]string {
+	files.lock.Lock()
+	defer files.lock.Unlock()
+
+	hashes := make(map[string]string, len(files.hashes))
+	for path, hash := range files.hashes {
+		hashes[path] = hash
+	}
+
+	return hashes
+}
+
+// unchangedFiles tells whether the given files still have the content
+// described by their hash.
+func unchangedFiles(hashes map[string]string) bool {
+	for path, hash := range hashes {
+		content, err := os.ReadFile(path)
+		if err != nil || contentHash(content) != hash {
+			return false
+		}
+	}
+
+	return true
+}
+
+func sortedPaths(hashes map[string]string) []string {
+	paths := make([]string, 0, len(hashes))
+	for path := range hashes {
+		paths = append(paths, path)
+	}
+
+	sort.Strings(paths)
+
+	return paths
+}
+
+func contentHash(content []byte) string {
+	sum := sha256.Sum256(content)
+
+	return hex.EncodeToString(sum[:])
+}
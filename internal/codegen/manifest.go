@@ -0,0 +1,213 @@
+package codegen
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/grafana/codejen"
+)
+
+// ManifestFile is the name of the manifest written in each language output
+// directory, listing the files generated in it.
+const ManifestFile = ".cog-manifest.json"
+
+// Manifest lists generated files, to detect the ones that aren't generated
+// anymore.
+type Manifest struct {
+	// Files maps the path of generated files, relative to the directory
+	// containing the manifest, to the SHA-256 hash of their content.
+	Files map[string]string `json:"files"`
+}
+
+func (manifest Manifest) marshal() ([]byte, error) {
+	output, err := json.MarshalIndent(manifest, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+
+	return append(output, '\n'), nil
+}
+
+func unmarshalManifest(content []byte) (Manifest, error) {
+	manifest := Manifest{}
+	if err := json.Unmarshal(content, &manifest); err != nil {
+		return Manifest{}, err
+	}
+
+	return manifest, nil
+}
+
+type manifestJenny struct{}
+
+func (jenny manifestJenny) JennyName() string {
+	return "Manifest"
+}
+
+// addManifests adds a manifest to each language output directory, listing
+// the files generated in it.
+func (pipeline *Pipeline) addManifests(generatedFS *codejen.FS) error {
+	outputDirectories, err := pipeline.OutputDirectories()
+	if err != nil {
+		return err
+	}
+
+	files := generatedFS.AsFiles()
+	for _, directory := range outputDirectories {
+		manifest := Manifest{Files: make(map[string]string)}
+
+		for _, file := range files {
+			relativePath, err := filepath.Rel(directory, file.RelativePath)
+			if err != nil || !filepath.IsLocal(relativePath) {
+				continue
+			}
+
+			manifest.Files[filepath.ToSlash(relativePath)] = hashContent(file.Data)
+		}
+
+		if len(manifest.Files) == 0 {
+			continue
+		}
+
+		content, err := manifest.marshal()
+		if err != nil {
+			return err
+		}
+
+		manifestPath := filepath.Join(directory, ManifestFile)
+		if err := generatedFS.Add(*codejen.NewFile(manifestPath, content, manifestJenny{})); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// PruneReport describes the files affected by PruneStaleFiles.
+type PruneReport struct {
+	// Removed lists the stale files that were deleted.
+	Removed []string
+
+	// Edited lists the stale files that were left in place because they
+	// were modified since they were generated.
+	Edited []string
+}
+
+// PruneStaleFiles deletes the files listed in the manifests found on disk,
+// under the given prefix, that aren't generated anymore. Files modified since
+// they were generated are kept.
+// It must be called before the given FS is written, since it overwrites
+// the manifests.
+func PruneStaleFiles(generatedFS *codejen.FS, prefix string) (PruneReport, error) {
+	report := PruneReport{}
+
+	for _, file := range generatedFS.AsFiles() {
+		if filepath.Base(file.RelativePath) != ManifestFile {
+			continue
+		}
+
+		manifestPath := filepath.Join(prefix, file.RelativePath)
+		previousContent, err := os.ReadFile(manifestPath)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return report, err
+		}
+
+		previous, err := unmarshalManifest(previousContent)
+		if err != nil {
+			return report, fmt.Errorf("%s: %w", manifestPath, err)
+		}
+
+		current, err := unmarshalManifest(file.Data)
+		if err != nil {
+			return report, fmt.Errorf("%s: %w", file.RelativePath, err)
+		}
+
+		directory := filepath.Dir(manifestPath)
+		if err := pruneManifest(directory, previous, current, &report); err != nil {
+			return report, err
+		}
+	}
+
+	return report, nil
+}
+
+func pruneManifest(directory string, previous Manifest, current Manifest, report *PruneReport) error {
+	stalePaths := make([]string, 0, len(previous.Files))
+	for path := range previous.Files {
+		if _, found := current.Files[path]; found {
+			continue
+		}
+
+		// don't trust manifests pointing outside of their directory
+		if !filepath.IsLocal(filepath.FromSlash(path)) {
+			continue
+		}
+
+		stalePaths = append(stalePaths, path)
+	}
+	sort.Strings(stalePaths)
+
+	for _, path := range stalePaths {
+		fullPath := filepath.Join(directory, filepath.FromSlash(path))
+
+		content, err := os.ReadFile(fullPath)
+		if os.IsNotExist(err) {
+			continue
+		}
+		if err != nil {
+			return err
+		}
+
+		if hashContent(content) != previous.Files[path] {
+			report.Edited = append(report.Edited, fullPath)
+			continue
+		}
+
+		if err := os.Remove(fullPath); err != nil {
+			return err
+		}
+		report.Removed = append(report.Removed, fullPath)
+
+		if err := removeEmptyParents(filepath.Dir(fullPath), directory); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// removeEmptyParents removes the given directory and its parents as long as
+// they are empty, stopping at the root directory.
+func removeEmptyParents(directory string, root string) error {
+	for directory != root && strings.HasPrefix(directory, root) {
+		entries, err := os.ReadDir(directory)
+		if err != nil {
+			return err
+		}
+		if len(entries) != 0 {
+			return nil
+		}
+
+		if err := os.Remove(directory); err != nil {
+			return err
+		}
+
+		directory = filepath.Dir(directory)
+	}
+
+	return nil
+}
+
+func hashContent(content []byte) string {
+	hash := sha256.Sum256(content)
+
+	return hex.EncodeToString(hash[:])
+}
@@ -0,0 +1,102 @@
+package codegen
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/jennies/golang"
+	"github.com/grafana/cog/internal/jennies/typescript"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipeline_addManifests(t *testing.T) {
+	req := require.New(t)
+
+	pipeline := &Pipeline{
+		Output: Output{
+			Directory: "out/%l",
+			Languages: []*OutputLanguage{
+				{Go: &golang.Config{}},
+				{Typescript: &typescript.Config{}},
+			},
+		},
+	}
+
+	generatedFS := codejen.NewFS()
+	req.NoError(generatedFS.Add(
+		*codejen.NewFile("out/go/dashboard/types_gen.go", []byte("package dashboard\n"), nil),
+		*codejen.NewFile("out/typescript/src/index.ts", []byte("export {};\n"), nil),
+		*codejen.NewFile("README.md", []byte("repository template\n"), nil),
+	))
+
+	req.NoError(pipeline.addManifests(generatedFS))
+
+	files := generatedFS.AsFiles()
+	req.Len(files, 5)
+
+	req.Equal("out/go/"+ManifestFile, files[1].RelativePath)
+	req.Equal(`{
+  "files": {
+    "dashboard/types_gen.go": "`+hashContent([]byte("package dashboard\n"))+`"
+  }
+}
+`, string(files[1].Data))
+
+	req.Equal("out/typescript/"+ManifestFile, files[3].RelativePath)
+	req.Equal(`{
+  "files": {
+    "src/index.ts": "`+hashContent([]byte("export {};\n"))+`"
+  }
+}
+`, string(files[3].Data))
+}
+
+func TestPruneStaleFiles(t *testing.T) {
+	req := require.New(t)
+
+	previousManifest, err := Manifest{Files: map[string]string{
+		"dashboard/types_gen.go": hashContent([]byte("package dashboard\n")),
+		"removed/types_gen.go":   hashContent([]byte("package removed\n")),
+		"edited/types_gen.go":    hashContent([]byte("package edited\n")),
+		"deleted/types_gen.go":   hashContent([]byte("package deleted\n")),
+		"../outside.go":          hashContent([]byte("package outside\n")),
+	}}.marshal()
+	req.NoError(err)
+
+	dir := writeFiles(t, map[string]string{
+		"go/" + ManifestFile:            string(previousManifest),
+		"go/dashboard/types_gen.go":     "package dashboard\n",
+		"go/removed/types_gen.go":       "package removed\n",
+		"go/edited/types_gen.go":        "package edited\n\n// hand-made change\n",
+		"go/handwritten/handwritten.go": "package handwritten\n",
+		"outside.go":                    "package outside\n",
+	})
+
+	currentManifest, err := Manifest{Files: map[string]string{
+		"dashboard/types_gen.go": hashContent([]byte("package dashboard\n")),
+	}}.marshal()
+	req.NoError(err)
+
+	generatedFS := codejen.NewFS()
+	req.NoError(generatedFS.Add(
+		*codejen.NewFile("go/dashboard/types_gen.go", []byte("package dashboard\n"), nil),
+		*codejen.NewFile("go/"+ManifestFile, currentManifest, nil),
+	))
+
+	report, err := PruneStaleFiles(generatedFS, dir)
+	req.NoError(err)
+
+	req.Equal([]string{filepath.Join(dir, "go", "removed", "types_gen.go")}, report.Removed)
+	req.Equal([]string{filepath.Join(dir, "go", "edited", "types_gen.go")}, report.Edited)
+
+	// the directory left empty is removed too
+	_, err = os.Stat(filepath.Join(dir, "go", "removed"))
+	req.True(os.IsNotExist(err))
+
+	for _, kept := range []string{"go/dashboard/types_gen.go", "go/edited/types_gen.go", "go/handwritten/handwritten.go", "outside.go"} {
+		_, err = os.Stat(filepath.Join(dir, kept))
+		req.NoError(err, kept)
+	}
+}
@@ -2,7 +2,10 @@ package codegen
 
 import (
 	"context"
+	"fmt"
+	"net/http"
 	"net/url"
+	"path/filepath"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/grafana/cog/internal/ast"
@@ -10,7 +13,8 @@ import (
 )
 
 type OpenAPIInput struct {
-	InputBase `yaml:",inline"`
+	InputBase  `yaml:",inline"`
+	FilesInput `yaml:",inline"`
 
 	// Path to an OpenAPI file.
 	Path string `yaml:"path"`
@@ -20,16 +24,39 @@ type OpenAPIInput struct {
 
 	// Package name to use for the input schema. If empty, it will be guessed
 	// from the input file name.
+	// When loading several files, all of them are generated in this package.
 	Package string `yaml:"package"`
 
 	// NoValidate disables validation of the OpenAPI spec.
 	NoValidate bool `yaml:"no_validate"`
+
+	// Operations enables the generation of the operations described in
+	// the `paths` section of the schema.
+	Operations bool `yaml:"operations"`
 }
 
-func (input *OpenAPIInput) loadSchema(ctx context.Context) (*openapi3.T, error) {
+func (input *OpenAPIInput) newLoader(ctx context.Context) *openapi3.Loader {
 	loader := openapi3.NewLoader()
 	loader.Context = ctx
 	loader.IsExternalRefsAllowed = true
+	// the default reader caches files for the lifetime of the process,
+	// which would hide changes to referenced files when watching.
+	loader.ReadFromURIFunc = openapi3.ReadFromURIs(
+		openapi3.ReadFromHTTP(http.DefaultClient),
+		func(_ *openapi3.Loader, location *url.URL) ([]byte, error) {
+			if location.Path == "" || location.Host != "" || (location.Scheme != "" && location.Scheme != "file") {
+				return nil, openapi3.ErrURINotSupported
+			}
+
+			return readLoadedFile(ctx, filepath.FromSlash(location.Path))
+		},
+	)
+
+	return loader
+}
+
+func (input *OpenAPIInput) loadSchema(ctx context.Context) (*openapi3.T, error) {
+	loader := input.newLoader(ctx)
 
 	if input.Path != "" {
 		return loader.LoadFromFile(input.Path)
@@ -53,6 +80,7 @@ func (input *OpenAPIInput) packageName() string {
 
 func (input *OpenAPIInput) interpolateParameters(interpolator ParametersInterpolator) {
 	input.InputBase.interpolateParameters(interpolator)
+	input.FilesInput.interpolateParameters(interpolator)
 
 	input.Path = interpolator(input.Path)
 	input.URL = interpolator(input.URL)
@@ -60,19 +88,83 @@ func (input *OpenAPIInput) interpolateParameters(interpolator ParametersInterpol
 }
 
 func (input *OpenAPIInput) LoadSchemas(ctx context.Context) (ast.Schemas, error) {
+	if !input.FilesInput.isEmpty() {
+		return input.loadFiles(ctx)
+	}
+
 	oapiSchema, err := input.loadSchema(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	schema, err := openapi.GenerateAST(ctx, oapiSchema, openapi.Config{
-		Package:        input.packageName(),
-		SchemaMetadata: input.schemaMetadata(),
-		Validate:       !input.NoValidate,
-	})
+	schema, err := input.generateAST(ctx, oapiSchema, input.packageName())
 	if err != nil {
 		return nil, err
 	}
 
 	return input.filterSchema(schema)
 }
+
+func (input *OpenAPIInput) loadFiles(ctx context.Context) (ast.Schemas, error) {
+	files, err := input.FilesInput.files([]string{".json", ".yaml", ".yml"})
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := make(ast.Schemas, 0, len(files))
+	packages := make([]string, 0, len(files))
+	for _, file := range files {
+		oapiSchema, err := input.newLoader(ctx).LoadFromFile(file)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		pkg := input.Package
+		if pkg == "" {
+			pkg, err = input.FilesInput.packageForFile(file, func(_ string) (string, error) {
+				return openAPIPackageAnnotation(oapiSchema)
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		schema, err := input.generateAST(ctx, oapiSchema, pkg)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+
+		schemas = append(schemas, schema)
+		packages = append(packages, pkg)
+	}
+
+	consolidated, err := schemas.Consolidate()
+	if err != nil {
+		return nil, err
+	}
+
+	return input.filterSchemaIn(packages, consolidated)
+}
+
+func (input *OpenAPIInput) generateAST(ctx context.Context, oapiSchema *openapi3.T, pkg string) (*ast.Schema, error) {
+	return openapi.GenerateAST(ctx, oapiSchema, openapi.Config{
+		Package:        pkg,
+		SchemaMetadata: input.schemaMetadata(),
+		Validate:       !input.NoValidate,
+		Operations:     input.Operations,
+	})
+}
+
+func openAPIPackageAnnotation(oapiSchema *openapi3.T) (string, error) {
+	annotation, found := oapiSchema.Extensions[PackageAnnotation]
+	if !found {
+		return "", nil
+	}
+
+	pkg, ok := annotation.(string)
+	if !ok {
+		return "", fmt.Errorf("'%s' annotation must be a string", PackageAnnotation)
+	}
+
+	return pkg, nil
+}
@@ -2,6 +2,8 @@ package codegen
 
 import (
 	"fmt"
+
+	"github.com/grafana/cog/internal/trace"
 )
 
 func StdoutReporter(msg string) {
@@ -23,3 +25,27 @@ func Reporter(reporter ProgressReporter) PipelineOption {
 		pipeline.reporter = reporter
 	}
 }
+
+// Tracer records the changes made to schemas and builders by every compiler
+// pass and veneer.
+func Tracer(recorder *trace.Recorder) PipelineOption {
+	return func(pipeline *Pipeline) {
+		pipeline.tracer = recorder
+	}
+}
+
+// Concurrency limits the number of languages generated in parallel.
+// Values lower than 1 default to the number of available CPUs.
+func Concurrency(workers int) PipelineOption {
+	return func(pipeline *Pipeline) {
+		pipeline.concurrency = workers
+	}
+}
+
+// CacheDirectory enables caching parsed inputs and the result of common
+// compiler passes in the given directory.
+func CacheDirectory(directory string) PipelineOption {
+	return func(pipeline *Pipeline) {
+		pipeline.cache = &schemasCache{directory: directory}
+	}
+}
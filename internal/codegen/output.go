@@ -6,7 +6,9 @@ import (
 	"github.com/grafana/cog/internal/jennies/jsonschema"
 	"github.com/grafana/cog/internal/jennies/openapi"
 	"github.com/grafana/cog/internal/jennies/php"
+	"github.com/grafana/cog/internal/jennies/protobuf"
 	"github.com/grafana/cog/internal/jennies/python"
+	"github.com/grafana/cog/internal/jennies/rust"
 	"github.com/grafana/cog/internal/jennies/terraform"
 	"github.com/grafana/cog/internal/jennies/typescript"
 )
@@ -17,6 +19,11 @@ type Output struct {
 	Types    bool `yaml:"types"`
 	Builders bool `yaml:"builders"`
 
+	// Validation enables the generation of methods validating
+	// types against the constraints defined in the schemas.
+	// Note: only effective if types are generated.
+	Validation bool `yaml:"validation"`
+
 	Languages []*OutputLanguage `yaml:"languages"`
 
 	// PackageTemplates is the path to a directory containing "package templates".
@@ -57,6 +64,13 @@ type Output struct {
 	// TemplatesData holds data that will be injected into package and
 	// repository templates when rendering them.
 	TemplatesData map[string]string `yaml:"templates_data"`
+
+	// SkipManifest disables the manifest written in each language output
+	// directory. The manifest lists generated files and is used to remove
+	// the ones that aren't generated anymore.
+	// Without it, `generate --check` reports every file of the output
+	// directories that isn't generated.
+	SkipManifest bool `yaml:"skip_manifest"`
 }
 
 func (output *Output) interpolateParameters(interpolator ParametersInterpolator) {
@@ -80,7 +94,9 @@ type OutputLanguage struct {
 	JSONSchema *jsonschema.Config `yaml:"jsonschema"`
 	OpenAPI    *openapi.Config    `yaml:"openapi"`
 	PHP        *php.Config        `yaml:"php"`
+	Protobuf   *protobuf.Config   `yaml:"protobuf"`
 	Python     *python.Config     `yaml:"python"`
+	Rust       *rust.Config       `yaml:"rust"`
 	Terraform  *terraform.Config  `yaml:"terraform"`
 	Typescript *typescript.Config `yaml:"typescript"`
 }
@@ -101,4 +117,10 @@ func (outputLanguage *OutputLanguage) interpolateParameters(interpolator Paramet
 	if outputLanguage.Terraform != nil {
 		outputLanguage.Terraform.InterpolateParameters(interpolator)
 	}
+	if outputLanguage.Rust != nil {
+		outputLanguage.Rust.InterpolateParameters(interpolator)
+	}
+	if outputLanguage.Protobuf != nil {
+		outputLanguage.Protobuf.InterpolateParameters(interpolator)
+	}
 }
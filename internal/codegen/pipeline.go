@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
+	"runtime"
 	"strings"
 
 	"github.com/grafana/cog/internal/ast"
@@ -14,10 +15,14 @@ import (
 	"github.com/grafana/cog/internal/jennies/jsonschema"
 	"github.com/grafana/cog/internal/jennies/openapi"
 	"github.com/grafana/cog/internal/jennies/php"
+	"github.com/grafana/cog/internal/jennies/protobuf"
 	"github.com/grafana/cog/internal/jennies/python"
+	"github.com/grafana/cog/internal/jennies/rust"
 	"github.com/grafana/cog/internal/jennies/terraform"
 	"github.com/grafana/cog/internal/jennies/typescript"
 	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+	"github.com/grafana/cog/internal/trace"
 	"github.com/grafana/cog/internal/veneers/rewrite"
 	cogyaml "github.com/grafana/cog/internal/yaml"
 	"gopkg.in/yaml.v3"
@@ -56,6 +61,7 @@ func PipelineFromFile(file string, opts ...PipelineOption) (*Pipeline, error) {
 	if err != nil {
 		return nil, err
 	}
+	pipeline.configFile = file
 	pipeline.Parameters = map[string]string{
 		"__config_dir":  filepath.Dir(file),
 		"__current_dir": currentDir,
@@ -80,8 +86,16 @@ type Pipeline struct {
 
 	Parameters map[string]string `yaml:"parameters"`
 
+	configFile       string
 	currentDirectory string
 	reporter         ProgressReporter
+	tracer           *trace.Recorder
+	concurrency      int
+	cache            *schemasCache
+
+	// loadedFiles lists the files read by inputs the last time their
+	// schemas were loaded.
+	loadedFiles []string
 }
 
 func NewPipeline() (*Pipeline, error) {
@@ -122,27 +136,46 @@ func (pipeline *Pipeline) interpolate(input string) string {
 	return interpolated
 }
 
+func (pipeline *Pipeline) workers() int {
+	if pipeline.concurrency < 1 {
+		return runtime.NumCPU()
+	}
+
+	return pipeline.concurrency
+}
+
 func (pipeline *Pipeline) jenniesConfig() languages.Config {
 	return languages.Config{
-		Debug:    pipeline.Debug,
-		Types:    pipeline.Output.Types,
-		Builders: pipeline.Output.Builders,
+		Debug:      pipeline.Debug,
+		Types:      pipeline.Output.Types,
+		Builders:   pipeline.Output.Builders,
+		Validation: pipeline.Output.Validation,
 	}
 }
 
-func (pipeline *Pipeline) commonPasses() (compiler.Passes, error) {
+func (pipeline *Pipeline) commonPasses(ctx context.Context) (compiler.Passes, error) {
 	if pipeline.Transforms.CommonPasses != nil {
 		return pipeline.Transforms.CommonPasses, nil
 	}
 
-	return cogyaml.NewCompilerLoader().PassesFrom(pipeline.Transforms.CommonPassesFiles)
+	return cogyaml.NewCompilerLoader().RelativeTo(pipeline.configDirectory()).WithContext(ctx).PassesFrom(pipeline.Transforms.CommonPassesFiles)
+}
+
+// configDirectory returns the directory containing the pipeline's
+// configuration file, or the current directory if there is none.
+func (pipeline *Pipeline) configDirectory() string {
+	if pipeline.configFile == "" {
+		return pipeline.currentDirectory
+	}
+
+	return filepath.Dir(pipeline.configFile)
 }
 
 func (pipeline *Pipeline) finalPasses() compiler.Passes {
 	return pipeline.Transforms.FinalPasses
 }
 
-func (pipeline *Pipeline) veneers() (*rewrite.Rewriter, error) {
+func (pipeline *Pipeline) veneers(ctx context.Context) (*rewrite.Rewriter, error) {
 	var veneers []string
 
 	for _, dir := range pipeline.Transforms.VeneersDirectories {
@@ -155,9 +188,16 @@ func (pipeline *Pipeline) veneers() (*rewrite.Rewriter, error) {
 		veneers = append(veneers, matches...)
 	}
 
-	return cogyaml.NewVeneersLoader().RewriterFrom(veneers, rewrite.Config{
+	rules, err := cogyaml.NewVeneersLoader().RelativeTo(pipeline.configDirectory()).WithContext(ctx).RulesFrom(veneers)
+	if err != nil {
+		return nil, err
+	}
+
+	rules = append(rules, pipeline.Transforms.Veneers...)
+
+	return rewrite.NewRewrite(rules, rewrite.Config{
 		Debug: pipeline.Debug,
-	})
+	}), nil
 }
 
 func (pipeline *Pipeline) outputDir(relativeToDir string) (string, error) {
@@ -178,22 +218,76 @@ func (pipeline *Pipeline) languageOutputDir(relativeToDir string, language strin
 }
 
 func (pipeline *Pipeline) LoadSchemas(ctx context.Context) (ast.Schemas, error) {
+	schemas, _, err := pipeline.loadSchemas(ctx, pipeline.tracer)
+
+	return schemas, err
+}
+
+// loadSchemas loads the schemas described by every input, and returns them
+// with the key identifying them in the cache. The key is empty if any of the
+// inputs can't be cached. The changes made by compiler passes defined by
+// inputs are recorded with the given tracer.
+func (pipeline *Pipeline) loadSchemas(ctx context.Context, tracer *trace.Recorder) (ast.Schemas, string, error) {
 	var allSchemas ast.Schemas
 
-	for _, input := range pipeline.Inputs {
-		schemas, err := input.LoadSchemas(ctx)
+	// the cache is bypassed when tracing: traces would be incomplete otherwise.
+	cache := pipeline.cache
+	if tracer.Enabled() {
+		cache = nil
+	}
+
+	keys := make([]string, 0, len(pipeline.Inputs))
+	loadedFiles := make(map[string]string)
+	hasher := newCacheKeyHasher("inputs")
+	for i, input := range pipeline.Inputs {
+		key, err := cache.inputCacheKey(input)
 		if err != nil {
-			return nil, err
+			return nil, "", err
+		}
+		keys = append(keys, key)
+
+		entry, found := cache.load(key)
+		if !found {
+			inputCtx, files := withLoadedFiles(ctx)
+			schemas, err := input.loadSchemas(inputCtx, tracer.WithScope(fmt.Sprintf("inputs[%d]", i)))
+			if err != nil {
+				return nil, "", err
+			}
+
+			entry = cacheEntry{Schemas: schemas, Files: files.contentHashes()}
+			cache.store(key, entry)
+		}
+
+		// the files discovered while loading aren't part of the input's
+		// key: they identify the schemas as much as the key does.
+		hasher.write([]byte(key))
+		for _, path := range sortedPaths(entry.Files) {
+			hasher.write([]byte(path))
+			hasher.write([]byte(entry.Files[path]))
+
+			loadedFiles[path] = entry.Files[path]
 		}
 
-		allSchemas = append(allSchemas, schemas...)
+		allSchemas = append(allSchemas, entry.Schemas...)
+	}
+
+	pipeline.loadedFiles = sortedPaths(loadedFiles)
+
+	schemasKey := ""
+	if cache.enabled() && !tools.ItemInList("", keys) {
+		schemasKey = hasher.sum()
 	}
 
 	if allSchemas == nil {
-		return nil, nil
+		return nil, schemasKey, nil
 	}
 
-	return allSchemas.Consolidate()
+	consolidated, err := allSchemas.Consolidate()
+	if err != nil {
+		return nil, "", err
+	}
+
+	return consolidated, schemasKey, nil
 }
 
 func (pipeline *Pipeline) outputLanguages() (languages.Languages, error) {
@@ -211,8 +305,17 @@ func (pipeline *Pipeline) outputLanguages() (languages.Languages, error) {
 			outputs[openapi.LanguageRef] = openapi.New(*output.OpenAPI)
 		case output.PHP != nil:
 			outputs[php.LanguageRef] = php.New(*output.PHP)
+		case output.Protobuf != nil:
+			protobufOutput, err := pipeline.protobufOutput(*output.Protobuf)
+			if err != nil {
+				return nil, err
+			}
+
+			outputs[protobuf.LanguageRef] = protobufOutput
 		case output.Python != nil:
 			outputs[python.LanguageRef] = python.New(*output.Python)
+		case output.Rust != nil:
+			outputs[rust.LanguageRef] = rust.New(*output.Rust)
 		case output.Terraform != nil:
 			outputs[terraform.LanguageRef] = terraform.New(*output.Terraform)
 		case output.Typescript != nil:
@@ -224,3 +327,17 @@ func (pipeline *Pipeline) outputLanguages() (languages.Languages, error) {
 
 	return outputs, nil
 }
+
+// protobufOutput configures the protobuf output to read the lock file that a
+// previous run generated in its output directory, to keep the numbers of
+// fields stable across runs.
+func (pipeline *Pipeline) protobufOutput(config protobuf.Config) (*protobuf.Language, error) {
+	outputDir, err := pipeline.languageOutputDir(pipeline.currentDirectory, protobuf.LanguageRef)
+	if err != nil {
+		return nil, err
+	}
+
+	config.LockFile = filepath.Join(outputDir, protobuf.LockFileName)
+
+	return protobuf.New(config), nil
+}
@@ -0,0 +1,58 @@
+package codegen
+
+import (
+	"context"
+	"io"
+	"os"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/protobuf"
+)
+
+type ProtobufInput struct {
+	InputBase `yaml:",inline"`
+
+	// Path to a .proto file.
+	Path string `yaml:"path"`
+
+	// URL to a .proto file.
+	URL string `yaml:"url"`
+
+	// Package name to use for the input schema. If empty, it will be guessed
+	// from the package declared in the .proto file.
+	Package string `yaml:"package"`
+}
+
+func (input *ProtobufInput) interpolateParameters(interpolator ParametersInterpolator) {
+	input.InputBase.interpolateParameters(interpolator)
+
+	input.Path = interpolator(input.Path)
+	input.URL = interpolator(input.URL)
+	input.Package = interpolator(input.Package)
+}
+
+func (input *ProtobufInput) schemaReader(ctx context.Context) (io.ReadCloser, error) {
+	if input.Path != "" {
+		return os.Open(input.Path)
+	}
+
+	return loadURL(ctx, input.URL)
+}
+
+func (input *ProtobufInput) LoadSchemas(ctx context.Context) (ast.Schemas, error) {
+	schemaReader, err := input.schemaReader(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() { _ = schemaReader.Close() }()
+
+	schema, err := protobuf.GenerateAST(schemaReader, protobuf.Config{
+		Package:        input.Package,
+		SchemaMetadata: input.schemaMetadata(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return input.filterSchema(schema)
+}
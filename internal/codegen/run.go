@@ -2,23 +2,27 @@ package codegen
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"sync"
 
 	"github.com/grafana/codejen"
 	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/ast/compiler"
 	"github.com/grafana/cog/internal/jennies/common"
 	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/trace"
 	"github.com/grafana/cog/internal/veneers/rewrite"
 )
 
 func (pipeline *Pipeline) Run(ctx context.Context) (*codejen.FS, error) {
-	veneers, err := pipeline.veneers()
+	veneers, err := pipeline.veneers(ctx)
 	if err != nil {
 		return nil, err
 	}
 
-	commonPasses, err := pipeline.commonPasses()
+	commonPasses, err := pipeline.commonPasses(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -32,56 +36,140 @@ func (pipeline *Pipeline) Run(ctx context.Context) (*codejen.FS, error) {
 	}
 
 	pipeline.reporter("Parsing inputs...")
-	schemas, err := pipeline.LoadSchemas(ctx)
+	schemas, schemasKey, err := pipeline.loadSchemas(ctx, pipeline.tracer)
 	if err != nil {
 		return nil, err
 	}
 
-	generatedFS := codejen.NewFS()
-	for language, target := range targetsByLanguage {
-		pipeline.reporter(fmt.Sprintf("Running '%s' jennies...", language))
+	commonPassesKey, err := pipeline.cache.commonPassesCacheKey(pipeline, schemasKey)
+	if err != nil {
+		return nil, err
+	}
 
-		languageOutputDir, err := pipeline.languageOutputDir(pipeline.currentDirectory, language)
+	generatedFS, err := pipeline.runLanguages(targetsByLanguage, schemas, commonPasses, commonPassesKey, finalPasses, veneers)
+	if err != nil {
+		return nil, err
+	}
+
+	if pipeline.Output.RepositoryTemplates != "" {
+		repoTemplatesJenny, err := repositoryTemplatesJenny(pipeline)
 		if err != nil {
 			return nil, err
 		}
+		jennyInput := common.BuildOptions{
+			Languages: targetsByLanguage.AsLanguageRefs(),
+		}
 
-		jenniesInput, err := pipeline.jenniesInputForLanguage(target, schemas, commonPasses, finalPasses, veneers)
-		if err != nil {
+		if err := runJenny(repoTemplatesJenny, jennyInput, generatedFS); err != nil {
 			return nil, err
 		}
+	}
 
-		// prepare the jennies
-		languageJennies := target.Jennies(pipeline.jenniesConfig())
-		languageJennies.AddPostprocessors(common.PathPrefixer(languageOutputDir))
-
-		// then delegate the codegen to the jennies
-		if err := runJenny(languageJennies, jenniesInput, generatedFS); err != nil {
+	if !pipeline.Output.SkipManifest {
+		if err := pipeline.addManifests(generatedFS); err != nil {
 			return nil, err
 		}
+	}
 
-		if pipeline.Output.PackageTemplates != "" {
-			packageJennies, err := packageTemplatesJenny(pipeline, language)
-			if err != nil {
-				return nil, err
-			}
+	return generatedFS, nil
+}
+
+// runLanguages generates code for every language, in parallel. Languages
+// that fail don't prevent the others from being generated: their errors
+// are all reported.
+func (pipeline *Pipeline) runLanguages(targetsByLanguage languages.Languages, schemas ast.Schemas, commonPasses compiler.Passes, commonPassesKey string, finalPasses compiler.Passes, veneers *rewrite.Rewriter) (*codejen.FS, error) {
+	type languageResult struct {
+		fs     *codejen.FS
+		tracer *trace.Recorder
+		err    error
+	}
+
+	// languages are sorted to keep errors and traces deterministic
+	languageRefs := targetsByLanguage.AsLanguageRefs()
+	sort.Strings(languageRefs)
+
+	results := make([]languageResult, len(languageRefs))
+	workers := make(chan struct{}, pipeline.workers())
+	wg := sync.WaitGroup{}
+
+	for i, language := range languageRefs {
+		wg.Add(1)
+
+		go func(i int, language string) {
+			defer wg.Done()
+
+			workers <- struct{}{}
+			defer func() { <-workers }()
 
-			if err := runJenny(packageJennies, jenniesInput, generatedFS); err != nil {
-				return nil, err
+			// each language records its trace separately: they are
+			// merged once all of them are done.
+			var tracer *trace.Recorder
+			if pipeline.tracer.Enabled() {
+				tracer = trace.NewRecorder()
 			}
+
+			languageFS, err := pipeline.runLanguage(language, targetsByLanguage[language], schemas, commonPasses, commonPassesKey, finalPasses, veneers, tracer)
+			results[i] = languageResult{fs: languageFS, tracer: tracer, err: err}
+		}(i, language)
+	}
+
+	wg.Wait()
+
+	var errs []error
+	generatedFS := codejen.NewFS()
+	for i, result := range results {
+		if result.tracer != nil {
+			pipeline.tracer.Merge(result.tracer.Report())
+		}
+
+		if result.err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", languageRefs[i], result.err))
+			continue
+		}
+
+		if err := generatedFS.Merge(result.fs); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", languageRefs[i], err))
 		}
 	}
 
-	if pipeline.Output.RepositoryTemplates != "" {
-		repoTemplatesJenny, err := repositoryTemplatesJenny(pipeline)
+	if len(errs) != 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return generatedFS, nil
+}
+
+func (pipeline *Pipeline) runLanguage(language string, target languages.Language, schemas ast.Schemas, commonPasses compiler.Passes, commonPassesKey string, finalPasses compiler.Passes, veneers *rewrite.Rewriter, tracer *trace.Recorder) (*codejen.FS, error) {
+	pipeline.reporter(fmt.Sprintf("Running '%s' jennies...", language))
+
+	generatedFS := codejen.NewFS()
+
+	languageOutputDir, err := pipeline.languageOutputDir(pipeline.currentDirectory, language)
+	if err != nil {
+		return nil, err
+	}
+
+	jenniesInput, err := pipeline.jenniesInputForLanguage(target, schemas, commonPasses, commonPassesKey, finalPasses, veneers, StageNilChecks, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	// prepare the jennies
+	languageJennies := target.Jennies(pipeline.jenniesConfig())
+	languageJennies.AddPostprocessors(common.PathPrefixer(languageOutputDir))
+
+	// then delegate the codegen to the jennies
+	if err := runJenny(languageJennies, jenniesInput, generatedFS); err != nil {
+		return nil, err
+	}
+
+	if pipeline.Output.PackageTemplates != "" {
+		packageJennies, err := packageTemplatesJenny(pipeline, language)
 		if err != nil {
 			return nil, err
 		}
-		jennyInput := common.BuildOptions{
-			Languages: targetsByLanguage.AsLanguageRefs(),
-		}
 
-		if err := runJenny(repoTemplatesJenny, jennyInput, generatedFS); err != nil {
+		if err := runJenny(packageJennies, jenniesInput, generatedFS); err != nil {
 			return nil, err
 		}
 	}
@@ -89,20 +177,37 @@ func (pipeline *Pipeline) Run(ctx context.Context) (*codejen.FS, error) {
 	return generatedFS, nil
 }
 
-func (pipeline *Pipeline) jenniesInputForLanguage(language languages.Language, schemas ast.Schemas, commonPasses compiler.Passes, finalPasses compiler.Passes, veneers *rewrite.Rewriter) (languages.Context, error) {
+// jenniesInputForLanguage transforms the given schemas into the input expected
+// by the jennies of a language. The transformation stops after the given stage.
+// The result of common passes is cached under the given key, if not empty.
+func (pipeline *Pipeline) jenniesInputForLanguage(language languages.Language, schemas ast.Schemas, commonPasses compiler.Passes, commonPassesKey string, finalPasses compiler.Passes, veneers *rewrite.Rewriter, stopAfter Stage, recorder *trace.Recorder) (languages.Context, error) {
 	var err error
 	jenniesInput := languages.Context{
 		Schemas: schemas,
 	}
+	tracer := recorder.WithScope(language.Name())
+
+	if stopAfter == StageRaw {
+		return jenniesInput, nil
+	}
 
-	// apply common and language-specific compiler passes
-	compilerPasses := commonPasses.Concat(language.CompilerPasses()).Concat(finalPasses)
-	jenniesInput.Schemas, err = compilerPasses.Process(jenniesInput.Schemas)
+	// apply common compiler passes
+	jenniesInput.Schemas, err = pipeline.applyCommonPasses(jenniesInput.Schemas, commonPasses, commonPassesKey, tracer.WithScope("common passes"))
 	if err != nil {
 		return languages.Context{}, err
 	}
 
-	if !pipeline.Output.Builders {
+	if stopAfter == StageCommonPasses {
+		return jenniesInput, nil
+	}
+
+	// apply language-specific compiler passes
+	jenniesInput.Schemas, err = language.CompilerPasses().Concat(finalPasses).ProcessWithTrace(jenniesInput.Schemas, tracer.WithScope("language passes"))
+	if err != nil {
+		return languages.Context{}, err
+	}
+
+	if stopAfter == StageLanguagePasses || !pipeline.Output.Builders {
 		return jenniesInput, nil
 	}
 
@@ -111,11 +216,15 @@ func (pipeline *Pipeline) jenniesInputForLanguage(language languages.Language, s
 	jenniesInput.Builders = builderGenerator.FromAST(jenniesInput.Schemas)
 
 	// apply veneers to builders
-	jenniesInput.Builders, err = veneers.ApplyTo(jenniesInput.Schemas, jenniesInput.Builders, language.Name())
+	jenniesInput.Builders, err = veneers.ApplyToWithTrace(jenniesInput.Schemas, jenniesInput.Builders, language.Name(), tracer.WithScope("veneers"))
 	if err != nil {
 		return languages.Context{}, err
 	}
 
+	if stopAfter == StageVeneers {
+		return jenniesInput, nil
+	}
+
 	// with the veneers applied, generate "nil-checks" for assignments
 	jenniesInput, err = languages.GenerateBuilderNilChecks(language, jenniesInput)
 	if err != nil {
@@ -124,3 +233,26 @@ func (pipeline *Pipeline) jenniesInputForLanguage(language languages.Language, s
 
 	return jenniesInput, nil
 }
+
+// applyCommonPasses runs the common compiler passes on the given schemas, or
+// returns their cached result.
+func (pipeline *Pipeline) applyCommonPasses(schemas ast.Schemas, commonPasses compiler.Passes, cacheKey string, tracer *trace.Recorder) (ast.Schemas, error) {
+	// the cache is bypassed when tracing: traces would be incomplete otherwise.
+	cache := pipeline.cache
+	if tracer.Enabled() {
+		cache = nil
+	}
+
+	if cached, found := cache.load(cacheKey); found {
+		return cached.Schemas, nil
+	}
+
+	processed, err := commonPasses.ProcessWithTrace(schemas, tracer)
+	if err != nil {
+		return nil, err
+	}
+
+	cache.store(cacheKey, cacheEntry{Schemas: processed})
+
+	return processed, nil
+}
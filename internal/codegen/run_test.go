@@ -0,0 +1,138 @@
+package codegen
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/ast/compiler"
+	"github.com/grafana/cog/internal/jennies/golang"
+	"github.com/grafana/cog/internal/jennies/python"
+	"github.com/grafana/cog/internal/jennies/typescript"
+	"github.com/stretchr/testify/require"
+)
+
+type failingPass struct{}
+
+func (pass failingPass) Process(_ []*ast.Schema) ([]*ast.Schema, error) {
+	return nil, fmt.Errorf("failing pass")
+}
+
+func testPipeline(t *testing.T, opts ...PipelineOption) *Pipeline {
+	t.Helper()
+
+	dir := writeFiles(t, map[string]string{
+		"dashboard.json": `{
+  "type": "object",
+  "properties": {
+    "title": {"type": "string"},
+    "panels": {"type": "array", "items": {"$ref": "#/$defs/Panel"}}
+  },
+  "$defs": {
+    "Panel": {"type": "object", "properties": {"id": {"type": "integer"}}}
+  }
+}`,
+	})
+
+	pipeline, err := NewPipeline()
+	require.NoError(t, err)
+
+	pipeline.Inputs = []*Input{
+		{JSONSchema: &JSONSchemaInput{Path: filepath.Join(dir, "dashboard.json"), Package: "dashboard"}},
+	}
+	pipeline.Output = Output{
+		Directory: "out/%l",
+		Types:     true,
+		Builders:  true,
+		Languages: []*OutputLanguage{
+			{Go: &golang.Config{PackageRoot: "github.com/grafana/cog/generated"}},
+			{Python: &python.Config{}},
+			{Typescript: &typescript.Config{}},
+		},
+	}
+
+	for _, opt := range opts {
+		opt(pipeline)
+	}
+
+	return pipeline
+}
+
+func filesContent(generatedFS *codejen.FS) map[string]string {
+	files := make(map[string]string)
+	for _, file := range generatedFS.AsFiles() {
+		files[file.RelativePath] = string(file.Data)
+	}
+
+	return files
+}
+
+func TestPipeline_Run_deterministicAcrossConcurrencyLevels(t *testing.T) {
+	req := require.New(t)
+
+	sequentialFS, err := testPipeline(t, Concurrency(1)).Run(context.Background())
+	req.NoError(err)
+
+	for i := 0; i < 5; i++ {
+		concurrentFS, err := testPipeline(t, Concurrency(3)).Run(context.Background())
+		req.NoError(err)
+
+		req.Equal(filesContent(sequentialFS), filesContent(concurrentFS))
+	}
+}
+
+// Passes are shared by languages generated concurrently: stateful passes
+// must not race. Races are only detected with `go test -race` (see `make tests-race`).
+func TestPipeline_Run_sharesStatefulPassesAcrossLanguages(t *testing.T) {
+	req := require.New(t)
+
+	dir := writeFiles(t, map[string]string{
+		"passes.yaml": `passes:
+  - anonymous_structs_to_named: {}
+`,
+		"dashboard.json": `{
+  "type": "object",
+  "properties": {
+    "title": {"type": "string"},
+    "time": {"type": "object", "properties": {"from": {"type": "string"}, "to": {"type": "string"}}},
+    "refresh": {"type": "object", "properties": {"every": {"type": "string"}}}
+  }
+}`,
+	})
+
+	run := func() map[string]string {
+		pipeline := testPipeline(t, Concurrency(3))
+		pipeline.Inputs = []*Input{
+			{JSONSchema: &JSONSchemaInput{Path: filepath.Join(dir, "dashboard.json"), Package: "dashboard"}},
+		}
+		pipeline.Transforms.CommonPassesFiles = []string{filepath.Join(dir, "passes.yaml")}
+		pipeline.Transforms.FinalPasses = compiler.Passes{
+			&compiler.AnonymousStructsToNamed{},
+			&compiler.InlineObjectsWithTypes{InlineTypes: []ast.Kind{ast.KindScalar}},
+		}
+
+		generatedFS, err := pipeline.Run(context.Background())
+		req.NoError(err)
+
+		return filesContent(generatedFS)
+	}
+
+	expected := run()
+	for i := 0; i < 20; i++ {
+		req.Equal(expected, run())
+	}
+}
+
+func TestPipeline_Run_aggregatesErrorsPerLanguage(t *testing.T) {
+	req := require.New(t)
+
+	pipeline := testPipeline(t, Concurrency(2))
+	pipeline.Transforms.FinalPasses = compiler.Passes{failingPass{}}
+
+	_, err := pipeline.Run(context.Background())
+	req.Error(err)
+	req.Equal("go: failing pass\npython: failing pass\ntypescript: failing pass", err.Error())
+}
@@ -3,6 +3,7 @@ package codegen
 import (
 	"github.com/grafana/cog/internal/ast/compiler"
 	"github.com/grafana/cog/internal/tools"
+	"github.com/grafana/cog/internal/veneers/rewrite"
 )
 
 type Transforms struct {
@@ -23,6 +24,10 @@ type Transforms struct {
 	// VeneersDirectories holds a list of paths to directories containing
 	// veneers to apply to all the builders.
 	VeneersDirectories []string `yaml:"builders"`
+
+	// Veneers holds a list of veneers to apply to all the builders.
+	// Note: these veneers are applied *after* the ones found in VeneersDirectories.
+	Veneers []rewrite.LanguageRules `yaml:"-"`
 }
 
 func (transforms *Transforms) interpolateParameters(interpolator ParametersInterpolator) {
@@ -0,0 +1,229 @@
+package codegen
+
+import (
+	"bytes"
+	"context"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/grafana/codejen"
+)
+
+type watchable interface {
+	watchedPaths() []string
+}
+
+// WatchedPaths lists the files and directories the pipeline depends on:
+// its configuration file, inputs, transformations and templates.
+// Once schemas were loaded, it also includes the files read in the process
+// that the configuration doesn't mention: referenced schemas, CUE
+// libraries, …
+// Directories are meant to be watched recursively.
+func (pipeline *Pipeline) WatchedPaths() []string {
+	var paths []string
+
+	if pipeline.configFile != "" {
+		paths = append(paths, pipeline.configFile)
+	}
+
+	for _, input := range pipeline.Inputs {
+		loader, err := input.loader()
+		if err != nil {
+			continue
+		}
+
+		if watchableLoader, ok := loader.(watchable); ok {
+			paths = append(paths, watchableLoader.watchedPaths()...)
+		}
+	}
+
+	paths = append(paths, pipeline.loadedFiles...)
+	paths = append(paths, pipeline.Transforms.CommonPassesFiles...)
+	paths = append(paths, pipeline.Transforms.VeneersDirectories...)
+	paths = append(paths, pipeline.Output.PackageTemplates, pipeline.Output.RepositoryTemplates)
+
+	return uniquePaths(paths)
+}
+
+func (input *InputBase) watchedPaths() []string {
+	return append([]string{}, input.Transforms...)
+}
+
+func (input *FilesInput) watchedPaths() []string {
+	paths := append([]string{}, input.Paths...)
+	if input.Glob != "" {
+		paths = append(paths, globRoot(input.Glob))
+	}
+
+	return paths
+}
+
+func (input *JSONSchemaInput) watchedPaths() []string {
+	paths := append(input.InputBase.watchedPaths(), input.Path)
+	paths = append(paths, input.FilesInput.watchedPaths()...)
+	for path := range input.References {
+		paths = append(paths, path)
+	}
+
+	return paths
+}
+
+func (input *OpenAPIInput) watchedPaths() []string {
+	paths := append(input.InputBase.watchedPaths(), input.Path)
+
+	return append(paths, input.FilesInput.watchedPaths()...)
+}
+
+func (input *ProtobufInput) watchedPaths() []string {
+	return append(input.InputBase.watchedPaths(), input.Path)
+}
+
+func (input *KindRegistryInput) watchedPaths() []string {
+	if input.Path == "" {
+		return input.InputBase.watchedPaths()
+	}
+
+	return append(input.InputBase.watchedPaths(), filepath.Join(kindRegistryRoot(input), input.Version))
+}
+
+func (input *CueInput) watchedPaths() []string {
+	paths := append(input.InputBase.watchedPaths(), input.Entrypoint)
+	for _, importDefinition := range input.CueImports {
+		// see simplecue.ParseImports(): imports are defined as `[path]:[import]`
+		if path, _, found := strings.Cut(importDefinition, ":"); found {
+			paths = append(paths, path)
+		}
+	}
+
+	return paths
+}
+
+func uniquePaths(paths []string) []string {
+	seen := make(map[string]struct{}, len(paths))
+	unique := make([]string, 0, len(paths))
+
+	for _, path := range paths {
+		if path == "" {
+			continue
+		}
+		if _, found := seen[path]; found {
+			continue
+		}
+
+		seen[path] = struct{}{}
+		unique = append(unique, path)
+	}
+
+	sort.Strings(unique)
+
+	return unique
+}
+
+type fileState struct {
+	size    int64
+	modTime int64
+}
+
+// FilesSnapshot records the state of a set of files, to detect when they
+// change.
+type FilesSnapshot map[string]fileState
+
+// SnapshotFiles records the state of the given files. Directories are walked
+// recursively and missing files are ignored: they will be seen as changed
+// once they are created.
+func SnapshotFiles(paths []string) (FilesSnapshot, error) {
+	snapshot := make(FilesSnapshot)
+
+	for _, path := range paths {
+		err := filepath.WalkDir(path, func(file string, entry fs.DirEntry, err error) error {
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+
+				return err
+			}
+
+			if entry.IsDir() {
+				return nil
+			}
+
+			info, err := entry.Info()
+			if err != nil {
+				if os.IsNotExist(err) {
+					return nil
+				}
+
+				return err
+			}
+
+			snapshot[file] = fileState{size: info.Size(), modTime: info.ModTime().UnixNano()}
+
+			return nil
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return snapshot, nil
+}
+
+// ChangedSince lists the files created, modified or removed between the
+// given snapshot and this one.
+func (snapshot FilesSnapshot) ChangedSince(previous FilesSnapshot) []string {
+	var changed []string
+
+	for file, state := range snapshot {
+		if previousState, found := previous[file]; !found || previousState != state {
+			changed = append(changed, file)
+		}
+	}
+
+	for file := range previous {
+		if _, found := snapshot[file]; !found {
+			changed = append(changed, file)
+		}
+	}
+
+	sort.Strings(changed)
+
+	return changed
+}
+
+// WriteChangedFiles writes the files of the given FS under the given prefix,
+// skipping the ones whose content didn't change. It returns the paths of the
+// files that were written.
+func WriteChangedFiles(ctx context.Context, generatedFS *codejen.FS, prefix string) ([]string, error) {
+	var written []string
+
+	for _, file := range generatedFS.AsFiles() {
+		if err := ctx.Err(); err != nil {
+			return written, err
+		}
+
+		path := filepath.Join(prefix, file.RelativePath)
+
+		existing, err := os.ReadFile(path)
+		if err == nil && bytes.Equal(existing, file.Data) {
+			continue
+		}
+		if err != nil && !os.IsNotExist(err) {
+			return written, err
+		}
+
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			return written, err
+		}
+		if err := os.WriteFile(path, file.Data, 0644); err != nil { //nolint:gosec
+			return written, err
+		}
+
+		written = append(written, path)
+	}
+
+	return written, nil
+}
@@ -0,0 +1,148 @@
+package codegen
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grafana/codejen"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipeline_WatchedPaths(t *testing.T) {
+	req := require.New(t)
+
+	pipeline := &Pipeline{
+		configFile: "/config/pipeline.yaml",
+		Inputs: []*Input{
+			{
+				JSONSchema: &JSONSchemaInput{
+					InputBase:  InputBase{Transforms: []string{"/passes/jsonschema.yaml"}},
+					Path:       "/schemas/dashboard.json",
+					References: map[string]string{"/schemas/common.json": "common"},
+				},
+			},
+			{
+				OpenAPI: &OpenAPIInput{
+					FilesInput: FilesInput{Glob: "/openapi/**/*.yaml"},
+				},
+			},
+			{
+				Cue: &CueInput{
+					Entrypoint: "/cue/dashboard",
+					CueImports: []string{"/cue/common:github.com/grafana/grafana/packages/grafana-schema/src/common"},
+				},
+			},
+		},
+		Transforms: Transforms{
+			CommonPassesFiles:  []string{"/passes/common.yaml"},
+			VeneersDirectories: []string{"/veneers"},
+		},
+		Output: Output{
+			PackageTemplates: "/templates/package",
+		},
+	}
+
+	req.Equal([]string{
+		"/config/pipeline.yaml",
+		"/cue/common",
+		"/cue/dashboard",
+		"/openapi",
+		"/passes/common.yaml",
+		"/passes/jsonschema.yaml",
+		"/schemas/common.json",
+		"/schemas/dashboard.json",
+		"/templates/package",
+		"/veneers",
+	}, pipeline.WatchedPaths())
+}
+
+func TestPipeline_WatchedPaths_includesLoadedFiles(t *testing.T) {
+	req := require.New(t)
+
+	dir := writeFiles(t, map[string]string{
+		"jsonschema/panel.json":  `{"type": "object", "properties": {"unit": {"$ref": "../common/types.json#/$defs/Unit"}}}`,
+		"common/types.json":      `{"$defs": {"Unit": {"type": "string"}}}`,
+		"openapi/api.yaml":       "openapi: 3.0.0\ninfo: {title: api, version: 1.0.0}\npaths: {}\ncomponents:\n  schemas:\n    Unit:\n      $ref: '../common/openapi.yaml#/components/schemas/Unit'\n",
+		"common/openapi.yaml":    "openapi: 3.0.0\ninfo: {title: common, version: 1.0.0}\npaths: {}\ncomponents:\n  schemas:\n    Unit:\n      type: string\n",
+		"cue/dashboard/dash.cue": "package dashboard\n\nimport \"example.com/common\"\n\nDashboard: {\n\tunit: common.Unit\n}\n",
+		"cue/common/common.cue":  "package common\n\nUnit: string\n",
+	})
+
+	pipeline, err := NewPipeline()
+	req.NoError(err)
+	pipeline.Inputs = []*Input{
+		{JSONSchema: &JSONSchemaInput{Path: filepath.Join(dir, "jsonschema", "panel.json"), Package: "panel"}},
+		{OpenAPI: &OpenAPIInput{Path: filepath.Join(dir, "openapi", "api.yaml"), Package: "api", NoValidate: true}},
+		{Cue: &CueInput{
+			Entrypoint: filepath.Join(dir, "cue", "dashboard"),
+			CueImports: []string{filepath.Join(dir, "cue", "common") + ":example.com/common"},
+		}},
+	}
+
+	_, err = pipeline.LoadSchemas(context.Background())
+	req.NoError(err)
+
+	watchedPaths := pipeline.WatchedPaths()
+	req.Contains(watchedPaths, filepath.Join(dir, "common", "types.json"))
+	req.Contains(watchedPaths, filepath.Join(dir, "common", "openapi.yaml"))
+	req.Contains(watchedPaths, filepath.Join(dir, "cue", "common", "common.cue"))
+}
+
+func TestFilesSnapshot_ChangedSince(t *testing.T) {
+	req := require.New(t)
+
+	dir := writeFiles(t, map[string]string{
+		"schema.json":         "{}",
+		"veneers/panel.yaml":  "builders: []",
+		"veneers/unused.yaml": "builders: []",
+	})
+	paths := []string{filepath.Join(dir, "schema.json"), filepath.Join(dir, "veneers")}
+
+	before, err := SnapshotFiles(append(paths, filepath.Join(dir, "missing.json")))
+	req.NoError(err)
+
+	unchanged, err := SnapshotFiles(paths)
+	req.NoError(err)
+	req.Empty(unchanged.ChangedSince(before))
+
+	req.NoError(os.WriteFile(filepath.Join(dir, "schema.json"), []byte(`{"type": "object"}`), 0600))
+	req.NoError(os.WriteFile(filepath.Join(dir, "veneers", "new.yaml"), []byte("builders: []"), 0600))
+	req.NoError(os.Remove(filepath.Join(dir, "veneers", "unused.yaml")))
+
+	after, err := SnapshotFiles(paths)
+	req.NoError(err)
+	req.Equal([]string{
+		filepath.Join(dir, "schema.json"),
+		filepath.Join(dir, "veneers", "new.yaml"),
+		filepath.Join(dir, "veneers", "unused.yaml"),
+	}, after.ChangedSince(before))
+}
+
+func TestWriteChangedFiles(t *testing.T) {
+	req := require.New(t)
+
+	dir := writeFiles(t, map[string]string{
+		"go/unchanged.go": "package unchanged",
+		"go/changed.go":   "package old",
+	})
+
+	generatedFS := codejen.NewFS()
+	req.NoError(generatedFS.Add(
+		*codejen.NewFile("go/unchanged.go", []byte("package unchanged"), nil),
+		*codejen.NewFile("go/changed.go", []byte("package changed"), nil),
+		*codejen.NewFile("go/new/new.go", []byte("package new"), nil),
+	))
+
+	written, err := WriteChangedFiles(context.Background(), generatedFS, dir)
+	req.NoError(err)
+	req.Equal([]string{
+		filepath.Join(dir, "go", "changed.go"),
+		filepath.Join(dir, "go", "new", "new.go"),
+	}, written)
+
+	content, err := os.ReadFile(filepath.Join(dir, "go", "changed.go"))
+	req.NoError(err)
+	req.Equal("package changed", string(content))
+}
@@ -0,0 +1,152 @@
+package diff
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/grafana/cog/internal/ast"
+)
+
+// Builders compares two versions of a set of builders and reports the
+// differences between them.
+func Builders(oldBuilders ast.Builders, newBuilders ast.Builders) Report {
+	differ := &differ{}
+
+	newByName := make(map[string]ast.Builder, len(newBuilders))
+	for _, builder := range newBuilders {
+		newByName[builderPath(builder)] = builder
+	}
+
+	oldByName := make(map[string]ast.Builder, len(oldBuilders))
+	for _, oldBuilder := range oldBuilders {
+		path := builderPath(oldBuilder)
+		oldByName[path] = oldBuilder
+
+		newBuilder, found := newByName[path]
+		if !found {
+			differ.report(Removed, path, true, "builder removed")
+			continue
+		}
+
+		differ.diffBuilder(path, oldBuilder, newBuilder)
+	}
+
+	for _, newBuilder := range newBuilders {
+		if _, found := oldByName[builderPath(newBuilder)]; !found {
+			differ.report(Added, builderPath(newBuilder), false, "builder added")
+		}
+	}
+
+	return Report{Changes: differ.changes}
+}
+
+func builderPath(builder ast.Builder) string {
+	return builder.Package + "." + builder.Name
+}
+
+func (differ *differ) diffBuilder(path string, oldBuilder ast.Builder, newBuilder ast.Builder) {
+	if oldFor, newFor := oldBuilder.For.SelfRef.String(), newBuilder.For.SelfRef.String(); oldFor != newFor {
+		differ.reportValues(Changed, path, true, "built object changed", oldFor, newFor)
+	}
+
+	if oldConstructor, newConstructor := describeJSON(oldBuilder.Constructor), describeJSON(newBuilder.Constructor); oldConstructor != newConstructor {
+		differ.reportValues(Changed, path, true, "constructor changed", oldConstructor, newConstructor)
+	}
+
+	if oldProperties, newProperties := describeJSON(oldBuilder.Properties), describeJSON(newBuilder.Properties); oldProperties != newProperties {
+		differ.reportValues(Changed, path, false, "properties changed", oldProperties, newProperties)
+	}
+
+	for _, oldOption := range oldBuilder.Options {
+		optionPath := path + "." + oldOption.Name
+
+		newOption, found := newBuilder.OptionByName(oldOption.Name)
+		if !found {
+			differ.report(Removed, optionPath, true, "option removed")
+			continue
+		}
+
+		differ.diffOption(optionPath, oldOption, newOption)
+	}
+
+	for _, newOption := range newBuilder.Options {
+		if _, found := oldBuilder.OptionByName(newOption.Name); !found {
+			differ.report(Added, path+"."+newOption.Name, false, "option added")
+		}
+	}
+}
+
+func (differ *differ) diffOption(path string, oldOption ast.Option, newOption ast.Option) {
+	if oldArgs, newArgs := describeJSON(oldOption.Args), describeJSON(newOption.Args); oldArgs != newArgs {
+		differ.reportValues(Changed, path, true, "arguments changed", oldArgs, newArgs)
+	}
+
+	if oldAssignments, newAssignments := describeJSON(oldOption.Assignments), describeJSON(newOption.Assignments); oldAssignments != newAssignments {
+		differ.reportValues(Changed, path, false, "assignments changed", oldAssignments, newAssignments)
+	}
+
+	if oldDefault, newDefault := describeJSON(oldOption.Default), describeJSON(newOption.Default); oldDefault != newDefault {
+		differ.reportValues(Changed, path, false, "default changed", oldDefault, newDefault)
+	}
+
+	if oldComments, newComments := describeJSON(oldOption.Comments), describeJSON(newOption.Comments); oldComments != newComments {
+		differ.reportValues(Changed, path, false, "comments changed", oldComments, newComments)
+	}
+}
+
+// describeJSON returns a JSON representation of the given input, in which
+// nil and empty values are omitted: they are considered equivalent.
+func describeJSON(input any) string {
+	marshaled, err := json.Marshal(input)
+	if err != nil {
+		return fmt.Sprintf("%v", input)
+	}
+
+	var decoded any
+	if err := json.Unmarshal(marshaled, &decoded); err != nil {
+		return string(marshaled)
+	}
+
+	decoded = withoutEmptyValues(decoded)
+	if decoded == nil {
+		return ""
+	}
+
+	marshaled, err = json.Marshal(decoded)
+	if err != nil {
+		return fmt.Sprintf("%v", input)
+	}
+
+	return string(marshaled)
+}
+
+func withoutEmptyValues(input any) any {
+	switch value := input.(type) {
+	case map[string]any:
+		for key, item := range value {
+			if cleaned := withoutEmptyValues(item); cleaned != nil {
+				value[key] = cleaned
+			} else {
+				delete(value, key)
+			}
+		}
+
+		if len(value) == 0 {
+			return nil
+		}
+
+		return value
+	case []any:
+		if len(value) == 0 {
+			return nil
+		}
+
+		for i, item := range value {
+			value[i] = withoutEmptyValues(item)
+		}
+
+		return value
+	default:
+		return value
+	}
+}
@@ -0,0 +1,448 @@
+package diff
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+)
+
+type ChangeKind string
+
+const (
+	Added   ChangeKind = "added"
+	Removed ChangeKind = "removed"
+	Changed ChangeKind = "changed"
+)
+
+// Change describes a single difference between two versions of a set of schemas.
+type Change struct {
+	Kind ChangeKind `json:"kind"`
+	// Path identifies the element affected by the change.
+	// ie: `dashboard.Panel.gridPos.x`
+	Path string `json:"path"`
+	// Breaking indicates whether the change is likely to break code
+	// relying on the SDKs generated from the previous version.
+	Breaking bool   `json:"breaking"`
+	Message  string `json:"message"`
+	Old      string `json:"old,omitempty"`
+	New      string `json:"new,omitempty"`
+}
+
+type Report struct {
+	Changes []Change `json:"changes"`
+}
+
+func (report Report) HasBreakingChanges() bool {
+	for _, change := range report.Changes {
+		if change.Breaking {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (report Report) Breaking() []Change {
+	return report.filter(true)
+}
+
+func (report Report) NonBreaking() []Change {
+	return report.filter(false)
+}
+
+func (report Report) filter(breaking bool) []Change {
+	changes := make([]Change, 0, len(report.Changes))
+	for _, change := range report.Changes {
+		if change.Breaking == breaking {
+			changes = append(changes, change)
+		}
+	}
+
+	return changes
+}
+
+// Schemas compares two versions of a set of schemas and reports the
+// differences between them.
+func Schemas(oldSchemas ast.Schemas, newSchemas ast.Schemas) Report {
+	differ := &differ{}
+
+	for _, oldSchema := range oldSchemas {
+		newSchema, found := newSchemas.Locate(oldSchema.Package)
+		if !found {
+			differ.report(Removed, oldSchema.Package, true, "package removed")
+			continue
+		}
+
+		differ.diffSchema(oldSchema, newSchema)
+	}
+
+	for _, newSchema := range newSchemas {
+		if _, found := oldSchemas.Locate(newSchema.Package); !found {
+			differ.report(Added, newSchema.Package, false, "package added")
+		}
+	}
+
+	return Report{Changes: differ.changes}
+}
+
+type differ struct {
+	changes []Change
+}
+
+func (differ *differ) report(kind ChangeKind, path string, breaking bool, message string) {
+	differ.reportValues(kind, path, breaking, message, "", "")
+}
+
+func (differ *differ) reportValues(kind ChangeKind, path string, breaking bool, message string, oldValue string, newValue string) {
+	differ.changes = append(differ.changes, Change{
+		Kind:     kind,
+		Path:     path,
+		Breaking: breaking,
+		Message:  message,
+		Old:      oldValue,
+		New:      newValue,
+	})
+}
+
+func (differ *differ) diffSchema(oldSchema *ast.Schema, newSchema *ast.Schema) {
+	oldSchema.Objects.Iterate(func(name string, oldObject ast.Object) {
+		path := oldSchema.Package + "." + name
+
+		newObject, found := newSchema.LocateObject(name)
+		if !found {
+			differ.report(Removed, path, true, "object removed")
+			return
+		}
+
+		differ.diffDeprecation(path, oldObject.Deprecated, newObject.Deprecated)
+		differ.diffType(path, oldObject.Type, newObject.Type)
+	})
+
+	newSchema.Objects.Iterate(func(name string, _ ast.Object) {
+		if _, found := oldSchema.LocateObject(name); !found {
+			differ.report(Added, newSchema.Package+"."+name, false, "object added")
+		}
+	})
+}
+
+func (differ *differ) diffDeprecation(path string, oldDeprecation *ast.Deprecation, newDeprecation *ast.Deprecation) {
+	if oldDeprecation == nil && newDeprecation != nil {
+		differ.reportValues(Changed, path, false, "deprecated", "", newDeprecation.Message)
+	}
+	if oldDeprecation != nil && newDeprecation == nil {
+		differ.report(Changed, path, false, "no longer deprecated")
+	}
+}
+
+func (differ *differ) diffType(path string, oldType ast.Type, newType ast.Type) {
+	if oldType.Kind != newType.Kind {
+		differ.reportValues(Changed, path, true, "type changed", describeType(oldType), describeType(newType))
+		return
+	}
+
+	if oldType.Nullable != newType.Nullable {
+		differ.reportValues(Changed, path, true, "nullability changed", fmt.Sprint(oldType.Nullable), fmt.Sprint(newType.Nullable))
+	}
+
+	differ.diffDefault(path, oldType.Default, newType.Default)
+
+	switch oldType.Kind {
+	case ast.KindScalar:
+		differ.diffScalar(path, oldType.AsScalar(), newType.AsScalar())
+	case ast.KindRef:
+		if oldType.AsRef().String() != newType.AsRef().String() {
+			differ.reportValues(Changed, path, true, "type changed", describeType(oldType), describeType(newType))
+		}
+	case ast.KindArray:
+		differ.diffType(path+"[]", oldType.AsArray().ValueType, newType.AsArray().ValueType)
+	case ast.KindMap:
+		differ.diffType(path+"{key}", oldType.AsMap().IndexType, newType.AsMap().IndexType)
+		differ.diffType(path+"{}", oldType.AsMap().ValueType, newType.AsMap().ValueType)
+	case ast.KindEnum:
+		differ.diffEnum(path, oldType.AsEnum(), newType.AsEnum())
+	case ast.KindStruct:
+		differ.diffStruct(path, oldType.AsStruct(), newType.AsStruct())
+	case ast.KindDisjunction:
+		differ.diffBranches(path, oldType.AsDisjunction().Branches, newType.AsDisjunction().Branches)
+	case ast.KindIntersection:
+		differ.diffBranches(path, oldType.AsIntersection().Branches, newType.AsIntersection().Branches)
+	case ast.KindComposableSlot:
+		if oldType.AsComposableSlot().Variant != newType.AsComposableSlot().Variant {
+			differ.reportValues(Changed, path, true, "type changed", describeType(oldType), describeType(newType))
+		}
+	}
+}
+
+func (differ *differ) diffDefault(path string, oldDefault any, newDefault any) {
+	oldValue := describeValue(oldDefault)
+	newValue := describeValue(newDefault)
+
+	switch {
+	case oldDefault == nil && newDefault != nil:
+		differ.reportValues(Added, path, false, "default value added", "", newValue)
+	case oldDefault != nil && newDefault == nil:
+		differ.reportValues(Removed, path, false, "default value removed", oldValue, "")
+	case oldValue != newValue:
+		differ.reportValues(Changed, path, false, "default value changed", oldValue, newValue)
+	}
+}
+
+func (differ *differ) diffScalar(path string, oldScalar ast.ScalarType, newScalar ast.ScalarType) {
+	if oldScalar.ScalarKind != newScalar.ScalarKind {
+		differ.reportValues(Changed, path, true, "type changed", string(oldScalar.ScalarKind), string(newScalar.ScalarKind))
+		return
+	}
+
+	if oldValue, newValue := describeValue(oldScalar.Value), describeValue(newScalar.Value); oldValue != newValue {
+		differ.reportValues(Changed, path, true, "constant value changed", oldValue, newValue)
+	}
+
+	oldConstraints := describeConstraints(oldScalar.Constraints)
+	newConstraints := describeConstraints(newScalar.Constraints)
+
+	for _, op := range sortedKeys(oldConstraints) {
+		newConstraint, found := newConstraints[op]
+		if !found {
+			differ.reportValues(Removed, path, false, "constraint removed", oldConstraints[op], "")
+			continue
+		}
+
+		if newConstraint != oldConstraints[op] {
+			breaking := constraintTightened(ast.Op(op), oldScalar.Constraints, newScalar.Constraints)
+			differ.reportValues(Changed, path, breaking, "constraint changed", oldConstraints[op], newConstraint)
+		}
+	}
+
+	for _, op := range sortedKeys(newConstraints) {
+		if _, found := oldConstraints[op]; !found {
+			differ.reportValues(Added, path, true, "constraint added", "", newConstraints[op])
+		}
+	}
+}
+
+func (differ *differ) diffEnum(path string, oldEnum ast.EnumType, newEnum ast.EnumType) {
+	newValues := make(map[string]ast.EnumValue, len(newEnum.Values))
+	for _, value := range newEnum.Values {
+		newValues[describeValue(value.Value)] = value
+	}
+
+	oldValues := make(map[string]ast.EnumValue, len(oldEnum.Values))
+	for _, oldValue := range oldEnum.Values {
+		value := describeValue(oldValue.Value)
+		oldValues[value] = oldValue
+
+		newValue, found := newValues[value]
+		if !found {
+			differ.reportValues(Removed, path, true, "enum value removed", value, "")
+			continue
+		}
+
+		// generated code refers to enum values by their name
+		if newValue.Name != oldValue.Name {
+			differ.reportValues(Changed, path, true, "enum member renamed", oldValue.Name, newValue.Name)
+		}
+	}
+
+	for _, newValue := range newEnum.Values {
+		if _, found := oldValues[describeValue(newValue.Value)]; !found {
+			differ.reportValues(Added, path, false, "enum value added", "", describeValue(newValue.Value))
+		}
+	}
+}
+
+func (differ *differ) diffStruct(path string, oldStruct ast.StructType, newStruct ast.StructType) {
+	for _, oldField := range oldStruct.Fields {
+		fieldPath := path + "." + oldField.Name
+
+		newField, found := newStruct.FieldByName(oldField.Name)
+		if !found {
+			differ.report(Removed, fieldPath, true, "field removed")
+			continue
+		}
+
+		// the generated types depend on whether a field is required or not
+		// (pointers in Go, optional values in Rust or TypeScript, ...)
+		if oldField.Required != newField.Required {
+			message := "field is now required"
+			if !newField.Required {
+				message = "field is now optional"
+			}
+
+			differ.report(Changed, fieldPath, true, message)
+		}
+
+		differ.diffDeprecation(fieldPath, oldField.Deprecated, newField.Deprecated)
+		differ.diffType(fieldPath, oldField.Type, newField.Type)
+	}
+
+	for _, newField := range newStruct.Fields {
+		if _, found := oldStruct.FieldByName(newField.Name); !found {
+			message := "optional field added"
+			if newField.Required {
+				message = "required field added"
+			}
+
+			differ.report(Added, path+"."+newField.Name, newField.Required, message)
+		}
+	}
+}
+
+func (differ *differ) diffBranches(path string, oldBranches ast.Types, newBranches ast.Types) {
+	oldDescriptions := make(map[string]struct{}, len(oldBranches))
+	for _, branch := range oldBranches {
+		oldDescriptions[describeType(branch)] = struct{}{}
+	}
+
+	newDescriptions := make(map[string]struct{}, len(newBranches))
+	for _, branch := range newBranches {
+		newDescriptions[describeType(branch)] = struct{}{}
+	}
+
+	for _, branch := range oldBranches {
+		if _, found := newDescriptions[describeType(branch)]; !found {
+			differ.reportValues(Removed, path, true, "branch removed", describeType(branch), "")
+		}
+	}
+
+	for _, branch := range newBranches {
+		if _, found := oldDescriptions[describeType(branch)]; !found {
+			differ.reportValues(Added, path, false, "branch added", "", describeType(branch))
+		}
+	}
+}
+
+func describeConstraints(constraints []ast.TypeConstraint) map[string]string {
+	described := make(map[string]string, len(constraints))
+	for _, constraint := range constraints {
+		args := make([]string, 0, len(constraint.Args))
+		for _, arg := range constraint.Args {
+			args = append(args, describeValue(arg))
+		}
+
+		description := fmt.Sprintf("%s %s", constraint.Op, strings.Join(args, ", "))
+		if existing, found := described[string(constraint.Op)]; found {
+			description = existing + " && " + description
+		}
+
+		described[string(constraint.Op)] = description
+	}
+
+	return described
+}
+
+// constraintTightened tells whether the constraints using the given operator
+// accept fewer values than before. Changes that can't be compared, like
+// patterns, are considered as tightening.
+func constraintTightened(op ast.Op, oldConstraints []ast.TypeConstraint, newConstraints []ast.TypeConstraint) bool {
+	oldArg, oldFound := singleNumericArg(op, oldConstraints)
+	newArg, newFound := singleNumericArg(op, newConstraints)
+	if !oldFound || !newFound {
+		return true
+	}
+
+	switch op {
+	case ast.GreaterThanOp, ast.GreaterThanEqualOp, ast.MinLengthOp:
+		return newArg > oldArg
+	case ast.LessThanOp, ast.LessThanEqualOp, ast.MaxLengthOp:
+		return newArg < oldArg
+	case ast.MultipleOfOp:
+		// loosened if every multiple of the old value is a multiple of the new one
+		return oldArg == 0 || math.Mod(oldArg, newArg) != 0
+	default:
+		return true
+	}
+}
+
+// singleNumericArg returns the argument of the only constraint using the
+// given operator, if it is a number.
+func singleNumericArg(op ast.Op, constraints []ast.TypeConstraint) (float64, bool) {
+	var matching []ast.TypeConstraint
+	for _, constraint := range constraints {
+		if constraint.Op == op {
+			matching = append(matching, constraint)
+		}
+	}
+
+	if len(matching) != 1 || len(matching[0].Args) == 0 {
+		return 0, false
+	}
+
+	switch arg := matching[0].Args[0].(type) {
+	case int:
+		return float64(arg), true
+	case int64:
+		return float64(arg), true
+	case uint64:
+		return float64(arg), true
+	case float64:
+		return arg, true
+	default:
+		return 0, false
+	}
+}
+
+func describeValue(value any) string {
+	if value == nil {
+		return ""
+	}
+
+	if str, ok := value.(string); ok {
+		return fmt.Sprintf("%q", str)
+	}
+
+	return fmt.Sprintf("%v", value)
+}
+
+func describeType(def ast.Type) string {
+	description := ""
+
+	switch def.Kind {
+	case ast.KindScalar:
+		description = string(def.AsScalar().ScalarKind)
+		if def.AsScalar().Value != nil {
+			description = describeValue(def.AsScalar().Value)
+		}
+	case ast.KindRef:
+		description = def.AsRef().String()
+	case ast.KindArray:
+		description = "[]" + describeType(def.AsArray().ValueType)
+	case ast.KindMap:
+		description = fmt.Sprintf("map[%s]%s", describeType(def.AsMap().IndexType), describeType(def.AsMap().ValueType))
+	case ast.KindDisjunction:
+		branches := make([]string, 0, len(def.AsDisjunction().Branches))
+		for _, branch := range def.AsDisjunction().Branches {
+			branches = append(branches, describeType(branch))
+		}
+		description = strings.Join(branches, " | ")
+	case ast.KindIntersection:
+		branches := make([]string, 0, len(def.AsIntersection().Branches))
+		for _, branch := range def.AsIntersection().Branches {
+			branches = append(branches, describeType(branch))
+		}
+		description = strings.Join(branches, " & ")
+	case ast.KindComposableSlot:
+		description = fmt.Sprintf("composable slot (%s)", def.AsComposableSlot().Variant)
+	default:
+		description = string(def.Kind)
+	}
+
+	if def.Nullable {
+		return description + "?"
+	}
+
+	return description
+}
+
+func sortedKeys(input map[string]string) []string {
+	keys := make([]string, 0, len(input))
+	for key := range input {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
@@ -0,0 +1,256 @@
+package diff
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func schemaWith(objects ...ast.Object) *ast.Schema {
+	schema := ast.NewSchema("pkg", ast.SchemaMeta{})
+	schema.AddObjects(objects...)
+
+	return schema
+}
+
+func TestSchemas(t *testing.T) {
+	testCases := []struct {
+		description string
+		old         ast.Schemas
+		new         ast.Schemas
+		expected    []Change
+	}{
+		{
+			description: "identical schemas",
+			old:         ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.String()))},
+			new:         ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.String()))},
+			expected:    nil,
+		},
+		{
+			description: "added and removed packages",
+			old:         ast.Schemas{ast.NewSchema("old", ast.SchemaMeta{})},
+			new:         ast.Schemas{ast.NewSchema("new", ast.SchemaMeta{})},
+			expected: []Change{
+				{Kind: Removed, Path: "old", Breaking: true, Message: "package removed"},
+				{Kind: Added, Path: "new", Breaking: false, Message: "package added"},
+			},
+		},
+		{
+			description: "added and removed objects",
+			old:         ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.String()))},
+			new:         ast.Schemas{schemaWith(ast.NewObject("pkg", "Bar", ast.String()))},
+			expected: []Change{
+				{Kind: Removed, Path: "pkg.Foo", Breaking: true, Message: "object removed"},
+				{Kind: Added, Path: "pkg.Bar", Breaking: false, Message: "object added"},
+			},
+		},
+		{
+			description: "changed type",
+			old:         ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.String()))},
+			new:         ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewArray(ast.String())))},
+			expected: []Change{
+				{Kind: Changed, Path: "pkg.Foo", Breaking: true, Message: "type changed", Old: "string", New: "[]string"},
+			},
+		},
+		{
+			description: "struct fields",
+			old: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewStruct(
+				ast.NewStructField("removed", ast.String()),
+				ast.NewStructField("nowOptional", ast.String(), ast.Required()),
+				ast.NewStructField("retyped", ast.NewScalar(ast.KindInt64)),
+			)))},
+			new: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewStruct(
+				ast.NewStructField("nowOptional", ast.String()),
+				ast.NewStructField("retyped", ast.NewScalar(ast.KindInt32)),
+				ast.NewStructField("optional", ast.String()),
+				ast.NewStructField("required", ast.String(), ast.Required()),
+			)))},
+			expected: []Change{
+				{Kind: Removed, Path: "pkg.Foo.removed", Breaking: true, Message: "field removed"},
+				{Kind: Changed, Path: "pkg.Foo.nowOptional", Breaking: true, Message: "field is now optional"},
+				{Kind: Changed, Path: "pkg.Foo.retyped", Breaking: true, Message: "type changed", Old: "int64", New: "int32"},
+				{Kind: Added, Path: "pkg.Foo.optional", Breaking: false, Message: "optional field added"},
+				{Kind: Added, Path: "pkg.Foo.required", Breaking: true, Message: "required field added"},
+			},
+		},
+		{
+			description: "enum values",
+			old: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewEnum([]ast.EnumValue{
+				{Name: "A", Value: "a", Type: ast.String()},
+				{Name: "B", Value: "b", Type: ast.String()},
+			})))},
+			new: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewEnum([]ast.EnumValue{
+				{Name: "A", Value: "a", Type: ast.String()},
+				{Name: "C", Value: "c", Type: ast.String()},
+			})))},
+			expected: []Change{
+				{Kind: Removed, Path: "pkg.Foo", Breaking: true, Message: "enum value removed", Old: `"b"`},
+				{Kind: Added, Path: "pkg.Foo", Breaking: false, Message: "enum value added", New: `"c"`},
+			},
+		},
+		{
+			description: "defaults",
+			old: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewStruct(
+				ast.NewStructField("changed", ast.String(ast.Default("a"))),
+				ast.NewStructField("removed", ast.String(ast.Default("a"))),
+				ast.NewStructField("added", ast.String()),
+			)))},
+			new: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewStruct(
+				ast.NewStructField("changed", ast.String(ast.Default("b"))),
+				ast.NewStructField("removed", ast.String()),
+				ast.NewStructField("added", ast.String(ast.Default("b"))),
+			)))},
+			expected: []Change{
+				{Kind: Changed, Path: "pkg.Foo.changed", Breaking: false, Message: "default value changed", Old: `"a"`, New: `"b"`},
+				{Kind: Removed, Path: "pkg.Foo.removed", Breaking: false, Message: "default value removed", Old: `"a"`},
+				{Kind: Added, Path: "pkg.Foo.added", Breaking: false, Message: "default value added", New: `"b"`},
+			},
+		},
+		{
+			description: "constraints",
+			old: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewScalar(ast.KindInt64, func(def *ast.Type) {
+				def.Scalar.Constraints = []ast.TypeConstraint{
+					{Op: ast.GreaterThanEqualOp, Args: []any{0}},
+					{Op: ast.LessThanOp, Args: []any{10}},
+				}
+			})))},
+			new: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewScalar(ast.KindInt64, func(def *ast.Type) {
+				def.Scalar.Constraints = []ast.TypeConstraint{
+					{Op: ast.GreaterThanEqualOp, Args: []any{1}},
+					{Op: ast.MultipleOfOp, Args: []any{2}},
+				}
+			})))},
+			expected: []Change{
+				{Kind: Removed, Path: "pkg.Foo", Breaking: false, Message: "constraint removed", Old: "< 10"},
+				{Kind: Changed, Path: "pkg.Foo", Breaking: true, Message: "constraint changed", Old: ">= 0", New: ">= 1"},
+				{Kind: Added, Path: "pkg.Foo", Breaking: true, Message: "constraint added", New: "multipleOf 2"},
+			},
+		},
+		{
+			description: "tightened constraints",
+			old: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewScalar(ast.KindInt64, func(def *ast.Type) {
+				def.Scalar.Constraints = []ast.TypeConstraint{
+					{Op: ast.GreaterThanOp, Args: []any{0}},
+					{Op: ast.LessThanEqualOp, Args: []any{10}},
+					{Op: ast.MultipleOfOp, Args: []any{2}},
+				}
+			})))},
+			new: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewScalar(ast.KindInt64, func(def *ast.Type) {
+				def.Scalar.Constraints = []ast.TypeConstraint{
+					{Op: ast.GreaterThanOp, Args: []any{5}},
+					{Op: ast.LessThanEqualOp, Args: []any{8}},
+					{Op: ast.MultipleOfOp, Args: []any{3}},
+				}
+			})))},
+			expected: []Change{
+				{Kind: Changed, Path: "pkg.Foo", Breaking: true, Message: "constraint changed", Old: "<= 10", New: "<= 8"},
+				{Kind: Changed, Path: "pkg.Foo", Breaking: true, Message: "constraint changed", Old: "> 0", New: "> 5"},
+				{Kind: Changed, Path: "pkg.Foo", Breaking: true, Message: "constraint changed", Old: "multipleOf 2", New: "multipleOf 3"},
+			},
+		},
+		{
+			description: "loosened constraints",
+			old: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewScalar(ast.KindString, func(def *ast.Type) {
+				def.Scalar.Constraints = []ast.TypeConstraint{
+					{Op: ast.MinLengthOp, Args: []any{5}},
+					{Op: ast.MaxLengthOp, Args: []any{10}},
+					{Op: ast.PatternOp, Args: []any{"^[a-z]+$"}},
+				}
+			})))},
+			new: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewScalar(ast.KindString, func(def *ast.Type) {
+				def.Scalar.Constraints = []ast.TypeConstraint{
+					{Op: ast.MinLengthOp, Args: []any{1}},
+					{Op: ast.MaxLengthOp, Args: []any{20}},
+					{Op: ast.PatternOp, Args: []any{"^[a-z0-9]+$"}},
+				}
+			})))},
+			expected: []Change{
+				{Kind: Changed, Path: "pkg.Foo", Breaking: true, Message: "constraint changed", Old: `=~ "^[a-z]+$"`, New: `=~ "^[a-z0-9]+$"`},
+				{Kind: Changed, Path: "pkg.Foo", Breaking: false, Message: "constraint changed", Old: "maxLength 10", New: "maxLength 20"},
+				{Kind: Changed, Path: "pkg.Foo", Breaking: false, Message: "constraint changed", Old: "minLength 5", New: "minLength 1"},
+			},
+		},
+		{
+			description: "loosened multipleOf constraint",
+			old: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewScalar(ast.KindFloat64, func(def *ast.Type) {
+				def.Scalar.Constraints = []ast.TypeConstraint{
+					{Op: ast.MultipleOfOp, Args: []any{1.5}},
+				}
+			})))},
+			new: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewScalar(ast.KindFloat64, func(def *ast.Type) {
+				def.Scalar.Constraints = []ast.TypeConstraint{
+					{Op: ast.MultipleOfOp, Args: []any{0.5}},
+				}
+			})))},
+			expected: []Change{
+				{Kind: Changed, Path: "pkg.Foo", Breaking: false, Message: "constraint changed", Old: "multipleOf 1.5", New: "multipleOf 0.5"},
+			},
+		},
+		{
+			description: "nested types",
+			old: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewStruct(
+				ast.NewStructField("items", ast.NewArray(ast.NewRef("pkg", "Bar"))),
+			)))},
+			new: ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewStruct(
+				ast.NewStructField("items", ast.NewArray(ast.NewRef("pkg", "Baz", ast.Nullable()))),
+			)))},
+			expected: []Change{
+				{Kind: Changed, Path: "pkg.Foo.items[]", Breaking: true, Message: "nullability changed", Old: "false", New: "true"},
+				{Kind: Changed, Path: "pkg.Foo.items[]", Breaking: true, Message: "type changed", Old: "pkg.Bar", New: "pkg.Baz?"},
+			},
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+
+		t.Run(tc.description, func(t *testing.T) {
+			req := require.New(t)
+
+			report := Schemas(tc.old, tc.new)
+
+			req.Equal(tc.expected, report.Changes)
+		})
+	}
+}
+
+func TestReport_Breaking(t *testing.T) {
+	req := require.New(t)
+
+	report := Report{
+		Changes: []Change{
+			{Kind: Removed, Path: "pkg.Foo", Breaking: true},
+			{Kind: Added, Path: "pkg.Bar", Breaking: false},
+		},
+	}
+
+	req.True(report.HasBreakingChanges())
+	req.Equal([]Change{report.Changes[0]}, report.Breaking())
+	req.Equal([]Change{report.Changes[1]}, report.NonBreaking())
+	req.False(Report{Changes: report.NonBreaking()}.HasBreakingChanges())
+}
+
+func TestBuilders(t *testing.T) {
+	req := require.New(t)
+
+	schemas := ast.Schemas{schemaWith(ast.NewObject("pkg", "Foo", ast.NewStruct(
+		ast.NewStructField("title", ast.String()),
+		ast.NewStructField("tags", ast.NewArray(ast.String())),
+	)))}
+	oldBuilders := (&ast.BuilderGenerator{}).FromAST(schemas)
+
+	newBuilders := ast.Builders{oldBuilders[0].DeepCopy()}
+	newBuilders[0].Options = newBuilders[0].Options[:1]
+	newBuilders[0].Options[0].Name = "name"
+	newBuilders = append(newBuilders, ast.Builder{Package: "pkg", Name: "Bar"})
+
+	report := Builders(oldBuilders, newBuilders)
+
+	req.Equal([]Change{
+		{Kind: Removed, Path: "pkg.Foo.title", Breaking: true, Message: "option removed"},
+		{Kind: Removed, Path: "pkg.Foo.tags", Breaking: true, Message: "option removed"},
+		{Kind: Added, Path: "pkg.Foo.name", Breaking: false, Message: "option added"},
+		{Kind: Added, Path: "pkg.Bar", Breaking: false, Message: "builder added"},
+	}, report.Changes)
+}
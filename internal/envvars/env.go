@@ -9,3 +9,12 @@ const VarUpdateGolden = "COG_UPDATE_GOLDEN"
 // archives on failures.
 // It is controlled by setting COG_UPDATE_GOLDEN to a non-empty string like "true".
 var UpdateGoldenFiles = os.Getenv(VarUpdateGolden) != "" //nolint: gochecknoglobals
+
+// VarCompileGenerated is the name of the env var enabling tests that
+// compile generated code with the language's toolchain.
+const VarCompileGenerated = "COG_COMPILE_GENERATED"
+
+// CompileGeneratedCode determines whether tests should compile generated
+// code. These tests need the toolchain and access to its package registry.
+// It is controlled by setting COG_COMPILE_GENERATED to a non-empty string like "true".
+var CompileGeneratedCode = os.Getenv(VarCompileGenerated) != "" //nolint: gochecknoglobals
@@ -0,0 +1,120 @@
+package common
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+)
+
+// ValidationPath represents the JSON path of a value being validated.
+// Each item is either a field name or a variable holding an array index
+// or a map key.
+type ValidationPath []ValidationPathItem
+
+type ValidationPathItem struct {
+	Field    string
+	Variable string
+}
+
+func (path ValidationPath) AppendField(name string) ValidationPath {
+	return path.append(ValidationPathItem{Field: name})
+}
+
+func (path ValidationPath) AppendVariable(variable string) ValidationPath {
+	return path.append(ValidationPathItem{Variable: variable})
+}
+
+func (path ValidationPath) append(item ValidationPathItem) ValidationPath {
+	newPath := make(ValidationPath, 0, len(path)+1)
+	newPath = append(newPath, path...)
+
+	return append(newPath, item)
+}
+
+// IsStatic tells whether the path can be fully known without evaluating any variable.
+func (path ValidationPath) IsStatic() bool {
+	for _, item := range path {
+		if item.Variable != "" {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Format turns the path into a string: fields are separated by a dot and
+// variables are enclosed in brackets. The given formatter is used to
+// reference variables in the target language.
+// Example: `panels[<idx>].targets`
+func (path ValidationPath) Format(variableFormatter func(variable string) string) string {
+	var buffer strings.Builder
+
+	for i, item := range path {
+		if item.Variable != "" {
+			buffer.WriteString("[" + variableFormatter(item.Variable) + "]")
+			continue
+		}
+
+		if i != 0 {
+			buffer.WriteString(".")
+		}
+		buffer.WriteString(item.Field)
+	}
+
+	return buffer.String()
+}
+
+// ConstraintViolationMessage describes what a value must respect to satisfy
+// the given constraint.
+func ConstraintViolationMessage(constraint ast.TypeConstraint) string {
+	param := ""
+	if len(constraint.Args) != 0 {
+		param = fmt.Sprintf("%v", constraint.Args[0])
+	}
+
+	switch constraint.Op {
+	case ast.MinLengthOp:
+		return "length must be >= " + param
+	case ast.MaxLengthOp:
+		return "length must be <= " + param
+	case ast.MultipleOfOp:
+		return "must be a multiple of " + param
+	case ast.PatternOp:
+		return "must match the pattern " + param
+	case ast.NotPatternOp:
+		return "must not match the pattern " + param
+	default:
+		return fmt.Sprintf("must be %s %s", constraint.Op, param)
+	}
+}
+
+// CheckRegexPattern makes sure that the parameter of a pattern constraint
+// can be compiled by RE2-like engines, such as Go's `regexp` package or
+// Rust's `regex` crate: lookarounds and backreferences aren't supported.
+func CheckRegexPattern(pattern any) (string, error) {
+	patternStr, ok := pattern.(string)
+	if !ok {
+		return "", fmt.Errorf("pattern constraint expects a string, got %T", pattern)
+	}
+
+	if _, err := regexp.Compile(patternStr); err != nil {
+		return "", fmt.Errorf("pattern %q is not supported by the target regex engine: %w", patternStr, err)
+	}
+
+	return patternStr, nil
+}
+
+// EnumViolationMessage describes the values allowed by an enum.
+func EnumViolationMessage(values []any) string {
+	formatted := make([]string, 0, len(values))
+	for _, value := range values {
+		formatted = append(formatted, fmt.Sprintf("%v", value))
+	}
+
+	return "must be one of: " + strings.Join(formatted, ", ")
+}
+
+// ValidationRequiredMessage is used when a required field is missing.
+const ValidationRequiredMessage = "is required"
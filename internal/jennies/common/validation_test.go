@@ -0,0 +1,44 @@
+package common
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidationPath_Format(t *testing.T) {
+	req := require.New(t)
+
+	path := ValidationPath{}.AppendField("panels").AppendVariable("i").AppendField("targets")
+
+	req.False(path.IsStatic())
+	req.Equal("panels[<i>].targets", path.Format(func(variable string) string {
+		return "<" + variable + ">"
+	}))
+	req.True(ValidationPath{}.AppendField("spec").AppendField("title").IsStatic())
+	req.Equal("spec.title", ValidationPath{}.AppendField("spec").AppendField("title").Format(nil))
+}
+
+func TestConstraintViolationMessage(t *testing.T) {
+	req := require.New(t)
+
+	req.Equal("length must be >= 1", ConstraintViolationMessage(ast.TypeConstraint{Op: ast.MinLengthOp, Args: []any{1}}))
+	req.Equal("must be a multiple of 5", ConstraintViolationMessage(ast.TypeConstraint{Op: ast.MultipleOfOp, Args: []any{5}}))
+	req.Equal("must be < 10", ConstraintViolationMessage(ast.TypeConstraint{Op: ast.LessThanOp, Args: []any{10}}))
+	req.Equal("must be one of: a, b", EnumViolationMessage([]any{"a", "b"}))
+}
+
+func TestCheckRegexPattern(t *testing.T) {
+	req := require.New(t)
+
+	pattern, err := CheckRegexPattern("^[a-z0-9-]+$")
+	req.NoError(err)
+	req.Equal("^[a-z0-9-]+$", pattern)
+
+	_, err = CheckRegexPattern("^(?!-)[a-z]+$")
+	req.ErrorContains(err, `pattern "^(?!-)[a-z]+$" is not supported`)
+
+	_, err = CheckRegexPattern(42)
+	req.ErrorContains(err, "expects a string")
+}
@@ -66,14 +66,21 @@ func (jenny *Builder) generateBuilder(context languages.Context, builder ast.Bui
 		buildObjectSignature = jenny.typeFormatter.variantInterface(builder.For.Type.ImplementedVariant())
 	}
 
-	err := templates.
+	patterns, err := jenny.regexPatterns(builder)
+	if err != nil {
+		return nil, err
+	}
+
+	err = templates.
 		Funcs(map[string]any{
 			"formatPath": jenny.formatFieldPath,
 			"formatType": jenny.typeFormatter.formatType,
 			"formatTypeNoBuilder": func(typeDef ast.Type) string {
 				return jenny.typeFormatter.doFormatType(typeDef, false)
 			},
-			"typeHasBuilder": context.ResolveToBuilder,
+			"typeHasBuilder":           context.ResolveToBuilder,
+			"regexPattern":             patterns.add,
+			"regexPatternDeclarations": patterns.declarations,
 			"resolvesToComposableSlot": func(typeDef ast.Type) bool {
 				_, found := context.ResolveToComposableSlot(typeDef)
 				return found
@@ -112,6 +119,31 @@ func (jenny *Builder) generateBuilder(context languages.Context, builder ast.Bui
 	return []byte(buffer.String()), nil
 }
 
+// regexPatterns registers the patterns used by the builder's constraints
+// upfront, to reject unsupported ones before generating anything.
+func (jenny *Builder) regexPatterns(builder ast.Builder) (*regexPatterns, error) {
+	patterns := newRegexPatterns(tools.LowerCamelCase(builder.Name) + "BuilderPattern")
+
+	assignments := append([]ast.Assignment{}, builder.Constructor.Assignments...)
+	for _, opt := range builder.Options {
+		assignments = append(assignments, opt.Assignments...)
+	}
+
+	for _, assignment := range assignments {
+		for _, constraint := range assignment.Constraints {
+			if constraint.Op != ast.PatternOp && constraint.Op != ast.NotPatternOp {
+				continue
+			}
+
+			if _, err := patterns.add(constraint.Parameter); err != nil {
+				return nil, fmt.Errorf("builder %s: %s: %w", builder.Name, constraint.Argument.Name, err)
+			}
+		}
+	}
+
+	return patterns, nil
+}
+
 func (jenny *Builder) genDefaultOptionsCalls(context languages.Context, builder ast.Builder) []template.OptionCall {
 	calls := make([]template.OptionCall, 0)
 	for _, opt := range builder.Options {
@@ -3,6 +3,7 @@ package golang
 import (
 	"testing"
 
+	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/languages"
 	"github.com/grafana/cog/internal/testutils"
 	"github.com/stretchr/testify/require"
@@ -14,6 +15,7 @@ func TestBuilder_Generate(t *testing.T) {
 		Name:         "GoBuilder",
 		Skip: map[string]string{
 			"builder_delegation_in_disjunction": "disjunctions are eliminated with compiler passes",
+			"dashboard_from_object":             "disjunctions are eliminated with compiler passes",
 		},
 	}
 
@@ -37,3 +39,42 @@ func TestBuilder_Generate(t *testing.T) {
 		tc.WriteFiles(files)
 	})
 }
+
+func TestBuilder_Generate_rejectsUnsupportedPattern(t *testing.T) {
+	req := require.New(t)
+
+	slugField := ast.NewStructField("slug", ast.String())
+	slugArg := ast.Argument{Name: "slug", Type: ast.String()}
+	object := ast.NewObject("constraints", "SomeStruct", ast.NewStruct(slugField))
+	builder := ast.Builder{
+		For:     object,
+		Package: "constraints",
+		Name:    "SomeStruct",
+		Options: []ast.Option{
+			{
+				Name: "slug",
+				Args: []ast.Argument{slugArg},
+				Assignments: []ast.Assignment{
+					{
+						Path:   ast.PathFromStructField(slugField),
+						Value:  ast.AssignmentValue{Argument: &slugArg},
+						Method: ast.DirectAssignment,
+						Constraints: []ast.AssignmentConstraint{
+							{Argument: slugArg, Op: ast.PatternOp, Parameter: "^(?!-)[a-z]+$"},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	schema := ast.NewSchema("constraints", ast.SchemaMeta{})
+	schema.AddObject(object)
+
+	jenny := Builder{Config: Config{PackageRoot: "github.com/grafana/cog/generated"}}
+	_, err := jenny.Generate(languages.Context{
+		Schemas:  ast.Schemas{schema},
+		Builders: ast.Builders{builder},
+	})
+	req.ErrorContains(err, `builder SomeStruct: slug: pattern "^(?!-)[a-z]+$" is not supported`)
+}
@@ -0,0 +1,599 @@
+package golang
+
+import (
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// BuilderConverter generates, for each builder, a function turning an
+// instance of the object it builds into the Go code calling the builder's
+// constructor and options to produce that same instance.
+type BuilderConverter struct {
+	Config Config
+
+	typeImportMapper func(pkg string) string
+	typeFormatter    *typeFormatter
+	// codeTypeFormatter formats types as they appear in the generated code:
+	// always qualified with their package name.
+	codeTypeFormatter *typeFormatter
+	varsCount         int
+}
+
+func (jenny *BuilderConverter) JennyName() string {
+	return "GoConverter"
+}
+
+func (jenny *BuilderConverter) Generate(context languages.Context) (codejen.Files, error) {
+	files := codejen.Files{}
+
+	for _, builder := range context.Builders {
+		output, err := jenny.generateConverter(context, builder)
+		if err != nil {
+			return nil, err
+		}
+
+		filename := filepath.Join(
+			formatPackageName(builder.Package),
+			fmt.Sprintf("%s_converter_gen.go", strings.ToLower(builder.Name)),
+		)
+
+		files = append(files, *codejen.NewFile(filename, output, jenny))
+	}
+
+	return files, nil
+}
+
+func (jenny *BuilderConverter) generateConverter(context languages.Context, builder ast.Builder) ([]byte, error) {
+	var buffer strings.Builder
+
+	imports := NewImportMap()
+	jenny.typeImportMapper = func(pkg string) string {
+		if imports.IsIdentical(pkg, builder.Package) {
+			return ""
+		}
+
+		return imports.Add(pkg, jenny.Config.importPath(pkg))
+	}
+	jenny.typeFormatter = defaultTypeFormatter(jenny.Config, context, jenny.typeImportMapper)
+	jenny.codeTypeFormatter = builderTypeFormatter(jenny.Config, context, formatPackageName)
+	jenny.varsCount = 0
+
+	// every converter relies on cog's runtime to dump values, so let's make sure it's declared.
+	jenny.typeImportMapper("cog")
+
+	inputType := jenny.importType(builder.For.SelfRef)
+	body := jenny.converterBody(context, builder)
+	objectName := tools.UpperCamelCase(builder.For.Name)
+
+	buffer.WriteString(fmt.Sprintf("package %s\n\n", formatPackageName(builder.Package)))
+	buffer.WriteString(imports.String() + "\n\n")
+	buffer.WriteString(fmt.Sprintf("// %s accepts a `%s` object and generates the Go code to build this object using builders.\n", converterFuncName(builder), objectName))
+	buffer.WriteString(fmt.Sprintf("func %s(input %s) string {\n", converterFuncName(builder), inputType))
+	buffer.WriteString(body)
+	buffer.WriteString("}\n")
+
+	output, err := format.Source([]byte(buffer.String()))
+	if err != nil {
+		return nil, fmt.Errorf("builder %s: could not format converter: %w", builder.Name, err)
+	}
+
+	return output, nil
+}
+
+func (jenny *BuilderConverter) converterBody(context languages.Context, builder ast.Builder) string {
+	var buffer strings.Builder
+
+	// paths already set by the constructor or by a previous option
+	assignedPaths := make(map[string]bool)
+
+	constructorArgs := make([]string, 0, len(builder.Constructor.Args))
+	for _, arg := range builder.Constructor.Args {
+		argVar := jenny.newVar(formatArgName(arg.Name) + "Arg")
+		zeroValue := fmt.Sprintf("%q", jenny.zeroValueCode(context, arg.Type))
+
+		assignment, found := assignmentForArg(builder.Constructor.Assignments, arg)
+		if !found {
+			buffer.WriteString(fmt.Sprintf("%s := %s\n", argVar, zeroValue))
+			constructorArgs = append(constructorArgs, argVar)
+			continue
+		}
+
+		guards, valueExpr := jenny.readPath("input", assignment.Path)
+		lastType := assignment.Path.Last().Type
+		if jenny.isPointer(context, lastType) {
+			guards = append(guards, valueExpr+" != nil")
+			valueExpr = "*" + valueExpr
+		}
+
+		setup, value, ok := jenny.argValueCode(context, arg.Type, valueExpr)
+		if !ok {
+			buffer.WriteString(fmt.Sprintf("%s := %s\n", argVar, zeroValue))
+			constructorArgs = append(constructorArgs, argVar)
+			continue
+		}
+
+		if len(guards) == 0 {
+			buffer.WriteString(setup)
+			buffer.WriteString(fmt.Sprintf("%s := %s\n", argVar, value))
+		} else {
+			buffer.WriteString(fmt.Sprintf("%s := %s\n", argVar, zeroValue))
+			openingCode, closingCode := jenny.openGuards(guards)
+			buffer.WriteString(openingCode)
+			buffer.WriteString(setup)
+			buffer.WriteString(fmt.Sprintf("%s = %s\n", argVar, value))
+			buffer.WriteString(closingCode)
+		}
+
+		constructorArgs = append(constructorArgs, argVar)
+	}
+
+	for _, assignment := range builder.Constructor.Assignments {
+		assignedPaths[assignment.Path.String()] = true
+	}
+
+	constructorCall := formatCallCode(fmt.Sprintf("%s.New%sBuilder", formatPackageName(builder.Package), tools.UpperCamelCase(builder.Name)), constructorArgs)
+
+	buffer.WriteString(fmt.Sprintf("calls := []string{\n%s,\n}\n\n", constructorCall))
+
+	for _, opt := range builder.Options {
+		code, ok := jenny.optionCode(context, opt, assignedPaths)
+		if !ok {
+			continue
+		}
+
+		buffer.WriteString(code + "\n")
+	}
+
+	buffer.WriteString("return strings.Join(calls, \".\\n\")\n")
+
+	return buffer.String()
+}
+
+// optionCode generates the code checking whether the given option is needed
+// to reproduce the input, and adding a call to it if so.
+// Options that can not be reproduced, or that set paths already set by
+// another option are skipped.
+func (jenny *BuilderConverter) optionCode(context languages.Context, opt ast.Option, assignedPaths map[string]bool) (string, bool) {
+	if len(opt.Assignments) == 0 {
+		return "", false
+	}
+
+	var guards []string
+	var setup strings.Builder
+	argValues := make(map[string]string, len(opt.Args))
+	loops := 0
+	newPaths := make([]string, 0, len(opt.Assignments))
+
+	for _, assignment := range opt.Assignments {
+		pathKey := assignment.Path.String()
+		if assignment.Value.Constant != nil {
+			pathKey += fmt.Sprintf("=%v", assignment.Value.Constant)
+		}
+		if assignedPaths[pathKey] {
+			return "", false
+		}
+		newPaths = append(newPaths, pathKey)
+
+		pathGuards, valueExpr := jenny.readPath("input", assignment.Path)
+		guards = append(guards, pathGuards...)
+		lastType := assignment.Path.Last().Type
+
+		switch assignment.Method {
+		case ast.AppendAssignment:
+			if !lastType.IsArray() || loops != 0 {
+				return "", false
+			}
+			loops++
+
+			itemVar := jenny.newVar("item")
+			guards = append(guards, fmt.Sprintf("for _, %s := range %s", itemVar, valueExpr))
+
+			valueGuards, ok := jenny.bindValue(context, assignment.Value, itemVar, lastType.AsArray().ValueType, false, argValues)
+			if !ok {
+				return "", false
+			}
+			guards = append(guards, valueGuards...)
+		case ast.IndexAssignment:
+			if !lastType.IsMap() || loops != 0 || assignment.Index == nil {
+				return "", false
+			}
+			loops++
+
+			keyVar := jenny.newVar("key")
+			itemVar := jenny.newVar("item")
+			guards = append(guards, fmt.Sprintf("for %s, %s := range %s", keyVar, itemVar, valueExpr))
+
+			indexGuards, ok := jenny.bindValue(context, *assignment.Index, keyVar, lastType.AsMap().IndexType, true, argValues)
+			if !ok {
+				return "", false
+			}
+			guards = append(guards, indexGuards...)
+
+			valueGuards, ok := jenny.bindValue(context, assignment.Value, itemVar, lastType.AsMap().ValueType, false, argValues)
+			if !ok {
+				return "", false
+			}
+			guards = append(guards, valueGuards...)
+		default:
+			if lastType.IsAny() && assignment.Value.Argument == nil {
+				return "", false
+			}
+
+			if assignment.Value.Constant == nil && jenny.isNilable(context, lastType) {
+				guards = append(guards, valueExpr+" != nil")
+			} else if assignment.Value.Argument != nil && len(opt.Assignments) == 1 {
+				// the builder leaves this value untouched: only call the
+				// option if it holds something else.
+				if untouched, ok := jenny.untouchedValueCode(context, opt, lastType); ok {
+					guards = append(guards, fmt.Sprintf("%s != %s", valueExpr, untouched))
+				}
+			}
+
+			valueGuards, ok := jenny.bindValue(context, assignment.Value, valueExpr, lastType, true, argValues)
+			if !ok {
+				return "", false
+			}
+			guards = append(guards, valueGuards...)
+		}
+	}
+
+	args := make([]string, 0, len(opt.Args))
+	for _, arg := range opt.Args {
+		valueExpr, found := argValues[arg.Name]
+		if !found {
+			return "", false
+		}
+
+		argSetup, value, ok := jenny.argValueCode(context, arg.Type, valueExpr)
+		if !ok {
+			return "", false
+		}
+
+		argVar := jenny.newVar(formatArgName(arg.Name) + "Arg")
+		setup.WriteString(argSetup)
+		setup.WriteString(fmt.Sprintf("%s := %s\n", argVar, value))
+		args = append(args, argVar)
+	}
+
+	for _, path := range newPaths {
+		assignedPaths[path] = true
+	}
+
+	call := formatCallCode(tools.UpperCamelCase(opt.Name), args)
+
+	openingCode, closingCode := jenny.openGuards(guards)
+
+	var buffer strings.Builder
+	buffer.WriteString(openingCode)
+	buffer.WriteString(setup.String())
+	buffer.WriteString(fmt.Sprintf("calls = append(calls, %s)\n", call))
+	buffer.WriteString(closingCode)
+
+	return buffer.String(), true
+}
+
+// bindValue records where the arguments used by the given assignment value
+// can be read from, and returns the conditions under which this value
+// matches the input.
+// Builders' arguments are never pointers: pointers read from the input are
+// dereferenced, after checking that they aren't nil unless guarded is set.
+func (jenny *BuilderConverter) bindValue(context languages.Context, value ast.AssignmentValue, valueExpr string, valueType ast.Type, guarded bool, argValues map[string]string) ([]string, bool) {
+	if value.Constant != nil {
+		if valueType.IsAny() {
+			return nil, false
+		}
+
+		if jenny.isPointer(context, valueType) {
+			return []string{fmt.Sprintf("%s != nil && *%s == %s", valueExpr, valueExpr, formatScalar(value.Constant))}, true
+		}
+
+		return []string{fmt.Sprintf("%s == %s", valueExpr, formatScalar(value.Constant))}, true
+	}
+
+	if value.Argument != nil {
+		var guards []string
+		if jenny.isPointer(context, valueType) {
+			if !guarded {
+				guards = append(guards, valueExpr+" != nil")
+			}
+			valueExpr = "*" + valueExpr
+		}
+
+		argValues[value.Argument.Name] = valueExpr
+
+		return guards, true
+	}
+
+	if value.Envelope != nil {
+		var guards []string
+		for _, envelopeValue := range value.Envelope.Values {
+			pathGuards, fieldExpr := jenny.readPath(valueExpr, envelopeValue.Path)
+			guards = append(guards, pathGuards...)
+
+			valueGuards, ok := jenny.bindValue(context, envelopeValue.Value, fieldExpr, envelopeValue.Path.Last().Type, false, argValues)
+			if !ok {
+				return nil, false
+			}
+			guards = append(guards, valueGuards...)
+		}
+
+		return guards, true
+	}
+
+	return nil, false
+}
+
+// argValueCode returns the code producing the Go code for the given
+// argument's value. Arguments resolving to builders are converted using
+// the converter of these builders, other values are dumped as-is.
+func (jenny *BuilderConverter) argValueCode(context languages.Context, argType ast.Type, valueExpr string) (string, string, bool) {
+	if _, isComposableSlot := context.ResolveToComposableSlot(argType); isComposableSlot {
+		return "", "", false
+	}
+
+	if !context.ResolveToBuilder(argType) {
+		return "", fmt.Sprintf("%s.Dump(%s)", jenny.typeImportMapper("cog"), valueExpr), true
+	}
+
+	if argType.IsRef() {
+		builder, found := builderForRef(context, argType.AsRef())
+		if !found {
+			return "", "", false
+		}
+
+		converter := converterFuncName(builder)
+		if pkg := jenny.typeImportMapper(builder.Package); pkg != "" {
+			converter = pkg + "." + converter
+		}
+
+		return "", fmt.Sprintf("%s(%s)", converter, valueExpr), true
+	}
+
+	if !argType.IsArray() {
+		return "", "", false
+	}
+
+	itemVar := jenny.newVar("item")
+	resultVar := jenny.newVar("tmp")
+	itemType := argType.AsArray().ValueType
+	itemExpr := itemVar
+	if jenny.isPointer(context, itemType) {
+		itemExpr = "*" + itemVar
+	}
+
+	itemSetup, itemValue, ok := jenny.argValueCode(context, itemType, itemExpr)
+	if !ok {
+		return "", "", false
+	}
+
+	var setup strings.Builder
+	setup.WriteString(fmt.Sprintf("%s := make([]string, 0, len(%s))\n", resultVar, valueExpr))
+	setup.WriteString(fmt.Sprintf("for _, %s := range %s {\n", itemVar, valueExpr))
+	setup.WriteString(itemSetup)
+	setup.WriteString(fmt.Sprintf("%s = append(%s, %s)\n", resultVar, resultVar, itemValue))
+	setup.WriteString("}\n")
+
+	value := fmt.Sprintf(
+		"`%s{` + strings.Join(%s, \",\\n\") + `}`",
+		jenny.codeTypeFormatter.formatType(argType),
+		resultVar,
+	)
+
+	return setup.String(), value, true
+}
+
+// readPath returns the expression reading the given path from root, along
+// with the conditions to check before reading it.
+func (jenny *BuilderConverter) readPath(root string, path ast.Path) ([]string, string) {
+	var guards []string
+	expr := root
+
+	for i, item := range path {
+		expr += "." + tools.UpperCamelCase(item.Identifier)
+
+		if i == len(path)-1 {
+			break
+		}
+
+		if item.Type.IsAny() && item.TypeHint != nil {
+			castVar := jenny.newVar("cast")
+			guards = append(guards, fmt.Sprintf(
+				"%s, ok := %s.(*%s); ok",
+				castVar, expr, jenny.typeFormatter.doFormatType(*item.TypeHint, false),
+			))
+			expr = castVar
+			continue
+		}
+
+		if item.Type.Nullable || item.Type.IsAny() {
+			guards = append(guards, expr+" != nil")
+		}
+	}
+
+	return guards, expr
+}
+
+// openGuards opens a block for each of the given conditions or loops, and
+// returns the code closing them. Conditions already checked by an enclosing
+// block are ignored.
+func (jenny *BuilderConverter) openGuards(guards []string) (string, string) {
+	var buffer strings.Builder
+	opened := make(map[string]bool, len(guards))
+
+	for _, guard := range guards {
+		if opened[guard] {
+			continue
+		}
+		opened[guard] = true
+
+		if strings.HasPrefix(guard, "for ") {
+			buffer.WriteString(guard + " {\n")
+			continue
+		}
+
+		buffer.WriteString(fmt.Sprintf("if %s {\n", guard))
+	}
+
+	return buffer.String(), strings.Repeat("}\n", len(opened))
+}
+
+// untouchedValueCode returns the value a non-nullable field holds when the
+// given option isn't called: its default, or the zero value of its type.
+func (jenny *BuilderConverter) untouchedValueCode(context languages.Context, opt ast.Option, typeDef ast.Type) (string, bool) {
+	resolved := context.ResolveRefs(typeDef)
+	if resolved.IsEnum() {
+		resolved = resolved.AsEnum().Values[0].Type
+	}
+
+	if !resolved.IsScalar() || resolved.AsScalar().ScalarKind == ast.KindBytes || resolved.HasHint(ast.HintStringFormatDateTime) {
+		return "", false
+	}
+
+	if opt.Default != nil && len(opt.Default.ArgsValues) == 1 {
+		return formatScalar(opt.Default.ArgsValues[0]), true
+	}
+
+	return zeroScalarCode(resolved.AsScalar().ScalarKind), true
+}
+
+// zeroValueCode returns the Go code of the zero value of the given type.
+func (jenny *BuilderConverter) zeroValueCode(context languages.Context, typeDef ast.Type) string {
+	if jenny.isNilable(context, typeDef) || context.ResolveToBuilder(typeDef) {
+		return "nil"
+	}
+
+	resolved := context.ResolveRefs(typeDef)
+	if resolved.IsEnum() {
+		resolved = resolved.AsEnum().Values[0].Type
+	}
+
+	if resolved.IsScalar() && !resolved.HasHint(ast.HintStringFormatDateTime) {
+		return zeroScalarCode(resolved.AsScalar().ScalarKind)
+	}
+
+	return jenny.codeTypeFormatter.doFormatType(typeDef, false) + "{}"
+}
+
+func zeroScalarCode(kind ast.ScalarKind) string {
+	switch kind {
+	case ast.KindString:
+		return `""`
+	case ast.KindBool:
+		return "false"
+	case ast.KindBytes:
+		return "nil"
+	default:
+		return "0"
+	}
+}
+
+// isPointer tells whether the given type is represented as a pointer in
+// Go types.
+func (jenny *BuilderConverter) isPointer(context languages.Context, typeDef ast.Type) bool {
+	if !typeDef.Nullable || typeDef.IsArray() || typeDef.IsMap() || typeDef.IsAny() || typeDef.IsComposableSlot() {
+		return false
+	}
+
+	if typeDef.IsScalar() {
+		return typeDef.AsScalar().ScalarKind != ast.KindBytes
+	}
+
+	// references to constants are represented by the constant's type
+	if typeDef.IsRef() {
+		referredType, found := context.LocateObject(typeDef.AsRef().ReferredPkg, typeDef.AsRef().ReferredType)
+		if found && referredType.Type.IsConcreteScalar() {
+			return false
+		}
+	}
+
+	return true
+}
+
+// isNilable tells whether values of the given type can be nil.
+func (jenny *BuilderConverter) isNilable(context languages.Context, typeDef ast.Type) bool {
+	if typeDef.IsArray() || typeDef.IsMap() || typeDef.IsAny() || typeDef.IsComposableSlot() {
+		return true
+	}
+
+	if typeDef.IsScalar() && typeDef.AsScalar().ScalarKind == ast.KindBytes {
+		return true
+	}
+
+	return jenny.isPointer(context, typeDef)
+}
+
+func (jenny *BuilderConverter) newVar(prefix string) string {
+	jenny.varsCount++
+
+	return fmt.Sprintf("%s%d", prefix, jenny.varsCount)
+}
+
+// importType declares an import statement for the type definition of
+// the given object and returns a fully qualified type name for it.
+func (jenny *BuilderConverter) importType(typeRef ast.RefType) string {
+	pkg := jenny.typeImportMapper(typeRef.ReferredPkg)
+	typeName := tools.UpperCamelCase(typeRef.ReferredType)
+	if pkg == "" {
+		return typeName
+	}
+
+	return fmt.Sprintf("%s.%s", pkg, typeName)
+}
+
+// formatCallCode returns the code producing a call to the given function,
+// with arguments held by the given variables.
+func formatCallCode(function string, argVars []string) string {
+	if len(argVars) == 0 {
+		return fmt.Sprintf("`%s()`", function)
+	}
+
+	return fmt.Sprintf("`%s(` + %s + `)`", function, strings.Join(argVars, ` + ", " + `))
+}
+
+func converterFuncName(builder ast.Builder) string {
+	return tools.UpperCamelCase(builder.Name) + "ToCode"
+}
+
+// builderForRef returns the builder used to convert instances of the given
+// object: the builder named after the object if it exists, or the first
+// builder for it.
+func builderForRef(context languages.Context, ref ast.RefType) (ast.Builder, bool) {
+	var candidate *ast.Builder
+
+	for i, builder := range context.Builders {
+		if builder.For.SelfRef.ReferredPkg != ref.ReferredPkg || builder.For.SelfRef.ReferredType != ref.ReferredType {
+			continue
+		}
+
+		if builder.Package == ref.ReferredPkg && builder.Name == ref.ReferredType {
+			return builder, true
+		}
+
+		if candidate == nil {
+			candidate = &context.Builders[i]
+		}
+	}
+
+	if candidate == nil {
+		return ast.Builder{}, false
+	}
+
+	return *candidate, true
+}
+
+func assignmentForArg(assignments []ast.Assignment, arg ast.Argument) (ast.Assignment, bool) {
+	for _, assignment := range assignments {
+		if assignment.Value.Argument != nil && assignment.Value.Argument.Name == arg.Name && assignment.Method == ast.DirectAssignment {
+			return assignment, true
+		}
+	}
+
+	return ast.Assignment{}, false
+}
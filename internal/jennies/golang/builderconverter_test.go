@@ -0,0 +1,37 @@
+package golang
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilderConverter_Generate(t *testing.T) {
+	test := testutils.GoldenFilesTestSuite[languages.Context]{
+		TestDataRoot: "../../../testdata/jennies/builders",
+		Name:         "GoConverter",
+		Skip: map[string]string{
+			"builder_delegation_in_disjunction": "disjunctions are eliminated with compiler passes",
+			"dashboard_from_object":             "disjunctions are eliminated with compiler passes",
+		},
+	}
+
+	config := Config{
+		PackageRoot:        "github.com/grafana/cog/generated",
+		GenerateConverters: true,
+	}
+	jenny := BuilderConverter{Config: config}
+
+	test.Run(t, func(tc *testutils.Test[languages.Context]) {
+		req := require.New(tc)
+
+		context := tc.UnmarshalJSONInput(testutils.BuildersContextInputFile)
+
+		files, err := jenny.Generate(context)
+		req.NoError(err)
+
+		tc.WriteFiles(files)
+	})
+}
@@ -0,0 +1,228 @@
+package golang
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// Client generates an HTTP client exposing one method per operation
+// described in a schema.
+type Client struct {
+	Config Config
+
+	typeFormatter *typeFormatter
+}
+
+func (jenny Client) JennyName() string {
+	return "GoClient"
+}
+
+func (jenny Client) Generate(context languages.Context) (codejen.Files, error) {
+	files := make(codejen.Files, 0, len(context.Schemas))
+
+	for _, schema := range context.Schemas {
+		if len(schema.Operations) == 0 {
+			continue
+		}
+
+		output, err := jenny.generateSchema(context, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		filename := filepath.Join(
+			formatPackageName(schema.Package),
+			"client_gen.go",
+		)
+
+		files = append(files, *codejen.NewFile(filename, output, jenny))
+	}
+
+	return files, nil
+}
+
+func (jenny Client) generateSchema(context languages.Context, schema *ast.Schema) ([]byte, error) {
+	var buffer strings.Builder
+
+	imports := NewImportMap()
+	packageMapper := func(pkg string) string {
+		if imports.IsIdentical(pkg, schema.Package) {
+			return ""
+		}
+
+		return imports.Add(pkg, jenny.Config.importPath(pkg))
+	}
+	jenny.typeFormatter = defaultTypeFormatter(jenny.Config, context, packageMapper)
+
+	for _, operation := range schema.Operations {
+		buffer.WriteString(jenny.generateOperation(operation))
+		buffer.WriteString("\n")
+	}
+
+	output, err := renderTemplate("clients/client.tmpl", map[string]any{
+		"package":    formatPackageName(schema.Package),
+		"imports":    imports,
+		"operations": buffer.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(output), nil
+}
+
+func (jenny Client) generateOperation(operation ast.Operation) string {
+	var buffer strings.Builder
+
+	methodName := tools.UpperCamelCase(operation.Name)
+	paramsStructName := methodName + "Params"
+	queryAndHeaderParams := append(operation.ParamsIn(ast.ParamInQuery), operation.ParamsIn(ast.ParamInHeader)...)
+
+	// parameters struct
+	if len(queryAndHeaderParams) != 0 {
+		buffer.WriteString(fmt.Sprintf("// %s holds the query and header parameters accepted by %s.\n", paramsStructName, methodName))
+		buffer.WriteString(fmt.Sprintf("type %s struct {\n", paramsStructName))
+		for _, param := range queryAndHeaderParams {
+			for _, commentLine := range param.Comments {
+				buffer.WriteString(fmt.Sprintf("\t// %s\n", commentLine))
+			}
+			buffer.WriteString(fmt.Sprintf("\t%s %s\n", tools.UpperCamelCase(param.Name), jenny.paramType(param)))
+		}
+		buffer.WriteString("}\n\n")
+	}
+
+	// method signature
+	args := []string{"ctx context.Context"}
+	for _, param := range operation.ParamsIn(ast.ParamInPath) {
+		args = append(args, fmt.Sprintf("%s %s", formatArgName(param.Name), jenny.typeFormatter.formatType(param.Type)))
+	}
+	if len(queryAndHeaderParams) != 0 {
+		args = append(args, "params "+paramsStructName)
+	}
+	if operation.RequestBody != nil {
+		bodyType := "io.Reader"
+		if ast.IsJSONContentType(operation.RequestBody.ContentType) {
+			bodyType = jenny.typeFormatter.formatType(operation.RequestBody.Type)
+		}
+		args = append(args, "body "+bodyType)
+	}
+
+	responseType := ""
+	returnValues := "error"
+	errorReturn := "return err"
+	if response, found := operation.SuccessResponse(); found && response.Type != nil {
+		responseType = jenny.typeFormatter.formatType(*response.Type)
+		returnValues = fmt.Sprintf("(%s, error)", responseType)
+		errorReturn = "return response, err"
+	}
+
+	comments := operation.Comments
+	if len(comments) == 0 {
+		comments = []string{fmt.Sprintf("%s calls `%s %s`.", methodName, operation.Method, operation.Path)}
+	}
+	for _, commentLine := range comments {
+		buffer.WriteString(fmt.Sprintf("// %s\n", commentLine))
+	}
+	buffer.WriteString(formatDeprecation(operation.Deprecated, true))
+
+	buffer.WriteString(fmt.Sprintf("func (client *Client) %s(%s) %s {\n", methodName, strings.Join(args, ", "), returnValues))
+	if responseType != "" {
+		buffer.WriteString(fmt.Sprintf("\tvar response %s\n\n", responseType))
+	}
+
+	// path & query
+	buffer.WriteString(fmt.Sprintf("\tpath := %s\n", jenny.pathExpression(operation)))
+	buffer.WriteString("\tquery := url.Values{}\n")
+	for _, param := range operation.ParamsIn(ast.ParamInQuery) {
+		buffer.WriteString(jenny.setParam(param, "query.Add"))
+	}
+	buffer.WriteString("\n")
+
+	// request
+	bodyArg := "nil"
+	if operation.RequestBody != nil {
+		bodyArg = "body"
+	}
+	contentType := ""
+	if operation.RequestBody != nil {
+		contentType = operation.RequestBody.ContentType
+	}
+	buffer.WriteString(fmt.Sprintf("\treq, err := client.newRequest(ctx, %q, path, query, %s, %q)\n", operation.Method, bodyArg, contentType))
+	buffer.WriteString("\tif err != nil {\n")
+	buffer.WriteString(fmt.Sprintf("\t\t%s\n", errorReturn))
+	buffer.WriteString("\t}\n")
+	for _, param := range operation.ParamsIn(ast.ParamInHeader) {
+		buffer.WriteString(jenny.setParam(param, "req.Header.Add"))
+	}
+	buffer.WriteString("\n")
+
+	if responseType != "" {
+		buffer.WriteString("\terr = client.do(req, &response)\n")
+		buffer.WriteString("\treturn response, err\n")
+	} else {
+		buffer.WriteString("\treturn client.do(req, nil)\n")
+	}
+	buffer.WriteString("}\n")
+
+	return buffer.String()
+}
+
+// paramType returns the type used to represent a query or header parameter.
+// Optional parameters are pointers, unless their type already is nullable.
+func (jenny Client) paramType(param ast.OperationParam) string {
+	paramType := param.Type
+	if !param.Required && !paramType.IsArray() && !paramType.IsMap() && !paramType.IsAny() {
+		paramType.Nullable = true
+	}
+
+	return jenny.typeFormatter.formatType(paramType)
+}
+
+func (jenny Client) setParam(param ast.OperationParam, setter string) string {
+	fieldName := "params." + tools.UpperCamelCase(param.Name)
+
+	if param.Type.IsArray() {
+		return fmt.Sprintf(`	for _, value := range %[1]s {
+		%[2]s(%[3]q, fmt.Sprint(value))
+	}
+`, fieldName, setter, param.Name)
+	}
+
+	if param.Type.IsAny() || param.Type.IsMap() {
+		return fmt.Sprintf(`	if %[1]s != nil {
+		%[2]s(%[3]q, fmt.Sprint(%[1]s))
+	}
+`, fieldName, setter, param.Name)
+	}
+
+	if !param.Required {
+		return fmt.Sprintf(`	if %[1]s != nil {
+		%[2]s(%[3]q, fmt.Sprint(*%[1]s))
+	}
+`, fieldName, setter, param.Name)
+	}
+
+	return fmt.Sprintf("\t%s(%q, fmt.Sprint(%s))\n", setter, param.Name, fieldName)
+}
+
+func (jenny Client) pathExpression(operation ast.Operation) string {
+	parts := tools.Map(operation.PathSegments(), func(segment ast.OperationPathSegment) string {
+		if segment.Param == "" {
+			return fmt.Sprintf("%q", segment.Literal)
+		}
+
+		return fmt.Sprintf("url.PathEscape(fmt.Sprint(%s))", formatArgName(segment.Param))
+	})
+
+	if len(parts) == 0 {
+		return `"/"`
+	}
+
+	return strings.Join(parts, " + ")
+}
@@ -0,0 +1,40 @@
+package golang
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_Generate(t *testing.T) {
+	test := testutils.GoldenFilesTestSuite[ast.Schema]{
+		TestDataRoot: "../../../testdata/jennies/clients",
+		Name:         "GoClient",
+	}
+
+	config := Config{
+		PackageRoot: "github.com/grafana/cog/generated",
+	}
+	jenny := Client{
+		Config: config,
+	}
+	compilerPasses := New(config).CompilerPasses()
+
+	test.Run(t, func(tc *testutils.Test[ast.Schema]) {
+		req := require.New(tc)
+
+		schema := tc.UnmarshalJSONInput(testutils.RawTypesIRInputFile)
+		processedAsts, err := compilerPasses.Process(ast.Schemas{&schema})
+		req.NoError(err)
+
+		files, err := jenny.Generate(languages.Context{
+			Schemas: processedAsts,
+		})
+		req.NoError(err)
+
+		tc.WriteFiles(files)
+	})
+}
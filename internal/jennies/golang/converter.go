@@ -0,0 +1,318 @@
+package golang
+
+import (
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// ToCode turns the given builder call into Go code.
+func (language *Language) ToCode(context languages.Context, call languages.BuilderCall) (string, error) {
+	imports := NewImportMap()
+	packageMapper := func(pkg string) string {
+		return imports.Add(pkg, language.config.importPath(pkg))
+	}
+
+	converter := &converter{
+		context:       context,
+		imports:       imports,
+		packageMapper: packageMapper,
+		typeFormatter: defaultTypeFormatter(language.config, context, packageMapper),
+	}
+
+	expression := converter.formatBuilderCall(call)
+
+	source := fmt.Sprintf("package converted\n\n%s\n\nvar _ = %s\n", imports.String(), expression)
+	formatted, err := format.Source([]byte(source))
+	if err != nil {
+		return "", fmt.Errorf("could not format converted code: %w", err)
+	}
+
+	code := strings.TrimPrefix(string(formatted), "package converted\n\n")
+
+	return strings.Replace(code, "var _ = ", "", 1), nil
+}
+
+type converter struct {
+	context       languages.Context
+	imports       *common.DirectImportMap
+	packageMapper func(pkg string) string
+	typeFormatter *typeFormatter
+}
+
+func (converter *converter) formatBuilderCall(call languages.BuilderCall) string {
+	var buffer strings.Builder
+
+	buffer.WriteString(fmt.Sprintf(
+		"%s.New%sBuilder(%s)",
+		converter.packageMapper(call.Builder.Package),
+		tools.UpperCamelCase(call.Builder.Name),
+		converter.formatArgs(call.Args),
+	))
+
+	for _, opt := range call.Options {
+		buffer.WriteString(fmt.Sprintf(".\n%s(%s)", tools.UpperCamelCase(opt.Option.Name), converter.formatArgs(opt.Args)))
+	}
+
+	return buffer.String()
+}
+
+func (converter *converter) formatArgs(args []languages.ConvertedValue) string {
+	return strings.Join(tools.Map(args, converter.formatArg), ", ")
+}
+
+func (converter *converter) formatArg(arg languages.ConvertedValue) string {
+	if arg.IsBuilder() {
+		return converter.formatBuilderCall(*arg.Builder)
+	}
+
+	if arg.IsBuildersList() {
+		items := tools.Map(arg.Items, converter.formatArg)
+
+		return fmt.Sprintf("%s{\n%s,\n}", converter.typeFormatter.doFormatType(arg.Type, true), strings.Join(items, ",\n"))
+	}
+
+	// arguments are never pointers
+	argType := arg.Type.DeepCopy()
+	argType.Nullable = false
+
+	return converter.formatValue(argType, arg.Value)
+}
+
+func (converter *converter) formatValue(def ast.Type, value any) string {
+	if value == nil {
+		return converter.zeroValue(def)
+	}
+
+	switch def.Kind {
+	case ast.KindRef:
+		return converter.formatRefValue(def, value)
+	case ast.KindScalar:
+		return converter.formatScalarValue(def, value)
+	case ast.KindArray:
+		items, _ := value.([]any)
+		formattedItems := tools.Map(items, func(item any) string {
+			return converter.formatValue(def.AsArray().ValueType, item)
+		})
+
+		return fmt.Sprintf("%s{%s}", converter.typeFormatter.doFormatType(def, false), strings.Join(formattedItems, ", "))
+	case ast.KindMap:
+		entries, _ := value.(map[string]any)
+
+		return fmt.Sprintf("%s{%s}", converter.typeFormatter.doFormatType(def, false), converter.formatEntries(entries, func(entry any) string {
+			return converter.formatValue(def.AsMap().ValueType, entry)
+		}))
+	case ast.KindStruct:
+		typeName := strings.TrimPrefix(converter.typeFormatter.doFormatType(def, false), "*")
+
+		return converter.formatStructValue(typeName, def, value)
+	default:
+		return formatAnyValue(value)
+	}
+}
+
+func (converter *converter) formatRefValue(def ast.Type, value any) string {
+	referredObj, found := converter.context.LocateObjectByRef(def.AsRef())
+	if !found {
+		return formatAnyValue(value)
+	}
+
+	nonNullableRef := def.DeepCopy()
+	nonNullableRef.Nullable = false
+	typeName := converter.typeFormatter.formatRef(nonNullableRef, false)
+
+	switch referredObj.Type.Kind {
+	case ast.KindEnum:
+		member := languages.EnumMember(referredObj.Type.AsEnum(), value)
+		formatted := tools.CleanupNames(tools.UpperCamelCase(member.Name))
+		if alias := converter.packageMapper(referredObj.SelfRef.ReferredPkg); alias != "" {
+			formatted = alias + "." + formatted
+		}
+
+		if def.Nullable {
+			return fmt.Sprintf("%s.ToPtr[%s](%s)", converter.packageMapper("cog"), typeName, formatted)
+		}
+
+		return formatted
+	case ast.KindScalar:
+		// constants are typed using their scalar type
+		if referredObj.Type.IsConcreteScalar() {
+			scalarType := referredObj.Type.DeepCopy()
+			scalarType.Nullable = def.Nullable
+
+			return converter.formatScalarValue(scalarType, value)
+		}
+
+		literal := formatScalarLiteral(referredObj.Type.AsScalar().ScalarKind, value)
+		if def.Nullable {
+			return fmt.Sprintf("%s.ToPtr[%s](%s)", converter.packageMapper("cog"), typeName, literal)
+		}
+
+		return literal
+	case ast.KindStruct:
+		formatted := converter.formatStructValue(typeName, referredObj.Type, value)
+		if def.Nullable {
+			return "&" + formatted
+		}
+
+		return formatted
+	case ast.KindArray, ast.KindMap:
+		formatted := converter.formatValue(referredObj.Type, value)
+
+		// use the named type instead of its definition
+		definition := converter.typeFormatter.doFormatType(referredObj.Type, false)
+		formatted = typeName + strings.TrimPrefix(formatted, definition)
+		if def.Nullable {
+			return "&" + formatted
+		}
+
+		return formatted
+	default:
+		return converter.formatValue(referredObj.Type, value)
+	}
+}
+
+func (converter *converter) formatStructValue(typeName string, def ast.Type, value any) string {
+	fields, _ := value.(map[string]any)
+	structType := def.AsStruct()
+
+	if def.IsStructGeneratedFromDisjunction() {
+		branches := tools.Map(structType.Fields, func(field ast.StructField) ast.Type {
+			return field.Type
+		})
+
+		branch, found := languages.MatchingBranch(converter.context, branches, value)
+		if !found {
+			return typeName + "{}"
+		}
+
+		field := structType.Fields[branch]
+
+		return fmt.Sprintf("%s{\n%s: %s,\n}", typeName, tools.UpperCamelCase(field.Name), converter.formatValue(field.Type, value))
+	}
+
+	formattedFields := make([]string, 0, len(structType.Fields))
+	for _, field := range structType.Fields {
+		fieldValue, found := fields[field.Name]
+		if !found || fieldValue == nil {
+			continue
+		}
+
+		formattedFields = append(formattedFields, fmt.Sprintf("%s: %s,\n", tools.UpperCamelCase(field.Name), converter.formatValue(field.Type, fieldValue)))
+	}
+
+	if len(formattedFields) == 0 {
+		return typeName + "{}"
+	}
+
+	return fmt.Sprintf("%s{\n%s}", typeName, strings.Join(formattedFields, ""))
+}
+
+func (converter *converter) formatScalarValue(def ast.Type, value any) string {
+	scalarKind := def.AsScalar().ScalarKind
+	literal := formatScalarLiteral(scalarKind, value)
+	typeName := string(scalarKind)
+
+	if def.HasHint(ast.HintStringFormatDateTime) {
+		timePkg := converter.imports.Add("time", "time")
+		literal = fmt.Sprintf("func() %[1]s.Time { parsed, _ := %[1]s.Parse(%[1]s.RFC3339, %[2]s); return parsed }()", timePkg, literal)
+		typeName = timePkg + ".Time"
+	}
+
+	if !def.Nullable || scalarKind == ast.KindAny || scalarKind == ast.KindBytes {
+		return literal
+	}
+
+	return fmt.Sprintf("%s.ToPtr[%s](%s)", converter.packageMapper("cog"), typeName, literal)
+}
+
+func (converter *converter) zeroValue(def ast.Type) string {
+	if def.Nullable || def.IsAnyOf(ast.KindArray, ast.KindMap, ast.KindComposableSlot) {
+		return "nil"
+	}
+
+	switch def.Kind {
+	case ast.KindScalar:
+		switch def.AsScalar().ScalarKind {
+		case ast.KindString:
+			return `""`
+		case ast.KindBool:
+			return "false"
+		case ast.KindAny, ast.KindBytes, ast.KindNull:
+			return "nil"
+		default:
+			return "0"
+		}
+	case ast.KindRef:
+		referredObj, found := converter.context.LocateObjectByRef(def.AsRef())
+		if found && !referredObj.Type.IsStruct() {
+			return converter.zeroValue(referredObj.Type)
+		}
+
+		return converter.typeFormatter.formatRef(def, false) + "{}"
+	default:
+		return converter.typeFormatter.doFormatType(def, false) + "{}"
+	}
+}
+
+func (converter *converter) formatEntries(entries map[string]any, formatEntry func(entry any) string) string {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	formatted := make([]string, 0, len(keys))
+	for _, key := range keys {
+		formatted = append(formatted, fmt.Sprintf("%s: %s", strconv.Quote(key), formatEntry(entries[key])))
+	}
+
+	return strings.Join(formatted, ", ")
+}
+
+func formatScalarLiteral(kind ast.ScalarKind, value any) string {
+	if kind == ast.KindBytes {
+		return fmt.Sprintf("[]byte(%s)", formatAnyValue(value))
+	}
+
+	return formatAnyValue(value)
+}
+
+// formatAnyValue formats a value decoded from JSON, without any information
+// about its type.
+func formatAnyValue(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return "nil"
+	case string:
+		return strconv.Quote(typed)
+	case json.Number:
+		return typed.String()
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case []any:
+		return fmt.Sprintf("[]any{%s}", strings.Join(tools.Map(typed, formatAnyValue), ", "))
+	case map[string]any:
+		keys := make([]string, 0, len(typed))
+		for key := range typed {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		entries := tools.Map(keys, func(key string) string {
+			return fmt.Sprintf("%s: %s", strconv.Quote(key), formatAnyValue(typed[key]))
+		})
+
+		return fmt.Sprintf("map[string]any{%s}", strings.Join(entries, ", "))
+	default:
+		return fmt.Sprintf("%#v", value)
+	}
+}
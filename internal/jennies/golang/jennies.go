@@ -14,18 +14,25 @@ import (
 const LanguageRef = "go"
 
 type Config struct {
-	debug            bool
-	generateBuilders bool
+	debug              bool
+	generateBuilders   bool
+	generateValidation bool
 
 	// GenerateGoMod indicates whether a go.mod file should be generated.
 	// If enabled, PackageRoot is used as module path.
 	GenerateGoMod bool `yaml:"go_mod"`
 
 	// SkipRuntime disables runtime-related code generation when enabled.
-	// Note: builders can NOT be generated with this flag turned on, as they
+	// Note: builders and validation methods can NOT be generated with this flag turned on, as they
 	// rely on the runtime to function.
 	SkipRuntime bool `yaml:"skip_runtime"`
 
+	// GenerateConverters indicates whether converters should be generated
+	// alongside builders. A converter turns an object into the Go code
+	// calling the builders needed to produce it.
+	// Note: converters can NOT be generated if builders aren't.
+	GenerateConverters bool `yaml:"converters"`
+
 	// Root path for imports.
 	// Ex: github.com/grafana/cog/generated
 	PackageRoot string `yaml:"package_root"`
@@ -39,6 +46,7 @@ func (config Config) MergeWithGlobal(global languages.Config) Config {
 	newConfig := config
 	newConfig.debug = global.Debug
 	newConfig.generateBuilders = global.Builders
+	newConfig.generateValidation = global.Validation && !config.SkipRuntime
 
 	return newConfig
 }
@@ -75,8 +83,10 @@ func (language *Language) Jennies(globalConfig languages.Config) *codejen.JennyL
 		common.If[languages.Context](config.GenerateGoMod, GoMod{Config: config}),
 
 		common.If[languages.Context](globalConfig.Types, RawTypes{Config: config}),
+		common.If[languages.Context](globalConfig.Types, Client{Config: config}),
 
 		common.If[languages.Context](!config.SkipRuntime && globalConfig.Builders, &Builder{Config: config}),
+		common.If[languages.Context](!config.SkipRuntime && globalConfig.Builders && config.GenerateConverters, &BuilderConverter{Config: config}),
 	)
 	jenny.AddPostprocessors(PostProcessFile, common.GeneratedCommentHeader(globalConfig))
 
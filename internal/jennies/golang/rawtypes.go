@@ -58,6 +58,11 @@ func (jenny RawTypes) generateSchema(context languages.Context, schema *ast.Sche
 		packageMapper: packageMapper,
 		typeFormatter: jenny.typeFormatter,
 	}
+	validationGenerator := ValidationMethods{
+		packageMapper: packageMapper,
+		context:       context,
+		patterns:      newRegexPatterns("validationPattern"),
+	}
 
 	schema.Objects.Iterate(func(_ string, object ast.Object) {
 		objectOutput, innerErr := jenny.formatObject(object)
@@ -74,6 +79,14 @@ func (jenny RawTypes) generateSchema(context languages.Context, schema *ast.Sche
 			err = innerErr
 			return
 		}
+
+		if jenny.Config.generateValidation {
+			innerErr = validationGenerator.generateForObject(&buffer, object)
+			if innerErr != nil {
+				err = innerErr
+				return
+			}
+		}
 	})
 	if err != nil {
 		return nil, err
@@ -88,9 +101,14 @@ func (jenny RawTypes) generateSchema(context languages.Context, schema *ast.Sche
 		importStatements += "\n\n"
 	}
 
+	patternDeclarations := validationGenerator.patterns.declarations()
+	if patternDeclarations != "" {
+		patternDeclarations += "\n"
+	}
+
 	return []byte(fmt.Sprintf(`package %[1]s
 
-%[2]s%[3]s`, formatPackageName(schema.Package), importStatements, buffer.String())), nil
+%[2]s%[3]s%[4]s`, formatPackageName(schema.Package), importStatements, patternDeclarations, buffer.String())), nil
 }
 
 func (jenny RawTypes) formatObject(def ast.Object) ([]byte, error) {
@@ -109,6 +127,7 @@ func (jenny RawTypes) formatObject(def ast.Object) ([]byte, error) {
 	for _, commentLine := range comments {
 		buffer.WriteString(fmt.Sprintf("// %s\n", commentLine))
 	}
+	buffer.WriteString(formatDeprecation(def.Deprecated, len(comments) != 0))
 
 	switch def.Type.Kind {
 	case ast.KindEnum:
@@ -43,3 +43,59 @@ func TestRawTypes_Generate(t *testing.T) {
 		tc.WriteFiles(files)
 	})
 }
+
+func TestRawTypes_GenerateWithValidation(t *testing.T) {
+	test := testutils.GoldenFilesTestSuite[ast.Schema]{
+		TestDataRoot: "../../../testdata/jennies/validation",
+		Name:         "GoRawTypes",
+	}
+
+	config := Config{
+		PackageRoot:        "github.com/grafana/cog/generated",
+		generateValidation: true,
+	}
+	jenny := RawTypes{
+		Config: config,
+	}
+	compilerPasses := New(config).CompilerPasses()
+
+	test.Run(t, func(tc *testutils.Test[ast.Schema]) {
+		req := require.New(tc)
+
+		schema := tc.UnmarshalJSONInput(testutils.RawTypesIRInputFile)
+		processedAsts, err := compilerPasses.Process(ast.Schemas{&schema})
+		req.NoError(err)
+
+		files, err := jenny.Generate(languages.Context{
+			Schemas: processedAsts,
+		})
+		req.NoError(err)
+
+		tc.WriteFiles(files)
+	})
+}
+
+func TestRawTypes_GenerateWithValidation_rejectsUnsupportedPattern(t *testing.T) {
+	req := require.New(t)
+
+	slugType := ast.String()
+	slugType.Scalar.Constraints = []ast.TypeConstraint{
+		{Op: ast.PatternOp, Args: []any{"^(?!-)[a-z]+$"}},
+	}
+
+	schema := ast.NewSchema("constraints", ast.SchemaMeta{})
+	schema.AddObject(ast.NewObject("constraints", "SomeStruct", ast.NewStruct(
+		ast.NewStructField("slug", slugType),
+	)))
+
+	jenny := RawTypes{
+		Config: Config{
+			PackageRoot:        "github.com/grafana/cog/generated",
+			generateValidation: true,
+		},
+	}
+	_, err := jenny.Generate(languages.Context{
+		Schemas: ast.Schemas{schema},
+	})
+	req.ErrorContains(err, `SomeStruct: slug: pattern "^(?!-)[a-z]+$" is not supported`)
+}
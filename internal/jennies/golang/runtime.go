@@ -26,11 +26,19 @@ func (jenny Runtime) Generate(_ languages.Context) (codejen.Files, error) {
 	if jenny.Config.generateBuilders {
 		files = append(files,
 			*codejen.NewFile("cog/builder.go", []byte(jenny.generateBuilderInterface()), jenny),
-			*codejen.NewFile("cog/errors.go", []byte(jenny.generateErrorTools()), jenny),
 			*codejen.NewFile("cog/tools.go", []byte(jenny.generateToPtrFunc()), jenny),
 		)
 	}
 
+	if jenny.Config.generateBuilders && jenny.Config.GenerateConverters {
+		files = append(files, *codejen.NewFile("cog/dump.go", []byte(jenny.generateDumpFunc()), jenny))
+	}
+
+	// errors are used to report both build and validation errors
+	if jenny.Config.generateBuilders || jenny.Config.generateValidation {
+		files = append(files, *codejen.NewFile("cog/errors.go", []byte(jenny.generateErrorTools()), jenny))
+	}
+
 	return files, nil
 }
 
@@ -116,3 +124,124 @@ func ToPtr[T any](v T) *T {
 
 `
 }
+
+func (jenny Runtime) generateDumpFunc() string {
+	return `package cog
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// Dump returns the Go code describing the given value.
+func Dump(root any) string {
+	return dumpValue(reflect.ValueOf(root), false)
+}
+
+func dumpValue(value reflect.Value, inInterface bool) string {
+	if !value.IsValid() {
+		return "nil"
+	}
+
+	switch value.Kind() {
+	case reflect.Bool:
+		return dumpTyped(value, strconv.FormatBool(value.Bool()), inInterface)
+	case reflect.String:
+		return dumpTyped(value, strconv.Quote(value.String()), inInterface)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return dumpTyped(value, strconv.FormatInt(value.Int(), 10), inInterface)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return dumpTyped(value, strconv.FormatUint(value.Uint(), 10), inInterface)
+	case reflect.Float32, reflect.Float64:
+		return dumpTyped(value, strconv.FormatFloat(value.Float(), 'f', -1, 64), inInterface)
+	case reflect.Pointer:
+		if value.IsNil() {
+			return "nil"
+		}
+
+		if value.Elem().Kind() == reflect.Struct {
+			return "&" + dumpValue(value.Elem(), false)
+		}
+
+		return fmt.Sprintf("cog.ToPtr[%s](%s)", typeName(value.Elem().Type()), dumpValue(value.Elem(), false))
+	case reflect.Interface:
+		if value.IsNil() {
+			return "nil"
+		}
+
+		return dumpValue(value.Elem(), true)
+	case reflect.Slice, reflect.Array:
+		if value.Kind() == reflect.Slice && value.IsNil() {
+			return "nil"
+		}
+
+		items := make([]string, 0, value.Len())
+		for i := 0; i < value.Len(); i++ {
+			items = append(items, dumpValue(value.Index(i), value.Type().Elem().Kind() == reflect.Interface))
+		}
+
+		return fmt.Sprintf("%s{%s}", typeName(value.Type()), strings.Join(items, ", "))
+	case reflect.Map:
+		if value.IsNil() {
+			return "nil"
+		}
+
+		items := make([]string, 0, value.Len())
+		for _, key := range value.MapKeys() {
+			items = append(items, fmt.Sprintf(
+				"%s: %s",
+				dumpValue(key, value.Type().Key().Kind() == reflect.Interface),
+				dumpValue(value.MapIndex(key), value.Type().Elem().Kind() == reflect.Interface),
+			))
+		}
+		sort.Strings(items)
+
+		return fmt.Sprintf("%s{%s}", typeName(value.Type()), strings.Join(items, ", "))
+	case reflect.Struct:
+		fields := make([]string, 0, value.NumField())
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Type().Field(i)
+			if !field.IsExported() || value.Field(i).IsZero() {
+				continue
+			}
+
+			fields = append(fields, fmt.Sprintf("%s: %s", field.Name, dumpValue(value.Field(i), field.Type.Kind() == reflect.Interface)))
+		}
+
+		return fmt.Sprintf("%s{%s}", typeName(value.Type()), strings.Join(fields, ", "))
+	default:
+		return fmt.Sprintf("%#v", value.Interface())
+	}
+}
+
+// dumpTyped makes sure that scalars held by interfaces keep their type, as
+// it can't be inferred from the context they're used in.
+func dumpTyped(value reflect.Value, literal string, inInterface bool) string {
+	if !inInterface || value.Type().PkgPath() == "" && isDefaultType(value.Kind(), literal) {
+		return literal
+	}
+
+	return fmt.Sprintf("%s(%s)", typeName(value.Type()), literal)
+}
+
+// isDefaultType tells whether the given literal is of the given kind when
+// untyped.
+func isDefaultType(kind reflect.Kind, literal string) bool {
+	switch kind {
+	case reflect.Bool, reflect.String, reflect.Int:
+		return true
+	case reflect.Float64:
+		return strings.ContainsAny(literal, ".e")
+	default:
+		return false
+	}
+}
+
+func typeName(t reflect.Type) string {
+	return strings.ReplaceAll(t.String(), "interface {}", "any")
+}
+`
+}
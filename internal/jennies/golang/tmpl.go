@@ -13,7 +13,7 @@ import (
 //nolint:gochecknoglobals
 var templates *template.Template
 
-//go:embed templates/runtime/*.tmpl templates/builders/*.tmpl templates/builders/veneers/*.tmpl templates/types/*.tmpl
+//go:embed templates/runtime/*.tmpl templates/builders/*.tmpl templates/builders/veneers/*.tmpl templates/types/*.tmpl templates/clients/*.tmpl
 //nolint:gochecknoglobals
 var veneersFS embed.FS
 
@@ -43,6 +43,12 @@ func init() {
 			"resolvesToComposableSlot": func(_ ast.Type) bool {
 				panic("resolvesToComposableSlot() needs to be overridden by a jenny")
 			},
+			"regexPattern": func(_ any) (string, error) {
+				panic("regexPattern() needs to be overridden by a jenny")
+			},
+			"regexPatternDeclarations": func() string {
+				panic("regexPatternDeclarations() needs to be overridden by a jenny")
+			},
 		}).
 		Funcs(map[string]any{
 			"formatPackageName": formatPackageName,
@@ -1,9 +1,11 @@
 package golang
 
 import (
+	"fmt"
 	"regexp"
 	"strings"
 
+	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/tools"
 )
 
@@ -17,6 +19,20 @@ func formatPackageName(pkg string) string {
 	return strings.ToLower(rgx.ReplaceAllString(pkg, ""))
 }
 
+// formatDeprecation returns a `Deprecated:` paragraph, as recognized by Go tooling.
+func formatDeprecation(deprecation *ast.Deprecation, hasComments bool) string {
+	if deprecation == nil {
+		return ""
+	}
+
+	separator := ""
+	if hasComments {
+		separator = "//\n"
+	}
+
+	return fmt.Sprintf("%s// Deprecated: %s\n", separator, deprecation.Notice())
+}
+
 func formatArgName(name string) string {
 	return escapeVarName(tools.LowerCamelCase(name))
 }
@@ -144,6 +144,7 @@ func (formatter *typeFormatter) formatField(def ast.StructField) string {
 	for _, commentLine := range comments {
 		buffer.WriteString(fmt.Sprintf("// %s\n", commentLine))
 	}
+	buffer.WriteString(formatDeprecation(def.Deprecated, len(comments) != 0))
 
 	jsonOmitEmpty := ""
 	if !def.Required {
@@ -0,0 +1,286 @@
+package golang
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/orderedmap"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// ValidationMethods generates `Validate()` methods on structs, checking
+// that the constraints defined in the schema are respected.
+type ValidationMethods struct {
+	packageMapper func(string) string
+	context       languages.Context
+	patterns      *regexPatterns
+}
+
+func (jenny ValidationMethods) generateForObject(buffer *strings.Builder, object ast.Object) error {
+	if !object.Type.IsStruct() {
+		return nil
+	}
+
+	objectName := tools.UpperCamelCase(object.Name)
+	checks, err := jenny.checksForStruct("resource", object.Type.AsStruct(), nil, 0)
+	if err != nil {
+		return fmt.Errorf("%s: %w", objectName, err)
+	}
+
+	buffer.WriteString(fmt.Sprintf("// Validate checks all the validation constraints that may be defined on `%s` fields for violations and returns them.\n", objectName))
+	buffer.WriteString(fmt.Sprintf("func (resource %s) Validate() error {\n", objectName))
+
+	if checks == "" {
+		buffer.WriteString("\treturn nil\n")
+		buffer.WriteString("}\n\n")
+		return nil
+	}
+
+	cogAlias := jenny.packageMapper("cog")
+
+	buffer.WriteString(fmt.Sprintf("\tvar errs %s.BuildErrors\n\n", cogAlias))
+	buffer.WriteString(indentChecks(checks))
+	buffer.WriteString(`
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+`)
+
+	return nil
+}
+
+func (jenny ValidationMethods) checksForStruct(valueExpr string, structType ast.StructType, path common.ValidationPath, depth int) (string, error) {
+	checks := make([]string, 0, len(structType.Fields))
+
+	for _, field := range structType.Fields {
+		fieldExpr := valueExpr + "." + tools.UpperCamelCase(field.Name)
+
+		check, err := jenny.checksForValue(fieldExpr, field.Type, path.AppendField(field.Name), depth)
+		if err != nil {
+			return "", err
+		}
+		if check != "" {
+			checks = append(checks, check)
+		}
+	}
+
+	return strings.Join(checks, ""), nil
+}
+
+func (jenny ValidationMethods) checksForValue(valueExpr string, typeDef ast.Type, path common.ValidationPath, depth int) (string, error) {
+	// Go represents nullable scalars, structs and refs as pointers
+	isBytes := typeDef.IsScalar() && typeDef.AsScalar().ScalarKind == ast.KindBytes
+	if typeDef.Nullable && typeDef.IsAnyOf(ast.KindScalar, ast.KindStruct, ast.KindRef) && !isBytes {
+		nonNullableType := typeDef
+		nonNullableType.Nullable = false
+
+		innerExpr := valueExpr
+		if jenny.context.ResolveRefs(typeDef).IsAnyOf(ast.KindScalar, ast.KindEnum) {
+			innerExpr = "*" + valueExpr
+		}
+
+		checks, err := jenny.checksForValue(innerExpr, nonNullableType, path, depth)
+		if err != nil || checks == "" {
+			return "", err
+		}
+
+		return fmt.Sprintf("if %s != nil {\n%s}\n", valueExpr, indentChecks(checks)), nil
+	}
+
+	switch typeDef.Kind {
+	case ast.KindScalar:
+		// date-time strings are represented as `time.Time`
+		if typeDef.HasHint(ast.HintStringFormatDateTime) {
+			return "", nil
+		}
+
+		return jenny.checksForConstraints(valueExpr, typeDef.AsScalar(), path)
+	case ast.KindStruct:
+		return jenny.checksForStruct(valueExpr, typeDef.AsStruct(), path, depth)
+	case ast.KindArray:
+		indexVar := fmt.Sprintf("i%d", depth+1)
+		checks, err := jenny.checksForValue(valueExpr+"["+indexVar+"]", typeDef.AsArray().ValueType, path.AppendVariable(indexVar), depth+1)
+		if err != nil || checks == "" {
+			return "", err
+		}
+
+		return fmt.Sprintf("for %s := range %s {\n%s}\n", indexVar, valueExpr, indentChecks(checks)), nil
+	case ast.KindMap:
+		keyVar := fmt.Sprintf("key%d", depth+1)
+		checks, err := jenny.checksForValue(valueExpr+"["+keyVar+"]", typeDef.AsMap().ValueType, path.AppendVariable(keyVar), depth+1)
+		if err != nil || checks == "" {
+			return "", err
+		}
+
+		return fmt.Sprintf("for %s := range %s {\n%s}\n", keyVar, valueExpr, indentChecks(checks)), nil
+	case ast.KindRef:
+		return jenny.checksForRef(valueExpr, typeDef, path, depth)
+	default:
+		return "", nil
+	}
+}
+
+func (jenny ValidationMethods) checksForRef(valueExpr string, typeDef ast.Type, path common.ValidationPath, depth int) (string, error) {
+	referredObject, found := jenny.context.LocateObjectByRef(typeDef.AsRef())
+	if !found {
+		return "", nil
+	}
+
+	switch referredObject.Type.Kind {
+	case ast.KindStruct:
+		return fmt.Sprintf(`if err := %[1]s.Validate(); err != nil {
+	errs = append(errs, %[2]s.MakeBuildErrors(%[3]s, err)...)
+}
+`, valueExpr, jenny.packageMapper("cog"), jenny.formatPath(path)), nil
+	case ast.KindEnum:
+		values := tools.Map(referredObject.Type.AsEnum().Values, func(value ast.EnumValue) any {
+			return value.Value
+		})
+		conditions := tools.Map(values, func(value any) string {
+			return fmt.Sprintf("%s != %s", valueExpr, formatScalar(value))
+		})
+
+		return jenny.violation(strings.Join(conditions, " && "), path, common.EnumViolationMessage(values)), nil
+	case ast.KindScalar, ast.KindArray, ast.KindMap, ast.KindRef:
+		// constants don't need to be validated
+		if referredObject.Type.IsConcreteScalar() {
+			return "", nil
+		}
+
+		return jenny.checksForValue(valueExpr, referredObject.Type, path, depth)
+	default:
+		return "", nil
+	}
+}
+
+func (jenny ValidationMethods) checksForConstraints(valueExpr string, scalarType ast.ScalarType, path common.ValidationPath) (string, error) {
+	var buffer strings.Builder
+
+	for _, constraint := range scalarType.Constraints {
+		if len(constraint.Args) == 0 {
+			continue
+		}
+
+		leftOperand := valueExpr
+		operator := string(constraint.Op)
+		rightOperand := formatScalar(constraint.Args[0])
+
+		switch constraint.Op {
+		case ast.MinLengthOp:
+			leftOperand = fmt.Sprintf("len([]rune(%s))", valueExpr)
+			operator = ">="
+		case ast.MaxLengthOp:
+			leftOperand = fmt.Sprintf("len([]rune(%s))", valueExpr)
+			operator = "<="
+		case ast.MultipleOfOp:
+			leftOperand = fmt.Sprintf("%s%%%s", valueExpr, rightOperand)
+			if scalarType.ScalarKind == ast.KindFloat32 || scalarType.ScalarKind == ast.KindFloat64 {
+				leftOperand = fmt.Sprintf("math.Mod(float64(%s), %s)", valueExpr, rightOperand)
+			}
+			operator = "=="
+			rightOperand = "0"
+		}
+
+		condition := fmt.Sprintf("!(%s %s %s)", leftOperand, operator, rightOperand)
+		if constraint.Op == ast.PatternOp || constraint.Op == ast.NotPatternOp {
+			pattern, err := jenny.patterns.add(constraint.Args[0])
+			if err != nil {
+				return "", fmt.Errorf("%s: %w", path.Format(func(string) string { return "*" }), err)
+			}
+
+			condition = fmt.Sprintf("%s.MatchString(%s)", pattern, valueExpr)
+			if constraint.Op == ast.PatternOp {
+				condition = "!" + condition
+			}
+		}
+		buffer.WriteString(jenny.violation(condition, path, common.ConstraintViolationMessage(constraint)))
+	}
+
+	return buffer.String(), nil
+}
+
+func (jenny ValidationMethods) violation(condition string, path common.ValidationPath, message string) string {
+	return fmt.Sprintf(`if %[1]s {
+	errs = append(errs, %[2]s.MakeBuildErrors(%[3]s, errors.New(%[4]s))...)
+}
+`, condition, jenny.packageMapper("cog"), jenny.formatPath(path), formatScalar(message))
+}
+
+func (jenny ValidationMethods) formatPath(path common.ValidationPath) string {
+	if path.IsStatic() {
+		return formatScalar(path.Format(nil))
+	}
+
+	var args []string
+	format := path.Format(func(variable string) string {
+		args = append(args, variable)
+		return "%v"
+	})
+
+	return fmt.Sprintf("fmt.Sprintf(%s, %s)", formatScalar(format), strings.Join(args, ", "))
+}
+
+func indentChecks(checks string) string {
+	lines := strings.Split(strings.TrimSuffix(checks, "\n"), "\n")
+	for i := range lines {
+		lines[i] = "\t" + lines[i]
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// regexPatterns keeps track of the patterns used by a generated file, so
+// that each of them is compiled only once, as a package-level variable.
+// Variables are named after the given prefix, which must be unique among
+// the files of a package.
+type regexPatterns struct {
+	prefix string
+	names  *orderedmap.Map[string, string]
+}
+
+func newRegexPatterns(prefix string) *regexPatterns {
+	return &regexPatterns{
+		prefix: prefix,
+		names:  orderedmap.New[string, string](),
+	}
+}
+
+// add validates the given pattern and returns the name of the variable
+// holding its compiled version.
+func (patterns *regexPatterns) add(pattern any) (string, error) {
+	patternStr, err := common.CheckRegexPattern(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	if !patterns.names.Has(patternStr) {
+		patterns.names.Set(patternStr, fmt.Sprintf("%s%d", patterns.prefix, patterns.names.Len()+1))
+	}
+
+	return patterns.names.Get(patternStr), nil
+}
+
+// declarations returns the package-level variables for every pattern
+// registered so far.
+func (patterns *regexPatterns) declarations() string {
+	if patterns.names.Len() == 0 {
+		return ""
+	}
+
+	var buffer strings.Builder
+
+	buffer.WriteString("var (\n")
+	patterns.names.Iterate(func(pattern string, name string) {
+		buffer.WriteString(fmt.Sprintf("\t%s = regexp.MustCompile(%s)\n", name, formatScalar(pattern)))
+	})
+	buffer.WriteString(")\n")
+
+	return buffer.String()
+}
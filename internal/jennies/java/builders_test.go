@@ -12,8 +12,8 @@ func TestBuidlers_Generate(t *testing.T) {
 	test := testutils.GoldenFilesTestSuite[languages.Context]{
 		TestDataRoot: "../../../testdata/jennies/builders",
 		Name:         "JavaBuilders",
-		Skip:         map[string]string{
-			// "initialization_safeguards": "",
+		Skip: map[string]string{
+			"dashboard_from_object": "disjunctions are eliminated with compiler passes",
 		},
 	}
 
@@ -0,0 +1,263 @@
+package java
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// ToCode turns the given builder call into Java code.
+func (language *Language) ToCode(context languages.Context, call languages.BuilderCall) (string, error) {
+	imports := NewImportMap(language.config.PackagePath)
+	packageMapper := func(pkg string, class string) string {
+		return imports.Add(class, pkg)
+	}
+
+	converter := &converter{
+		context:       context,
+		imports:       imports,
+		typeFormatter: createFormatter(context, language.config).withPackageMapper(packageMapper),
+	}
+
+	expression := converter.formatBuilderCall(call)
+
+	return fmt.Sprintf("%s\n%s;\n", imports.String(), expression), nil
+}
+
+type converter struct {
+	context       languages.Context
+	imports       *common.DirectImportMap
+	typeFormatter *typeFormatter
+}
+
+func (converter *converter) formatBuilderCall(call languages.BuilderCall) string {
+	var buffer strings.Builder
+
+	buffer.WriteString(fmt.Sprintf("new %s(%s)", converter.builderClass(call.Builder), converter.formatArgs(call.Args)))
+
+	for _, opt := range call.Options {
+		formatted := fmt.Sprintf("\n.%s(%s)", escapeVarName(tools.LowerCamelCase(opt.Option.Name)), converter.formatArgs(opt.Args))
+
+		buffer.WriteString(indent(formatted))
+	}
+
+	return buffer.String()
+}
+
+// builderClass mirrors how builders classes are named by the RawTypes jenny.
+func (converter *converter) builderClass(builder ast.Builder) string {
+	pkg := formatPackageName(builder.Package)
+
+	// panel builders live in their own class, that would clash with the
+	// ones from other packages if imported.
+	if builder.Name == "Panel" && builder.Package != "dashboard" {
+		return converter.typeFormatter.formatPackage(pkg + ".PanelBuilder")
+	}
+
+	objectName := tools.UpperCamelCase(builder.For.Name)
+	converter.imports.Add(objectName, pkg)
+
+	siblings := tools.Filter(converter.context.Builders, func(candidate ast.Builder) bool {
+		return candidate.Package == builder.Package && candidate.For.SelfRef.ReferredType == builder.For.SelfRef.ReferredType
+	})
+	if len(siblings) > 1 {
+		return fmt.Sprintf("%s.%sBuilder", objectName, builder.Name)
+	}
+
+	return objectName + ".Builder"
+}
+
+func (converter *converter) formatArgs(args []languages.ConvertedValue) string {
+	return strings.Join(tools.Map(args, converter.formatArg), ", ")
+}
+
+func (converter *converter) formatArg(arg languages.ConvertedValue) string {
+	if arg.IsBuilder() {
+		return converter.formatBuilderCall(*arg.Builder)
+	}
+
+	if arg.IsBuildersList() {
+		return converter.formatList(tools.Map(arg.Items, converter.formatArg))
+	}
+
+	return converter.formatValue(arg.Type, arg.Value)
+}
+
+func (converter *converter) formatValue(def ast.Type, value any) string {
+	if value == nil {
+		return "null"
+	}
+
+	switch def.Kind {
+	case ast.KindRef:
+		return converter.formatRefValue(def, value)
+	case ast.KindScalar:
+		return converter.formatScalarValue(def.AsScalar().ScalarKind, value)
+	case ast.KindEnum:
+		return converter.formatAnyValue(languages.EnumMember(def.AsEnum(), value).Value)
+	case ast.KindArray:
+		items, _ := value.([]any)
+
+		return converter.formatList(tools.Map(items, func(item any) string {
+			return converter.formatValue(def.AsArray().ValueType, item)
+		}))
+	case ast.KindMap:
+		entries, _ := value.(map[string]any)
+
+		return converter.formatMap(entries, func(entry any) string {
+			return converter.formatValue(def.AsMap().ValueType, entry)
+		})
+	default:
+		return converter.formatAnyValue(value)
+	}
+}
+
+func (converter *converter) formatRefValue(def ast.Type, value any) string {
+	referredObj, found := converter.context.LocateObjectByRef(def.AsRef())
+	if !found {
+		return converter.formatAnyValue(value)
+	}
+
+	switch referredObj.Type.Kind {
+	case ast.KindEnum:
+		member := languages.EnumMember(referredObj.Type.AsEnum(), value)
+		converter.imports.Add(referredObj.Name, formatPackageName(referredObj.SelfRef.ReferredPkg))
+
+		return converter.typeFormatter.formatEnumValue(referredObj, member.Value)
+	case ast.KindStruct:
+		typeName := converter.typeFormatter.formatReference(def.AsRef())
+
+		return converter.formatStructValue(typeName, referredObj.Type, value)
+	default:
+		return converter.formatValue(referredObj.Type, value)
+	}
+}
+
+func (converter *converter) formatStructValue(typeName string, def ast.Type, value any) string {
+	fields, _ := value.(map[string]any)
+	structType := def.AsStruct()
+
+	assignments := make([]string, 0, len(structType.Fields))
+	if def.IsStructGeneratedFromDisjunction() {
+		branches := tools.Map(structType.Fields, func(field ast.StructField) ast.Type {
+			return field.Type
+		})
+
+		if branch, found := languages.MatchingBranch(converter.context, branches, value); found {
+			field := structType.Fields[branch]
+			assignments = append(assignments, converter.formatFieldAssignment(field, value))
+		}
+	} else {
+		for _, field := range structType.Fields {
+			fieldValue, found := fields[field.Name]
+
+			// constants are set when the object is created
+			if !found || fieldValue == nil || field.Type.IsConcreteScalar() {
+				continue
+			}
+
+			assignments = append(assignments, converter.formatFieldAssignment(field, fieldValue))
+		}
+	}
+
+	if len(assignments) == 0 {
+		return fmt.Sprintf("new %s()", typeName)
+	}
+
+	// public fields can only be set using an initializer block
+	return fmt.Sprintf("new %s() {{\n    %s\n}}", typeName, indent(strings.Join(assignments, "\n")))
+}
+
+func (converter *converter) formatFieldAssignment(field ast.StructField, value any) string {
+	return fmt.Sprintf("%s = %s;", escapeVarName(tools.LowerCamelCase(field.Name)), converter.formatValue(field.Type, value))
+}
+
+func (converter *converter) formatList(items []string) string {
+	converter.imports.Add("List", "java.util")
+
+	if len(items) == 0 {
+		return "List.of()"
+	}
+
+	return fmt.Sprintf("List.of(\n    %s\n)", indent(strings.Join(items, ",\n")))
+}
+
+func (converter *converter) formatMap(entries map[string]any, formatEntry func(entry any) string) string {
+	converter.imports.Add("Map", "java.util")
+
+	if len(entries) == 0 {
+		return "Map.of()"
+	}
+
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	formatted := tools.Map(keys, func(key string) string {
+		return fmt.Sprintf("Map.entry(%s, %s)", strconv.Quote(key), formatEntry(entries[key]))
+	})
+
+	return fmt.Sprintf("Map.ofEntries(\n    %s\n)", indent(strings.Join(formatted, ",\n")))
+}
+
+// formatAnyValue formats a value decoded from JSON, without any information
+// about its type.
+func (converter *converter) formatAnyValue(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return strconv.Quote(typed)
+	case json.Number:
+		return typed.String()
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case []any:
+		return converter.formatList(tools.Map(typed, converter.formatAnyValue))
+	case map[string]any:
+		return converter.formatMap(typed, converter.formatAnyValue)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+// formatScalarValue formats numbers with the suffix their boxed type needs.
+func (converter *converter) formatScalarValue(kind ast.ScalarKind, value any) string {
+	var number string
+	switch typed := value.(type) {
+	case json.Number:
+		number = typed.String()
+	case float64:
+		number = strconv.FormatFloat(typed, 'f', -1, 64)
+	default:
+		return converter.formatAnyValue(value)
+	}
+
+	switch kind {
+	case ast.KindInt64, ast.KindUint64:
+		return number + "L"
+	case ast.KindFloat32:
+		return number + "f"
+	case ast.KindFloat64:
+		if !strings.ContainsAny(number, ".eE") {
+			return number + ".0"
+		}
+
+		return number
+	default:
+		return number
+	}
+}
+
+func indent(input string) string {
+	return strings.ReplaceAll(input, "\n", "\n    ")
+}
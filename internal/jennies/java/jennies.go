@@ -19,10 +19,11 @@ type Config struct {
 	SkipGradleDev bool   `yaml:"skip_gradle_dev"`
 
 	// SkipRuntime disables runtime-related code generation when enabled.
-	// Note: builders can NOT be generated with this flag turned on, as they
-	// rely on the runtime to function.
-	SkipRuntime      bool `yaml:"skip_runtime"`
-	generateBuilders bool
+	// Note: builders and validation methods can NOT be generated with this
+	// flag turned on, as they rely on the runtime to function.
+	SkipRuntime        bool `yaml:"skip_runtime"`
+	generateBuilders   bool
+	generateValidation bool
 }
 
 func (config *Config) InterpolateParameters(interpolator func(input string) string) {
@@ -33,6 +34,7 @@ func (config *Config) InterpolateParameters(interpolator func(input string) stri
 func (config Config) MergeWithGlobal(global languages.Config) Config {
 	newConfig := config
 	newConfig.generateBuilders = global.Builders
+	newConfig.generateValidation = global.Validation && !config.SkipRuntime
 
 	return newConfig
 }
@@ -56,7 +58,7 @@ func (language *Language) Jennies(globalConfig languages.Config) *codejen.JennyL
 		return LanguageRef
 	})
 	jenny.AppendOneToMany(
-		common.If[languages.Context](!config.SkipRuntime, Runtime{config: language.config}),
+		common.If[languages.Context](!config.SkipRuntime, Runtime{config: config}),
 		common.If[languages.Context](!config.SkipRuntime, Registry{config: language.config}),
 		common.If[languages.Context](!config.SkipRuntime, &Deserializers{config: language.config}),
 		common.If[languages.Context](!config.SkipRuntime, &Serializers{config: language.config}),
@@ -208,11 +208,12 @@ func (jenny RawTypes) formatEnum(pkg string, object ast.Object) ([]byte, error)
 	}
 
 	err := jenny.getTemplate().ExecuteTemplate(&buffer, "types/enum.tmpl", EnumTemplate{
-		Package:  jenny.typeFormatter.formatPackage(pkg),
-		Name:     object.Name,
-		Values:   values,
-		Type:     enumType,
-		Comments: object.Comments,
+		Package:    jenny.typeFormatter.formatPackage(pkg),
+		Name:       object.Name,
+		Values:     values,
+		Type:       enumType,
+		Comments:   object.Comments,
+		Deprecated: object.Deprecated,
 	})
 
 	if err != nil {
@@ -228,9 +229,10 @@ func (jenny RawTypes) formatStruct(pkg string, object ast.Object) ([]byte, error
 	fields := make([]Field, 0)
 	for _, field := range object.Type.AsStruct().Fields {
 		fields = append(fields, Field{
-			Name:     field.Name,
-			Type:     jenny.typeFormatter.formatFieldType(field.Type),
-			Comments: field.Comments,
+			Name:       field.Name,
+			Type:       jenny.typeFormatter.formatFieldType(field.Type),
+			Comments:   field.Comments,
+			Deprecated: field.Deprecated,
 		})
 	}
 
@@ -242,11 +244,13 @@ func (jenny RawTypes) formatStruct(pkg string, object ast.Object) ([]byte, error
 		Name:                  tools.UpperCamelCase(object.Name),
 		Fields:                fields,
 		Comments:              object.Comments,
+		Deprecated:            object.Deprecated,
 		Variant:               jenny.getVariant(object.Type),
 		Builders:              builders,
 		HasBuilder:            hasBuilder,
 		Annotation:            jenny.jsonMarshaller.annotation(object.Type),
 		ToJSONFunction:        jenny.jsonMarshaller.genToJSONFunction(object.Type),
+		ValidateFunction:      jenny.genValidateFunction(object),
 		ShouldAddSerializer:   jenny.typeFormatter.objectNeedsCustomSerializer(object),
 		ShouldAddDeserializer: jenny.typeFormatter.objectNeedsCustomDeserializer(object),
 	}); err != nil {
@@ -284,12 +288,13 @@ func (jenny RawTypes) formatReference(pkg string, object ast.Object) ([]byte, er
 	reference := jenny.typeFormatter.formatReference(object.Type.AsRef())
 
 	if err := jenny.getTemplate().ExecuteTemplate(&buffer, "types/class.tmpl", ClassTemplate{
-		Package:  jenny.typeFormatter.formatPackage(pkg),
-		Imports:  jenny.imports,
-		Name:     tools.UpperCamelCase(object.Name),
-		Extends:  []string{reference},
-		Comments: object.Comments,
-		Variant:  jenny.getVariant(object.Type),
+		Package:    jenny.typeFormatter.formatPackage(pkg),
+		Imports:    jenny.imports,
+		Name:       tools.UpperCamelCase(object.Name),
+		Extends:    []string{reference},
+		Comments:   object.Comments,
+		Deprecated: object.Deprecated,
+		Variant:    jenny.getVariant(object.Type),
 	}); err != nil {
 		return nil, err
 	}
@@ -314,13 +319,14 @@ func (jenny RawTypes) formatIntersection(pkg string, object ast.Object) ([]byte,
 	}
 
 	if err := jenny.getTemplate().ExecuteTemplate(&buffer, "types/class.tmpl", ClassTemplate{
-		Package:  jenny.typeFormatter.formatPackage(pkg),
-		Imports:  jenny.imports,
-		Name:     object.Name,
-		Extends:  extensions,
-		Comments: object.Comments,
-		Fields:   fields,
-		Variant:  jenny.getVariant(object.Type),
+		Package:    jenny.typeFormatter.formatPackage(pkg),
+		Imports:    jenny.imports,
+		Name:       object.Name,
+		Extends:    extensions,
+		Comments:   object.Comments,
+		Deprecated: object.Deprecated,
+		Fields:     fields,
+		Variant:    jenny.getVariant(object.Type),
 	}); err != nil {
 		return nil, err
 	}
@@ -332,9 +338,10 @@ func (jenny RawTypes) formatFields(def ast.StructType) []Field {
 	fields := make([]Field, len(def.Fields))
 	for i, field := range def.Fields {
 		fields[i] = Field{
-			Name:     field.Name,
-			Type:     jenny.typeFormatter.formatFieldType(field.Type),
-			Comments: field.Comments,
+			Name:       field.Name,
+			Type:       jenny.typeFormatter.formatFieldType(field.Type),
+			Comments:   field.Comments,
+			Deprecated: field.Deprecated,
 		}
 	}
 
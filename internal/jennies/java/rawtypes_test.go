@@ -40,3 +40,30 @@ func TestRawTypes_Generate(t *testing.T) {
 		tc.WriteFiles(files)
 	})
 }
+
+func TestRawTypes_GenerateWithValidation(t *testing.T) {
+	test := testutils.GoldenFilesTestSuite[ast.Schema]{
+		TestDataRoot: "../../../testdata/jennies/validation",
+		Name:         "JavaRawTypes",
+	}
+
+	cfg := Config{generateValidation: true}
+
+	jenny := RawTypes{config: cfg}
+	compilerPasses := New(cfg).CompilerPasses()
+
+	test.Run(t, func(tc *testutils.Test[ast.Schema]) {
+		req := require.New(tc)
+
+		schema := tc.UnmarshalJSONInput(testutils.RawTypesIRInputFile)
+		processedAsts, err := compilerPasses.Process(ast.Schemas{&schema})
+		req.NoError(err)
+
+		files, err := jenny.Generate(languages.Context{
+			Schemas: processedAsts,
+		})
+		req.NoError(err)
+
+		tc.WriteFiles(files)
+	})
+}
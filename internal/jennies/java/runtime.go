@@ -28,10 +28,21 @@ func (jenny Runtime) Generate(_ languages.Context) (codejen.Files, error) {
 		return nil, err
 	}
 
-	return codejen.Files{
+	files := codejen.Files{
 		*codejen.NewFile(filepath.Join(jenny.config.ProjectPath, "cog/variants/Dataquery.java"), variants, jenny),
 		*codejen.NewFile(filepath.Join(jenny.config.ProjectPath, "cog/Builder.java"), builder, jenny),
-	}, nil
+	}
+
+	if jenny.config.generateValidation {
+		validationError, err := jenny.renderValidationError()
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, *codejen.NewFile(filepath.Join(jenny.config.ProjectPath, "cog/ValidationError.java"), validationError, jenny))
+	}
+
+	return files, nil
 }
 
 func (jenny Runtime) renderDataQueryVariant(variant string) ([]byte, error) {
@@ -57,6 +68,17 @@ func (jenny Runtime) renderBuilderInterface() ([]byte, error) {
 	return buf.Bytes(), nil
 }
 
+func (jenny Runtime) renderValidationError() ([]byte, error) {
+	buf := bytes.Buffer{}
+	if err := templates.ExecuteTemplate(&buf, "runtime/validation_error.tmpl", map[string]any{
+		"Package": jenny.formatPackage("cog"),
+	}); err != nil {
+		return nil, fmt.Errorf("failed executing template: %w", err)
+	}
+
+	return buf.Bytes(), nil
+}
+
 func (jenny Runtime) formatPackage(pkg string) string {
 	if jenny.config.PackagePath != "" {
 		return fmt.Sprintf("%s.%s", jenny.config.PackagePath, pkg)
@@ -29,12 +29,13 @@ func init() {
 
 func functions() template.FuncMap {
 	return template.FuncMap{
-		"escapeVar":             escapeVarName,
-		"formatScalar":          formatScalar,
-		"lastPathIdentifier":    lastPathIdentifier,
-		"fillAnnotationPattern": fillAnnotationPattern,
-		"containsValue":         containsValue,
-		"getJavaFieldTypeCheck": getJavaFieldTypeCheck,
+		"escapeVar":               escapeVarName,
+		"formatDeprecationNotice": formatDeprecationNotice,
+		"formatScalar":            formatScalar,
+		"lastPathIdentifier":      lastPathIdentifier,
+		"fillAnnotationPattern":   fillAnnotationPattern,
+		"containsValue":           containsValue,
+		"getJavaFieldTypeCheck":   getJavaFieldTypeCheck,
 		"lastItem": func(index int, values []EnumValue) bool {
 			return len(values)-1 == index
 		},
@@ -72,11 +73,12 @@ func functions() template.FuncMap {
 }
 
 type EnumTemplate struct {
-	Package  string
-	Name     string
-	Values   []EnumValue
-	Type     string
-	Comments []string
+	Package    string
+	Name       string
+	Values     []EnumValue
+	Type       string
+	Comments   []string
+	Deprecated *ast.Deprecation
 }
 
 type EnumValue struct {
@@ -85,11 +87,12 @@ type EnumValue struct {
 }
 
 type ClassTemplate struct {
-	Package  string
-	Imports  fmt.Stringer
-	Name     string
-	Extends  []string
-	Comments []string
+	Package    string
+	Imports    fmt.Stringer
+	Name       string
+	Extends    []string
+	Comments   []string
+	Deprecated *ast.Deprecation
 
 	Fields     []Field
 	Builders   []Builder
@@ -98,14 +101,16 @@ type ClassTemplate struct {
 	Variant               string
 	Annotation            string
 	ToJSONFunction        string
+	ValidateFunction      string
 	ShouldAddSerializer   bool
 	ShouldAddDeserializer bool
 }
 
 type Field struct {
-	Name     string
-	Type     string
-	Comments []string
+	Name       string
+	Type       string
+	Comments   []string
+	Deprecated *ast.Deprecation
 }
 
 type ConstantTemplate struct {
@@ -156,3 +156,9 @@ func objectNeedsCustomDeserialiser(context languages.Context, obj ast.Object) bo
 
 	return false
 }
+
+// formatDeprecationNotice ensures that the deprecation notice can't
+// prematurely close the Javadoc comment it's rendered in.
+func formatDeprecationNotice(deprecation *ast.Deprecation) string {
+	return strings.ReplaceAll(deprecation.Notice(), "*/", "*\\/")
+}
@@ -0,0 +1,205 @@
+package java
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/tools"
+)
+
+func (jenny RawTypes) genValidateFunction(object ast.Object) string {
+	if !jenny.config.generateValidation || !object.Type.IsStruct() {
+		return ""
+	}
+
+	jenny.typeFormatter.packageMapper("java.util", "List")
+	jenny.typeFormatter.packageMapper("java.util", "LinkedList")
+	jenny.typeFormatter.packageMapper("cog", "ValidationError")
+
+	var buffer strings.Builder
+
+	checks := jenny.validationChecksForStruct("this", object.Type.AsStruct(), nil, 0)
+
+	buffer.WriteString(fmt.Sprintf("// validate checks all the validation constraints that may be defined on `%s` fields for violations and returns them.\n", tools.UpperCamelCase(object.Name)))
+	buffer.WriteString("public List<ValidationError> validate() {\n")
+	buffer.WriteString("    List<ValidationError> errors = new LinkedList<>();\n")
+	if checks != "" {
+		buffer.WriteString("\n")
+		buffer.WriteString(indentChecks(checks))
+	}
+	buffer.WriteString("\n    return errors;\n")
+	buffer.WriteString("}")
+
+	// the method is rendered within the class, one indentation level deep
+	lines := strings.Split(buffer.String(), "\n")
+	for i := 1; i < len(lines); i++ {
+		if lines[i] != "" {
+			lines[i] = "    " + lines[i]
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (jenny RawTypes) validationChecksForStruct(valueExpr string, structType ast.StructType, path common.ValidationPath, depth int) string {
+	var buffer strings.Builder
+
+	for _, field := range structType.Fields {
+		fieldExpr := valueExpr + "." + escapeVarName(tools.LowerCamelCase(field.Name))
+		fieldPath := path.AppendField(field.Name)
+
+		nonNullableType := field.Type
+		nonNullableType.Nullable = false
+		checks := jenny.validationChecksForValue(fieldExpr, nonNullableType, fieldPath, depth)
+
+		// values of type `any` can legitimately be null
+		if field.Required && !field.Type.Nullable && !field.Type.IsAny() {
+			buffer.WriteString(fmt.Sprintf("if (%s == null) {\n", fieldExpr))
+			buffer.WriteString(indentChecks(jenny.validationError(fieldPath, common.ValidationRequiredMessage)))
+			if checks != "" {
+				buffer.WriteString("} else {\n")
+				buffer.WriteString(indentChecks(checks))
+			}
+			buffer.WriteString("}\n")
+			continue
+		}
+
+		if checks == "" {
+			continue
+		}
+
+		buffer.WriteString(fmt.Sprintf("if (%s != null) {\n", fieldExpr))
+		buffer.WriteString(indentChecks(checks))
+		buffer.WriteString("}\n")
+	}
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) validationChecksForValue(valueExpr string, typeDef ast.Type, path common.ValidationPath, depth int) string {
+	if typeDef.Nullable {
+		nonNullableType := typeDef
+		nonNullableType.Nullable = false
+
+		checks := jenny.validationChecksForValue(valueExpr, nonNullableType, path, depth)
+		if checks == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("if (%s != null) {\n%s}\n", valueExpr, indentChecks(checks))
+	}
+
+	switch typeDef.Kind {
+	case ast.KindScalar:
+		return jenny.validationChecksForConstraints(valueExpr, typeDef.AsScalar(), path)
+	case ast.KindArray:
+		indexVar := fmt.Sprintf("i%d", depth+1)
+		itemExpr := fmt.Sprintf("%s.get(%s)", valueExpr, indexVar)
+		checks := jenny.validationChecksForValue(itemExpr, typeDef.AsArray().ValueType, path.AppendVariable(indexVar), depth+1)
+		if checks == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("for (int %[1]s = 0; %[1]s < %[2]s.size(); %[1]s++) {\n%[3]s}\n", indexVar, valueExpr, indentChecks(checks))
+	case ast.KindMap:
+		keyVar := fmt.Sprintf("key%d", depth+1)
+		itemExpr := fmt.Sprintf("%s.get(%s)", valueExpr, keyVar)
+		checks := jenny.validationChecksForValue(itemExpr, typeDef.AsMap().ValueType, path.AppendVariable(keyVar), depth+1)
+		if checks == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("for (String %s : %s.keySet()) {\n%s}\n", keyVar, valueExpr, indentChecks(checks))
+	case ast.KindRef:
+		return jenny.validationChecksForRef(valueExpr, typeDef, path, depth)
+	default:
+		// anonymous structs are represented as `Object` and enums are
+		// enforced by the type system: there is nothing to check.
+		return ""
+	}
+}
+
+func (jenny RawTypes) validationChecksForRef(valueExpr string, typeDef ast.Type, path common.ValidationPath, depth int) string {
+	referredObject, found := jenny.typeFormatter.context.LocateObjectByRef(typeDef.AsRef())
+	if !found {
+		return ""
+	}
+
+	switch referredObject.Type.Kind {
+	case ast.KindStruct:
+		return fmt.Sprintf("errors.addAll(ValidationError.prefix(%s, %s.validate()));\n", jenny.formatValidationPath(path), valueExpr)
+	case ast.KindScalar, ast.KindArray, ast.KindMap, ast.KindRef:
+		// constants don't need to be validated
+		if referredObject.Type.IsConcreteScalar() {
+			return ""
+		}
+
+		return jenny.validationChecksForValue(valueExpr, referredObject.Type, path, depth)
+	default:
+		return ""
+	}
+}
+
+func (jenny RawTypes) validationChecksForConstraints(valueExpr string, scalarType ast.ScalarType, path common.ValidationPath) string {
+	var buffer strings.Builder
+
+	for _, constraint := range scalarType.Constraints {
+		if len(constraint.Args) == 0 {
+			continue
+		}
+
+		rightOperand := jenny.typeFormatter.formatScalar(constraint.Args[0])
+		condition := fmt.Sprintf("!(%s %s %s)", valueExpr, constraint.Op, rightOperand)
+
+		switch constraint.Op {
+		case ast.MinLengthOp:
+			condition = fmt.Sprintf("!(%s.length() >= %s)", valueExpr, rightOperand)
+		case ast.MaxLengthOp:
+			condition = fmt.Sprintf("!(%s.length() <= %s)", valueExpr, rightOperand)
+		case ast.MultipleOfOp:
+			condition = fmt.Sprintf("!(%s %% %s == 0)", valueExpr, rightOperand)
+		case ast.PatternOp:
+			condition = fmt.Sprintf("!java.util.regex.Pattern.compile(%s).matcher(%s).find()", rightOperand, valueExpr)
+		case ast.NotPatternOp:
+			condition = fmt.Sprintf("java.util.regex.Pattern.compile(%s).matcher(%s).find()", rightOperand, valueExpr)
+		case ast.EqualOp, ast.NotEqualOp:
+			// strings must be compared by value, not by reference
+			if scalarType.ScalarKind == ast.KindString {
+				condition = fmt.Sprintf("!%s.equals(%s)", rightOperand, valueExpr)
+				if constraint.Op == ast.NotEqualOp {
+					condition = fmt.Sprintf("%s.equals(%s)", rightOperand, valueExpr)
+				}
+			}
+		}
+
+		buffer.WriteString(jenny.validationCheck(condition, path, common.ConstraintViolationMessage(constraint)))
+	}
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) validationCheck(condition string, path common.ValidationPath, message string) string {
+	return fmt.Sprintf("if (%s) {\n%s}\n", condition, indentChecks(jenny.validationError(path, message)))
+}
+
+func (jenny RawTypes) validationError(path common.ValidationPath, message string) string {
+	return fmt.Sprintf("errors.add(new ValidationError(%s, %#v));\n", jenny.formatValidationPath(path), message)
+}
+
+func (jenny RawTypes) formatValidationPath(path common.ValidationPath) string {
+	if path.IsStatic() {
+		return fmt.Sprintf("%#v", path.Format(nil))
+	}
+
+	formatted := `"` + path.Format(func(variable string) string {
+		return `" + ` + variable + ` + "`
+	}) + `"`
+
+	return strings.TrimSuffix(formatted, ` + ""`)
+}
+
+func indentChecks(checks string) string {
+	return tools.Indent(strings.TrimSuffix(checks, "\n"), 4) + "\n"
+}
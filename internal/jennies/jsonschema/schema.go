@@ -104,6 +104,10 @@ func (jenny Schema) objectToDefinition(object ast.Object) Definition {
 		definition.Set("description", comments)
 	}
 
+	if object.Deprecated != nil {
+		definition.Set("deprecated", true)
+	}
+
 	return definition
 }
 
@@ -174,6 +178,13 @@ func (jenny Schema) addStringConstraints(definition *orderedmap.Map[string, any]
 			definition.Set("minLength", constraint.Args[0])
 		case ast.MaxLengthOp:
 			definition.Set("maxLength", constraint.Args[0])
+		case ast.PatternOp:
+			definition.Set("pattern", constraint.Args[0])
+		case ast.NotPatternOp:
+			notPattern := orderedmap.New[string, any]()
+			notPattern.Set("pattern", constraint.Args[0])
+
+			definition.Set("not", notPattern)
 		}
 	}
 }
@@ -211,6 +222,10 @@ func (jenny Schema) formatStruct(typeDef ast.Type) Definition {
 			fieldDef.Set("description", comments)
 		}
 
+		if field.Deprecated != nil {
+			fieldDef.Set("deprecated", true)
+		}
+
 		properties.Set(field.Name, fieldDef)
 
 		if field.Required {
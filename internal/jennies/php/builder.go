@@ -46,6 +46,10 @@ func (jenny *Builder) Generate(context languages.Context) (codejen.Files, error)
 				return newArg
 			})
 
+			if option.Deprecated != nil {
+				option.Comments = append(option.Comments, formatDeprecationTag(option.Deprecated))
+			}
+
 			return option, nil
 		},
 	}
@@ -12,6 +12,9 @@ func TestBuilder_Generate(t *testing.T) {
 	test := testutils.GoldenFilesTestSuite[languages.Context]{
 		TestDataRoot: "../../../testdata/jennies/builders",
 		Name:         "PHPBuilder",
+		Skip: map[string]string{
+			"dashboard_from_object_disjunction_as_type": "disjunctions of references are supported",
+		},
 	}
 
 	config := Config{
@@ -0,0 +1,188 @@
+package php
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// ToCode turns the given builder call into PHP code.
+func (language *Language) ToCode(context languages.Context, call languages.BuilderCall) (string, error) {
+	converter := &converter{
+		config:        language.config,
+		context:       context,
+		typeFormatter: defaultTypeFormatter(language.config, context),
+	}
+
+	return converter.formatBuilderCall(call) + ";\n", nil
+}
+
+type converter struct {
+	config        Config
+	context       languages.Context
+	typeFormatter *typeFormatter
+}
+
+func (converter *converter) formatBuilderCall(call languages.BuilderCall) string {
+	var buffer strings.Builder
+
+	builderClass := converter.config.fullNamespaceRef(formatPackageName(call.Builder.Package) + "\\" + formatObjectName(call.Builder.Name) + "Builder")
+
+	buffer.WriteString(fmt.Sprintf("(new %s(%s))", builderClass, converter.formatArgs(call.Args)))
+
+	for _, opt := range call.Options {
+		formatted := fmt.Sprintf("\n->%s(%s)", formatOptionName(opt.Option.Name), converter.formatArgs(opt.Args))
+
+		buffer.WriteString(indent(formatted))
+	}
+
+	return buffer.String()
+}
+
+func (converter *converter) formatArgs(args []languages.ConvertedValue) string {
+	return strings.Join(tools.Map(args, converter.formatArg), ", ")
+}
+
+func (converter *converter) formatArg(arg languages.ConvertedValue) string {
+	if arg.IsBuilder() {
+		return converter.formatBuilderCall(*arg.Builder)
+	}
+
+	if arg.IsBuildersList() {
+		return formatList(tools.Map(arg.Items, converter.formatArg))
+	}
+
+	return converter.formatValue(arg.Type, arg.Value)
+}
+
+func (converter *converter) formatValue(def ast.Type, value any) string {
+	if value == nil {
+		return "null"
+	}
+
+	switch def.Kind {
+	case ast.KindRef:
+		return converter.formatRefValue(def, value)
+	case ast.KindEnum:
+		return formatAnyValue(languages.EnumMember(def.AsEnum(), value).Value)
+	case ast.KindArray:
+		items, _ := value.([]any)
+
+		return formatList(tools.Map(items, func(item any) string {
+			return converter.formatValue(def.AsArray().ValueType, item)
+		}))
+	case ast.KindMap:
+		entries, _ := value.(map[string]any)
+
+		return formatArray(entries, func(entry any) string {
+			return converter.formatValue(def.AsMap().ValueType, entry)
+		})
+	case ast.KindDisjunction:
+		branches := def.AsDisjunction().Branches
+		branch, found := languages.MatchingBranch(converter.context, branches, value)
+		if !found {
+			return formatAnyValue(value)
+		}
+
+		return converter.formatValue(branches[branch], value)
+	default:
+		return formatAnyValue(value)
+	}
+}
+
+func (converter *converter) formatRefValue(def ast.Type, value any) string {
+	referredObj, found := converter.context.LocateObjectByRef(def.AsRef())
+	if !found {
+		return formatAnyValue(value)
+	}
+
+	switch referredObj.Type.Kind {
+	case ast.KindEnum:
+		member := languages.EnumMember(referredObj.Type.AsEnum(), value)
+
+		return converter.typeFormatter.formatEnumValue(referredObj, member.Value)
+	case ast.KindStruct:
+		typeName := converter.typeFormatter.formatRef(def, false)
+
+		return converter.formatStructValue(typeName, referredObj.Type.AsStruct(), value)
+	default:
+		return converter.formatValue(referredObj.Type, value)
+	}
+}
+
+func (converter *converter) formatStructValue(typeName string, def ast.StructType, value any) string {
+	fields, _ := value.(map[string]any)
+
+	args := make([]string, 0, len(def.Fields))
+	for _, field := range def.Fields {
+		fieldValue, found := fields[field.Name]
+
+		// constants are set by the constructor
+		if !found || fieldValue == nil || field.Type.IsConcreteScalar() {
+			continue
+		}
+
+		args = append(args, fmt.Sprintf("%s: %s", formatFieldName(field.Name), converter.formatValue(field.Type, fieldValue)))
+	}
+
+	if len(args) == 0 {
+		return fmt.Sprintf("new %s()", typeName)
+	}
+
+	return fmt.Sprintf("new %s(\n    %s,\n)", typeName, indent(strings.Join(args, ",\n")))
+}
+
+func formatList(items []string) string {
+	if len(items) == 0 {
+		return "[]"
+	}
+
+	return fmt.Sprintf("[\n    %s,\n]", indent(strings.Join(items, ",\n")))
+}
+
+func formatArray(entries map[string]any, formatEntry func(entry any) string) string {
+	if len(entries) == 0 {
+		return "[]"
+	}
+
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	formatted := tools.Map(keys, func(key string) string {
+		return fmt.Sprintf("%s => %s", formatStringLiteral(key), formatEntry(entries[key]))
+	})
+
+	return fmt.Sprintf("[\n    %s,\n]", indent(strings.Join(formatted, ",\n")))
+}
+
+// formatAnyValue formats a value decoded from JSON, without any information
+// about its type.
+func formatAnyValue(value any) string {
+	switch typed := value.(type) {
+	case string:
+		return formatStringLiteral(typed)
+	case json.Number:
+		return typed.String()
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case []any:
+		return formatList(tools.Map(typed, formatAnyValue))
+	case map[string]any:
+		return formatArray(typed, formatAnyValue)
+	default:
+		return formatValue(value)
+	}
+}
+
+func indent(input string) string {
+	return strings.ReplaceAll(input, "\n", "\n    ")
+}
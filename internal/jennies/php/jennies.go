@@ -11,7 +11,8 @@ import (
 const LanguageRef = "php"
 
 type Config struct {
-	debug bool
+	debug              bool
+	generateValidation bool
 
 	NamespaceRoot string `yaml:"namespace_root"`
 }
@@ -31,6 +32,7 @@ func (config Config) fullNamespaceRef(typeName string) string {
 func (config Config) MergeWithGlobal(global languages.Config) Config {
 	newConfig := config
 	newConfig.debug = global.Debug
+	newConfig.generateValidation = global.Validation
 
 	return newConfig
 }
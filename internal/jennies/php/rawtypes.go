@@ -176,6 +176,10 @@ func (jenny RawTypes) formatObject(context languages.Context, schema *ast.Schema
 		comments = append(comments, passesTrail...)
 	}
 
+	if def.Deprecated != nil {
+		comments = append(comments, formatDeprecationTag(def.Deprecated))
+	}
+
 	buffer.WriteString(formatCommentsBlock(comments))
 
 	switch def.Type.Kind {
@@ -231,6 +235,12 @@ func (jenny RawTypes) formatStructDef(context languages.Context, def ast.Object)
 
 	buffer.WriteString(tools.Indent(jenny.generateJSONSerialize(def), 4))
 
+	if jenny.config.generateValidation {
+		buffer.WriteString("\n\n")
+		// don't leave trailing whitespaces on empty lines
+		buffer.WriteString(strings.ReplaceAll(tools.Indent(jenny.generateValidate(context, def), 4), "\n    \n", "\n\n"))
+	}
+
 	buffer.WriteString("\n}")
 
 	return buffer.String()
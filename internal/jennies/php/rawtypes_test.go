@@ -46,3 +46,34 @@ func TestRawTypes_Generate(t *testing.T) {
 		tc.WriteFiles(files)
 	})
 }
+
+func TestRawTypes_GenerateWithValidation(t *testing.T) {
+	test := testutils.GoldenFilesTestSuite[ast.Schema]{
+		TestDataRoot: "../../../testdata/jennies/validation",
+		Name:         "PHPRawTypes",
+	}
+
+	config := Config{
+		NamespaceRoot:      "Grafana\\Foundation",
+		generateValidation: true,
+	}
+	jenny := RawTypes{
+		config: config,
+	}
+	compilerPasses := New(config).CompilerPasses()
+
+	test.Run(t, func(tc *testutils.Test[ast.Schema]) {
+		req := require.New(tc)
+
+		schema := tc.UnmarshalJSONInput(testutils.RawTypesIRInputFile)
+		processedAsts, err := compilerPasses.Process(ast.Schemas{&schema})
+		req.NoError(err)
+
+		files, err := jenny.Generate(languages.Context{
+			Schemas: processedAsts,
+		})
+		req.NoError(err)
+
+		tc.WriteFiles(files)
+	})
+}
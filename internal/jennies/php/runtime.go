@@ -32,11 +32,33 @@ func (jenny Runtime) Generate(context languages.Context) (codejen.Files, error)
 		return nil, err
 	}
 
-	return codejen.Files{
+	files := codejen.Files{
 		runtime,
 		builderInterface,
 		unknownDataquery,
-	}, nil
+	}
+
+	if jenny.config.generateValidation {
+		validationError, err := jenny.validationError()
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, validationError)
+	}
+
+	return files, nil
+}
+
+func (jenny Runtime) validationError() (codejen.File, error) {
+	rendered, err := renderTemplate("runtime/validation_error.tmpl", map[string]any{
+		"NamespaceRoot": jenny.config.NamespaceRoot,
+	})
+	if err != nil {
+		return codejen.File{}, err
+	}
+
+	return *codejen.NewFile("src/Cog/ValidationError.php", []byte(rendered), jenny), nil
 }
 
 func (jenny Runtime) builderInterface() (codejen.File, error) {
@@ -61,6 +61,8 @@ func init() {
 			"formatArgName":        formatArgName,
 			"formatScalar":         formatValue,
 			"formatDocsBlock":      formatCommentsBlock,
+			"formatStringLiteral":  formatStringLiteral,
+			"formatRegex":          formatRegex,
 		})
 
 	templates = template.Must(cogtemplate.FindAndParseTemplates(templatesFS, base, "templates"))
@@ -42,6 +42,12 @@ func formatEnumMemberName(name string) string {
 	return tools.LowerCamelCase(name)
 }
 
+// formatDeprecationTag returns a `@deprecated` tag, meant to be rendered
+// within a docblock.
+func formatDeprecationTag(deprecation *ast.Deprecation) string {
+	return "@deprecated " + strings.ReplaceAll(deprecation.Notice(), "*/", "*\\/")
+}
+
 func formatCommentsBlock(comments []string) string {
 	if len(comments) == 0 {
 		return ""
@@ -62,6 +68,18 @@ func formatCommentsBlock(comments []string) string {
 	return buffer.String()
 }
 
+// formatStringLiteral returns the given string as a single-quoted literal,
+// to prevent PHP from interpolating variables within it.
+func formatStringLiteral(input string) string {
+	return "'" + strings.NewReplacer(`\`, `\\`, `'`, `\'`).Replace(input) + "'"
+}
+
+// formatRegex returns the given pattern as a string literal usable by
+// `preg_*` functions.
+func formatRegex(pattern string) string {
+	return formatStringLiteral("~" + strings.ReplaceAll(pattern, "~", `\~`) + "~")
+}
+
 func formatValue(val any) string {
 	if val == nil {
 		return "null"
@@ -99,6 +99,10 @@ func (formatter *typeFormatter) formatField(def ast.StructField) string {
 		comments = append(comments, passesTrail...)
 	}
 
+	if def.Deprecated != nil {
+		comments = append(comments, formatDeprecationTag(def.Deprecated))
+	}
+
 	buffer.WriteString(formatCommentsBlock(comments))
 
 	fieldType := def.Type
@@ -0,0 +1,180 @@
+package php
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+func (jenny RawTypes) generateValidate(context languages.Context, def ast.Object) string {
+	var buffer strings.Builder
+
+	validationErrorRef := jenny.config.fullNamespaceRef("Cog\\ValidationError")
+	checks := jenny.validationChecksForStruct(context, "$this", def.Type.AsStruct(), nil, 0)
+
+	buffer.WriteString("/**\n")
+	buffer.WriteString(" * Checks all the validation constraints that may be defined on the fields of this object for violations and returns them.\n")
+	buffer.WriteString(" *\n")
+	buffer.WriteString(fmt.Sprintf(" * @return array<%s>\n", validationErrorRef))
+	buffer.WriteString(" */\n")
+	buffer.WriteString("public function validate(): array\n")
+	buffer.WriteString("{\n")
+
+	if checks == "" {
+		buffer.WriteString("    return [];\n")
+		buffer.WriteString("}")
+		return buffer.String()
+	}
+
+	buffer.WriteString("    $errors = [];\n\n")
+	buffer.WriteString(indentChecks(checks))
+	buffer.WriteString("\n    return $errors;\n")
+	buffer.WriteString("}")
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) validationChecksForStruct(context languages.Context, valueExpr string, structType ast.StructType, path common.ValidationPath, depth int) string {
+	var buffer strings.Builder
+
+	// non-nullable properties are typed and always initialized by the
+	// constructor: checking for their presence isn't needed.
+	for _, field := range structType.Fields {
+		fieldExpr := valueExpr + "->" + formatFieldName(field.Name)
+
+		buffer.WriteString(jenny.validationChecksForValue(context, fieldExpr, field.Type, path.AppendField(field.Name), depth))
+	}
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) validationChecksForValue(context languages.Context, valueExpr string, typeDef ast.Type, path common.ValidationPath, depth int) string {
+	if typeDef.Nullable {
+		nonNullableType := typeDef
+		nonNullableType.Nullable = false
+
+		checks := jenny.validationChecksForValue(context, valueExpr, nonNullableType, path, depth)
+		if checks == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("if (%s !== null) {\n%s}\n", valueExpr, indentChecks(checks))
+	}
+
+	switch typeDef.Kind {
+	case ast.KindScalar:
+		return jenny.validationChecksForConstraints(valueExpr, typeDef.AsScalar(), path)
+	case ast.KindArray:
+		indexVar := fmt.Sprintf("$i%d", depth+1)
+		itemVar := fmt.Sprintf("$item%d", depth+1)
+		checks := jenny.validationChecksForValue(context, itemVar, typeDef.AsArray().ValueType, path.AppendVariable(indexVar), depth+1)
+		if checks == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("foreach (%s as %s => %s) {\n%s}\n", valueExpr, indexVar, itemVar, indentChecks(checks))
+	case ast.KindMap:
+		keyVar := fmt.Sprintf("$key%d", depth+1)
+		itemVar := fmt.Sprintf("$item%d", depth+1)
+		checks := jenny.validationChecksForValue(context, itemVar, typeDef.AsMap().ValueType, path.AppendVariable(keyVar), depth+1)
+		if checks == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("foreach (%s as %s => %s) {\n%s}\n", valueExpr, keyVar, itemVar, indentChecks(checks))
+	case ast.KindRef:
+		return jenny.validationChecksForRef(context, valueExpr, typeDef, path, depth)
+	default:
+		// enums are enforced by the type system: there is nothing to check.
+		return ""
+	}
+}
+
+func (jenny RawTypes) validationChecksForRef(context languages.Context, valueExpr string, typeDef ast.Type, path common.ValidationPath, depth int) string {
+	referredObject, found := context.LocateObjectByRef(typeDef.AsRef())
+	if !found {
+		return ""
+	}
+
+	switch referredObject.Type.Kind {
+	case ast.KindStruct:
+		validationErrorRef := jenny.config.fullNamespaceRef("Cog\\ValidationError")
+
+		return fmt.Sprintf("$errors = array_merge($errors, %s::prefix(%s, %s->validate()));\n", validationErrorRef, formatValidationPath(path), valueExpr)
+	case ast.KindScalar, ast.KindArray, ast.KindMap, ast.KindRef:
+		// constants don't need to be validated
+		if referredObject.Type.IsConcreteScalar() {
+			return ""
+		}
+
+		return jenny.validationChecksForValue(context, valueExpr, referredObject.Type, path, depth)
+	default:
+		return ""
+	}
+}
+
+func (jenny RawTypes) validationChecksForConstraints(valueExpr string, scalarType ast.ScalarType, path common.ValidationPath) string {
+	var buffer strings.Builder
+
+	for _, constraint := range scalarType.Constraints {
+		if len(constraint.Args) == 0 {
+			continue
+		}
+
+		leftOperand := valueExpr
+		operator := string(constraint.Op)
+		rightOperand := formatValue(constraint.Args[0])
+
+		switch constraint.Op {
+		case ast.MinLengthOp:
+			leftOperand = fmt.Sprintf("strlen(%s)", valueExpr)
+			operator = ">="
+		case ast.MaxLengthOp:
+			leftOperand = fmt.Sprintf("strlen(%s)", valueExpr)
+			operator = "<="
+		case ast.MultipleOfOp:
+			leftOperand = fmt.Sprintf("%s %% %s", valueExpr, rightOperand)
+			if scalarType.ScalarKind == ast.KindFloat32 || scalarType.ScalarKind == ast.KindFloat64 {
+				leftOperand = fmt.Sprintf("fmod(%s, %s)", valueExpr, rightOperand)
+			}
+			operator = "=="
+			rightOperand = "0"
+		}
+
+		condition := fmt.Sprintf("!(%s %s %s)", leftOperand, operator, rightOperand)
+		switch constraint.Op {
+		case ast.PatternOp:
+			condition = fmt.Sprintf("preg_match(%s, %s) !== 1", formatRegex(fmt.Sprint(constraint.Args[0])), valueExpr)
+		case ast.NotPatternOp:
+			condition = fmt.Sprintf("preg_match(%s, %s) === 1", formatRegex(fmt.Sprint(constraint.Args[0])), valueExpr)
+		}
+		buffer.WriteString(jenny.validationCheck(condition, path, common.ConstraintViolationMessage(constraint)))
+	}
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) validationCheck(condition string, path common.ValidationPath, message string) string {
+	validationErrorRef := jenny.config.fullNamespaceRef("Cog\\ValidationError")
+	violation := fmt.Sprintf("$errors[] = new %s(%s, %s);", validationErrorRef, formatValidationPath(path), formatStringLiteral(message))
+
+	return fmt.Sprintf("if (%s) {\n%s}\n", condition, indentChecks(violation))
+}
+
+func formatValidationPath(path common.ValidationPath) string {
+	if path.IsStatic() {
+		return formatStringLiteral(path.Format(nil))
+	}
+
+	return `"` + path.Format(func(variable string) string {
+		return "{" + variable + "}"
+	}) + `"`
+}
+
+func indentChecks(checks string) string {
+	return tools.Indent(strings.TrimSuffix(checks, "\n"), 4) + "\n"
+}
@@ -0,0 +1,76 @@
+package protobuf
+
+import (
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast/compiler"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/languages"
+)
+
+const LanguageRef = "protobuf"
+
+type Config struct {
+	debug bool
+
+	// PackagePrefix is prepended to the name of every generated protobuf package.
+	// Ex: "grafana.foundation" will generate `package grafana.foundation.dashboard;`
+	PackagePrefix string `yaml:"package_prefix"`
+
+	// GoPackageRoot is used to generate the `go_package` option of every file.
+	// Ex: github.com/grafana/cog/generated/protobuf
+	GoPackageRoot string `yaml:"go_package_root"`
+
+	// LockFile is the path of the lock file keeping track of the numbers
+	// assigned to fields and enum values, from which the numbers used by a
+	// previous generation are read.
+	// It is set by the codegen pipeline to the lock file living in the
+	// output directory.
+	LockFile string `yaml:"-"`
+}
+
+func (config *Config) InterpolateParameters(interpolator func(input string) string) {
+	config.PackagePrefix = interpolator(config.PackagePrefix)
+	config.GoPackageRoot = interpolator(config.GoPackageRoot)
+}
+
+func (config Config) MergeWithGlobal(global languages.Config) Config {
+	newConfig := config
+	newConfig.debug = global.Debug
+
+	return newConfig
+}
+
+type Language struct {
+	config Config
+}
+
+func New(config Config) *Language {
+	return &Language{
+		config: config,
+	}
+}
+
+func (language *Language) Name() string {
+	return LanguageRef
+}
+
+func (language *Language) Jennies(globalConfig languages.Config) *codejen.JennyList[languages.Context] {
+	config := language.config.MergeWithGlobal(globalConfig)
+	jenny := codejen.JennyListWithNamer[languages.Context](func(_ languages.Context) string {
+		return LanguageRef
+	})
+
+	jenny.AppendOneToMany(Schema{Config: config})
+	jenny.AddPostprocessors(common.GeneratedCommentHeader(globalConfig))
+
+	return jenny
+}
+
+func (language *Language) CompilerPasses() compiler.Passes {
+	return compiler.Passes{
+		&compiler.AnonymousEnumToExplicitType{},
+		&compiler.FlattenDisjunctions{},
+		&compiler.DisjunctionWithNullToOptional{},
+		&compiler.InferEntrypoint{},
+	}
+}
@@ -0,0 +1,144 @@
+package protobuf
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+)
+
+// LockFileName is the name of the lock file generated alongside .proto files.
+const LockFileName = "protobuf.lock.json"
+
+// Numbers in this range are reserved for the protobuf implementation.
+const (
+	reservedRangeStart = 19000
+	reservedRangeEnd   = 19999
+)
+
+// Lock keeps track of the numbers assigned to message fields and enum values.
+// Numbers are never re-assigned: they stay stable across regenerations, and
+// the ones used by fields or values that disappeared are marked as reserved.
+type Lock struct {
+	// Messages maps fully-qualified message names to the numbers of their fields.
+	Messages map[string]map[string]int `json:"messages"`
+	// Enums maps fully-qualified enum names to the numbers of their values.
+	Enums map[string]map[string]int `json:"enums"`
+}
+
+func NewLock() *Lock {
+	return &Lock{
+		Messages: make(map[string]map[string]int),
+		Enums:    make(map[string]map[string]int),
+	}
+}
+
+// LoadLock reads the lock file at the given path. An empty lock is returned
+// if the path is empty or if the file doesn't exist.
+func LoadLock(path string) (*Lock, error) {
+	lock := NewLock()
+	if path == "" {
+		return lock, nil
+	}
+
+	contents, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return lock, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := json.Unmarshal(contents, lock); err != nil {
+		return nil, fmt.Errorf("could not parse protobuf lock file '%s': %w", path, err)
+	}
+
+	if lock.Messages == nil {
+		lock.Messages = make(map[string]map[string]int)
+	}
+	if lock.Enums == nil {
+		lock.Enums = make(map[string]map[string]int)
+	}
+
+	return lock, nil
+}
+
+func (lock *Lock) Marshal() ([]byte, error) {
+	return json.MarshalIndent(lock, "", "  ")
+}
+
+// FieldNumber returns the number of the given field within a message,
+// assigning a new one if needed.
+func (lock *Lock) FieldNumber(message string, field string) int {
+	return assignNumber(lock.Messages, message, field)
+}
+
+// EnumValueNumber returns the number of the given value within an enum,
+// assigning a new one if needed.
+// Note: 0 is never assigned, as it is used by the "unspecified" value of enums.
+func (lock *Lock) EnumValueNumber(enum string, value string) int {
+	return assignNumber(lock.Enums, enum, value)
+}
+
+// unusedFields returns the fields known to the lock for the given message
+// that aren't part of the given list of used fields.
+func (lock *Lock) unusedFields(message string, used []string) []lockedNumber {
+	return unusedNumbers(lock.Messages, message, used)
+}
+
+// unusedEnumValues returns the values known to the lock for the given enum
+// that aren't part of the given list of used values.
+func (lock *Lock) unusedEnumValues(enum string, used []string) []lockedNumber {
+	return unusedNumbers(lock.Enums, enum, used)
+}
+
+type lockedNumber struct {
+	name   string
+	number int
+}
+
+func assignNumber(numbers map[string]map[string]int, scope string, name string) int {
+	if numbers[scope] == nil {
+		numbers[scope] = make(map[string]int)
+	}
+
+	if number, found := numbers[scope][name]; found {
+		return number
+	}
+
+	next := 1
+	for _, number := range numbers[scope] {
+		if number >= next {
+			next = number + 1
+		}
+	}
+
+	if next >= reservedRangeStart && next <= reservedRangeEnd {
+		next = reservedRangeEnd + 1
+	}
+
+	numbers[scope][name] = next
+
+	return next
+}
+
+func unusedNumbers(numbers map[string]map[string]int, scope string, used []string) []lockedNumber {
+	usedSet := make(map[string]struct{}, len(used))
+	for _, name := range used {
+		usedSet[name] = struct{}{}
+	}
+
+	var unused []lockedNumber
+	for name, number := range numbers[scope] {
+		if _, found := usedSet[name]; !found {
+			unused = append(unused, lockedNumber{name: name, number: number})
+		}
+	}
+
+	sort.SliceStable(unused, func(i, j int) bool {
+		return unused[i].number < unused[j].number
+	})
+
+	return unused
+}
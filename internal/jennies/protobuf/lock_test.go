@@ -0,0 +1,91 @@
+package protobuf
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/stretchr/testify/require"
+)
+
+func generateWithLock(t *testing.T, lockFile string, objects ...ast.Object) string {
+	t.Helper()
+	req := require.New(t)
+
+	schema := ast.NewSchema("pkg", ast.SchemaMeta{})
+	schema.AddObjects(objects...)
+
+	files, err := Schema{Config: Config{LockFile: lockFile}}.Generate(languages.Context{
+		Schemas: ast.Schemas{schema},
+	})
+	req.NoError(err)
+	req.Len(files, 2)
+	req.Equal(LockFileName, files[1].RelativePath)
+
+	req.NoError(os.WriteFile(lockFile, files[1].Data, 0600))
+
+	return string(files[0].Data)
+}
+
+func TestSchema_Generate_numbersAreStable(t *testing.T) {
+	req := require.New(t)
+	lockFile := filepath.Join(t.TempDir(), LockFileName)
+
+	generateWithLock(t, lockFile,
+		ast.NewObject("pkg", "Foo", ast.NewStruct(
+			ast.NewStructField("id", ast.String(), ast.Required()),
+			ast.NewStructField("title", ast.String(), ast.Required()),
+		)),
+		ast.NewObject("pkg", "Status", ast.NewEnum([]ast.EnumValue{
+			{Type: ast.String(), Name: "on", Value: "on"},
+			{Type: ast.String(), Name: "off", Value: "off"},
+		})),
+	)
+
+	// "title" and "on" are removed, "label" and "unknown" are added
+	output := generateWithLock(t, lockFile,
+		ast.NewObject("pkg", "Foo", ast.NewStruct(
+			ast.NewStructField("label", ast.String(), ast.Required()),
+			ast.NewStructField("id", ast.String(), ast.Required()),
+		)),
+		ast.NewObject("pkg", "Status", ast.NewEnum([]ast.EnumValue{
+			{Type: ast.String(), Name: "unknown", Value: "unknown"},
+			{Type: ast.String(), Name: "off", Value: "off"},
+		})),
+	)
+
+	req.Equal(`syntax = "proto3";
+
+package pkg;
+
+message Foo {
+  reserved 2;
+  reserved "title";
+
+  string label = 3;
+  string id = 1;
+}
+
+enum Status {
+  reserved 1;
+  reserved "STATUS_ON";
+
+  STATUS_UNSPECIFIED = 0;
+  STATUS_UNKNOWN = 3;
+  STATUS_OFF = 2;
+}
+`, output)
+}
+
+func TestLoadLock_missingFile(t *testing.T) {
+	req := require.New(t)
+
+	lock, err := LoadLock(filepath.Join(t.TempDir(), "does-not-exist.json"))
+	req.NoError(err)
+
+	req.Equal(1, lock.FieldNumber("pkg.Foo", "id"))
+	req.Equal(2, lock.FieldNumber("pkg.Foo", "title"))
+	req.Equal(1, lock.FieldNumber("pkg.Foo", "id"))
+}
@@ -0,0 +1,688 @@
+package protobuf
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+const wellKnownStructImport = "google/protobuf/struct.proto"
+
+var nonIdentifierCharsRegex = regexp.MustCompile("[^a-zA-Z0-9_]+")
+
+type Schema struct {
+	Config Config
+}
+
+func (jenny Schema) JennyName() string {
+	return "Protobuf"
+}
+
+func (jenny Schema) Generate(context languages.Context) (codejen.Files, error) {
+	lock, err := LoadLock(jenny.Config.LockFile)
+	if err != nil {
+		return nil, err
+	}
+
+	files := make(codejen.Files, 0, len(context.Schemas)+1)
+
+	for _, schema := range context.Schemas {
+		output, err := jenny.generateSchema(context, lock, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		files = append(files, *codejen.NewFile(schema.Package+".proto", output, jenny))
+	}
+
+	lockContents, err := lock.Marshal()
+	if err != nil {
+		return nil, err
+	}
+
+	files = append(files, *codejen.NewFile(LockFileName, lockContents, jenny))
+
+	return files, nil
+}
+
+func (jenny Schema) generateSchema(context languages.Context, lock *Lock, schema *ast.Schema) ([]byte, error) {
+	gen := &generator{
+		pkg:     schema.Package,
+		config:  jenny.Config,
+		context: context,
+		lock:    lock,
+		imports: make(map[string]struct{}),
+	}
+
+	var err error
+	var definitions []string
+	schema.Objects.Iterate(func(_ string, object ast.Object) {
+		if err != nil {
+			return
+		}
+
+		definition, objectErr := gen.objectDefinition(object)
+		if objectErr != nil {
+			err = fmt.Errorf("%s: %w", object.SelfRef.String(), objectErr)
+			return
+		}
+
+		if definition != "" {
+			definitions = append(definitions, definition)
+		}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var buffer strings.Builder
+
+	buffer.WriteString("syntax = \"proto3\";\n\n")
+	buffer.WriteString(fmt.Sprintf("package %s;\n", jenny.Config.packageName(schema.Package)))
+
+	if jenny.Config.GoPackageRoot != "" {
+		goPackage := strings.TrimSuffix(jenny.Config.GoPackageRoot, "/") + "/" + formatPackageName(schema.Package)
+		buffer.WriteString(fmt.Sprintf("\noption go_package = %q;\n", goPackage))
+	}
+
+	if len(gen.imports) != 0 {
+		buffer.WriteString("\n")
+	}
+	for _, importPath := range gen.sortedImports() {
+		buffer.WriteString(fmt.Sprintf("import %q;\n", importPath))
+	}
+
+	for _, definition := range definitions {
+		buffer.WriteString("\n" + definition + "\n")
+	}
+
+	return []byte(buffer.String()), nil
+}
+
+func (config Config) packageName(pkg string) string {
+	if config.PackagePrefix == "" {
+		return formatPackageName(pkg)
+	}
+
+	return strings.TrimSuffix(config.PackagePrefix, ".") + "." + formatPackageName(pkg)
+}
+
+type generator struct {
+	pkg     string
+	config  Config
+	context languages.Context
+	lock    *Lock
+	imports map[string]struct{}
+}
+
+// message holds a protobuf message while it is being generated.
+type message struct {
+	name       string
+	fullName   string
+	comments   []string
+	deprecated bool
+
+	// nested messages and enums definitions
+	definitions []string
+	// fields and oneofs definitions
+	members []string
+	// names of the fields defined in this message, used to reserve the
+	// numbers of the fields that were removed.
+	fieldNames []string
+}
+
+func (gen *generator) objectDefinition(object ast.Object) (string, error) {
+	fullName := object.SelfRef.ReferredPkg + "." + object.Name
+	comments := withDeprecationNotice(object.Comments, object.Deprecated)
+
+	switch object.Type.Kind {
+	case ast.KindStruct:
+		msg := &message{name: object.Name, fullName: fullName, comments: comments, deprecated: object.Deprecated != nil}
+		if err := gen.addStructFields(msg, object.Type.AsStruct()); err != nil {
+			return "", err
+		}
+
+		return gen.renderMessage(msg), nil
+	case ast.KindEnum:
+		return gen.enumDefinition(object.Name, fullName, comments, object.Deprecated != nil, object.Type.AsEnum()), nil
+	case ast.KindDisjunction:
+		msg := &message{name: object.Name, fullName: fullName, comments: comments, deprecated: object.Deprecated != nil}
+		if err := gen.addField(msg, "value", object.Type, true, nil, nil); err != nil {
+			return "", err
+		}
+
+		return gen.renderMessage(msg), nil
+	case ast.KindIntersection:
+		msg := &message{name: object.Name, fullName: fullName, comments: comments, deprecated: object.Deprecated != nil}
+		if err := gen.addIntersectionFields(msg, object.Type.AsIntersection()); err != nil {
+			return "", err
+		}
+
+		return gen.renderMessage(msg), nil
+	default:
+		// protobuf doesn't have type aliases: references to this object
+		// are replaced by its type.
+		return "", nil
+	}
+}
+
+func (gen *generator) addStructFields(msg *message, structType ast.StructType) error {
+	for _, field := range structType.Fields {
+		if err := gen.addField(msg, field.Name, field.Type, field.Required, field.Comments, field.Deprecated); err != nil {
+			return fmt.Errorf("field '%s': %w", field.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// addIntersectionFields flattens the fields of every branch of the given
+// intersection into the message. Branches that can't be flattened are
+// embedded as fields.
+func (gen *generator) addIntersectionFields(msg *message, intersection ast.IntersectionType) error {
+	for _, branch := range intersection.Branches {
+		resolved := branch
+		if branch.IsRef() {
+			if object, found := gen.context.LocateObjectByRef(branch.AsRef()); found && object.Type.IsStruct() {
+				resolved = object.Type
+			}
+		}
+
+		if resolved.IsStruct() {
+			if err := gen.addStructFields(msg, resolved.AsStruct()); err != nil {
+				return err
+			}
+
+			continue
+		}
+
+		if err := gen.addField(msg, tools.LowerCamelCase(gen.branchName(branch)), branch, true, nil, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (gen *generator) addField(msg *message, name string, typeDef ast.Type, required bool, comments []string, deprecation *ast.Deprecation) error {
+	fieldName := formatFieldName(name)
+	resolved := gen.resolveAlias(typeDef)
+	options := fieldOptions(name, fieldName, deprecation)
+	comment := formatComments(withDeprecationNotice(comments, deprecation))
+
+	var definition string
+
+	switch resolved.Kind {
+	case ast.KindArray:
+		valueType, err := gen.singleValueType(msg, name, resolved.AsArray().ValueType)
+		if err != nil {
+			return err
+		}
+
+		definition = fmt.Sprintf("repeated %s %s = %d%s;", valueType, fieldName, gen.fieldNumber(msg, fieldName), options)
+	case ast.KindMap:
+		keyType, err := gen.mapKeyType(resolved.AsMap().IndexType)
+		if err != nil {
+			return err
+		}
+
+		valueType, err := gen.singleValueType(msg, name, resolved.AsMap().ValueType)
+		if err != nil {
+			return err
+		}
+
+		definition = fmt.Sprintf("map<%s, %s> %s = %d%s;", keyType, valueType, fieldName, gen.fieldNumber(msg, fieldName), options)
+	case ast.KindDisjunction:
+		oneof, err := gen.oneof(msg, name, fieldName, resolved.AsDisjunction())
+		if err != nil {
+			return err
+		}
+
+		definition = oneof
+	default:
+		valueType, err := gen.singleValueType(msg, name, resolved)
+		if err != nil {
+			return err
+		}
+
+		label := ""
+		if !required || resolved.Nullable {
+			label = "optional "
+		}
+
+		definition = fmt.Sprintf("%s%s %s = %d%s;", label, valueType, fieldName, gen.fieldNumber(msg, fieldName), options)
+	}
+
+	msg.members = append(msg.members, comment+definition)
+
+	return nil
+}
+
+// oneof generates a `oneof` block with a field for each branch of the
+// given disjunction.
+func (gen *generator) oneof(msg *message, name string, fieldName string, disjunction ast.DisjunctionType) (string, error) {
+	branches := make([]string, 0, len(disjunction.Branches))
+	seenBranchNames := make(map[string]int)
+
+	for _, branch := range disjunction.Branches {
+		// the absence of a value in the oneof already represents null
+		if branch.IsNull() {
+			continue
+		}
+
+		branchName := gen.branchName(branch)
+		seenBranchNames[branchName]++
+		if seenBranchNames[branchName] > 1 {
+			branchName = fmt.Sprintf("%s%d", branchName, seenBranchNames[branchName])
+		}
+
+		branchFieldName := fieldName + "_" + branchName
+		valueType, err := gen.singleValueType(msg, name+"_"+branchName, branch)
+		if err != nil {
+			return "", err
+		}
+
+		branches = append(branches, fmt.Sprintf("%s %s = %d;", valueType, branchFieldName, gen.fieldNumber(msg, branchFieldName)))
+	}
+
+	return fmt.Sprintf("oneof %s {\n%s\n}", fieldName, indent(strings.Join(branches, "\n"))), nil
+}
+
+func (gen *generator) branchName(branch ast.Type) string {
+	resolved := gen.resolveAlias(branch)
+
+	switch resolved.Kind {
+	case ast.KindRef:
+		return formatFieldName(resolved.AsRef().ReferredType)
+	case ast.KindScalar:
+		return string(resolved.AsScalar().ScalarKind)
+	case ast.KindArray:
+		return "list"
+	default:
+		return string(resolved.Kind)
+	}
+}
+
+// singleValueType returns the protobuf type to use for the given type, when
+// used as a single value: as field type, array item or map value.
+// Nested messages and enums are defined within the given message when needed.
+func (gen *generator) singleValueType(msg *message, name string, typeDef ast.Type) (string, error) {
+	resolved := gen.resolveAlias(typeDef)
+	nestedName := tools.UpperCamelCase(name)
+	nestedFullName := msg.fullName + "." + nestedName
+
+	switch resolved.Kind {
+	case ast.KindScalar:
+		return gen.scalarType(resolved.AsScalar().ScalarKind), nil
+	case ast.KindRef:
+		return gen.refType(resolved.AsRef()), nil
+	case ast.KindComposableSlot:
+		gen.imports[wellKnownStructImport] = struct{}{}
+
+		return "google.protobuf.Struct", nil
+	case ast.KindEnum:
+		msg.definitions = append(msg.definitions, gen.enumDefinition(nestedName, nestedFullName, nil, false, resolved.AsEnum()))
+
+		return nestedName, nil
+	case ast.KindStruct:
+		nested := &message{name: nestedName, fullName: nestedFullName}
+		if err := gen.addStructFields(nested, resolved.AsStruct()); err != nil {
+			return "", err
+		}
+
+		msg.definitions = append(msg.definitions, gen.renderMessage(nested))
+
+		return nestedName, nil
+	case ast.KindArray, ast.KindMap, ast.KindDisjunction:
+		// these types can't be nested in protobuf: they are wrapped in a message.
+		fieldName := "values"
+		if resolved.IsDisjunction() {
+			fieldName = "value"
+		}
+
+		nested := &message{name: nestedName, fullName: nestedFullName}
+		if err := gen.addField(nested, fieldName, resolved, true, nil, nil); err != nil {
+			return "", err
+		}
+
+		msg.definitions = append(msg.definitions, gen.renderMessage(nested))
+
+		return nestedName, nil
+	default:
+		return "", fmt.Errorf("type '%s' can not be represented in protobuf", resolved.Kind)
+	}
+}
+
+func (gen *generator) scalarType(kind ast.ScalarKind) string {
+	switch kind {
+	case ast.KindString:
+		return "string"
+	case ast.KindBytes:
+		return "bytes"
+	case ast.KindBool:
+		return "bool"
+	case ast.KindFloat32:
+		return "float"
+	case ast.KindFloat64:
+		return "double"
+	case ast.KindInt8, ast.KindInt16, ast.KindInt32:
+		return "int32"
+	case ast.KindInt64:
+		return "int64"
+	case ast.KindUint8, ast.KindUint16, ast.KindUint32:
+		return "uint32"
+	case ast.KindUint64:
+		return "uint64"
+	case ast.KindNull:
+		gen.imports[wellKnownStructImport] = struct{}{}
+
+		return "google.protobuf.NullValue"
+	default:
+		gen.imports[wellKnownStructImport] = struct{}{}
+
+		return "google.protobuf.Value"
+	}
+}
+
+func (gen *generator) refType(ref ast.RefType) string {
+	if ref.ReferredPkg == "" || ref.ReferredPkg == gen.pkg {
+		return ref.ReferredType
+	}
+
+	gen.imports[ref.ReferredPkg+".proto"] = struct{}{}
+
+	return gen.config.packageName(ref.ReferredPkg) + "." + ref.ReferredType
+}
+
+func (gen *generator) mapKeyType(typeDef ast.Type) (string, error) {
+	resolved := gen.resolveAlias(typeDef)
+
+	// enums can't be used as map keys: their JSON representation is used instead
+	if resolved.IsEnum() {
+		return "string", nil
+	}
+	if resolved.IsRef() {
+		if object, found := gen.context.LocateObjectByRef(resolved.AsRef()); found && object.Type.IsEnum() {
+			return "string", nil
+		}
+	}
+
+	if resolved.IsScalar() {
+		switch resolved.AsScalar().ScalarKind {
+		case ast.KindString, ast.KindBool,
+			ast.KindInt8, ast.KindInt16, ast.KindInt32, ast.KindInt64,
+			ast.KindUint8, ast.KindUint16, ast.KindUint32, ast.KindUint64:
+			return gen.scalarType(resolved.AsScalar().ScalarKind), nil
+		}
+	}
+
+	return "", fmt.Errorf("map keys of type '%s' can not be represented in protobuf", describeKind(resolved))
+}
+
+// resolveAlias follows references to objects that don't define a message
+// or an enum, since protobuf doesn't support type aliases.
+func (gen *generator) resolveAlias(typeDef ast.Type) ast.Type {
+	if !typeDef.IsRef() {
+		return typeDef
+	}
+
+	object, found := gen.context.LocateObjectByRef(typeDef.AsRef())
+	if !found || object.Type.IsAnyOf(ast.KindStruct, ast.KindEnum, ast.KindDisjunction) {
+		return typeDef
+	}
+
+	resolved := gen.resolveAlias(object.Type)
+	resolved.Nullable = resolved.Nullable || typeDef.Nullable
+
+	return resolved
+}
+
+func (gen *generator) fieldNumber(msg *message, fieldName string) int {
+	msg.fieldNames = append(msg.fieldNames, fieldName)
+
+	return gen.lock.FieldNumber(msg.fullName, fieldName)
+}
+
+func (gen *generator) enumDefinition(name string, fullName string, comments []string, deprecated bool, enum ast.EnumType) string {
+	prefix := formatEnumValueName(name) + "_"
+	var header []string
+	var values []string
+
+	if deprecated {
+		header = append(header, "option deprecated = true;")
+	}
+
+	if numbers, ok := integerEnumNumbers(enum); ok {
+		if !hasZeroValue(numbers) {
+			values = append(values, prefix+"UNSPECIFIED = 0;")
+		}
+
+		// proto3 requires the first enum value to be zero
+		indices := make([]int, len(enum.Values))
+		for i := range indices {
+			indices[i] = i
+		}
+		sort.SliceStable(indices, func(i, j int) bool {
+			return numbers[indices[i]] == 0 && numbers[indices[j]] != 0
+		})
+
+		for _, i := range indices {
+			values = append(values, fmt.Sprintf("%s%s = %d;", prefix, formatEnumValueName(enum.Values[i].Name), numbers[i]))
+		}
+	} else {
+		values = append(values, prefix+"UNSPECIFIED = 0;")
+
+		valueNames := make([]string, 0, len(enum.Values))
+		for _, value := range enum.Values {
+			valueName := prefix + formatEnumValueName(value.Name)
+			valueNames = append(valueNames, valueName)
+			values = append(values, fmt.Sprintf("%s = %d;", valueName, gen.lock.EnumValueNumber(fullName, valueName)))
+		}
+
+		header = append(header, reservedStatements(gen.lock.unusedEnumValues(fullName, valueNames))...)
+	}
+
+	body := strings.Join(values, "\n")
+	if len(header) != 0 {
+		body = strings.Join(header, "\n") + "\n\n" + body
+	}
+
+	return fmt.Sprintf("%senum %s {\n%s\n}", formatComments(comments), name, indent(body))
+}
+
+func (gen *generator) renderMessage(msg *message) string {
+	var header []string
+	if msg.deprecated {
+		header = append(header, "option deprecated = true;")
+	}
+	header = append(header, reservedStatements(gen.lock.unusedFields(msg.fullName, msg.fieldNames))...)
+
+	var sections []string
+	if len(header) != 0 {
+		sections = append(sections, strings.Join(header, "\n"))
+	}
+	sections = append(sections, msg.definitions...)
+	if len(msg.members) != 0 {
+		sections = append(sections, strings.Join(msg.members, "\n"))
+	}
+
+	if len(sections) == 0 {
+		return fmt.Sprintf("%smessage %s {}", formatComments(msg.comments), msg.name)
+	}
+
+	return fmt.Sprintf("%smessage %s {\n%s\n}", formatComments(msg.comments), msg.name, indent(strings.Join(sections, "\n\n")))
+}
+
+func (gen *generator) sortedImports() []string {
+	imports := make([]string, 0, len(gen.imports))
+	for importPath := range gen.imports {
+		imports = append(imports, importPath)
+	}
+
+	sort.Strings(imports)
+
+	return imports
+}
+
+// integerEnumNumbers returns the values of the given enum if they can be
+// used as-is as numbers for the protobuf enum.
+func integerEnumNumbers(enum ast.EnumType) ([]int, bool) {
+	numbers := make([]int, 0, len(enum.Values))
+
+	for _, value := range enum.Values {
+		var number int
+
+		switch v := value.Value.(type) {
+		case int:
+			number = v
+		case int64:
+			number = int(v)
+		case float64:
+			if v != float64(int(v)) {
+				return nil, false
+			}
+			number = int(v)
+		default:
+			return nil, false
+		}
+
+		if number < 0 {
+			return nil, false
+		}
+
+		numbers = append(numbers, number)
+	}
+
+	return numbers, true
+}
+
+func hasZeroValue(numbers []int) bool {
+	for _, number := range numbers {
+		if number == 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func reservedStatements(unused []lockedNumber) []string {
+	if len(unused) == 0 {
+		return nil
+	}
+
+	numbers := tools.Map(unused, func(locked lockedNumber) string {
+		return strconv.Itoa(locked.number)
+	})
+	names := tools.Map(unused, func(locked lockedNumber) string {
+		return strconv.Quote(locked.name)
+	})
+
+	return []string{
+		fmt.Sprintf("reserved %s;", strings.Join(numbers, ", ")),
+		fmt.Sprintf("reserved %s;", strings.Join(names, ", ")),
+	}
+}
+
+func fieldOptions(originalName string, fieldName string, deprecation *ast.Deprecation) string {
+	var options []string
+
+	if defaultJSONName(fieldName) != originalName {
+		options = append(options, fmt.Sprintf("json_name = %q", originalName))
+	}
+
+	if deprecation != nil {
+		options = append(options, "deprecated = true")
+	}
+
+	if len(options) == 0 {
+		return ""
+	}
+
+	return " [" + strings.Join(options, ", ") + "]"
+}
+
+// defaultJSONName mimics the algorithm used by protoc to derive the JSON
+// name of a field.
+func defaultJSONName(fieldName string) string {
+	var buffer strings.Builder
+	capitalizeNext := false
+
+	for _, char := range fieldName {
+		if char == '_' {
+			capitalizeNext = true
+			continue
+		}
+
+		if capitalizeNext {
+			buffer.WriteString(strings.ToUpper(string(char)))
+			capitalizeNext = false
+			continue
+		}
+
+		buffer.WriteRune(char)
+	}
+
+	return buffer.String()
+}
+
+func withDeprecationNotice(comments []string, deprecation *ast.Deprecation) []string {
+	if deprecation == nil {
+		return comments
+	}
+
+	withNotice := make([]string, 0, len(comments)+1)
+	withNotice = append(withNotice, comments...)
+
+	return append(withNotice, "Deprecated: "+deprecation.Notice())
+}
+
+func formatComments(comments []string) string {
+	var buffer strings.Builder
+
+	for _, comment := range comments {
+		for _, line := range strings.Split(comment, "\n") {
+			buffer.WriteString(strings.TrimRight("// "+line, " ") + "\n")
+		}
+	}
+
+	return buffer.String()
+}
+
+func formatPackageName(pkg string) string {
+	return strings.ToLower(nonIdentifierCharsRegex.ReplaceAllString(pkg, "_"))
+}
+
+func formatFieldName(name string) string {
+	return nonIdentifierCharsRegex.ReplaceAllString(tools.SnakeCase(name), "_")
+}
+
+func formatEnumValueName(name string) string {
+	return nonIdentifierCharsRegex.ReplaceAllString(tools.UpperSnakeCase(name), "_")
+}
+
+func describeKind(typeDef ast.Type) string {
+	if typeDef.IsScalar() {
+		return string(typeDef.AsScalar().ScalarKind)
+	}
+
+	return string(typeDef.Kind)
+}
+
+func indent(input string) string {
+	lines := strings.Split(input, "\n")
+	for i, line := range lines {
+		if line != "" {
+			lines[i] = "  " + line
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
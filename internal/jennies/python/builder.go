@@ -102,6 +102,9 @@ func (jenny *Builder) generateBuilder(context languages.Context, builder ast.Bui
 			"defaultForType": func(typeDef ast.Type) string {
 				return formatValue(defaultValueForType(context.Schemas, typeDef, jenny.importModule, nil))
 			},
+			"importPkg": func(pkg string) string {
+				return jenny.imports.AddPackage(pkg, pkg)
+			},
 		}).
 		ExecuteTemplate(&buffer, "builders/builder.tmpl", template.Builder{
 			Package:              builder.Package,
@@ -13,7 +13,8 @@ func TestBuilder_Generate(t *testing.T) {
 		TestDataRoot: "../../../testdata/jennies/builders",
 		Name:         "PythonBuilder",
 		Skip: map[string]string{
-			"anonymous_struct": "Anonymous structs are not supported in Python",
+			"anonymous_struct":                          "Anonymous structs are not supported in Python",
+			"dashboard_from_object_disjunction_as_type": "disjunctions of references are supported",
 		},
 	}
 
@@ -0,0 +1,290 @@
+package python
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// Client generates an HTTP client exposing one method per operation
+// described in a schema.
+type Client struct {
+	typeFormatter *typeFormatter
+	importModule  moduleImporter
+	importPkg     pkgImporter
+}
+
+func (jenny Client) JennyName() string {
+	return "PythonClient"
+}
+
+func (jenny Client) Generate(context languages.Context) (codejen.Files, error) {
+	files := make(codejen.Files, 0, len(context.Schemas))
+
+	for _, schema := range context.Schemas {
+		if len(schema.Operations) == 0 {
+			continue
+		}
+
+		filename := filepath.Join("clients", schema.Package+".py")
+
+		files = append(files, *codejen.NewFile(filename, jenny.generateSchema(context, schema), jenny))
+	}
+
+	return files, nil
+}
+
+func (jenny Client) generateSchema(context languages.Context, schema *ast.Schema) []byte {
+	var buffer strings.Builder
+
+	imports := NewImportMap()
+	jenny.importModule = func(alias string, pkg string, module string) string {
+		return imports.AddModule(alias, pkg, module)
+	}
+	jenny.importPkg = func(alias string, pkg string) string {
+		return imports.AddPackage(alias, pkg)
+	}
+	jenny.typeFormatter = defaultTypeFormatter(context, jenny.importPkg, jenny.importModule)
+
+	// used by the client's runtime
+	for _, pkg := range []string{"enum", "json", "typing", "urllib.error", "urllib.parse", "urllib.request"} {
+		jenny.importPkg(pkg, pkg)
+	}
+	jenny.importModule("cogencoder", "..cog", "encoder")
+
+	for _, operation := range schema.Operations {
+		buffer.WriteString("\n")
+		buffer.WriteString(jenny.generateOperation(context, operation))
+	}
+
+	var output strings.Builder
+	output.WriteString(imports.String())
+	output.WriteString("\n\n\n")
+	output.WriteString(clientPreamble)
+	output.WriteString(buffer.String())
+	output.WriteString(clientRequestMethod)
+
+	return []byte(output.String())
+}
+
+func (jenny Client) generateOperation(context languages.Context, operation ast.Operation) string {
+	var buffer strings.Builder
+
+	typingPkg := jenny.importPkg("typing", "typing")
+
+	args := []string{"self"}
+	for _, param := range operation.ParamsIn(ast.ParamInPath) {
+		args = append(args, fmt.Sprintf("%s: %s", formatIdentifier(param.Name), jenny.typeFormatter.formatType(param.Type)))
+	}
+	if operation.RequestBody != nil {
+		bodyType := "bytes"
+		if ast.IsJSONContentType(operation.RequestBody.ContentType) {
+			bodyType = jenny.typeFormatter.formatType(operation.RequestBody.Type)
+		}
+		args = append(args, "body: "+bodyType)
+	}
+
+	// query and header parameters are keyword-only, optional ones last
+	queryAndHeaderParams := append(operation.ParamsIn(ast.ParamInQuery), operation.ParamsIn(ast.ParamInHeader)...)
+	if len(queryAndHeaderParams) != 0 {
+		args = append(args, "*")
+	}
+	for _, required := range []bool{true, false} {
+		for _, param := range queryAndHeaderParams {
+			if param.Required != required {
+				continue
+			}
+
+			paramType := jenny.typeFormatter.formatType(param.Type)
+			if !param.Required {
+				args = append(args, fmt.Sprintf("%s: %s.Optional[%s] = None", formatIdentifier(param.Name), typingPkg, paramType))
+				continue
+			}
+
+			args = append(args, fmt.Sprintf("%s: %s", formatIdentifier(param.Name), paramType))
+		}
+	}
+
+	var responseType *ast.Type
+	returnType := "None"
+	if response, found := operation.SuccessResponse(); found && response.Type != nil {
+		responseType = response.Type
+		returnType = jenny.typeFormatter.formatType(*response.Type)
+	}
+
+	if operation.Deprecated != nil {
+		typingExtensionsPkg := jenny.importPkg("typing_extensions", "typing_extensions")
+		buffer.WriteString(fmt.Sprintf("    @%s.deprecated(%q)\n", typingExtensionsPkg, operation.Deprecated.Notice()))
+	}
+	buffer.WriteString(fmt.Sprintf("    def %s(%s) -> %s:\n", formatIdentifier(operation.Name), strings.Join(args, ", "), returnType))
+
+	comments := operation.Comments
+	if len(comments) == 0 {
+		comments = []string{fmt.Sprintf("Calls `%s %s`.", operation.Method, operation.Path)}
+	}
+	buffer.WriteString("        \"\"\"\n")
+	for _, commentLine := range comments {
+		buffer.WriteString(strings.TrimRight("        "+commentLine, " ") + "\n")
+	}
+	buffer.WriteString("        \"\"\"\n\n")
+
+	buffer.WriteString("        query_values: list[tuple[str, str]] = []\n")
+	for _, param := range operation.ParamsIn(ast.ParamInQuery) {
+		buffer.WriteString(jenny.setParam(param, "query_values.append((%q, %s))"))
+	}
+	buffer.WriteString("        header_values: dict[str, str] = {}\n")
+	for _, param := range operation.ParamsIn(ast.ParamInHeader) {
+		buffer.WriteString(jenny.setParam(param, "header_values[%q] = %s"))
+	}
+	buffer.WriteString("\n")
+
+	bodyArg := "None"
+	contentType := "None"
+	if operation.RequestBody != nil {
+		bodyArg = "body"
+		if ast.IsJSONContentType(operation.RequestBody.ContentType) {
+			cogEncoder := jenny.importModule("cogencoder", "..cog", "encoder")
+			bodyArg = fmt.Sprintf(`json.dumps(body, cls=%s.JSONEncoder).encode("utf-8")`, cogEncoder)
+		}
+		contentType = fmt.Sprintf("%q", operation.RequestBody.ContentType)
+	}
+
+	request := fmt.Sprintf("self._request(%q, %s, query_values, header_values, %s, %s)", operation.Method, jenny.pathExpression(operation), bodyArg, contentType)
+	if responseType == nil {
+		buffer.WriteString(fmt.Sprintf("        %s\n", request))
+		return buffer.String()
+	}
+
+	buffer.WriteString(fmt.Sprintf("        data = %s\n", request))
+	buffer.WriteString(fmt.Sprintf("        return %s\n", jenny.decodeValue(context, *responseType, "data")))
+
+	return buffer.String()
+}
+
+func (jenny Client) setParam(param ast.OperationParam, setterFormat string) string {
+	identifier := formatIdentifier(param.Name)
+
+	if param.Type.IsArray() {
+		setter := fmt.Sprintf(setterFormat, param.Name, "_format_param(value)")
+		if param.Required {
+			return fmt.Sprintf("        for value in %s:\n            %s\n", identifier, setter)
+		}
+
+		return fmt.Sprintf("        for value in %s or []:\n            %s\n", identifier, setter)
+	}
+
+	setter := fmt.Sprintf(setterFormat, param.Name, fmt.Sprintf("_format_param(%s)", identifier))
+	if param.Required {
+		return fmt.Sprintf("        %s\n", setter)
+	}
+
+	return fmt.Sprintf("        if %s is not None:\n            %s\n", identifier, setter)
+}
+
+func (jenny Client) pathExpression(operation ast.Operation) string {
+	urllibParse := jenny.importPkg("urllib.parse", "urllib.parse")
+
+	parts := tools.Map(operation.PathSegments(), func(segment ast.OperationPathSegment) string {
+		if segment.Param == "" {
+			return fmt.Sprintf("%q", segment.Literal)
+		}
+
+		return fmt.Sprintf(`%s.quote(_format_param(%s), safe="")`, urllibParse, formatIdentifier(segment.Param))
+	})
+
+	if len(parts) == 0 {
+		return `"/"`
+	}
+
+	return strings.Join(parts, " + ")
+}
+
+// decodeValue returns an expression decoding the given JSON value into the
+// given type.
+func (jenny Client) decodeValue(context languages.Context, typeDef ast.Type, value string) string {
+	switch {
+	case typeDef.IsRef():
+		ref := typeDef.AsRef()
+		referredObject, found := context.LocateObject(ref.ReferredPkg, ref.ReferredType)
+		if !found || !referredObject.Type.IsStruct() {
+			return value
+		}
+
+		return fmt.Sprintf("%s.from_json(%s)", jenny.typeFormatter.formatFullyQualifiedRef(ref, false), value)
+	case typeDef.IsArray():
+		decoded := jenny.decodeValue(context, typeDef.AsArray().ValueType, "item")
+		if decoded == "item" {
+			return value
+		}
+
+		return fmt.Sprintf("[%s for item in %s]", decoded, value)
+	case typeDef.IsMap():
+		decoded := jenny.decodeValue(context, typeDef.AsMap().ValueType, "item")
+		if decoded == "item" {
+			return value
+		}
+
+		return fmt.Sprintf("{key: %s for key, item in %s.items()}", decoded, value)
+	default:
+		return value
+	}
+}
+
+const clientPreamble = `def _format_param(value: typing.Any) -> str:
+    if isinstance(value, bool):
+        return "true" if value else "false"
+    if isinstance(value, enum.Enum):
+        return str(value.value)
+    return str(value)
+
+
+class APIError(Exception):
+    """
+    Raised when the API responds with a non-2XX status code.
+    """
+
+    def __init__(self, status: int, body: str):
+        super().__init__(f"unexpected status code {status}: {body}")
+        self.status = status
+        self.body = body
+
+
+class Client:
+    """
+    base_url: base URL of the API. ie: https://example.com/api
+    headers: headers sent with every request. ie: authentication headers.
+    """
+
+    def __init__(self, base_url: str, headers: typing.Optional[dict[str, str]] = None, timeout: float = 60.0):
+        self.base_url = base_url.rstrip("/")
+        self.headers = headers or {}
+        self.timeout = timeout
+`
+
+const clientRequestMethod = `
+    def _request(self, method: str, path: str, query: list[tuple[str, str]], headers: dict[str, str], body: typing.Optional[bytes], content_type: typing.Optional[str]) -> typing.Any:
+        url = self.base_url + path
+        if query:
+            url += "?" + urllib.parse.urlencode(query)
+
+        request_headers = {"Accept": "application/json", **self.headers, **headers}
+        if content_type is not None:
+            request_headers["Content-Type"] = content_type
+
+        request = urllib.request.Request(url, data=body, headers=request_headers, method=method)
+        try:
+            with urllib.request.urlopen(request, timeout=self.timeout) as response:
+                payload = response.read()
+        except urllib.error.HTTPError as error:
+            raise APIError(error.code, error.read().decode("utf-8", errors="replace")) from error
+
+        if not payload:
+            return None
+
+        return json.loads(payload)
+`
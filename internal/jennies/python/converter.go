@@ -0,0 +1,228 @@
+package python
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// ToCode turns the given builder call into Python code.
+func (language *Language) ToCode(context languages.Context, call languages.BuilderCall) (string, error) {
+	rootPkg := strings.Trim(strings.ReplaceAll(language.config.PathPrefix, "/", "."), ".")
+	qualify := func(pkg string) string {
+		if rootPkg == "" {
+			return pkg
+		}
+
+		return rootPkg + "." + pkg
+	}
+
+	imports := NewImportMap()
+	importModule := func(alias string, pkg string, module string) string {
+		switch pkg {
+		case "..models":
+			// builders and models of a package share the same name
+			return imports.AddModule(alias+"_models", qualify("models"), module)
+		case "..cog":
+			return imports.AddModule(alias, qualify("cog"), module)
+		default:
+			return imports.AddModule(alias, pkg, module)
+		}
+	}
+
+	converter := &converter{
+		context: context,
+		importBuilders: func(pkg string) string {
+			return imports.AddModule(pkg, qualify("builders"), pkg)
+		},
+		typeFormatter: defaultTypeFormatter(context, func(alias string, pkg string) string {
+			return imports.AddPackage(alias, pkg)
+		}, importModule),
+	}
+
+	expression := converter.formatBuilderCall(call)
+	if len(call.Options) != 0 {
+		expression = fmt.Sprintf("(\n    %s\n)", indent(expression))
+	}
+
+	return fmt.Sprintf("%s\n\n%s\n", imports.String(), expression), nil
+}
+
+type converter struct {
+	context        languages.Context
+	importBuilders func(pkg string) string
+	typeFormatter  *typeFormatter
+}
+
+func (converter *converter) formatBuilderCall(call languages.BuilderCall) string {
+	var buffer strings.Builder
+
+	buffer.WriteString(fmt.Sprintf(
+		"%s.%s(%s)",
+		converter.importBuilders(strings.ToLower(call.Builder.Package)),
+		tools.UpperCamelCase(call.Builder.Name),
+		converter.formatArgs(call.Args),
+	))
+
+	for _, opt := range call.Options {
+		buffer.WriteString(fmt.Sprintf("\n.%s(%s)", formatIdentifier(opt.Option.Name), converter.formatArgs(opt.Args)))
+	}
+
+	return buffer.String()
+}
+
+func (converter *converter) formatArgs(args []languages.ConvertedValue) string {
+	return strings.Join(tools.Map(args, converter.formatArg), ", ")
+}
+
+func (converter *converter) formatArg(arg languages.ConvertedValue) string {
+	if arg.IsBuilder() {
+		return indent(converter.formatBuilderCall(*arg.Builder))
+	}
+
+	if arg.IsBuildersList() {
+		return formatList(tools.Map(arg.Items, converter.formatListItem))
+	}
+
+	return converter.formatValue(arg.Type, arg.Value)
+}
+
+func (converter *converter) formatListItem(item languages.ConvertedValue) string {
+	if item.IsBuilder() {
+		return converter.formatBuilderCall(*item.Builder)
+	}
+
+	return converter.formatArg(item)
+}
+
+func (converter *converter) formatValue(def ast.Type, value any) string {
+	if value == nil {
+		return "None"
+	}
+
+	switch def.Kind {
+	case ast.KindRef:
+		return converter.formatRefValue(def, value)
+	case ast.KindEnum:
+		return formatAnyValue(languages.EnumMember(def.AsEnum(), value).Value)
+	case ast.KindArray:
+		items, _ := value.([]any)
+
+		return formatList(tools.Map(items, func(item any) string {
+			return converter.formatValue(def.AsArray().ValueType, item)
+		}))
+	case ast.KindMap:
+		entries, _ := value.(map[string]any)
+
+		return formatDict(entries, func(entry any) string {
+			return converter.formatValue(def.AsMap().ValueType, entry)
+		})
+	case ast.KindDisjunction:
+		branches := def.AsDisjunction().Branches
+		branch, found := languages.MatchingBranch(converter.context, branches, value)
+		if !found {
+			return formatAnyValue(value)
+		}
+
+		return converter.formatValue(branches[branch], value)
+	default:
+		return formatAnyValue(value)
+	}
+}
+
+func (converter *converter) formatRefValue(def ast.Type, value any) string {
+	referredObj, found := converter.context.LocateObjectByRef(def.AsRef())
+	if !found {
+		return formatAnyValue(value)
+	}
+
+	switch referredObj.Type.Kind {
+	case ast.KindEnum:
+		member := languages.EnumMember(referredObj.Type.AsEnum(), value)
+
+		return converter.typeFormatter.formatEnumValue(referredObj, member.Value)
+	case ast.KindStruct:
+		typeName := converter.typeFormatter.formatFullyQualifiedRef(def.AsRef(), false)
+
+		return converter.formatStructValue(typeName, referredObj.Type.AsStruct(), value)
+	default:
+		return converter.formatValue(referredObj.Type, value)
+	}
+}
+
+func (converter *converter) formatStructValue(typeName string, def ast.StructType, value any) string {
+	fields, _ := value.(map[string]any)
+
+	args := make([]string, 0, len(def.Fields))
+	for _, field := range def.Fields {
+		fieldValue, found := fields[field.Name]
+
+		// constants are set by the constructor
+		if !found || fieldValue == nil || field.Type.IsConcreteScalar() {
+			continue
+		}
+
+		args = append(args, fmt.Sprintf("%s=%s", formatIdentifier(field.Name), converter.formatValue(field.Type, fieldValue)))
+	}
+
+	if len(args) == 0 {
+		return typeName + "()"
+	}
+
+	return fmt.Sprintf("%s(\n    %s,\n)", typeName, indent(strings.Join(args, ",\n")))
+}
+
+func formatList(items []string) string {
+	if len(items) == 0 {
+		return "[]"
+	}
+
+	return fmt.Sprintf("[\n    %s,\n]", indent(strings.Join(items, ",\n")))
+}
+
+func formatDict(entries map[string]any, formatEntry func(entry any) string) string {
+	if len(entries) == 0 {
+		return "{}"
+	}
+
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	formatted := tools.Map(keys, func(key string) string {
+		return fmt.Sprintf("%s: %s", strconv.Quote(key), formatEntry(entries[key]))
+	})
+
+	return fmt.Sprintf("{\n    %s,\n}", indent(strings.Join(formatted, ",\n")))
+}
+
+// formatAnyValue formats a value decoded from JSON, without any information
+// about its type.
+func formatAnyValue(value any) string {
+	switch typed := value.(type) {
+	case string:
+		return strconv.Quote(typed)
+	case json.Number:
+		return typed.String()
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case []any:
+		return formatList(tools.Map(typed, formatAnyValue))
+	case map[string]any:
+		return formatDict(typed, formatAnyValue)
+	default:
+		return formatValue(value)
+	}
+}
+
+func indent(input string) string {
+	return strings.ReplaceAll(input, "\n", "\n    ")
+}
@@ -0,0 +1,45 @@
+package python
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLanguage_ToCode(t *testing.T) {
+	test := testutils.GoldenFilesTestSuite[languages.Context]{
+		TestDataRoot: "../../../testdata/jennies/converter",
+		Name:         "PythonConverter",
+	}
+
+	language := New(Config{
+		PathPrefix: "grafana_foundation_sdk",
+	})
+
+	test.Run(t, func(tc *testutils.Test[languages.Context]) {
+		req := require.New(tc)
+
+		context := tc.UnmarshalJSONInput(testutils.BuildersContextInputFile)
+
+		decoder := json.NewDecoder(tc.OpenInput("input.json"))
+		decoder.UseNumber()
+
+		var input any
+		req.NoError(decoder.Decode(&input))
+
+		call, warnings, err := languages.NewConverter(context).Convert(ast.RefType{ReferredPkg: "dashboard", ReferredType: "Dashboard"}, input)
+		req.NoError(err)
+
+		code, err := language.ToCode(context, call)
+		req.NoError(err)
+
+		tc.WriteFile(codejen.NewFile("converted.py", []byte(code), nil))
+		tc.WriteFile(codejen.NewFile("warnings.txt", []byte(strings.Join(warnings, "\n")), nil))
+	})
+}
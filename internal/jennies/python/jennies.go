@@ -14,7 +14,7 @@ type Config struct {
 	PathPrefix string `yaml:"path_prefix"`
 
 	// SkipRuntime disables runtime-related code generation when enabled.
-	// Note: builders can NOT be generated with this flag turned on, as they
+	// Note: builders and validation methods can NOT be generated with this flag turned on, as they
 	// rely on the runtime to function.
 	SkipRuntime bool `yaml:"skip_runtime"`
 }
@@ -45,7 +45,10 @@ func (language *Language) Jennies(globalConfig languages.Config) *codejen.JennyL
 		ModuleInit{},
 		common.If[languages.Context](!language.config.SkipRuntime, Runtime{}),
 
-		common.If[languages.Context](globalConfig.Types, RawTypes{}),
+		common.If[languages.Context](globalConfig.Types, RawTypes{
+			GenerateValidation: globalConfig.Validation && !language.config.SkipRuntime,
+		}),
+		common.If[languages.Context](!language.config.SkipRuntime && globalConfig.Types, Client{}),
 		common.If[languages.Context](!language.config.SkipRuntime && globalConfig.Builders, &Builder{}),
 	)
 	jenny.AddPostprocessors(common.GeneratedCommentHeader(globalConfig))
@@ -22,6 +22,13 @@ func (jenny ModuleInit) Generate(context languages.Context) (codejen.Files, erro
 	files = append(files, *codejen.NewFile("models/__init__.py", jenny.module("models"), jenny))
 	files = append(files, *codejen.NewFile("cog/__init__.py", jenny.module("runtime"), jenny))
 
+	for _, schema := range context.Schemas {
+		if len(schema.Operations) != 0 {
+			files = append(files, *codejen.NewFile("clients/__init__.py", jenny.module("clients"), jenny))
+			break
+		}
+	}
+
 	return files, nil
 }
 
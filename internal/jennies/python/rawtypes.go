@@ -13,6 +13,9 @@ import (
 )
 
 type RawTypes struct {
+	// GenerateValidation enables the generation of `validate()` methods.
+	GenerateValidation bool
+
 	typeFormatter *typeFormatter
 	importModule  moduleImporter
 	importPkg     pkgImporter
@@ -78,6 +81,11 @@ func (jenny RawTypes) generateSchema(context languages.Context, schema *ast.Sche
 
 			buffer.WriteString("\n\n")
 			buffer.WriteString(jenny.generateFromJSONMethod(context, object))
+
+			if jenny.GenerateValidation {
+				buffer.WriteString("\n\n")
+				buffer.WriteString(jenny.generateValidateMethod(context, object))
+			}
 		}
 
 		if object.Type.ImplementedVariant() == string(ast.SchemaVariantDataQuery) && !object.Type.HasHint(ast.HintSkipVariantPluginRegistration) {
@@ -36,6 +36,11 @@ func (jenny Runtime) Generate(context languages.Context) (codejen.Files, error)
 		return nil, err
 	}
 
+	validation, err := renderTemplate("runtime/validation.tmpl", map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+
 	plugins, err := jenny.variantPlugins(context)
 	if err != nil {
 		return nil, err
@@ -47,6 +52,7 @@ func (jenny Runtime) Generate(context languages.Context) (codejen.Files, error)
 		*codejen.NewFile("cog/variants.py", []byte(models), jenny),
 		*codejen.NewFile("cog/runtime.py", []byte(runtime), jenny),
 		*codejen.NewFile("cog/plugins.py", []byte(plugins), jenny),
+		*codejen.NewFile("cog/validation.py", []byte(validation), jenny),
 	}, nil
 }
 
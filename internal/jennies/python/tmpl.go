@@ -46,6 +46,9 @@ func init() {
 			"resolvesToComposableSlot": func(_ ast.Type) bool {
 				panic("resolvesToComposableSlot() needs to be overridden by a jenny")
 			},
+			"importPkg": func(_ string) string {
+				panic("importPkg() needs to be overridden by a jenny")
+			},
 		}).
 		Funcs(template.FuncMap{
 			"formatIdentifier": formatIdentifier,
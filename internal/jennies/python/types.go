@@ -44,6 +44,7 @@ func (formatter *typeFormatter) formatObject(def ast.Object) (string, error) {
 
 	if !def.Type.IsAnyOf(ast.KindStruct, ast.KindEnum) {
 		buffer.WriteString(formatter.formatComments(def.Comments))
+		buffer.WriteString(formatter.formatDeprecationComment(def.Deprecated, ""))
 	}
 
 	if def.Type.IsConcreteScalar() {
@@ -133,6 +134,9 @@ func (formatter *typeFormatter) formatEnum(def ast.Object) string {
 	if enumType.Values[0].Type.AsScalar().ScalarKind == ast.KindString {
 		enumKind = enumPkg + ".StrEnum"
 	}
+	// decorating enums with `typing_extensions.deprecated` would interfere with
+	// how their members are created: a comment will have to do.
+	buffer.WriteString(formatter.formatDeprecationComment(def.Deprecated, ""))
 	buffer.WriteString(fmt.Sprintf("class %s(%s):\n", enumName, enumKind))
 	buffer.WriteString(formatter.formatClassComments(def.Comments))
 
@@ -168,6 +172,11 @@ func (formatter *typeFormatter) formatStruct(def ast.Object) string {
 		classBases = fmt.Sprintf("(%s.%s)", cogVariants, variant)
 	}
 
+	if def.Deprecated != nil {
+		typingExtensionsPkg := formatter.importPkg("typing_extensions", "typing_extensions")
+		buffer.WriteString(fmt.Sprintf("@%s.deprecated(%q)\n", typingExtensionsPkg, def.Deprecated.Notice()))
+	}
+
 	buffer.WriteString(fmt.Sprintf("class %s%s:\n", tools.UpperCamelCase(def.Name), classBases))
 	buffer.WriteString(formatter.formatClassComments(def.Comments))
 
@@ -195,6 +204,8 @@ func (formatter *typeFormatter) formatStructField(def ast.StructField) string {
 	for _, commentLine := range def.Comments {
 		buffer.WriteString(fmt.Sprintf("    # %s\n", commentLine))
 	}
+	// attributes can't be decorated
+	buffer.WriteString(formatter.formatDeprecationComment(def.Deprecated, "    "))
 
 	field := formatter.formatType(def.Type)
 
@@ -308,6 +319,14 @@ func (formatter *typeFormatter) formatClassComments(comments []string) string {
 	return buffer.String()
 }
 
+func (formatter *typeFormatter) formatDeprecationComment(deprecation *ast.Deprecation, indent string) string {
+	if deprecation == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("%s# Deprecated: %s\n", indent, deprecation.Notice())
+}
+
 func (formatter *typeFormatter) formatComments(comments []string) string {
 	if len(comments) == 0 {
 		return ""
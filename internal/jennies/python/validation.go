@@ -0,0 +1,210 @@
+package python
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+func (jenny RawTypes) generateValidateMethod(context languages.Context, object ast.Object) string {
+	var buffer strings.Builder
+
+	cogvalidation := jenny.importModule("cogvalidation", "..cog", "validation")
+	checks := jenny.validationChecksForStruct(context, "self", object.Type.AsStruct(), nil, 0)
+
+	buffer.WriteString(fmt.Sprintf("    def validate(self) -> list[%s.ValidationError]:\n", cogvalidation))
+	buffer.WriteString(`        """
+        Checks all the validation constraints that may be defined on the fields of this object for violations and returns them.
+        """
+
+`)
+
+	if checks == "" {
+		buffer.WriteString("        return []")
+		return buffer.String()
+	}
+
+	buffer.WriteString(fmt.Sprintf("        errors: list[%s.ValidationError] = []\n", cogvalidation))
+	buffer.WriteString(tools.Indent(strings.TrimSuffix(checks, "\n"), 8))
+	buffer.WriteString("\n        return errors")
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) validationChecksForStruct(context languages.Context, valueExpr string, structType ast.StructType, path common.ValidationPath, depth int) string {
+	var buffer strings.Builder
+
+	for _, field := range structType.Fields {
+		fieldExpr := valueExpr + "." + formatIdentifier(field.Name)
+		fieldPath := path.AppendField(field.Name)
+
+		nonNullableType := field.Type
+		nonNullableType.Nullable = false
+		checks := jenny.validationChecksForValue(context, fieldExpr, nonNullableType, fieldPath, depth)
+
+		// values of type `any` can legitimately be null
+		if field.Required && !field.Type.Nullable && !field.Type.IsAny() {
+			buffer.WriteString(fmt.Sprintf("if %s is None:\n", fieldExpr))
+			buffer.WriteString(indentChecks(jenny.validationError(fieldPath, common.ValidationRequiredMessage)))
+			if checks != "" {
+				buffer.WriteString("else:\n")
+				buffer.WriteString(indentChecks(checks))
+			}
+			continue
+		}
+
+		if checks == "" {
+			continue
+		}
+
+		buffer.WriteString(fmt.Sprintf("if %s is not None:\n", fieldExpr))
+		buffer.WriteString(indentChecks(checks))
+	}
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) validationChecksForValue(context languages.Context, valueExpr string, typeDef ast.Type, path common.ValidationPath, depth int) string {
+	if typeDef.Nullable {
+		nonNullableType := typeDef
+		nonNullableType.Nullable = false
+
+		checks := jenny.validationChecksForValue(context, valueExpr, nonNullableType, path, depth)
+		if checks == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("if %s is not None:\n%s", valueExpr, indentChecks(checks))
+	}
+
+	switch typeDef.Kind {
+	case ast.KindScalar:
+		return jenny.validationChecksForConstraints(valueExpr, typeDef.AsScalar(), path)
+	case ast.KindEnum:
+		return jenny.validationChecksForEnum(valueExpr, typeDef.AsEnum(), path)
+	case ast.KindArray:
+		indexVar := fmt.Sprintf("i%d", depth+1)
+		checks := jenny.validationChecksForValue(context, valueExpr+"["+indexVar+"]", typeDef.AsArray().ValueType, path.AppendVariable(indexVar), depth+1)
+		if checks == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("for %s in range(len(%s)):\n%s", indexVar, valueExpr, indentChecks(checks))
+	case ast.KindMap:
+		keyVar := fmt.Sprintf("key%d", depth+1)
+		checks := jenny.validationChecksForValue(context, valueExpr+"["+keyVar+"]", typeDef.AsMap().ValueType, path.AppendVariable(keyVar), depth+1)
+		if checks == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("for %s in %s:\n%s", keyVar, valueExpr, indentChecks(checks))
+	case ast.KindRef:
+		return jenny.validationChecksForRef(context, valueExpr, typeDef, path, depth)
+	default:
+		return ""
+	}
+}
+
+func (jenny RawTypes) validationChecksForRef(context languages.Context, valueExpr string, typeDef ast.Type, path common.ValidationPath, depth int) string {
+	ref := typeDef.AsRef()
+	referredObject, found := context.LocateObjectByRef(ref)
+	if !found {
+		return ""
+	}
+
+	switch referredObject.Type.Kind {
+	case ast.KindStruct:
+		cogvalidation := jenny.importModule("cogvalidation", "..cog", "validation")
+		formattedRef := jenny.typeFormatter.formatFullyQualifiedRef(ref, false)
+
+		// values that weren't decoded by `from_json()` are left untouched.
+		return fmt.Sprintf(`if isinstance(%[1]s, %[2]s):
+    errors.extend(%[3]s.prefix_errors(%[4]s, %[1]s.validate()))
+`, valueExpr, formattedRef, cogvalidation, jenny.formatValidationPath(path))
+	case ast.KindScalar, ast.KindEnum, ast.KindArray, ast.KindMap, ast.KindRef:
+		// constants don't need to be validated
+		if referredObject.Type.IsConcreteScalar() {
+			return ""
+		}
+
+		return jenny.validationChecksForValue(context, valueExpr, referredObject.Type, path, depth)
+	default:
+		return ""
+	}
+}
+
+func (jenny RawTypes) validationChecksForEnum(valueExpr string, enumType ast.EnumType, path common.ValidationPath) string {
+	values := tools.Map(enumType.Values, func(value ast.EnumValue) any {
+		return value.Value
+	})
+
+	condition := fmt.Sprintf("%s not in %s", valueExpr, formatValue(values))
+
+	return jenny.validationCheck(condition, path, common.EnumViolationMessage(values))
+}
+
+func (jenny RawTypes) validationChecksForConstraints(valueExpr string, scalarType ast.ScalarType, path common.ValidationPath) string {
+	var buffer strings.Builder
+
+	for _, constraint := range scalarType.Constraints {
+		if len(constraint.Args) == 0 {
+			continue
+		}
+
+		leftOperand := valueExpr
+		operator := string(constraint.Op)
+		rightOperand := formatValue(constraint.Args[0])
+
+		switch constraint.Op {
+		case ast.MinLengthOp:
+			leftOperand = fmt.Sprintf("len(%s)", valueExpr)
+			operator = ">="
+		case ast.MaxLengthOp:
+			leftOperand = fmt.Sprintf("len(%s)", valueExpr)
+			operator = "<="
+		case ast.MultipleOfOp:
+			leftOperand = fmt.Sprintf("%s %% %s", valueExpr, rightOperand)
+			operator = "=="
+			rightOperand = "0"
+		}
+
+		condition := fmt.Sprintf("not (%s %s %s)", leftOperand, operator, rightOperand)
+		switch constraint.Op {
+		case ast.PatternOp:
+			condition = fmt.Sprintf("not %s.search(%s, %s)", jenny.importPkg("re", "re"), rightOperand, valueExpr)
+		case ast.NotPatternOp:
+			condition = fmt.Sprintf("%s.search(%s, %s)", jenny.importPkg("re", "re"), rightOperand, valueExpr)
+		}
+		buffer.WriteString(jenny.validationCheck(condition, path, common.ConstraintViolationMessage(constraint)))
+	}
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) validationCheck(condition string, path common.ValidationPath, message string) string {
+	return fmt.Sprintf("if %s:\n%s", condition, indentChecks(jenny.validationError(path, message)))
+}
+
+func (jenny RawTypes) validationError(path common.ValidationPath, message string) string {
+	cogvalidation := jenny.importModule("cogvalidation", "..cog", "validation")
+
+	return fmt.Sprintf("errors.append(%s.ValidationError(%s, %s))\n", cogvalidation, jenny.formatValidationPath(path), formatValue(message))
+}
+
+func (jenny RawTypes) formatValidationPath(path common.ValidationPath) string {
+	if path.IsStatic() {
+		return formatValue(path.Format(nil))
+	}
+
+	return "f" + formatValue(path.Format(func(variable string) string {
+		return "{" + variable + "}"
+	}))
+}
+
+func indentChecks(checks string) string {
+	return tools.Indent(strings.TrimSuffix(checks, "\n"), 4) + "\n"
+}
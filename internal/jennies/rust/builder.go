@@ -0,0 +1,289 @@
+package rust
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/jennies/template"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/orderedmap"
+	"github.com/grafana/cog/internal/tools"
+)
+
+type builderTemplateData struct {
+	template.Builder
+
+	ImplementsVariant bool
+}
+
+type Builder struct {
+	Config Config
+
+	typeImportMapper func(pkg string) string
+	typeFormatter    *typeFormatter
+}
+
+func (jenny *Builder) JennyName() string {
+	return "RustBuilder"
+}
+
+func (jenny *Builder) Generate(context languages.Context) (codejen.Files, error) {
+	files := codejen.Files{}
+
+	for _, builder := range context.Builders {
+		output, err := jenny.generateBuilder(context, builder)
+		if err != nil {
+			return nil, err
+		}
+
+		filename := filepath.Join(
+			"src",
+			formatPackageName(builder.Package),
+			builderModuleName(builder)+".rs",
+		)
+
+		files = append(files, *codejen.NewFile(filename, output, jenny))
+	}
+
+	return files, nil
+}
+
+func (jenny *Builder) generateBuilder(context languages.Context, builder ast.Builder) ([]byte, error) {
+	var buffer strings.Builder
+
+	imports := NewImportMap()
+	jenny.typeImportMapper = func(pkg string) string {
+		return imports.Add(pkg, "crate::"+formatPackageName(pkg))
+	}
+	jenny.typeFormatter = builderTypeFormatter(jenny.Config, context, jenny.typeImportMapper)
+
+	// every builder has a dependency on cog's runtime, so let's make sure it's declared.
+	jenny.typeImportMapper("cog")
+
+	err := templates.
+		Funcs(map[string]any{
+			"formatPath": func(path ast.Path) string {
+				return jenny.formatFieldPath(path, false)
+			},
+			"formatPathMut": func(path ast.Path) string {
+				return jenny.formatFieldPath(path, true)
+			},
+			"formatType":    jenny.typeFormatter.formatType,
+			"formatArgType": jenny.typeFormatter.formatArgType,
+			"formatTypeNoBuilder": func(typeDef ast.Type) string {
+				return jenny.typeFormatter.doFormatType(typeDef, false, false)
+			},
+			"formatEnvelopeType": func(typeDef ast.Type) string {
+				if typeDef.IsRef() {
+					return jenny.typeFormatter.qualifiedName(typeDef.AsRef())
+				}
+
+				return jenny.typeFormatter.doFormatType(typeDef, false, false)
+			},
+			"formatValue":    jenny.typeFormatter.formatValue,
+			"wrapValue":      wrapValue,
+			"typeHasBuilder": context.ResolveToBuilder,
+			"resolvesToComposableSlot": func(typeDef ast.Type) bool {
+				_, found := context.ResolveToComposableSlot(typeDef)
+				return found
+			},
+			"disjunctionVariant": func(assignment ast.Assignment) string {
+				return disjunctionVariant(builder, assignment)
+			},
+		}).
+		ExecuteTemplate(&buffer, "builders/builder.tmpl", builderTemplateData{
+			Builder: template.Builder{
+				Package:     builder.Package,
+				Imports:     imports,
+				BuilderName: formatObjectName(builder.Name),
+				ObjectName:  jenny.typeFormatter.qualifiedName(builder.For.SelfRef),
+				Comments:    builder.For.Comments,
+				Constructor: builder.Constructor,
+				Properties:  builder.Properties,
+				Defaults:    jenny.genDefaultOptionsCalls(builder),
+				Options:     builder.Options,
+			},
+			ImplementsVariant: builder.For.Type.ImplementsVariant(),
+		})
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(buffer.String()), nil
+}
+
+func (jenny *Builder) genDefaultOptionsCalls(builder ast.Builder) []template.OptionCall {
+	calls := make([]template.OptionCall, 0)
+	for _, opt := range builder.Options {
+		if opt.Default == nil {
+			continue
+		}
+
+		if len(opt.Args) == 0 {
+			continue
+		}
+
+		args := make([]string, 0, len(opt.Default.ArgsValues))
+		for i, value := range opt.Default.ArgsValues {
+			if i >= len(opt.Args) {
+				break
+			}
+
+			args = append(args, jenny.formatDefaultArg(opt.Args[i].Type, value))
+		}
+
+		calls = append(calls, template.OptionCall{
+			OptionName: opt.Name,
+			Args:       args,
+		})
+	}
+
+	return calls
+}
+
+func (jenny *Builder) formatDefaultArg(argType ast.Type, value any) string {
+	argType.Nullable = false
+
+	structValue, isStruct := value.(map[string]any)
+	if !isStruct || !argType.IsRef() {
+		return jenny.typeFormatter.formatValue(argType, value)
+	}
+
+	// the argument is a builder: we need to instantiate and configure it.
+	ref := argType.AsRef()
+	referredBuilder, found := jenny.typeFormatter.context.Builders.LocateByObject(ref.ReferredPkg, ref.ReferredType)
+	if !found {
+		return jenny.typeFormatter.formatValue(argType, value)
+	}
+
+	pkg := jenny.typeImportMapper(referredBuilder.Package)
+	calls := []string{fmt.Sprintf("%s::%sBuilder::new()", pkg, formatObjectName(referredBuilder.Name))}
+
+	orderedmap.FromMap(structValue).Iterate(func(key string, fieldValue any) {
+		opt, found := referredBuilder.OptionByName(key)
+		if !found || len(opt.Args) == 0 {
+			return
+		}
+
+		calls = append(calls, fmt.Sprintf(".%s(%s)", formatFunctionName(opt.Name), jenny.formatDefaultArg(opt.Args[0].Type, fieldValue)))
+	})
+
+	return strings.Join(calls, "")
+}
+
+func (jenny *Builder) formatFieldPath(fieldPath ast.Path, unwrapLast bool) string {
+	parts := make([]string, len(fieldPath))
+
+	for i := range fieldPath {
+		output := formatFieldName(fieldPath[i].Identifier)
+
+		// Optional values are guarded by nil-checks: they can safely be
+		// unwrapped to traverse the path.
+		isLast := i == len(fieldPath)-1
+		if fieldPath[i].Type.Nullable && (!isLast || unwrapLast) {
+			output += ".as_mut().unwrap()"
+		}
+
+		parts[i] = output
+	}
+
+	return strings.Join(parts, ".")
+}
+
+// assignmentTargetType returns the type of the value being assigned.
+func assignmentTargetType(assignment ast.Assignment) ast.Type {
+	target := assignment.Path.Last().Type
+	if assignment.Method == ast.AppendAssignment && target.IsArray() {
+		return target.AsArray().ValueType
+	}
+	if assignment.Method == ast.IndexAssignment && target.IsMap() {
+		return target.AsMap().ValueType
+	}
+
+	return target
+}
+
+// disjunctionVariant returns the name of the enum variant set by the given
+// assignment if the builder's object is a disjunction, or an empty string.
+// Disjunctions are represented as enums: setting one of their "fields"
+// means constructing the corresponding variant.
+func disjunctionVariant(builder ast.Builder, assignment ast.Assignment) string {
+	if !builder.For.Type.IsStructGeneratedFromDisjunction() {
+		return ""
+	}
+
+	if len(assignment.Path) != 1 || assignment.Method != ast.DirectAssignment {
+		return ""
+	}
+
+	return formatEnumMemberName(assignment.Path[0].Identifier)
+}
+
+// variantType returns the type of the value held by a disjunction variant.
+// Fields generated from a disjunction are all nullable, which doesn't apply
+// to enum variants.
+func variantType(fieldType ast.Type) ast.Type {
+	fieldType.Nullable = false
+
+	return fieldType
+}
+
+// wrapValue converts the given expression into a value of the target type.
+func wrapValue(intoType ast.Type, valueType ast.Type, expression string) string {
+	if intoType.IsAny() {
+		if valueType.IsAny() {
+			return expression
+		}
+
+		return fmt.Sprintf("serde_json::to_value(%s).unwrap_or_default()", expression)
+	}
+
+	if intoType.Nullable && !intoType.IsComposableSlot() {
+		return fmt.Sprintf("Some(%s)", expression)
+	}
+
+	return expression
+}
+
+func formatConstraintParameter(constraint ast.AssignmentConstraint) string {
+	// lengths are expressed as integers
+	if constraint.Op == ast.MinLengthOp || constraint.Op == ast.MaxLengthOp {
+		return fmt.Sprintf("%v", constraint.Parameter)
+	}
+
+	formatted := fmt.Sprintf("%v", constraint.Parameter)
+	argType := constraint.Argument.Type
+	if argType.IsScalar() && isFloatKind(argType.AsScalar().ScalarKind) && !strings.ContainsAny(formatted, ".eE") {
+		formatted += ".0"
+	}
+
+	return formatted
+}
+
+// formatRegexPattern rejects patterns that the `regex` crate can't compile
+// and formats the others as string literals.
+func formatRegexPattern(pattern any) (string, error) {
+	patternStr, err := common.CheckRegexPattern(pattern)
+	if err != nil {
+		return "", err
+	}
+
+	return formatScalar(patternStr), nil
+}
+
+func formatConstraintZero(constraint ast.AssignmentConstraint) string {
+	argType := constraint.Argument.Type
+	if argType.IsScalar() && isFloatKind(argType.AsScalar().ScalarKind) {
+		return "0.0"
+	}
+
+	return "0"
+}
+
+func builderModuleName(builder ast.Builder) string {
+	return tools.SnakeCase(tools.LowerCamelCase(builder.Name)) + "_builder"
+}
@@ -0,0 +1,124 @@
+package rust
+
+import (
+	"encoding/json"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/envvars"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBuilder_Generate(t *testing.T) {
+	test := testutils.GoldenFilesTestSuite[languages.Context]{
+		TestDataRoot: "../../../testdata/jennies/builders",
+		Name:         "RustBuilder",
+		Skip: map[string]string{
+			"anonymous_struct":                  "anonymous structs are eliminated with compiler passes",
+			"builder_delegation_in_disjunction": "disjunctions are eliminated with compiler passes",
+			"dashboard_from_object":             "disjunctions are eliminated with compiler passes",
+			"known_any":                         "assignments through `any` fields are not supported",
+			"struct_with_defaults":              "anonymous structs are eliminated with compiler passes",
+		},
+	}
+
+	config := Config{}
+	language := New(config)
+	jenny := Builder{Config: config}
+
+	test.Run(t, func(tc *testutils.Test[languages.Context]) {
+		var err error
+		req := require.New(tc)
+
+		context := tc.UnmarshalJSONInput(testutils.BuildersContextInputFile)
+		context, err = languages.GenerateBuilderNilChecks(language, context)
+		req.NoError(err)
+
+		files, err := jenny.Generate(context)
+		req.NoError(err)
+
+		tc.WriteFiles(files)
+	})
+}
+
+func TestBuilder_Generate_compiles(t *testing.T) {
+	if !envvars.CompileGeneratedCode {
+		t.Skipf("set $%s to compile the generated code", envvars.VarCompileGenerated)
+	}
+
+	cargo, err := exec.LookPath("cargo")
+	if err != nil {
+		t.Skip("cargo is not available")
+	}
+
+	req := require.New(t)
+
+	input, err := os.ReadFile("../../../testdata/jennies/builders/disjunction_of_scalars/" + testutils.BuildersContextInputFile)
+	req.NoError(err)
+
+	var context languages.Context
+	req.NoError(json.Unmarshal(input, &context))
+
+	language := New(Config{GenerateCargoToml: true, CrateName: "sandbox"})
+	context, err = languages.GenerateBuilderNilChecks(language, context)
+	req.NoError(err)
+
+	generatedFS, err := language.Jennies(languages.Config{Types: true, Builders: true}).GenerateFS(context)
+	req.NoError(err)
+
+	dir := t.TempDir()
+	for _, file := range generatedFS.AsFiles() {
+		path := filepath.Join(dir, file.RelativePath)
+		req.NoError(os.MkdirAll(filepath.Dir(path), 0750))
+		req.NoError(os.WriteFile(path, file.Data, 0600))
+	}
+
+	cmd := exec.Command(cargo, "check", "--quiet")
+	cmd.Dir = dir
+	output, err := cmd.CombinedOutput()
+	req.NoError(err, string(output))
+}
+
+func TestBuilder_Generate_rejectsUnsupportedPattern(t *testing.T) {
+	req := require.New(t)
+
+	slugField := ast.NewStructField("slug", ast.String())
+	slugArg := ast.Argument{Name: "slug", Type: ast.String()}
+	object := ast.NewObject("constraints", "SomeStruct", ast.NewStruct(slugField))
+	builder := ast.Builder{
+		For:     object,
+		Package: "constraints",
+		Name:    "SomeStruct",
+		Options: []ast.Option{
+			{
+				Name: "slug",
+				Args: []ast.Argument{slugArg},
+				Assignments: []ast.Assignment{
+					{
+						Path:   ast.PathFromStructField(slugField),
+						Value:  ast.AssignmentValue{Argument: &slugArg},
+						Method: ast.DirectAssignment,
+						Constraints: []ast.AssignmentConstraint{
+							{Argument: slugArg, Op: ast.NotPatternOp, Parameter: `^(\w)\1`},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	schema := ast.NewSchema("constraints", ast.SchemaMeta{})
+	schema.AddObject(object)
+
+	jenny := Builder{Config: Config{}}
+	_, err := jenny.Generate(languages.Context{
+		Schemas:  ast.Schemas{schema},
+		Builders: ast.Builders{builder},
+	})
+	req.ErrorContains(err, `pattern "^(\\w)\\1" is not supported`)
+}
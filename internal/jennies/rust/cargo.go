@@ -0,0 +1,27 @@
+package rust
+
+import (
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/languages"
+)
+
+type CargoToml struct {
+	Config Config
+}
+
+func (jenny CargoToml) JennyName() string {
+	return "RustCargoToml"
+}
+
+func (jenny CargoToml) Generate(_ languages.Context) (codejen.Files, error) {
+	manifest, err := renderTemplate("runtime/cargo.tmpl", map[string]any{
+		"CrateName": jenny.Config.CrateName,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return codejen.Files{
+		*codejen.NewFile("Cargo.toml", []byte(manifest), jenny),
+	}, nil
+}
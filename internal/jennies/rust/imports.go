@@ -0,0 +1,26 @@
+package rust
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grafana/cog/internal/jennies/common"
+)
+
+func NewImportMap() *common.DirectImportMap {
+	return common.NewDirectImportMap(
+		common.WithAliasSanitizer[common.DirectImportMap](formatPackageName),
+		common.WithFormatter(func(importMap common.DirectImportMap) string {
+			if importMap.Imports.Len() == 0 {
+				return ""
+			}
+
+			statements := make([]string, 0, importMap.Imports.Len())
+			importMap.Imports.Iterate(func(_ string, importPath string) {
+				statements = append(statements, fmt.Sprintf("use %s;", importPath))
+			})
+
+			return strings.Join(statements, "\n")
+		}),
+	)
+}
@@ -0,0 +1,98 @@
+package rust
+
+import (
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast/compiler"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/languages"
+)
+
+const LanguageRef = "rust"
+
+type Config struct {
+	debug bool
+
+	// SkipRuntime disables runtime-related code generation when enabled.
+	// Note: builders can NOT be generated with this flag turned on, as they
+	// rely on the runtime to function.
+	SkipRuntime bool `yaml:"skip_runtime"`
+
+	// GenerateCargoToml indicates whether a Cargo.toml file should be generated.
+	// If enabled, CrateName is used as package name.
+	GenerateCargoToml bool `yaml:"cargo_toml"`
+
+	// CrateName is the name of the generated crate.
+	// Ex: grafana_foundation_sdk
+	CrateName string `yaml:"crate_name"`
+}
+
+func (config *Config) InterpolateParameters(interpolator func(input string) string) {
+	config.CrateName = interpolator(config.CrateName)
+}
+
+func (config Config) MergeWithGlobal(global languages.Config) Config {
+	newConfig := config
+	newConfig.debug = global.Debug
+
+	return newConfig
+}
+
+type Language struct {
+	config Config
+}
+
+func New(config Config) *Language {
+	return &Language{
+		config: config,
+	}
+}
+
+func (language *Language) Name() string {
+	return LanguageRef
+}
+
+func (language *Language) Jennies(globalConfig languages.Config) *codejen.JennyList[languages.Context] {
+	config := language.config.MergeWithGlobal(globalConfig)
+
+	jenny := codejen.JennyListWithNamer[languages.Context](func(_ languages.Context) string {
+		return LanguageRef
+	})
+	jenny.AppendOneToMany(
+		common.If[languages.Context](!config.SkipRuntime, Runtime{Config: config}),
+
+		common.If[languages.Context](config.GenerateCargoToml, CargoToml{Config: config}),
+
+		Modules{Config: config, Targets: languages.Config{
+			Types:    globalConfig.Types,
+			Builders: !config.SkipRuntime && globalConfig.Builders,
+		}},
+
+		common.If[languages.Context](globalConfig.Types, RawTypes{Config: config}),
+
+		common.If[languages.Context](!config.SkipRuntime && globalConfig.Builders, &Builder{Config: config}),
+	)
+	jenny.AddPostprocessors(common.GeneratedCommentHeader(globalConfig))
+
+	return jenny
+}
+
+func (language *Language) CompilerPasses() compiler.Passes {
+	return compiler.Passes{
+		&compiler.AnonymousEnumToExplicitType{},
+		&compiler.AnonymousStructsToNamed{},
+		&compiler.NotRequiredFieldAsNullableType{},
+		&compiler.FlattenDisjunctions{},
+		&compiler.DisjunctionWithNullToOptional{},
+		&compiler.DisjunctionOfAnonymousStructsToExplicit{},
+		&compiler.DisjunctionInferMapping{},
+		&compiler.DisjunctionToType{},
+	}
+}
+
+func (language *Language) NullableKinds() languages.NullableConfig {
+	return languages.NullableConfig{
+		Kinds:              nil,
+		ProtectArrayAppend: true,
+		AnyIsNullable:      false,
+	}
+}
@@ -0,0 +1,79 @@
+package rust
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/languages"
+)
+
+// Modules generates the module declarations needed to expose every
+// generated file: a `mod.rs` file per package and the crate's `lib.rs`.
+type Modules struct {
+	Config  Config
+	Targets languages.Config
+}
+
+func (jenny Modules) JennyName() string {
+	return "RustModules"
+}
+
+func (jenny Modules) Generate(context languages.Context) (codejen.Files, error) {
+	packages := make(map[string][]string, len(context.Schemas))
+
+	if jenny.Targets.Types {
+		for _, schema := range context.Schemas {
+			packages[formatPackageName(schema.Package)] = []string{"types"}
+		}
+	}
+
+	if jenny.Targets.Builders {
+		for _, builder := range context.Builders {
+			pkg := formatPackageName(builder.Package)
+			packages[pkg] = append(packages[pkg], builderModuleName(builder))
+		}
+	}
+
+	packageNames := make([]string, 0, len(packages))
+	for pkg := range packages {
+		packageNames = append(packageNames, pkg)
+	}
+	sort.Strings(packageNames)
+
+	files := make(codejen.Files, 0, len(packages)+1)
+	for _, pkg := range packageNames {
+		files = append(files, *codejen.NewFile(filepath.Join("src", pkg, "mod.rs"), jenny.generatePackageModule(packages[pkg]), jenny))
+	}
+
+	if !jenny.Config.SkipRuntime {
+		packageNames = append([]string{"cog"}, packageNames...)
+	}
+
+	files = append(files, *codejen.NewFile("src/lib.rs", jenny.generateLib(packageNames), jenny))
+
+	return files, nil
+}
+
+func (jenny Modules) generatePackageModule(modules []string) []byte {
+	var buffer strings.Builder
+
+	for _, module := range modules {
+		buffer.WriteString(fmt.Sprintf("mod %s;\n", module))
+		buffer.WriteString(fmt.Sprintf("pub use %s::*;\n", module))
+	}
+
+	return []byte(buffer.String())
+}
+
+func (jenny Modules) generateLib(packages []string) []byte {
+	var buffer strings.Builder
+
+	for _, pkg := range packages {
+		buffer.WriteString(fmt.Sprintf("pub mod %s;\n", pkg))
+	}
+
+	return []byte(buffer.String())
+}
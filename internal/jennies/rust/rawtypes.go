@@ -0,0 +1,302 @@
+package rust
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+const deriveStatement = "#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]"
+
+type RawTypes struct {
+	Config Config
+
+	typeFormatter *typeFormatter
+}
+
+func (jenny RawTypes) JennyName() string {
+	return "RustRawTypes"
+}
+
+func (jenny RawTypes) Generate(context languages.Context) (codejen.Files, error) {
+	files := make(codejen.Files, 0, len(context.Schemas))
+
+	for _, schema := range context.Schemas {
+		output, err := jenny.generateSchema(context, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		filename := filepath.Join(
+			"src",
+			formatPackageName(schema.Package),
+			"types.rs",
+		)
+
+		files = append(files, *codejen.NewFile(filename, output, jenny))
+	}
+
+	return files, nil
+}
+
+func (jenny RawTypes) generateSchema(context languages.Context, schema *ast.Schema) ([]byte, error) {
+	var buffer strings.Builder
+	var err error
+
+	imports := NewImportMap()
+	imports.Add("serde", "serde::{Deserialize, Serialize}")
+
+	packageMapper := func(pkg string) string {
+		if imports.IsIdentical(pkg, schema.Package) {
+			return ""
+		}
+
+		return imports.Add(pkg, "crate::"+formatPackageName(pkg))
+	}
+	jenny.typeFormatter = defaultTypeFormatter(jenny.Config, context, packageMapper)
+
+	schema.Objects.Iterate(func(_ string, object ast.Object) {
+		if err != nil {
+			return
+		}
+
+		objectOutput, innerErr := jenny.formatObject(object, imports)
+		if innerErr != nil {
+			err = innerErr
+			return
+		}
+
+		buffer.Write(objectOutput)
+		buffer.WriteString("\n")
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	importStatements := imports.String()
+	if importStatements != "" {
+		importStatements += "\n\n"
+	}
+
+	return []byte(importStatements + buffer.String()), nil
+}
+
+func (jenny RawTypes) formatObject(def ast.Object, imports *common.DirectImportMap) ([]byte, error) {
+	var buffer strings.Builder
+
+	defName := formatObjectName(def.Name)
+
+	comments := def.Comments
+	if jenny.Config.debug {
+		passesTrail := tools.Map(def.PassesTrail, func(trail string) string {
+			return fmt.Sprintf("Modified by compiler pass '%s'", trail)
+		})
+		comments = append(comments, passesTrail...)
+	}
+
+	for _, commentLine := range comments {
+		buffer.WriteString(fmt.Sprintf("/// %s\n", commentLine))
+	}
+
+	selfRef := def.SelfRef
+	jenny.typeFormatter.currentObject = &selfRef
+	defer func() {
+		jenny.typeFormatter.currentObject = nil
+	}()
+
+	switch def.Type.Kind {
+	case ast.KindEnum:
+		buffer.WriteString(strings.TrimSuffix(jenny.formatEnumDef(def, imports), "\n"))
+	case ast.KindScalar:
+		scalarType := def.Type.AsScalar()
+
+		if scalarType.Value != nil {
+			buffer.WriteString(fmt.Sprintf("pub const %s: %s = %s;", formatConstantName(def.Name), formatConstantType(scalarType.ScalarKind), formatScalar(scalarType.Value)))
+		} else {
+			buffer.WriteString(fmt.Sprintf("pub type %s = %s;", defName, jenny.typeFormatter.formatType(def.Type)))
+		}
+	case ast.KindStruct:
+		output, err := jenny.formatStructDef(def)
+		if err != nil {
+			return nil, err
+		}
+		buffer.WriteString(strings.TrimSuffix(output, "\n"))
+	case ast.KindIntersection:
+		buffer.WriteString(strings.TrimSuffix(jenny.formatIntersectionDef(def), "\n"))
+	case ast.KindRef, ast.KindMap, ast.KindArray, ast.KindComposableSlot:
+		buffer.WriteString(fmt.Sprintf("pub type %s = %s;", defName, jenny.typeFormatter.formatType(def.Type)))
+	default:
+		return nil, fmt.Errorf("unhandled type def kind: %s", def.Type.Kind)
+	}
+
+	buffer.WriteString("\n")
+
+	return []byte(buffer.String()), nil
+}
+
+func (jenny RawTypes) formatStructDef(def ast.Object) (string, error) {
+	// There are only two types of disjunctions we support:
+	//  * discriminated: SomeStruct | SomeOtherStruct, where all the disjunction branches are references to
+	// 	  structs and these structs have a common "discriminator" field.
+	//  * undiscriminated: everything else, represented as an "untagged" enum.
+	if def.Type.HasHint(ast.HintDiscriminatedDisjunctionOfRefs) {
+		return jenny.formatDiscriminatedDisjunction(def)
+	}
+
+	if def.Type.IsStructGeneratedFromDisjunction() {
+		return jenny.formatUntaggedDisjunction(def)
+	}
+
+	var buffer strings.Builder
+
+	buffer.WriteString(deriveStatement + "\n")
+	buffer.WriteString(fmt.Sprintf("pub struct %s {\n", formatObjectName(def.Name)))
+	buffer.WriteString(jenny.typeFormatter.formatStructFields(def.Type.AsStruct()))
+	buffer.WriteString("}\n")
+
+	return buffer.String(), nil
+}
+
+func (jenny RawTypes) formatUntaggedDisjunction(def ast.Object) (string, error) {
+	return renderTemplate("types/disjunction_untagged.tmpl", map[string]any{
+		"def":      def,
+		"variants": jenny.disjunctionVariants(def),
+	})
+}
+
+func (jenny RawTypes) formatDiscriminatedDisjunction(def ast.Object) (string, error) {
+	hint, ok := def.Type.Hints[ast.HintDiscriminatedDisjunctionOfRefs].(ast.DisjunctionType)
+	if !ok {
+		return "", fmt.Errorf("invalid hint for discriminated disjunction '%s'", def.Name)
+	}
+
+	// the catch-all branch has to be the last arm of the `match`
+	discriminatorValues := make([]string, 0, len(hint.DiscriminatorMapping))
+	for value := range hint.DiscriminatorMapping {
+		discriminatorValues = append(discriminatorValues, value)
+	}
+	sort.SliceStable(discriminatorValues, func(i, j int) bool {
+		if discriminatorValues[i] == ast.DiscriminatorCatchAll {
+			return false
+		}
+		if discriminatorValues[j] == ast.DiscriminatorCatchAll {
+			return true
+		}
+
+		return discriminatorValues[i] < discriminatorValues[j]
+	})
+
+	mapping := make([]map[string]string, 0, len(discriminatorValues))
+	for _, value := range discriminatorValues {
+		mapping = append(mapping, map[string]string{
+			"Value":   value,
+			"Variant": formatEnumMemberName(hint.DiscriminatorMapping[value]),
+		})
+	}
+
+	return renderTemplate("types/disjunction_discriminated.tmpl", map[string]any{
+		"def":           def,
+		"variants":      jenny.disjunctionVariants(def),
+		"discriminator": hint.Discriminator,
+		"mapping":       mapping,
+		"hasCatchAll":   hint.DiscriminatorMapping[ast.DiscriminatorCatchAll] != "",
+	})
+}
+
+func (jenny RawTypes) disjunctionVariants(def ast.Object) []map[string]string {
+	fields := def.Type.AsStruct().Fields
+	variants := make([]map[string]string, 0, len(fields))
+
+	for _, field := range fields {
+		// fields generated from a disjunction are all nullable: only one of them can be set at a time.
+		// Since we represent them as enums, this doesn't apply here.
+		variantType := field.Type
+		variantType.Nullable = false
+
+		variants = append(variants, map[string]string{
+			"Name": formatEnumMemberName(field.Name),
+			"Type": jenny.typeFormatter.formatType(variantType),
+		})
+	}
+
+	return variants
+}
+
+func (jenny RawTypes) formatIntersectionDef(def ast.Object) string {
+	var buffer strings.Builder
+
+	buffer.WriteString(deriveStatement + "\n")
+	buffer.WriteString(fmt.Sprintf("pub struct %s {\n", formatObjectName(def.Name)))
+
+	for _, branch := range def.Type.AsIntersection().Branches {
+		if branch.IsRef() {
+			buffer.WriteString("    #[serde(flatten)]\n")
+			buffer.WriteString(fmt.Sprintf("    pub %s: %s,\n", formatFieldName(branch.AsRef().ReferredType), jenny.typeFormatter.formatType(branch)))
+			continue
+		}
+
+		if branch.IsStruct() {
+			buffer.WriteString(jenny.typeFormatter.formatStructFields(branch.AsStruct()))
+		}
+	}
+
+	buffer.WriteString("}\n")
+
+	return buffer.String()
+}
+
+func (jenny RawTypes) formatEnumDef(def ast.Object, imports *common.DirectImportMap) string {
+	var buffer strings.Builder
+
+	enumName := formatObjectName(def.Name)
+	enumType := def.Type.AsEnum()
+	valuesType := enumType.Values[0].Type
+	isStringEnum := valuesType.IsScalar() && valuesType.AsScalar().ScalarKind == ast.KindString
+
+	defaultValue := enumType.Values[0].Value
+	if def.Type.Default != nil {
+		defaultValue = def.Type.Default
+	}
+
+	if isStringEnum {
+		buffer.WriteString("#[derive(Clone, Copy, Debug, Default, PartialEq, Eq, Hash, Serialize, Deserialize)]\n")
+	} else {
+		imports.Add("serde_repr", "serde_repr::{Deserialize_repr, Serialize_repr}")
+
+		buffer.WriteString("#[derive(Clone, Copy, Debug, Default, PartialEq, Eq, Hash, Serialize_repr, Deserialize_repr)]\n")
+		buffer.WriteString(fmt.Sprintf("#[repr(%s)]\n", formatScalarKind(valuesType.AsScalar().ScalarKind)))
+	}
+
+	buffer.WriteString(fmt.Sprintf("pub enum %s {\n", enumName))
+	for _, val := range enumType.Values {
+		if val.Value == defaultValue {
+			buffer.WriteString("    #[default]\n")
+		}
+
+		name := formatEnumMemberName(val.Name)
+		if isStringEnum {
+			buffer.WriteString(fmt.Sprintf("    #[serde(rename = %s)]\n", formatScalar(val.Value)))
+			buffer.WriteString(fmt.Sprintf("    %s,\n", name))
+		} else {
+			buffer.WriteString(fmt.Sprintf("    %s = %v,\n", name, val.Value))
+		}
+	}
+	buffer.WriteString("}\n")
+
+	return buffer.String()
+}
+
+func formatConstantType(kind ast.ScalarKind) string {
+	if kind == ast.KindString {
+		return "&str"
+	}
+
+	return formatScalarKind(kind)
+}
@@ -0,0 +1,25 @@
+package rust
+
+import (
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/languages"
+)
+
+type Runtime struct {
+	Config Config
+}
+
+func (jenny Runtime) JennyName() string {
+	return "RustRuntime"
+}
+
+func (jenny Runtime) Generate(_ languages.Context) (codejen.Files, error) {
+	runtime, err := renderTemplate("runtime/runtime.tmpl", map[string]any{})
+	if err != nil {
+		return nil, err
+	}
+
+	return codejen.Files{
+		*codejen.NewFile("src/cog/mod.rs", []byte(runtime), jenny),
+	}, nil
+}
@@ -0,0 +1,85 @@
+package rust
+
+import (
+	"bytes"
+	"embed"
+	"fmt"
+	"text/template"
+
+	"github.com/grafana/cog/internal/ast"
+	cogtemplate "github.com/grafana/cog/internal/jennies/template"
+)
+
+//nolint:gochecknoglobals
+var templates *template.Template
+
+//go:embed templates/runtime/*.tmpl templates/builders/*.tmpl templates/builders/veneers/*.tmpl templates/types/*.tmpl
+//nolint:gochecknoglobals
+var veneersFS embed.FS
+
+//nolint:gochecknoinits
+func init() {
+	base := template.New("rust")
+	base.
+		Option("missingkey=error").
+		Funcs(cogtemplate.Helpers(base)).
+		// placeholder functions, will be overridden by jennies
+		Funcs(template.FuncMap{
+			"formatPath": func(_ ast.Path) string {
+				panic("formatPath() needs to be overridden by a jenny")
+			},
+			"formatPathMut": func(_ ast.Path) string {
+				panic("formatPathMut() needs to be overridden by a jenny")
+			},
+			"formatType": func(_ ast.Type) string {
+				panic("formatType() needs to be overridden by a jenny")
+			},
+			"formatTypeNoBuilder": func(_ ast.Type) string {
+				panic("formatTypeNoBuilder() needs to be overridden by a jenny")
+			},
+			"formatArgType": func(_ ast.Type) string {
+				panic("formatArgType() needs to be overridden by a jenny")
+			},
+			"formatEnvelopeType": func(_ ast.Type) string {
+				panic("formatEnvelopeType() needs to be overridden by a jenny")
+			},
+			"formatValue": func(_ ast.Type, _ any) string {
+				panic("formatValue() needs to be overridden by a jenny")
+			},
+			"wrapValue": func(_ ast.Type, _ ast.Type, _ string) string {
+				panic("wrapValue() needs to be overridden by a jenny")
+			},
+			"typeHasBuilder": func(_ ast.Type) bool {
+				panic("typeHasBuilder() needs to be overridden by a jenny")
+			},
+			"resolvesToComposableSlot": func(_ ast.Type) bool {
+				panic("resolvesToComposableSlot() needs to be overridden by a jenny")
+			},
+			"disjunctionVariant": func(_ ast.Assignment) string {
+				panic("disjunctionVariant() needs to be overridden by a jenny")
+			},
+		}).
+		Funcs(map[string]any{
+			"formatObjectName":          formatObjectName,
+			"formatFieldName":           formatFieldName,
+			"formatFunctionName":        formatFunctionName,
+			"formatArgName":             formatArgName,
+			"formatScalar":              formatScalar,
+			"formatConstraintParameter": formatConstraintParameter,
+			"formatConstraintZero":      formatConstraintZero,
+			"formatRegexPattern":        formatRegexPattern,
+			"assignmentTargetType":      assignmentTargetType,
+			"variantType":               variantType,
+		})
+
+	templates = template.Must(cogtemplate.FindAndParseTemplates(veneersFS, base, "templates"))
+}
+
+func renderTemplate(templateFile string, data map[string]any) (string, error) {
+	buf := bytes.Buffer{}
+	if err := templates.ExecuteTemplate(&buf, templateFile, data); err != nil {
+		return "", fmt.Errorf("failed executing template: %w", err)
+	}
+
+	return buf.String(), nil
+}
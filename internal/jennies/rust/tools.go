@@ -0,0 +1,71 @@
+package rust
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/grafana/cog/internal/tools"
+)
+
+func formatPackageName(pkg string) string {
+	splitPath := strings.Split(pkg, "/")
+	if len(splitPath) > 1 {
+		pkg = splitPath[len(splitPath)-1]
+	}
+	rgx := regexp.MustCompile("[^a-zA-Z0-9_]+")
+
+	return strings.ToLower(rgx.ReplaceAllString(pkg, "_"))
+}
+
+func formatObjectName(name string) string {
+	return tools.UpperCamelCase(name)
+}
+
+func formatFieldName(name string) string {
+	return escapeIdentifier(tools.SnakeCase(tools.LowerCamelCase(name)))
+}
+
+func formatArgName(name string) string {
+	return escapeIdentifier(tools.SnakeCase(tools.LowerCamelCase(name)))
+}
+
+func formatFunctionName(name string) string {
+	return escapeIdentifier(tools.SnakeCase(tools.LowerCamelCase(name)))
+}
+
+func formatConstantName(name string) string {
+	return tools.UpperSnakeCase(tools.LowerCamelCase(name))
+}
+
+func formatEnumMemberName(name string) string {
+	member := tools.CleanupNames(tools.UpperCamelCase(name))
+	if member == "" || (member[0] >= '0' && member[0] <= '9') {
+		member = "Value" + member
+	}
+
+	return member
+}
+
+func escapeIdentifier(identifier string) string {
+	if isReservedRustKeyword(identifier) {
+		return identifier + "_"
+	}
+
+	return identifier
+}
+
+func isReservedRustKeyword(input string) bool {
+	// see: https://doc.rust-lang.org/reference/keywords.html
+	switch input {
+	case "as", "async", "await", "break", "const", "continue", "crate", "dyn",
+		"else", "enum", "extern", "false", "fn", "for", "if", "impl", "in",
+		"let", "loop", "match", "mod", "move", "mut", "pub", "ref", "return",
+		"self", "Self", "static", "struct", "super", "trait", "true", "type",
+		"unsafe", "use", "where", "while",
+		"abstract", "become", "box", "do", "final", "macro", "override", "priv",
+		"typeof", "unsized", "virtual", "yield", "try":
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,386 @@
+package rust
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+type typeFormatter struct {
+	packageMapper func(pkg string) string
+	config        Config
+
+	forBuilder bool
+	context    languages.Context
+
+	// reference to the object currently being formatted.
+	// Used to detect recursive types, which need to be boxed.
+	currentObject *ast.RefType
+}
+
+func defaultTypeFormatter(config Config, context languages.Context, packageMapper func(pkg string) string) *typeFormatter {
+	return &typeFormatter{
+		packageMapper: packageMapper,
+		config:        config,
+		context:       context,
+	}
+}
+
+func builderTypeFormatter(config Config, context languages.Context, packageMapper func(pkg string) string) *typeFormatter {
+	return &typeFormatter{
+		packageMapper: packageMapper,
+		config:        config,
+		forBuilder:    true,
+		context:       context,
+	}
+}
+
+func (formatter *typeFormatter) formatType(def ast.Type) string {
+	return formatter.doFormatType(def, formatter.forBuilder, false)
+}
+
+// formatArgType formats the type of an argument given to a builder function.
+// Builders given directly as argument are accepted as `impl cog::Builder<T>`,
+// which is more idiomatic than a boxed trait object.
+func (formatter *typeFormatter) formatArgType(def ast.Type) string {
+	argType := def
+	argType.Nullable = false
+
+	if argType.IsComposableSlot() {
+		return fmt.Sprintf("impl %s::VariantBuilder", formatter.packageMapper("cog"))
+	}
+
+	if argType.IsRef() && formatter.context.ResolveToBuilder(argType) {
+		return fmt.Sprintf("impl %s::Builder<%s>", formatter.packageMapper("cog"), formatter.qualifiedName(argType.AsRef()))
+	}
+
+	return formatter.doFormatType(argType, true, false)
+}
+
+func (formatter *typeFormatter) doFormatType(def ast.Type, resolveBuilders bool, inCollection bool) string {
+	actualFormatter := func() string {
+		if def.IsAny() {
+			return "serde_json::Value"
+		}
+
+		if def.IsComposableSlot() {
+			if !resolveBuilders {
+				return "serde_json::Value"
+			}
+
+			cogAlias := formatter.packageMapper("cog")
+
+			return fmt.Sprintf("Box<dyn %s::VariantBuilder>", cogAlias)
+		}
+
+		if def.IsArray() {
+			return formatter.maybeOptional(def, fmt.Sprintf("Vec<%s>", formatter.doFormatType(def.AsArray().ValueType, resolveBuilders, true)))
+		}
+
+		if def.IsMap() {
+			return formatter.maybeOptional(def, formatter.formatMap(def.AsMap(), resolveBuilders))
+		}
+
+		if def.IsScalar() {
+			return formatter.maybeOptional(def, formatScalarKind(def.AsScalar().ScalarKind))
+		}
+
+		if def.IsRef() {
+			return formatter.formatRef(def, resolveBuilders, inCollection)
+		}
+
+		// FIXME: we should never be here: anonymous structs, disjunctions
+		// and intersections are eliminated by compiler passes.
+		return "unknown"
+	}
+
+	passesTrail := ""
+	if formatter.config.debug && len(def.PassesTrail) != 0 {
+		passesTrail = fmt.Sprintf(" /* %s */", strings.Join(def.PassesTrail, ", "))
+	}
+
+	return actualFormatter() + passesTrail
+}
+
+func (formatter *typeFormatter) maybeOptional(def ast.Type, typeName string) string {
+	if def.Nullable {
+		return fmt.Sprintf("Option<%s>", typeName)
+	}
+
+	return typeName
+}
+
+func (formatter *typeFormatter) formatMap(def ast.MapType, resolveBuilders bool) string {
+	keyTypeString := formatter.doFormatType(def.IndexType, false, true)
+	valueTypeString := formatter.doFormatType(def.ValueType, resolveBuilders, true)
+
+	return fmt.Sprintf("std::collections::HashMap<%s, %s>", keyTypeString, valueTypeString)
+}
+
+func (formatter *typeFormatter) formatRef(def ast.Type, resolveBuilders bool, inCollection bool) string {
+	ref := def.AsRef()
+	typeName := formatter.qualifiedName(ref)
+
+	if resolveBuilders && formatter.context.ResolveToBuilder(def) {
+		cogAlias := formatter.packageMapper("cog")
+
+		return fmt.Sprintf("Box<dyn %s::Builder<%s>>", cogAlias, typeName)
+	}
+
+	// Recursive types have an infinite size unless they are boxed.
+	// Collections already store their values on the heap.
+	if !inCollection && formatter.currentObject != nil && formatter.currentObject.String() == ref.String() {
+		typeName = fmt.Sprintf("Box<%s>", typeName)
+	}
+
+	return formatter.maybeOptional(def, typeName)
+}
+
+func (formatter *typeFormatter) qualifiedName(ref ast.RefType) string {
+	referredPkg := formatter.packageMapper(ref.ReferredPkg)
+	typeName := formatObjectName(ref.ReferredType)
+
+	if referredPkg != "" {
+		typeName = referredPkg + "::" + typeName
+	}
+
+	return typeName
+}
+
+func (formatter *typeFormatter) formatStructFields(def ast.StructType) string {
+	var buffer strings.Builder
+
+	for _, fieldDef := range def.Fields {
+		buffer.WriteString(tools.Indent(formatter.formatField(fieldDef), 4))
+		buffer.WriteString("\n")
+	}
+
+	return buffer.String()
+}
+
+func (formatter *typeFormatter) formatField(def ast.StructField) string {
+	var buffer strings.Builder
+
+	comments := def.Comments
+	if formatter.config.debug {
+		passesTrail := tools.Map(def.PassesTrail, func(trail string) string {
+			return fmt.Sprintf("Modified by compiler pass '%s'", trail)
+		})
+		comments = append(comments, passesTrail...)
+	}
+
+	for _, commentLine := range comments {
+		buffer.WriteString(fmt.Sprintf("/// %s\n", commentLine))
+	}
+
+	fieldType := def.Type
+
+	// if the field's type is a reference to a constant,
+	// we need to use the constant's type instead.
+	// ie: `some_field: String` instead of `some_field: MyStringConstant`
+	if def.Type.IsRef() {
+		referredType, found := formatter.context.LocateObject(def.Type.AsRef().ReferredPkg, def.Type.AsRef().ReferredType)
+		if found && referredType.Type.IsConcreteScalar() {
+			fieldType = referredType.Type
+			fieldType.Nullable = def.Type.Nullable
+		}
+	}
+
+	fieldName := formatFieldName(def.Name)
+	serdeAttributes := make([]string, 0, 3)
+	if fieldName != def.Name {
+		serdeAttributes = append(serdeAttributes, "rename = "+formatStringLiteral(def.Name))
+	}
+	if fieldType.Nullable && !fieldType.IsAny() {
+		serdeAttributes = append(serdeAttributes, "default", `skip_serializing_if = "Option::is_none"`)
+	}
+	if !def.Required && fieldType.IsAny() {
+		serdeAttributes = append(serdeAttributes, "default", `skip_serializing_if = "serde_json::Value::is_null"`)
+	}
+
+	if len(serdeAttributes) != 0 {
+		buffer.WriteString(fmt.Sprintf("#[serde(%s)]\n", strings.Join(serdeAttributes, ", ")))
+	}
+
+	buffer.WriteString(fmt.Sprintf(
+		"pub %s: %s,",
+		fieldName,
+		formatter.doFormatType(fieldType, false, false),
+	))
+
+	return buffer.String()
+}
+
+func formatScalarKind(kind ast.ScalarKind) string {
+	switch kind {
+	case ast.KindNull:
+		return "()"
+	case ast.KindAny:
+		return "serde_json::Value"
+	case ast.KindBytes:
+		return "Vec<u8>"
+	case ast.KindString:
+		return "String"
+	case ast.KindFloat32:
+		return "f32"
+	case ast.KindFloat64:
+		return "f64"
+	case ast.KindUint8:
+		return "u8"
+	case ast.KindUint16:
+		return "u16"
+	case ast.KindUint32:
+		return "u32"
+	case ast.KindUint64:
+		return "u64"
+	case ast.KindInt8:
+		return "i8"
+	case ast.KindInt16:
+		return "i16"
+	case ast.KindInt32:
+		return "i32"
+	case ast.KindInt64:
+		return "i64"
+	case ast.KindBool:
+		return "bool"
+	default:
+		return "unknown"
+	}
+}
+
+// formatScalar formats the given value as a Rust literal.
+func formatScalar(val any) string {
+	switch v := val.(type) {
+	case nil:
+		return "None"
+	case string:
+		return formatStringLiteral(v)
+	case []any:
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, formatScalar(item))
+		}
+
+		return fmt.Sprintf("vec![%s]", strings.Join(items, ", "))
+	default:
+		return fmt.Sprintf("%v", v)
+	}
+}
+
+func formatStringLiteral(input string) string {
+	var buffer strings.Builder
+
+	buffer.WriteString(`"`)
+	for _, r := range input {
+		switch {
+		case r == '"':
+			buffer.WriteString(`\"`)
+		case r == '\\':
+			buffer.WriteString(`\\`)
+		case r == '\n':
+			buffer.WriteString(`\n`)
+		case r == '\r':
+			buffer.WriteString(`\r`)
+		case r == '\t':
+			buffer.WriteString(`\t`)
+		case r < 0x20 || r == 0x7f:
+			buffer.WriteString(fmt.Sprintf(`\u{%x}`, r))
+		default:
+			buffer.WriteRune(r)
+		}
+	}
+	buffer.WriteString(`"`)
+
+	return buffer.String()
+}
+
+// formatValue formats the given value as a Rust expression of the given type.
+func (formatter *typeFormatter) formatValue(typeDef ast.Type, val any) string {
+	if typeDef.IsAny() || typeDef.IsComposableSlot() {
+		return fmt.Sprintf("serde_json::json!(%s)", formatJSON(val))
+	}
+
+	var formatted string
+
+	resolvedType := formatter.context.ResolveRefs(typeDef)
+
+	switch v := val.(type) {
+	case []any:
+		valueType := ast.Any()
+		if resolvedType.IsArray() {
+			valueType = resolvedType.AsArray().ValueType
+		}
+
+		items := make([]string, 0, len(v))
+		for _, item := range v {
+			items = append(items, formatter.formatValue(valueType, item))
+		}
+
+		formatted = fmt.Sprintf("vec![%s]", strings.Join(items, ", "))
+	case map[string]any:
+		formatted = fmt.Sprintf("serde_json::from_value(serde_json::json!(%s)).unwrap_or_default()", formatJSON(v))
+	case string:
+		formatted = formatStringLiteral(v) + ".to_string()"
+
+		if member, found := formatter.enumMemberForValue(typeDef, v); found {
+			formatted = member
+		}
+	default:
+		formatted = fmt.Sprintf("%v", v)
+
+		if member, found := formatter.enumMemberForValue(typeDef, v); found {
+			formatted = member
+		} else if resolvedType.IsScalar() && isFloatKind(resolvedType.AsScalar().ScalarKind) && !strings.ContainsAny(formatted, ".eE") {
+			formatted += ".0"
+		}
+	}
+
+	if typeDef.Nullable {
+		formatted = fmt.Sprintf("Some(%s)", formatted)
+	}
+
+	return formatted
+}
+
+func (formatter *typeFormatter) enumMemberForValue(typeDef ast.Type, value any) (string, bool) {
+	if !typeDef.IsRef() {
+		return "", false
+	}
+
+	referredObj, found := formatter.context.LocateObjectByRef(typeDef.AsRef())
+	if !found || !referredObj.Type.IsEnum() {
+		return "", false
+	}
+
+	for _, member := range referredObj.Type.AsEnum().Values {
+		if fmt.Sprintf("%v", member.Value) == fmt.Sprintf("%v", value) {
+			refType := typeDef
+			refType.Nullable = false
+
+			return fmt.Sprintf("%s::%s", formatter.qualifiedName(refType.AsRef()), formatEnumMemberName(member.Name)), true
+		}
+	}
+
+	return "", false
+}
+
+func formatJSON(val any) string {
+	buffer := &bytes.Buffer{}
+	encoder := json.NewEncoder(buffer)
+	encoder.SetEscapeHTML(false)
+
+	if err := encoder.Encode(val); err != nil {
+		return "null"
+	}
+
+	return strings.TrimSpace(buffer.String())
+}
+
+func isFloatKind(kind ast.ScalarKind) bool {
+	return kind == ast.KindFloat32 || kind == ast.KindFloat64
+}
@@ -0,0 +1,274 @@
+package typescript
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// typesAlias is the alias under which a package's types are imported by
+// its client.
+const typesAlias = "types"
+
+// Client generates an HTTP client exposing one method per operation
+// described in a schema.
+type Client struct {
+	typeFormatter *typeFormatter
+}
+
+func (jenny Client) JennyName() string {
+	return "TypescriptClient"
+}
+
+func (jenny Client) Generate(context languages.Context) (codejen.Files, error) {
+	files := make(codejen.Files, 0, len(context.Schemas))
+
+	for _, schema := range context.Schemas {
+		if len(schema.Operations) == 0 {
+			continue
+		}
+
+		filename := filepath.Join(
+			"src",
+			formatPackageName(schema.Package),
+			"client.gen.ts",
+		)
+
+		files = append(files, *codejen.NewFile(filename, jenny.generateSchema(context, schema), jenny))
+	}
+
+	return files, nil
+}
+
+func (jenny Client) generateSchema(context languages.Context, schema *ast.Schema) []byte {
+	var buffer strings.Builder
+
+	imports := NewImportMap()
+	packageMapper := func(pkg string) string {
+		if imports.IsIdentical(pkg, schema.Package) {
+			return typesAlias
+		}
+
+		return imports.Add(pkg, fmt.Sprintf("../%s", pkg))
+	}
+	jenny.typeFormatter = defaultTypeFormatter(context, packageMapper)
+
+	var methods strings.Builder
+	for _, operation := range schema.Operations {
+		buffer.WriteString(jenny.generateParamsInterface(operation))
+		methods.WriteString(jenny.generateOperation(operation))
+	}
+
+	var output strings.Builder
+	output.WriteString(fmt.Sprintf("import * as %s from './types.gen';\n", typesAlias))
+	output.WriteString(imports.String())
+	output.WriteString("\n")
+	output.WriteString(clientPreamble)
+	output.WriteString(buffer.String())
+	output.WriteString(`export class Client {
+	private readonly baseURL: string;
+	private readonly fetch: typeof fetch;
+	private readonly headers: Record<string, string>;
+
+	constructor(options: ClientOptions) {
+		this.baseURL = options.baseURL.replace(/\/+$/, '');
+		this.fetch = options.fetch ?? globalThis.fetch.bind(globalThis);
+		this.headers = options.headers ?? {};
+	}
+`)
+	output.WriteString(methods.String())
+	output.WriteString(clientRequestMethod)
+	output.WriteString("}\n")
+
+	return []byte(output.String())
+}
+
+func (jenny Client) generateParamsInterface(operation ast.Operation) string {
+	params := jenny.queryAndHeaderParams(operation)
+	if len(params) == 0 {
+		return ""
+	}
+
+	var buffer strings.Builder
+
+	buffer.WriteString(fmt.Sprintf("// Query and header parameters accepted by `%s()`.\n", formatIdentifier(operation.Name)))
+	buffer.WriteString(fmt.Sprintf("export interface %sParams {\n", tools.UpperCamelCase(operation.Name)))
+	for _, param := range params {
+		for _, commentLine := range param.Comments {
+			buffer.WriteString(fmt.Sprintf("\t// %s\n", commentLine))
+		}
+
+		optional := "?"
+		if param.Required {
+			optional = ""
+		}
+
+		buffer.WriteString(fmt.Sprintf("\t%s%s: %s;\n", formatIdentifier(param.Name), optional, jenny.typeFormatter.formatType(param.Type)))
+	}
+	buffer.WriteString("}\n\n")
+
+	return buffer.String()
+}
+
+func (jenny Client) generateOperation(operation ast.Operation) string {
+	var buffer strings.Builder
+
+	params := jenny.queryAndHeaderParams(operation)
+
+	args := tools.Map(operation.ParamsIn(ast.ParamInPath), func(param ast.OperationParam) string {
+		return fmt.Sprintf("%s: %s", formatIdentifier(param.Name), jenny.typeFormatter.formatType(param.Type))
+	})
+	if operation.RequestBody != nil {
+		bodyType := "BodyInit"
+		if ast.IsJSONContentType(operation.RequestBody.ContentType) {
+			bodyType = jenny.typeFormatter.formatType(operation.RequestBody.Type)
+		}
+		args = append(args, "body: "+bodyType)
+	}
+	if len(params) != 0 {
+		paramsArg := fmt.Sprintf("params: %sParams", tools.UpperCamelCase(operation.Name))
+		if !hasRequiredParam(params) {
+			paramsArg += " = {}"
+		}
+		args = append(args, paramsArg)
+	}
+
+	responseType := "void"
+	if response, found := operation.SuccessResponse(); found && response.Type != nil {
+		responseType = jenny.typeFormatter.formatType(*response.Type)
+	}
+
+	buffer.WriteString("\n")
+	comments := operation.Comments
+	if len(comments) == 0 {
+		comments = []string{fmt.Sprintf("Calls `%s %s`.", operation.Method, operation.Path)}
+	}
+	for _, commentLine := range comments {
+		buffer.WriteString(fmt.Sprintf("\t// %s\n", commentLine))
+	}
+	if deprecation := formatDeprecation(operation.Deprecated); deprecation != "" {
+		buffer.WriteString("\t" + deprecation)
+	}
+
+	buffer.WriteString(fmt.Sprintf("\tasync %s(%s): Promise<%s> {\n", formatIdentifier(operation.Name), strings.Join(args, ", "), responseType))
+
+	buffer.WriteString("\t\tconst query = new URLSearchParams();\n")
+	for _, param := range operation.ParamsIn(ast.ParamInQuery) {
+		buffer.WriteString(jenny.setParam(param, "query.append(%s, %s);"))
+	}
+	buffer.WriteString("\t\tconst headers: Record<string, string> = {};\n")
+	for _, param := range operation.ParamsIn(ast.ParamInHeader) {
+		buffer.WriteString(jenny.setParam(param, "headers[%s] = %s;"))
+	}
+
+	bodyArg := "undefined"
+	contentType := "undefined"
+	if operation.RequestBody != nil {
+		bodyArg = "body"
+		if ast.IsJSONContentType(operation.RequestBody.ContentType) {
+			bodyArg = "JSON.stringify(body)"
+		}
+		contentType = formatValue(operation.RequestBody.ContentType)
+	}
+
+	buffer.WriteString(fmt.Sprintf("\n\t\treturn this.request<%s>(%s, %s, query, headers, %s, %s);\n", responseType, formatValue(operation.Method), jenny.pathExpression(operation), bodyArg, contentType))
+	buffer.WriteString("\t}\n")
+
+	return buffer.String()
+}
+
+func (jenny Client) setParam(param ast.OperationParam, setterFormat string) string {
+	accessor := "params." + formatIdentifier(param.Name)
+
+	if param.Type.IsArray() {
+		setter := fmt.Sprintf(setterFormat, formatValue(param.Name), "String(value)")
+		return fmt.Sprintf("\t\tfor (const value of %s ?? []) {\n\t\t\t%s\n\t\t}\n", accessor, setter)
+	}
+
+	setter := fmt.Sprintf(setterFormat, formatValue(param.Name), fmt.Sprintf("String(%s)", accessor))
+	if param.Required {
+		return fmt.Sprintf("\t\t%s\n", setter)
+	}
+
+	return fmt.Sprintf("\t\tif (%s !== undefined) {\n\t\t\t%s\n\t\t}\n", accessor, setter)
+}
+
+func (jenny Client) pathExpression(operation ast.Operation) string {
+	var buffer strings.Builder
+
+	buffer.WriteString("`")
+	for _, segment := range operation.PathSegments() {
+		if segment.Param == "" {
+			buffer.WriteString(strings.NewReplacer("`", "\\`", "${", "\\${").Replace(segment.Literal))
+			continue
+		}
+
+		buffer.WriteString(fmt.Sprintf("${encodeURIComponent(String(%s))}", formatIdentifier(segment.Param)))
+	}
+	buffer.WriteString("`")
+
+	return buffer.String()
+}
+
+func (jenny Client) queryAndHeaderParams(operation ast.Operation) []ast.OperationParam {
+	return append(operation.ParamsIn(ast.ParamInQuery), operation.ParamsIn(ast.ParamInHeader)...)
+}
+
+func hasRequiredParam(params []ast.OperationParam) bool {
+	for _, param := range params {
+		if param.Required {
+			return true
+		}
+	}
+
+	return false
+}
+
+const clientPreamble = `// APIError is thrown when the API responds with a non-2XX status code.
+export class APIError extends Error {
+	readonly status: number;
+	readonly body: string;
+
+	constructor(status: number, body: string) {
+		super(` + "`unexpected status code ${status}: ${body}`" + `);
+		this.name = 'APIError';
+		this.status = status;
+		this.body = body;
+	}
+}
+
+export interface ClientOptions {
+	// Base URL of the API. ie: https://example.com/api
+	baseURL: string;
+	// fetch implementation to use. Defaults to the global one.
+	fetch?: typeof fetch;
+	// Headers sent with every request. ie: authentication headers.
+	headers?: Record<string, string>;
+}
+
+`
+
+const clientRequestMethod = `
+	private async request<T>(method: string, path: string, query: URLSearchParams, headers: Record<string, string>, body: BodyInit | undefined, contentType: string | undefined): Promise<T> {
+		const queryString = query.toString();
+		const url = this.baseURL + path + (queryString !== '' ? '?' + queryString : '');
+
+		const requestHeaders: Record<string, string> = { 'Accept': 'application/json', ...this.headers, ...headers };
+		if (contentType !== undefined) {
+			requestHeaders['Content-Type'] = contentType;
+		}
+
+		const response = await this.fetch(url, { method, headers: requestHeaders, body });
+		const text = await response.text();
+		if (!response.ok) {
+			throw new APIError(response.status, text);
+		}
+
+		return (text === '' ? undefined : JSON.parse(text)) as T;
+	}
+`
@@ -0,0 +1,216 @@
+package typescript
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+var identifierRegex = regexp.MustCompile(`^[a-zA-Z_$][a-zA-Z0-9_$]*$`)
+
+// ToCode turns the given builder call into TypeScript code.
+func (language *Language) ToCode(context languages.Context, call languages.BuilderCall) (string, error) {
+	imports := NewImportMap()
+	packageMapper := func(pkg string) string {
+		return imports.Add(pkg, "./"+pkg)
+	}
+
+	converter := &converter{
+		context:       context,
+		packageMapper: packageMapper,
+		typeFormatter: defaultTypeFormatter(context, packageMapper),
+	}
+
+	expression := converter.formatBuilderCall(call)
+
+	return fmt.Sprintf("%s\n%s;\n", imports.String(), expression), nil
+}
+
+type converter struct {
+	context       languages.Context
+	packageMapper func(pkg string) string
+	typeFormatter *typeFormatter
+}
+
+func (converter *converter) formatBuilderCall(call languages.BuilderCall) string {
+	var buffer strings.Builder
+
+	buffer.WriteString(fmt.Sprintf(
+		"new %s.%sBuilder(%s)",
+		converter.packageMapper(call.Builder.Package),
+		tools.UpperCamelCase(call.Builder.Name),
+		converter.formatArgs(call.Args),
+	))
+
+	for _, opt := range call.Options {
+		formatted := fmt.Sprintf("\n.%s(%s)", formatIdentifier(opt.Option.Name), converter.formatArgs(opt.Args))
+
+		buffer.WriteString(indent(formatted))
+	}
+
+	return buffer.String()
+}
+
+func (converter *converter) formatArgs(args []languages.ConvertedValue) string {
+	return strings.Join(tools.Map(args, converter.formatArg), ", ")
+}
+
+func (converter *converter) formatArg(arg languages.ConvertedValue) string {
+	if arg.IsBuilder() {
+		return converter.formatBuilderCall(*arg.Builder)
+	}
+
+	if arg.IsBuildersList() {
+		return formatList(tools.Map(arg.Items, converter.formatArg))
+	}
+
+	return converter.formatValue(arg.Type, arg.Value)
+}
+
+func (converter *converter) formatValue(def ast.Type, value any) string {
+	if value == nil {
+		return "null"
+	}
+
+	switch def.Kind {
+	case ast.KindRef:
+		return converter.formatRefValue(def, value)
+	case ast.KindEnum:
+		return formatAnyValue(languages.EnumMember(def.AsEnum(), value).Value)
+	case ast.KindArray:
+		items, _ := value.([]any)
+
+		return formatList(tools.Map(items, func(item any) string {
+			return converter.formatValue(def.AsArray().ValueType, item)
+		}))
+	case ast.KindMap:
+		entries, _ := value.(map[string]any)
+
+		return formatEntries(entries, func(entry any) string {
+			return converter.formatValue(def.AsMap().ValueType, entry)
+		})
+	case ast.KindStruct:
+		return converter.formatStructValue(def.AsStruct(), value, "")
+	case ast.KindDisjunction:
+		branches := def.AsDisjunction().Branches
+		branch, found := languages.MatchingBranch(converter.context, branches, value)
+		if !found {
+			return formatAnyValue(value)
+		}
+
+		return converter.formatValue(branches[branch], value)
+	default:
+		return formatAnyValue(value)
+	}
+}
+
+func (converter *converter) formatRefValue(def ast.Type, value any) string {
+	referredObj, found := converter.context.LocateObjectByRef(def.AsRef())
+	if !found {
+		return formatAnyValue(value)
+	}
+
+	switch referredObj.Type.Kind {
+	case ast.KindEnum:
+		member := languages.EnumMember(referredObj.Type.AsEnum(), value)
+
+		return converter.typeFormatter.formatEnumValue(referredObj, member.Value)
+	case ast.KindStruct:
+		// missing required fields are filled by the object's default value
+		defaults := fmt.Sprintf("%s.default%s()", converter.packageMapper(referredObj.SelfRef.ReferredPkg), tools.UpperCamelCase(referredObj.Name))
+
+		return converter.formatStructValue(referredObj.Type.AsStruct(), value, defaults)
+	default:
+		return converter.formatValue(referredObj.Type, value)
+	}
+}
+
+func (converter *converter) formatStructValue(def ast.StructType, value any, defaults string) string {
+	fields, _ := value.(map[string]any)
+
+	entries := make([]string, 0, len(def.Fields)+1)
+	missingRequired := false
+	for _, field := range def.Fields {
+		fieldValue, found := fields[field.Name]
+		if !found || fieldValue == nil {
+			missingRequired = missingRequired || field.Required
+			continue
+		}
+
+		entries = append(entries, fmt.Sprintf("%s: %s", formatKey(field.Name), converter.formatValue(field.Type, fieldValue)))
+	}
+
+	if missingRequired && defaults != "" {
+		entries = append([]string{"..." + defaults}, entries...)
+	}
+
+	return formatObjectLiteral(entries)
+}
+
+func formatList(items []string) string {
+	if len(items) == 0 {
+		return "[]"
+	}
+
+	return fmt.Sprintf("[\n    %s,\n]", indent(strings.Join(items, ",\n")))
+}
+
+func formatEntries(entries map[string]any, formatEntry func(entry any) string) string {
+	keys := make([]string, 0, len(entries))
+	for key := range entries {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	return formatObjectLiteral(tools.Map(keys, func(key string) string {
+		return fmt.Sprintf("%s: %s", formatKey(key), formatEntry(entries[key]))
+	}))
+}
+
+func formatObjectLiteral(entries []string) string {
+	if len(entries) == 0 {
+		return "{}"
+	}
+
+	return fmt.Sprintf("{\n    %s,\n}", indent(strings.Join(entries, ",\n")))
+}
+
+func formatKey(key string) string {
+	if identifierRegex.MatchString(key) {
+		return key
+	}
+
+	return strconv.Quote(key)
+}
+
+// formatAnyValue formats a value decoded from JSON, without any information
+// about its type.
+func formatAnyValue(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return "null"
+	case string:
+		return strconv.Quote(typed)
+	case json.Number:
+		return typed.String()
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64)
+	case []any:
+		return formatList(tools.Map(typed, formatAnyValue))
+	case map[string]any:
+		return formatEntries(typed, formatAnyValue)
+	default:
+		return fmt.Sprintf("%v", value)
+	}
+}
+
+func indent(input string) string {
+	return strings.ReplaceAll(input, "\n", "\n    ")
+}
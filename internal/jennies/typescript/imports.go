@@ -15,7 +15,7 @@ func NewImportMap() *common.DirectImportMap {
 			parts := strings.Split(importPath, "/")
 
 			return strings.Join(tools.Map(parts, func(input string) string {
-				if input == ".." {
+				if input == ".." || input == "." {
 					return input
 				}
 
@@ -25,6 +25,10 @@ func (jenny Index) Generate(context languages.Context) (codejen.Files, error) {
 	if jenny.Targets.Types {
 		for _, schema := range context.Schemas {
 			packages[schema.Package] = []string{"types.gen"}
+
+			if len(schema.Operations) != 0 {
+				packages[schema.Package] = append(packages[schema.Package], "client.gen")
+			}
 		}
 	}
 
@@ -14,10 +14,16 @@ type Config struct {
 	PathPrefix string `yaml:"path_prefix"`
 
 	// SkipRuntime disables runtime-related code generation when enabled.
-	// Note: builders can NOT be generated with this flag turned on, as they
+	// Note: builders and validation functions can NOT be generated with this flag turned on, as they
 	// rely on the runtime to function.
 	SkipRuntime bool `yaml:"skip_runtime"`
 
+	// Schemas enables the generation of runtime schemas describing every
+	// object, as well as `parseX()` and `isX()` helpers relying on them to
+	// check untrusted inputs.
+	// Note: schemas can NOT be generated if SkipRuntime is enabled.
+	Schemas bool `yaml:"schemas"`
+
 	// SkipIndex disables the generation of `index.ts` files.
 	SkipIndex bool `yaml:"skip_index"`
 }
@@ -41,9 +47,16 @@ func (language *Language) Jennies(globalConfig languages.Config) *codejen.JennyL
 		return LanguageRef
 	})
 	jenny.AppendOneToMany(
-		common.If[languages.Context](!language.config.SkipRuntime, Runtime{}),
+		common.If[languages.Context](!language.config.SkipRuntime, Runtime{
+			GenerateValidation: globalConfig.Validation,
+			GenerateSchemas:    language.config.Schemas,
+		}),
 
-		common.If[languages.Context](globalConfig.Types, RawTypes{}),
+		common.If[languages.Context](globalConfig.Types, RawTypes{
+			GenerateValidation: globalConfig.Validation && !language.config.SkipRuntime,
+			GenerateSchemas:    language.config.Schemas && !language.config.SkipRuntime,
+		}),
+		common.If[languages.Context](globalConfig.Types, Client{}),
 		common.If[languages.Context](!language.config.SkipRuntime && globalConfig.Builders, &Builder{}),
 
 		common.If[languages.Context](!language.config.SkipIndex, Index{Targets: globalConfig}),
@@ -54,9 +67,16 @@ func (language *Language) Jennies(globalConfig languages.Config) *codejen.JennyL
 }
 
 func (language *Language) CompilerPasses() compiler.Passes {
-	return compiler.Passes{
+	passes := compiler.Passes{
 		&compiler.RenameNumericEnumValues{},
 	}
+
+	// discriminated disjunctions make for more precise schemas
+	if language.config.Schemas {
+		passes = append(passes, &compiler.DisjunctionInferMapping{})
+	}
+
+	return passes
 }
 
 func (language *Language) NullableKinds() languages.NullableConfig {
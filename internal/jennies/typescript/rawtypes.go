@@ -17,6 +17,13 @@ type raw string
 type pkgMapper func(string) string
 
 type RawTypes struct {
+	// GenerateValidation enables the generation of `validateX()` functions.
+	GenerateValidation bool
+
+	// GenerateSchemas enables the generation of runtime schemas, as well as
+	// `parseX()` and `isX()` helpers.
+	GenerateSchemas bool
+
 	typeFormatter *typeFormatter
 	schemas       ast.Schemas
 }
@@ -61,6 +68,14 @@ func (jenny RawTypes) generateSchema(context languages.Context, schema *ast.Sche
 	}
 
 	jenny.typeFormatter = defaultTypeFormatter(context, packageMapper)
+	validationGenerator := ValidationFunctions{
+		packageMapper: packageMapper,
+		context:       context,
+	}
+	schemasGenerator := Schemas{
+		packageMapper: packageMapper,
+		context:       context,
+	}
 
 	schema.Objects.Iterate(func(_ string, object ast.Object) {
 		typeDefGen, innerErr := jenny.formatObject(object, packageMapper)
@@ -70,6 +85,15 @@ func (jenny RawTypes) generateSchema(context languages.Context, schema *ast.Sche
 		}
 
 		buffer.Write(typeDefGen)
+
+		if jenny.GenerateValidation {
+			validationGenerator.generateForObject(&buffer, object)
+		}
+
+		if jenny.GenerateSchemas {
+			schemasGenerator.generateForObject(&buffer, schema, object)
+		}
+
 		buffer.WriteString("\n")
 	})
 	if err != nil {
@@ -90,6 +114,7 @@ func (jenny RawTypes) formatObject(def ast.Object, packageMapper pkgMapper) ([]b
 	for _, commentLine := range def.Comments {
 		buffer.WriteString(fmt.Sprintf("// %s\n", commentLine))
 	}
+	buffer.WriteString(formatDeprecation(def.Deprecated))
 
 	buffer.WriteString("export ")
 
@@ -6,6 +6,13 @@ import (
 )
 
 type Runtime struct {
+	// GenerateValidation enables the generation of the runtime used by
+	// `validateX()` functions.
+	GenerateValidation bool
+
+	// GenerateSchemas enables the generation of the runtime used by
+	// schemas and `parseX()` helpers.
+	GenerateSchemas bool
 }
 
 func (jenny Runtime) JennyName() string {
@@ -13,17 +20,42 @@ func (jenny Runtime) JennyName() string {
 }
 
 func (jenny Runtime) Generate(_ languages.Context) (codejen.Files, error) {
-	return codejen.Files{
+	files := codejen.Files{
 		*codejen.NewFile("src/cog/variants_gen.ts", []byte(jenny.generateVariantsFile()), jenny),
 		*codejen.NewFile("src/cog/builder_gen.ts", []byte(jenny.generateOptionsBuilderFile()), jenny),
-		*codejen.NewFile("src/cog/index.ts", []byte(jenny.generateIndexFile()), jenny),
-	}, nil
+	}
+
+	if jenny.needsValidationRuntime() {
+		files = append(files, *codejen.NewFile("src/cog/validation_gen.ts", []byte(jenny.generateValidationFile()), jenny))
+	}
+
+	if jenny.GenerateSchemas {
+		files = append(files, *codejen.NewFile("src/cog/schema_gen.ts", []byte(jenny.generateSchemaFile()), jenny))
+	}
+
+	return append(files, *codejen.NewFile("src/cog/index.ts", []byte(jenny.generateIndexFile()), jenny)), nil
+}
+
+// needsValidationRuntime tells whether validation errors are used by the
+// generated code: schemas report them too.
+func (jenny Runtime) needsValidationRuntime() bool {
+	return jenny.GenerateValidation || jenny.GenerateSchemas
 }
 
 func (jenny Runtime) generateIndexFile() string {
-	return `export * from './variants_gen';
+	index := `export * from './variants_gen';
 export * from './builder_gen';
 `
+
+	if jenny.needsValidationRuntime() {
+		index += "export * from './validation_gen';\n"
+	}
+
+	if jenny.GenerateSchemas {
+		index += "export * as schema from './schema_gen';\n"
+	}
+
+	return index
 }
 
 func (jenny Runtime) generateVariantsFile() string {
@@ -51,3 +83,245 @@ export function isBuilder<T>(input: Builder<T> | any): input is Builder<T> {
 }
 `
 }
+
+func (jenny Runtime) generateValidationFile() string {
+	return `export interface ValidationError {
+  path: string;
+  message: string;
+}
+
+export function prefixValidationErrors(prefix: string, errors: ValidationError[]): ValidationError[] {
+  return errors.map((error) => ({ ...error, path: ` + "`${prefix}.${error.path}`" + ` }));
+}
+`
+}
+
+// generateSchemaFile returns a small, dependency-free library used to
+// describe the expected shape of values and check inputs against it.
+func (jenny Runtime) generateSchemaFile() string {
+	return `import { ValidationError } from './validation_gen';
+
+export interface Schema<T> {
+  // check returns the errors found while checking the given input against the schema.
+  check(input: unknown, path: string): ValidationError[];
+}
+
+export class ParseError extends Error {
+  readonly errors: ValidationError[];
+
+  constructor(errors: ValidationError[]) {
+    super(errors.map((error) => (error.path === '' ? error.message : error.path + ': ' + error.message)).join('\n'));
+    this.name = 'ParseError';
+    this.errors = errors;
+  }
+}
+
+export type ParseResult<T> = { success: true; data: T } | { success: false; errors: ValidationError[] };
+
+// parse checks the given input against a schema and returns it.
+// A ParseError is thrown if the input doesn't match the schema.
+export function parse<T>(schema: Schema<T>, input: unknown): T {
+  const errors = schema.check(input, '');
+  if (errors.length !== 0) {
+    throw new ParseError(errors);
+  }
+
+  return input as T;
+}
+
+// safeParse checks the given input against a schema, without throwing.
+export function safeParse<T>(schema: Schema<T>, input: unknown): ParseResult<T> {
+  const errors = schema.check(input, '');
+  if (errors.length !== 0) {
+    return { success: false, errors };
+  }
+
+  return { success: true, data: input as T };
+}
+
+export function is<T>(schema: Schema<T>, input: unknown): input is T {
+  return schema.check(input, '').length === 0;
+}
+
+export class BaseSchema<T> implements Schema<T> {
+  private readonly checker: (input: unknown, path: string) => ValidationError[];
+
+  constructor(checker: (input: unknown, path: string) => ValidationError[]) {
+    this.checker = checker;
+  }
+
+  check(input: unknown, path: string): ValidationError[] {
+    return this.checker(input, path);
+  }
+
+  // refine adds a constraint to the schema, checked only if the input
+  // matches the schema in the first place.
+  refine(constraint: (value: T) => boolean, message: string): BaseSchema<T> {
+    return new BaseSchema<T>((input, path) => {
+      const errors = this.check(input, path);
+      if (errors.length !== 0) {
+        return errors;
+      }
+
+      return constraint(input as T) ? [] : violation(path, message);
+    });
+  }
+}
+
+const violation = (path: string, message: string): ValidationError[] => [{ path, message }];
+
+const fieldPath = (path: string, field: string): string => (path === '' ? field : path + '.' + field);
+
+const isObject = (input: unknown): input is Record<string, unknown> => {
+  return typeof input === 'object' && input !== null && !Array.isArray(input);
+};
+
+const primitive = <T>(message: string, predicate: (input: unknown) => boolean): BaseSchema<T> => {
+  return new BaseSchema<T>((input, path) => (predicate(input) ? [] : violation(path, message)));
+};
+
+export const any = (): BaseSchema<any> => new BaseSchema<any>(() => []);
+
+export const nullValue = (): BaseSchema<null> => primitive('must be null', (input) => input === null);
+
+export const string = (): BaseSchema<string> => primitive('must be a string', (input) => typeof input === 'string');
+
+export const number = (): BaseSchema<number> => {
+  return primitive('must be a number', (input) => typeof input === 'number' && !Number.isNaN(input));
+};
+
+export const integer = (): BaseSchema<number> => primitive('must be an integer', (input) => Number.isInteger(input));
+
+export const boolean = (): BaseSchema<boolean> => primitive('must be a boolean', (input) => typeof input === 'boolean');
+
+export const literal = <T>(value: T): BaseSchema<T> => primitive('must be ' + JSON.stringify(value), (input) => input === value);
+
+export const enumOf = <T>(values: T[]): BaseSchema<T> => {
+  return primitive('must be one of: ' + values.join(', '), (input) => values.includes(input as T));
+};
+
+export const nullable = <T>(schema: Schema<T>): BaseSchema<T | null> => {
+  return new BaseSchema<T | null>((input, path) => (input === null ? [] : schema.check(input, path)));
+};
+
+export const array = <T>(item: Schema<T>): BaseSchema<T[]> => {
+  return new BaseSchema<T[]>((input, path) => {
+    if (!Array.isArray(input)) {
+      return violation(path, 'must be an array');
+    }
+
+    return input.flatMap((value, i) => item.check(value, path + '[' + i + ']'));
+  });
+};
+
+export const record = <T>(value: Schema<T>): BaseSchema<Record<string, T>> => {
+  return new BaseSchema<Record<string, T>>((input, path) => {
+    if (!isObject(input)) {
+      return violation(path, 'must be an object');
+    }
+
+    return Object.entries(input).flatMap(([key, item]) => value.check(item, path + '[' + key + ']'));
+  });
+};
+
+// object describes a value with known fields. Fields that aren't listed
+// in the schema are ignored.
+// Fields listed as required can't be undefined, and fields can only be
+// null if their schema accepts it.
+export const object = <T>(fields: Record<string, Schema<any>>, required: string[] = []): BaseSchema<T> => {
+  return new BaseSchema<T>((input, path) => {
+    if (!isObject(input)) {
+      return violation(path, 'must be an object');
+    }
+
+    const errors: ValidationError[] = [];
+    for (const name of Object.keys(fields)) {
+      const value = input[name];
+      if (value === undefined) {
+        if (required.includes(name)) {
+          errors.push(...violation(fieldPath(path, name), 'is required'));
+        }
+        continue;
+      }
+
+      errors.push(...fields[name].check(value, fieldPath(path, name)));
+    }
+
+    return errors;
+  });
+};
+
+export const union = <T>(branches: Array<Schema<any>>): BaseSchema<T> => {
+  return new BaseSchema<T>((input, path) => {
+    const matches = branches.some((branch) => branch.check(input, path).length === 0);
+
+    return matches ? [] : violation(path, 'must match one of the allowed types');
+  });
+};
+
+// discriminatedUnion describes a union of objects in which the type of the
+// value is given by a discriminator field.
+// The fallback schema is used for unknown discriminator values, if given.
+export const discriminatedUnion = <T>(
+  discriminator: string,
+  mapping: Record<string, Schema<any>>,
+  fallback?: Schema<any>
+): BaseSchema<T> => {
+  return new BaseSchema<T>((input, path) => {
+    if (!isObject(input)) {
+      return violation(path, 'must be an object');
+    }
+
+    const discriminatorValue = String(input[discriminator]);
+    const schema = Object.prototype.hasOwnProperty.call(mapping, discriminatorValue) ? mapping[discriminatorValue] : fallback;
+    if (schema === undefined) {
+      return violation(fieldPath(path, discriminator), 'must be one of: ' + Object.keys(mapping).join(', '));
+    }
+
+    return schema.check(input, path);
+  });
+};
+
+export const intersection = <T>(branches: Array<Schema<any>>): BaseSchema<T> => {
+  return new BaseSchema<T>((input, path) => branches.flatMap((branch) => branch.check(input, path)));
+};
+
+// lazy defers the resolution of a schema until it is needed, allowing
+// recursive schemas and schemas referring to each other.
+export const lazy = <T>(factory: () => Schema<T>): BaseSchema<T> => {
+  return new BaseSchema<T>((input, path) => factory().check(input, path));
+};
+
+const variants = new Map<string, Map<string, Schema<any>>>();
+
+// registerVariant makes a schema available to composable slots accepting
+// the given variant.
+export function registerVariant(variant: string, identifier: string, schema: Schema<any>): void {
+  if (!variants.has(variant)) {
+    variants.set(variant, new Map<string, Schema<any>>());
+  }
+
+  variants.get(variant)!.set(identifier, schema);
+}
+
+// variant describes a composable slot: the input must match at least one
+// of the schemas registered for the given variant.
+// Any object is accepted when no schema is registered.
+export const variant = <T>(name: string): BaseSchema<T> => {
+  return new BaseSchema<T>((input, path) => {
+    if (!isObject(input)) {
+      return violation(path, 'must be an object');
+    }
+
+    const candidates = Array.from(variants.get(name)?.values() ?? []);
+    if (candidates.length === 0) {
+      return [];
+    }
+
+    const matches = candidates.some((schema) => schema.check(input, path).length === 0);
+
+    return matches ? [] : violation(path, 'must match one of the registered ' + name + ' variants');
+  });
+};
+`
+}
@@ -16,3 +16,27 @@ func TestRuntime(t *testing.T) {
 
 	req.Len(files, 3)
 }
+
+func TestRuntime_withValidation(t *testing.T) {
+	req := require.New(t)
+	jenny := Runtime{GenerateValidation: true}
+
+	files, err := jenny.Generate(languages.Context{})
+	req.NoError(err)
+
+	req.Len(files, 4)
+	req.Equal("src/cog/validation_gen.ts", files[2].RelativePath)
+	req.Contains(string(files[3].Data), "export * from './validation_gen';")
+}
+
+func TestRuntime_withSchemas(t *testing.T) {
+	req := require.New(t)
+	jenny := Runtime{GenerateSchemas: true}
+
+	files, err := jenny.Generate(languages.Context{})
+	req.NoError(err)
+
+	req.Len(files, 5)
+	req.Equal("src/cog/schema_gen.ts", files[3].RelativePath)
+	req.Contains(string(files[4].Data), "export * as schema from './schema_gen';")
+}
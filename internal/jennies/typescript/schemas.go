@@ -0,0 +1,236 @@
+package typescript
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// Schemas generates runtime schemas for objects, as well as `parseX()` and
+// `isX()` helpers relying on them to check untrusted inputs.
+type Schemas struct {
+	packageMapper pkgMapper
+	context       languages.Context
+}
+
+func (jenny Schemas) generateForObject(buffer *strings.Builder, schema *ast.Schema, object ast.Object) {
+	// constants are values, not types: there is nothing to parse.
+	if isConstantObject(object) {
+		return
+	}
+
+	objectName := tools.CleanupNames(object.Name)
+	helperSuffix := tools.UpperCamelCase(objectName)
+	cogAlias := jenny.packageMapper("cog")
+
+	// parsed values are plain data: they don't carry the variant marker
+	// that default values and builders attach to objects.
+	parsedType := objectName
+	if object.Type.ImplementsVariant() {
+		marker := "_implements" + tools.UpperCamelCase(object.Type.ImplementedVariant()) + "Variant"
+		parsedType = fmt.Sprintf("Omit<%s, %s>", objectName, formatValue(marker))
+	}
+
+	buffer.WriteString("\n")
+	buffer.WriteString(fmt.Sprintf("export const %[1]sSchema: %[2]s.schema.Schema<%[3]s> = %[4]s;\n", objectName, cogAlias, parsedType, jenny.schemaForType(object.Type)))
+
+	if object.Type.ImplementsVariant() && schema.Metadata.Identifier != "" {
+		buffer.WriteString(fmt.Sprintf("%s.schema.registerVariant(%s, %s, %sSchema);\n", cogAlias, formatValue(object.Type.ImplementedVariant()), formatValue(schema.Metadata.Identifier), objectName))
+	}
+
+	buffer.WriteString("\n")
+	buffer.WriteString(fmt.Sprintf("// parse%[1]s checks that the given input is a valid `%[2]s` and returns it. A `%[3]s.schema.ParseError` is thrown otherwise.\n", helperSuffix, objectName, cogAlias))
+	buffer.WriteString(fmt.Sprintf("export const parse%[1]s = (input: unknown): %[2]s => %[3]s.schema.parse(%[4]sSchema, input);\n", helperSuffix, parsedType, cogAlias, objectName))
+
+	buffer.WriteString("\n")
+	buffer.WriteString(fmt.Sprintf("// is%[1]s checks whether the given input is a valid `%[2]s`.\n", helperSuffix, objectName))
+	buffer.WriteString(fmt.Sprintf("export const is%[1]s = (input: unknown): input is %[2]s => %[3]s.schema.is(%[4]sSchema, input);\n", helperSuffix, parsedType, cogAlias, objectName))
+}
+
+func (jenny Schemas) schemaForType(typeDef ast.Type) string {
+	if typeDef.Nullable && !typeDef.IsAny() {
+		nonNullableType := typeDef
+		nonNullableType.Nullable = false
+
+		return jenny.call("nullable", jenny.schemaForType(nonNullableType))
+	}
+
+	switch typeDef.Kind {
+	case ast.KindScalar:
+		return jenny.schemaForScalar(typeDef.AsScalar())
+	case ast.KindEnum:
+		return jenny.schemaForEnum(typeDef.AsEnum())
+	case ast.KindStruct:
+		return jenny.schemaForStruct(typeDef.AsStruct())
+	case ast.KindArray:
+		return jenny.call("array", jenny.schemaForType(typeDef.AsArray().ValueType))
+	case ast.KindMap:
+		return jenny.call("record", jenny.schemaForType(typeDef.AsMap().ValueType))
+	case ast.KindRef:
+		return jenny.schemaForRef(typeDef.AsRef())
+	case ast.KindDisjunction:
+		return jenny.schemaForDisjunction(typeDef.AsDisjunction())
+	case ast.KindIntersection:
+		return jenny.call("intersection", jenny.schemasList(typeDef.AsIntersection().Branches))
+	case ast.KindComposableSlot:
+		return jenny.call("variant", formatValue(string(typeDef.AsComposableSlot().Variant)))
+	default:
+		return jenny.call("any")
+	}
+}
+
+func (jenny Schemas) schemaForScalar(scalarType ast.ScalarType) string {
+	if scalarType.IsConcrete() {
+		return jenny.call("literal", formatValue(scalarType.Value))
+	}
+
+	var schema string
+	switch scalarType.ScalarKind {
+	case ast.KindNull:
+		schema = jenny.call("nullValue")
+	case ast.KindString, ast.KindBytes:
+		schema = jenny.call("string")
+	case ast.KindFloat32, ast.KindFloat64:
+		schema = jenny.call("number")
+	case ast.KindInt8, ast.KindInt16, ast.KindInt32, ast.KindInt64,
+		ast.KindUint8, ast.KindUint16, ast.KindUint32, ast.KindUint64:
+		schema = jenny.call("integer")
+	case ast.KindBool:
+		schema = jenny.call("boolean")
+	default:
+		return jenny.call("any")
+	}
+
+	for _, constraint := range scalarType.Constraints {
+		if len(constraint.Args) == 0 {
+			continue
+		}
+
+		respected, _ := constraintConditions("value", constraint)
+		schema += fmt.Sprintf(".refine((value) => %s, %s)", respected, formatValue(common.ConstraintViolationMessage(constraint)))
+	}
+
+	return schema
+}
+
+func (jenny Schemas) schemaForEnum(enumType ast.EnumType) string {
+	values := tools.Map(enumType.Values, func(value ast.EnumValue) string {
+		return formatValue(value.Value)
+	})
+
+	return jenny.call("enumOf", "["+strings.Join(values, ", ")+"]")
+}
+
+func (jenny Schemas) schemaForStruct(structType ast.StructType) string {
+	if len(structType.Fields) == 0 {
+		return jenny.call("object", "{}")
+	}
+
+	var buffer strings.Builder
+	var required []string
+
+	buffer.WriteString("{\n")
+	for _, field := range structType.Fields {
+		buffer.WriteString(prefixLinesWith(fmt.Sprintf("%s: %s,", field.Name, jenny.schemaForType(field.Type)), "\t"))
+		buffer.WriteString("\n")
+
+		// same rules as the ones used by validation functions
+		if field.Required && !field.Type.Nullable && !field.Type.IsAny() {
+			required = append(required, formatValue(field.Name))
+		}
+	}
+	buffer.WriteString("}")
+
+	if len(required) == 0 {
+		return jenny.call("object", buffer.String())
+	}
+
+	return jenny.call("object", buffer.String(), "["+strings.Join(required, ", ")+"]")
+}
+
+func (jenny Schemas) schemaForRef(ref ast.RefType) string {
+	referredObject, found := jenny.context.LocateObjectByRef(ref)
+	if !found {
+		return jenny.call("any")
+	}
+
+	referredName := tools.CleanupNames(referredObject.Name)
+	if referredPkg := jenny.packageMapper(ref.ReferredPkg); referredPkg != "" {
+		referredName = referredPkg + "." + referredName
+	}
+
+	if isConstantObject(referredObject) {
+		return jenny.call("literal", referredName)
+	}
+
+	// schemas are referenced lazily: they might not be defined yet, or be recursive.
+	return jenny.call("lazy", fmt.Sprintf("() => %sSchema", referredName))
+}
+
+func (jenny Schemas) schemaForDisjunction(disjunction ast.DisjunctionType) string {
+	if disjunction.Discriminator == "" || len(disjunction.DiscriminatorMapping) == 0 {
+		return jenny.call("union", jenny.schemasList(disjunction.Branches))
+	}
+
+	branchesByType := make(map[string]ast.Type, len(disjunction.Branches))
+	for _, branch := range disjunction.Branches {
+		if branch.IsRef() {
+			branchesByType[branch.AsRef().ReferredType] = branch
+		}
+	}
+
+	discriminatorValues := make([]string, 0, len(disjunction.DiscriminatorMapping))
+	for discriminatorValue := range disjunction.DiscriminatorMapping {
+		discriminatorValues = append(discriminatorValues, discriminatorValue)
+	}
+	sort.Strings(discriminatorValues)
+
+	var buffer strings.Builder
+	fallback := ""
+
+	buffer.WriteString("{\n")
+	for _, discriminatorValue := range discriminatorValues {
+		branch, found := branchesByType[disjunction.DiscriminatorMapping[discriminatorValue]]
+		if !found {
+			// the mapping can't be trusted: let's not be too clever.
+			return jenny.call("union", jenny.schemasList(disjunction.Branches))
+		}
+
+		if discriminatorValue == ast.DiscriminatorCatchAll {
+			fallback = jenny.schemaForType(branch)
+			continue
+		}
+
+		buffer.WriteString(fmt.Sprintf("\t%s: %s,\n", formatValue(discriminatorValue), jenny.schemaForType(branch)))
+	}
+	buffer.WriteString("}")
+
+	if fallback == "" {
+		return jenny.call("discriminatedUnion", formatValue(disjunction.Discriminator), buffer.String())
+	}
+
+	return jenny.call("discriminatedUnion", formatValue(disjunction.Discriminator), buffer.String(), fallback)
+}
+
+func (jenny Schemas) schemasList(types ast.Types) string {
+	schemas := tools.Map(types, func(typeDef ast.Type) string {
+		return jenny.schemaForType(typeDef)
+	})
+
+	return "[" + strings.Join(schemas, ", ") + "]"
+}
+
+func (jenny Schemas) call(function string, args ...string) string {
+	return fmt.Sprintf("%s.schema.%s(%s)", jenny.packageMapper("cog"), function, strings.Join(args, ", "))
+}
+
+// isConstantObject tells whether the given object is rendered as a
+// constant rather than a type.
+func isConstantObject(object ast.Object) bool {
+	return object.Type.IsConcreteScalar() && object.Type.Hints["kind"] != "type"
+}
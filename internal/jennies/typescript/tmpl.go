@@ -26,7 +26,8 @@ func init() {
 			"formatType": func(_ ast.Type) string {
 				panic("formatType() needs to be overridden by a jenny")
 			},
-			"formatIdentifier": formatIdentifier,
+			"formatIdentifier":        formatIdentifier,
+			"formatDeprecationNotice": formatDeprecationNotice,
 			"typeIsDisjunctionOfBuilders": func(_ ast.Type) string {
 				panic("typeIsDisjunctionOfBuilders() needs to be overridden by a jenny")
 			},
@@ -1,9 +1,28 @@
 package typescript
 
 import (
+	"fmt"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/tools"
 )
 
+// formatDeprecation returns a JSDoc comment holding a `@deprecated` tag.
+func formatDeprecation(deprecation *ast.Deprecation) string {
+	if deprecation == nil {
+		return ""
+	}
+
+	return fmt.Sprintf("/** @deprecated %s */\n", formatDeprecationNotice(deprecation))
+}
+
+// formatDeprecationNotice ensures that the deprecation notice can't
+// prematurely close the JSDoc comment it's rendered in.
+func formatDeprecationNotice(deprecation *ast.Deprecation) string {
+	return strings.ReplaceAll(deprecation.Notice(), "*/", "*\\/")
+}
+
 func formatIdentifier(name string) string {
 	return tools.LowerCamelCase(escapeIdentifier(name))
 }
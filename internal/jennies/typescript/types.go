@@ -135,6 +135,7 @@ func (formatter *typeFormatter) formatField(def ast.StructField) string {
 	for _, commentLine := range def.Comments {
 		buffer.WriteString(fmt.Sprintf("// %s\n", commentLine))
 	}
+	buffer.WriteString(formatDeprecation(def.Deprecated))
 
 	required := ""
 	if !def.Required {
@@ -0,0 +1,236 @@
+package typescript
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/jennies/common"
+	"github.com/grafana/cog/internal/languages"
+	"github.com/grafana/cog/internal/tools"
+)
+
+// ValidationFunctions generates `validateX()` functions for structs,
+// checking that the constraints defined in the schema are respected.
+type ValidationFunctions struct {
+	packageMapper pkgMapper
+	context       languages.Context
+}
+
+func (jenny ValidationFunctions) generateForObject(buffer *strings.Builder, object ast.Object) {
+	if !object.Type.IsStruct() {
+		return
+	}
+
+	objectName := tools.CleanupNames(object.Name)
+	cogAlias := jenny.packageMapper("cog")
+	checks := jenny.checksForStruct("input", object.Type.AsStruct(), nil, 0)
+
+	buffer.WriteString("\n")
+	buffer.WriteString(fmt.Sprintf("// validate%[1]s checks all the validation constraints that may be defined on `%[2]s` fields for violations and returns them.\n", tools.UpperCamelCase(objectName), objectName))
+	if checks == "" {
+		buffer.WriteString(fmt.Sprintf("export const validate%[1]s = (_input: %[2]s): %[3]s.ValidationError[] => {\n", tools.UpperCamelCase(objectName), objectName, cogAlias))
+		buffer.WriteString("\treturn [];\n")
+		buffer.WriteString("};\n")
+		return
+	}
+
+	buffer.WriteString(fmt.Sprintf("export const validate%[1]s = (input: %[2]s): %[3]s.ValidationError[] => {\n", tools.UpperCamelCase(objectName), objectName, cogAlias))
+	buffer.WriteString(fmt.Sprintf("\tconst errors: %s.ValidationError[] = [];\n\n", cogAlias))
+	buffer.WriteString(indentChecks(checks))
+	buffer.WriteString("\n\treturn errors;\n")
+	buffer.WriteString("};\n")
+}
+
+func (jenny ValidationFunctions) checksForStruct(valueExpr string, structType ast.StructType, path common.ValidationPath, depth int) string {
+	var buffer strings.Builder
+
+	for _, field := range structType.Fields {
+		fieldExpr := valueExpr + "." + field.Name
+		fieldPath := path.AppendField(field.Name)
+
+		nonNullableType := field.Type
+		nonNullableType.Nullable = false
+		checks := jenny.checksForValue(fieldExpr, nonNullableType, fieldPath, depth)
+
+		// values of type `any` can legitimately be null
+		if field.Required && !field.Type.Nullable && !field.Type.IsAny() {
+			buffer.WriteString(fmt.Sprintf("if (%s === undefined || %s === null) {\n", fieldExpr, fieldExpr))
+			buffer.WriteString(indentChecks(jenny.violation(fieldPath, common.ValidationRequiredMessage)))
+			if checks != "" {
+				buffer.WriteString("} else {\n")
+				buffer.WriteString(indentChecks(checks))
+			}
+			buffer.WriteString("}\n")
+			continue
+		}
+
+		if checks == "" {
+			continue
+		}
+
+		buffer.WriteString(fmt.Sprintf("if (%s !== undefined && %s !== null) {\n", fieldExpr, fieldExpr))
+		buffer.WriteString(indentChecks(checks))
+		buffer.WriteString("}\n")
+	}
+
+	return buffer.String()
+}
+
+func (jenny ValidationFunctions) checksForValue(valueExpr string, typeDef ast.Type, path common.ValidationPath, depth int) string {
+	if typeDef.Nullable {
+		nonNullableType := typeDef
+		nonNullableType.Nullable = false
+
+		checks := jenny.checksForValue(valueExpr, nonNullableType, path, depth)
+		if checks == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("if (%s !== null) {\n%s}\n", valueExpr, indentChecks(checks))
+	}
+
+	switch typeDef.Kind {
+	case ast.KindScalar:
+		return jenny.checksForConstraints(valueExpr, typeDef.AsScalar(), path)
+	case ast.KindEnum:
+		return jenny.checksForEnum(valueExpr, typeDef.AsEnum(), path)
+	case ast.KindStruct:
+		return jenny.checksForStruct(valueExpr, typeDef.AsStruct(), path, depth)
+	case ast.KindArray:
+		indexVar := fmt.Sprintf("i%d", depth+1)
+		checks := jenny.checksForValue(valueExpr+"["+indexVar+"]", typeDef.AsArray().ValueType, path.AppendVariable(indexVar), depth+1)
+		if checks == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("for (let %[1]s = 0; %[1]s < %[2]s.length; %[1]s++) {\n%[3]s}\n", indexVar, valueExpr, indentChecks(checks))
+	case ast.KindMap:
+		keyVar := fmt.Sprintf("key%d", depth+1)
+		checks := jenny.checksForValue(valueExpr+"["+keyVar+"]", typeDef.AsMap().ValueType, path.AppendVariable(keyVar), depth+1)
+		if checks == "" {
+			return ""
+		}
+
+		return fmt.Sprintf("for (const %s of Object.keys(%s)) {\n%s}\n", keyVar, valueExpr, indentChecks(checks))
+	case ast.KindRef:
+		return jenny.checksForRef(valueExpr, typeDef, path, depth)
+	default:
+		return ""
+	}
+}
+
+func (jenny ValidationFunctions) checksForRef(valueExpr string, typeDef ast.Type, path common.ValidationPath, depth int) string {
+	ref := typeDef.AsRef()
+	referredObject, found := jenny.context.LocateObjectByRef(ref)
+	if !found {
+		return ""
+	}
+
+	switch referredObject.Type.Kind {
+	case ast.KindStruct:
+		validateFunc := "validate" + tools.UpperCamelCase(tools.CleanupNames(referredObject.Name))
+		if referredPkg := jenny.packageMapper(ref.ReferredPkg); referredPkg != "" {
+			validateFunc = referredPkg + "." + validateFunc
+		}
+
+		return fmt.Sprintf("errors.push(...%[1]s.prefixValidationErrors(%[2]s, %[3]s(%[4]s)));\n", jenny.packageMapper("cog"), jenny.formatPath(path), validateFunc, valueExpr)
+	case ast.KindScalar, ast.KindEnum, ast.KindArray, ast.KindMap, ast.KindRef:
+		// constants don't need to be validated
+		if referredObject.Type.IsConcreteScalar() {
+			return ""
+		}
+
+		return jenny.checksForValue(valueExpr, referredObject.Type, path, depth)
+	default:
+		return ""
+	}
+}
+
+func (jenny ValidationFunctions) checksForEnum(valueExpr string, enumType ast.EnumType, path common.ValidationPath) string {
+	values := tools.Map(enumType.Values, func(value ast.EnumValue) any {
+		return value.Value
+	})
+	formattedValues := tools.Map(values, func(value any) string {
+		return formatValue(value)
+	})
+
+	condition := fmt.Sprintf("![%s].includes(%s)", strings.Join(formattedValues, ", "), valueExpr)
+
+	return jenny.checkCondition(condition, path, common.EnumViolationMessage(values))
+}
+
+func (jenny ValidationFunctions) checksForConstraints(valueExpr string, scalarType ast.ScalarType, path common.ValidationPath) string {
+	var buffer strings.Builder
+
+	for _, constraint := range scalarType.Constraints {
+		if len(constraint.Args) == 0 {
+			continue
+		}
+
+		_, violated := constraintConditions(valueExpr, constraint)
+		buffer.WriteString(jenny.checkCondition(violated, path, common.ConstraintViolationMessage(constraint)))
+	}
+
+	return buffer.String()
+}
+
+// constraintConditions returns the conditions under which the given value
+// expression respects (or violates) the given constraint.
+// Note: the constraint is expected to have at least one argument.
+func constraintConditions(valueExpr string, constraint ast.TypeConstraint) (string, string) {
+	leftOperand := valueExpr
+	operator := string(constraint.Op)
+	rightOperand := formatValue(constraint.Args[0])
+
+	switch constraint.Op {
+	case ast.MinLengthOp:
+		leftOperand = valueExpr + ".length"
+		operator = ">="
+	case ast.MaxLengthOp:
+		leftOperand = valueExpr + ".length"
+		operator = "<="
+	case ast.MultipleOfOp:
+		leftOperand = fmt.Sprintf("%s %% %s", valueExpr, rightOperand)
+		operator = "==="
+		rightOperand = "0"
+	case ast.EqualOp:
+		operator = "==="
+	case ast.NotEqualOp:
+		operator = "!=="
+	}
+
+	switch constraint.Op {
+	case ast.PatternOp:
+		matches := fmt.Sprintf("new RegExp(%s).test(%s)", rightOperand, valueExpr)
+		return matches, "!" + matches
+	case ast.NotPatternOp:
+		matches := fmt.Sprintf("new RegExp(%s).test(%s)", rightOperand, valueExpr)
+		return "!" + matches, matches
+	default:
+		respected := fmt.Sprintf("%s %s %s", leftOperand, operator, rightOperand)
+		return respected, fmt.Sprintf("!(%s)", respected)
+	}
+}
+
+func (jenny ValidationFunctions) checkCondition(condition string, path common.ValidationPath, message string) string {
+	return fmt.Sprintf("if (%s) {\n%s}\n", condition, indentChecks(jenny.violation(path, message)))
+}
+
+func (jenny ValidationFunctions) violation(path common.ValidationPath, message string) string {
+	return fmt.Sprintf("errors.push({ path: %s, message: %s });\n", jenny.formatPath(path), formatValue(message))
+}
+
+func (jenny ValidationFunctions) formatPath(path common.ValidationPath) string {
+	if path.IsStatic() {
+		return formatValue(path.Format(nil))
+	}
+
+	return "`" + path.Format(func(variable string) string {
+		return "${" + variable + "}"
+	}) + "`"
+}
+
+func indentChecks(checks string) string {
+	return prefixLinesWith(strings.TrimSuffix(checks, "\n"), "\t") + "\n"
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"path"
 	"sort"
 	"strings"
 
@@ -32,43 +33,95 @@ type Config struct {
 	// Package name used to generate code into.
 	Package string
 
+	// Path of the schema being parsed. References to other files are
+	// resolved relatively to it.
+	// Optional: references are resolved from the current directory otherwise.
+	Path string
+
+	// ReferencePackages maps paths of files referenced by the schema to the
+	// package in which their definitions are generated.
+	// These files can also be referenced using their `$id`.
+	// If a referenced file isn't listed, its package is guessed from the
+	// directory it lives in.
+	ReferencePackages map[string]string
+
+	// FileLoaded is called with every referenced file read while parsing
+	// the schema.
+	// Optional.
+	FileLoaded func(path string, content []byte)
+
 	SchemaMetadata ast.SchemaMeta
 }
 
 type generator struct {
 	schema *ast.Schema
-	seen   map[string]struct{}
+
+	// schemas generated for files referenced by the main schema, by package.
+	referencedSchemas map[string]*ast.Schema
+
+	// seen maps definitions (`pkg.Name`) to the resource declaring them.
+	seen map[string]string
+
+	// mainResource is the URL (or `$id`) of the schema being parsed.
+	mainResource string
+	// resourcePaths maps the URL (or `$id`) of referenced resources to the
+	// file they were loaded from.
+	resourcePaths map[string]string
+	// referencePackages maps absolute file paths to packages.
+	referencePackages map[string]string
+
+	fileLoaded func(path string, content []byte)
 }
 
-func GenerateAST(schemaReader io.Reader, c Config) (*ast.Schema, error) {
+// GenerateAST parses the given schema. Definitions from other files that
+// are referenced by the schema are generated in their own package: the
+// corresponding schemas are returned after the main one.
+func GenerateAST(schemaReader io.Reader, c Config) (ast.Schemas, error) {
 	g := &generator{
-		seen:   make(map[string]struct{}),
-		schema: ast.NewSchema(c.Package, c.SchemaMetadata),
+		seen:              make(map[string]string),
+		schema:            ast.NewSchema(c.Package, c.SchemaMetadata),
+		referencedSchemas: make(map[string]*ast.Schema),
+		resourcePaths:     make(map[string]string),
+		referencePackages: make(map[string]string, len(c.ReferencePackages)),
+		fileLoaded:        c.FileLoaded,
 	}
 
 	compiler := schemaparser.NewCompiler()
 	compiler.ExtractAnnotations = true
-	if err := compiler.AddResource("schema", schemaReader); err != nil {
+	compiler.LoadURL = g.loadURL
+
+	if err := g.addReferencedResources(compiler, c.ReferencePackages); err != nil {
 		return nil, fmt.Errorf("[%s] %w", c.Package, err)
 	}
 
-	schema, err := compiler.Compile("schema")
+	resourceURL := "schema"
+	if c.Path != "" {
+		resourceURL = c.Path
+	}
+
+	if err := compiler.AddResource(resourceURL, schemaReader); err != nil {
+		return nil, fmt.Errorf("[%s] %w", c.Package, err)
+	}
+
+	schema, err := compiler.Compile(resourceURL)
 	if err != nil {
 		return nil, fmt.Errorf("[%s] %w", c.Package, err)
 	}
 
+	g.mainResource = resourceFromLocation(schema.Location)
+
 	rootObjectName := c.Package
 
 	// The root of the schema is an actual type/object
 	if schema.Ref == nil {
-		if err := g.declareDefinition(rootObjectName, schema); err != nil {
+		if err := g.declareDefinition(c.Package, rootObjectName, schema); err != nil {
 			return nil, fmt.Errorf("[%s] %w", c.Package, err)
 		}
 	} else {
 		rootObjectName = g.definitionNameFromRef(schema)
 
 		// The root of the schema contains definitions, and a reference to the "main" object
-		if err := g.declareDefinition(rootObjectName, schema.Ref); err != nil {
+		if err := g.declareDefinition(c.Package, rootObjectName, schema.Ref); err != nil {
 			return nil, fmt.Errorf("[%s] %w", c.Package, err)
 		}
 	}
@@ -84,26 +137,46 @@ func GenerateAST(schemaReader io.Reader, c Config) (*ast.Schema, error) {
 	// doesn't guarantee the order of the definitions it parses.
 	g.schema.Objects.Sort(orderedmap.SortStrings)
 
-	return g.schema, nil
+	referencedPackages := make([]string, 0, len(g.referencedSchemas))
+	for pkg := range g.referencedSchemas {
+		referencedPackages = append(referencedPackages, pkg)
+	}
+	sort.Strings(referencedPackages)
+
+	schemas := ast.Schemas{g.schema}
+	for _, pkg := range referencedPackages {
+		g.referencedSchemas[pkg].Objects.Sort(orderedmap.SortStrings)
+		schemas = append(schemas, g.referencedSchemas[pkg])
+	}
+
+	return schemas, nil
 }
 
-func (g *generator) declareDefinition(definitionName string, schema *schemaparser.Schema) error {
-	if _, found := g.seen[definitionName]; found {
+func (g *generator) declareDefinition(pkg string, definitionName string, schema *schemaparser.Schema) error {
+	ref := pkg + "." + definitionName
+	resource := resourceFromLocation(schema.Location)
+
+	if declaringResource, found := g.seen[ref]; found {
+		if declaringResource != resource {
+			return fmt.Errorf("definition '%s' is declared both in '%s' and '%s'", ref, declaringResource, resource)
+		}
+
 		return nil
 	}
 
-	g.seen[definitionName] = struct{}{}
+	g.seen[ref] = resource
 
 	def, err := g.walkDefinition(schema)
 	if err != nil {
 		return fmt.Errorf("%s: %w", definitionName, err)
 	}
 
-	g.schema.AddObject(ast.Object{
-		Name: definitionName,
-		Type: def,
+	g.schemaForPackage(pkg).AddObject(ast.Object{
+		Name:       definitionName,
+		Deprecated: schemaDeprecation(schema),
+		Type:       def,
 		SelfRef: ast.RefType{
-			ReferredPkg:  g.schema.Package,
+			ReferredPkg:  pkg,
 			ReferredType: definitionName,
 		},
 	})
@@ -111,6 +184,18 @@ func (g *generator) declareDefinition(definitionName string, schema *schemaparse
 	return nil
 }
 
+func (g *generator) schemaForPackage(pkg string) *ast.Schema {
+	if pkg == g.schema.Package {
+		return g.schema
+	}
+
+	if _, found := g.referencedSchemas[pkg]; !found {
+		g.referencedSchemas[pkg] = ast.NewSchema(pkg, ast.SchemaMeta{})
+	}
+
+	return g.referencedSchemas[pkg]
+}
+
 func (g *generator) walkDefinition(schema *schemaparser.Schema) (ast.Type, error) {
 	var def ast.Type
 	var err error
@@ -276,20 +361,29 @@ func (g *generator) walkAllOf(schema *schemaparser.Schema) (ast.Type, error) {
 }
 
 func (g *generator) definitionNameFromRef(schema *schemaparser.Schema) string {
-	parts := strings.Split(schema.Ref.Location, "/")
+	resource, fragment, _ := strings.Cut(schema.Ref.Location, "#")
+
+	// the reference targets a whole file
+	if strings.Trim(fragment, "/") == "" {
+		filename := path.Base(resource)
+
+		return tools.UpperCamelCase(strings.TrimSuffix(filename, path.Ext(filename)))
+	}
+
+	parts := strings.Split(fragment, "/")
 
 	return parts[len(parts)-1] // Very naive
 }
 
 func (g *generator) walkRef(schema *schemaparser.Schema) (ast.Type, error) {
+	referredPkg := g.packageForResource(resourceFromLocation(schema.Ref.Location))
 	referredKindName := g.definitionNameFromRef(schema)
 
-	if err := g.declareDefinition(referredKindName, schema.Ref); err != nil {
+	if err := g.declareDefinition(referredPkg, referredKindName, schema.Ref); err != nil {
 		return ast.Type{}, err
 	}
 
-	// TODO: get the correct package for the referred type
-	return ast.NewRef(g.schema.Package, referredKindName), nil
+	return ast.NewRef(referredPkg, referredKindName), nil
 }
 
 func (g *generator) walkString(schema *schemaparser.Schema) (ast.Type, error) {
@@ -308,6 +402,11 @@ func (g *generator) walkString(schema *schemaparser.Schema) (ast.Type, error) {
 	// ```
 	if schema.Pattern != nil && tools.RegexMatchesConstantString(schema.Pattern.String()) {
 		def.Scalar.Value = tools.ConstantStringFromRegex(schema.Pattern.String())
+	} else if schema.Pattern != nil {
+		def.Scalar.Constraints = append(def.Scalar.Constraints, ast.TypeConstraint{
+			Op:   ast.PatternOp,
+			Args: []any{schema.Pattern.String()},
+		})
 	}
 
 	if schema.Format == formatDateTime {
@@ -452,6 +551,7 @@ func (g *generator) walkObject(schema *schemaparser.Schema) (ast.Type, error) {
 		}
 
 		field := ast.NewStructField(name, fieldDef, ast.Comments(schemaComments(property)))
+		field.Deprecated = schemaDeprecation(property)
 		field.Required = tools.ItemInList(name, schema.Required)
 
 		fields = append(fields, field)
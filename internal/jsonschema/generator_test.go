@@ -1,9 +1,12 @@
 package jsonschema
 
 import (
+	"fmt"
+	"path/filepath"
 	"strings"
 	"testing"
 
+	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/testutils"
 	"github.com/stretchr/testify/require"
 )
@@ -17,11 +20,19 @@ func TestGenerateAST(t *testing.T) {
 	test.Run(t, func(tc *testutils.Test[string]) {
 		req := require.New(tc)
 
-		schemaAst, err := GenerateAST(tc.OpenInput("schema.json"), Config{Package: "grafanatest"})
+		schemaAsts, err := GenerateAST(tc.OpenInput("schema.json"), Config{
+			Package: "grafanatest",
+			Path:    filepath.Join(tc.RootDir, "schema.json"),
+		})
 		req.NoError(err)
-		req.NotNil(schemaAst)
+		req.NotEmpty(schemaAsts)
 
-		tc.WriteJSON(testutils.GeneratorOutputFile, schemaAst)
+		tc.WriteJSON(testutils.GeneratorOutputFile, schemaAsts[0])
+
+		// schemas generated for referenced files
+		for _, schemaAst := range schemaAsts[1:] {
+			tc.WriteJSON(fmt.Sprintf("ir_%s.json", schemaAst.Package), schemaAst)
+		}
 	})
 }
 
@@ -45,11 +56,71 @@ func TestGenerateAST_parsesEnumValues(t *testing.T) {
   "$schema": "http://json-schema.org/draft-07/schema#"
 }`)
 
-	schemaAst, err := GenerateAST(input, Config{Package: "grafanatest"})
+	schemaAsts, err := GenerateAST(input, Config{Package: "grafanatest"})
 	req.NoError(err)
-	req.NotNil(t, schemaAst)
+	req.Len(schemaAsts, 1)
 
-	enumType := schemaAst.Objects.At(0).Type.Enum
+	enumType := schemaAsts[0].Objects.At(0).Type.Enum
 
 	req.Equal(int64(1), enumType.Values[0].Value)
 }
+
+func TestGenerateAST_resolvesReferencesByID(t *testing.T) {
+	req := require.New(t)
+
+	input := strings.NewReader(`{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "unit": {
+      "$ref": "https://example.com/schemas/units.json#/$defs/Unit"
+    }
+  }
+}`)
+
+	schemaAsts, err := GenerateAST(input, Config{
+		Package: "grafanatest",
+		ReferencePackages: map[string]string{
+			"../../testdata/jsonschema_references/units.json": "units",
+		},
+	})
+	req.NoError(err)
+	req.Len(schemaAsts, 2)
+
+	field, found := schemaAsts[0].Objects.Get("grafanatest").Type.AsStruct().FieldByName("unit")
+	req.True(found)
+	req.Equal(ast.NewRef("units", "Unit"), field.Type)
+
+	req.Equal("units", schemaAsts[1].Package)
+	req.True(schemaAsts[1].Objects.Has("Unit"))
+}
+
+func TestGenerateAST_rejectsConflictingDefinitions(t *testing.T) {
+	req := require.New(t)
+
+	input := strings.NewReader(`{
+  "$schema": "https://json-schema.org/draft/2020-12/schema",
+  "type": "object",
+  "properties": {
+    "local": {
+      "$ref": "#/$defs/Unit"
+    },
+    "remote": {
+      "$ref": "https://example.com/schemas/units.json#/$defs/Unit"
+    }
+  },
+  "$defs": {
+    "Unit": {
+      "type": "string"
+    }
+  }
+}`)
+
+	_, err := GenerateAST(input, Config{
+		Package: "grafanatest",
+		ReferencePackages: map[string]string{
+			"../../testdata/jsonschema_references/units.json": "grafanatest",
+		},
+	})
+	req.ErrorContains(err, "definition 'grafanatest.Unit' is declared both in")
+}
@@ -0,0 +1,153 @@
+package jsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	schemaparser "github.com/santhosh-tekuri/jsonschema/v5"
+)
+
+// addReferencedResources registers the given files with the compiler, under
+// their path as well as their `$id`.
+func (g *generator) addReferencedResources(compiler *schemaparser.Compiler, referencePackages map[string]string) error {
+	for file, pkg := range referencePackages {
+		absPath, err := filepath.Abs(file)
+		if err != nil {
+			return err
+		}
+
+		g.referencePackages[absPath] = pkg
+
+		content, err := g.readFile(absPath)
+		if err != nil {
+			return err
+		}
+
+		fileURL := fileURLFromPath(absPath)
+		g.resourcePaths[fileURL] = absPath
+		if err := compiler.AddResource(fileURL, bytes.NewReader(content)); err != nil {
+			return err
+		}
+
+		id := resourceID(content, fileURL)
+		if id == "" || id == fileURL {
+			continue
+		}
+
+		g.resourcePaths[id] = absPath
+		if err := compiler.AddResource(id, bytes.NewReader(content)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// loadURL loads resources referenced by the schema that weren't registered
+// beforehand, and keeps track of the files they originate from.
+func (g *generator) loadURL(resourceURL string) (io.ReadCloser, error) {
+	parsedURL, err := url.Parse(resourceURL)
+	if err != nil {
+		return nil, err
+	}
+
+	if parsedURL.Scheme != "file" {
+		return nil, fmt.Errorf("could not load '%s': only references to local files are supported", resourceURL)
+	}
+
+	content, err := g.readFile(filepath.FromSlash(parsedURL.Path))
+	if err != nil {
+		return nil, err
+	}
+
+	g.resourcePaths[resourceURL] = filepath.FromSlash(parsedURL.Path)
+	if id := resourceID(content, resourceURL); id != "" {
+		g.resourcePaths[id] = filepath.FromSlash(parsedURL.Path)
+	}
+
+	return io.NopCloser(bytes.NewReader(content)), nil
+}
+
+func (g *generator) readFile(path string) ([]byte, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	if g.fileLoaded != nil {
+		g.fileLoaded(path, content)
+	}
+
+	return content, nil
+}
+
+// packageForResource returns the package in which the definitions of the
+// given resource are generated.
+func (g *generator) packageForResource(resource string) string {
+	if resource == g.mainResource {
+		return g.schema.Package
+	}
+
+	file, found := g.resourcePaths[resource]
+	if !found {
+		return g.schema.Package
+	}
+
+	if pkg, found := g.referencePackages[file]; found {
+		return pkg
+	}
+
+	return filepath.Base(filepath.Dir(file))
+}
+
+// resourceID returns the `$id` (or `id`, for older drafts) declared by the
+// given JSON document, resolved against the URL it was loaded from and
+// without its fragment.
+func resourceID(content []byte, resourceURL string) string {
+	var document map[string]any
+	if err := json.Unmarshal(content, &document); err != nil {
+		return ""
+	}
+
+	for _, key := range []string{"$id", "id"} {
+		id, ok := document[key].(string)
+		if !ok || id == "" {
+			continue
+		}
+
+		base, err := url.Parse(resourceURL)
+		if err != nil {
+			return ""
+		}
+
+		resolved, err := base.Parse(id)
+		if err != nil {
+			return ""
+		}
+
+		return resourceFromLocation(resolved.String())
+	}
+
+	return ""
+}
+
+func resourceFromLocation(location string) string {
+	resource, _, _ := strings.Cut(location, "#")
+
+	return resource
+}
+
+func fileURLFromPath(absPath string) string {
+	fileURL := url.URL{Scheme: "file", Path: filepath.ToSlash(absPath)}
+	if !strings.HasPrefix(fileURL.Path, "/") {
+		fileURL.Path = "/" + fileURL.Path
+	}
+
+	return fileURL.String()
+}
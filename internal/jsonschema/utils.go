@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"strings"
 
+	"github.com/grafana/cog/internal/ast"
 	schemaparser "github.com/santhosh-tekuri/jsonschema/v5"
 )
 
@@ -24,6 +25,14 @@ func schemaComments(schema *schemaparser.Schema) []string {
 	return filtered
 }
 
+func schemaDeprecation(schema *schemaparser.Schema) *ast.Deprecation {
+	if !schema.Deprecated {
+		return nil
+	}
+
+	return &ast.Deprecation{}
+}
+
 func unwrapJSONNumber(input any) any {
 	if val, ok := input.(json.Number); ok {
 		asInt, err := val.Int64()
@@ -10,4 +10,8 @@ type Config struct {
 
 	// Builders indicates whether builders should be generated or not.
 	Builders bool
+
+	// Validation indicates whether validation methods should be generated
+	// alongside types or not.
+	Validation bool
 }
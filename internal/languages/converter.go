@@ -0,0 +1,921 @@
+package languages
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+)
+
+// CodeConverter is implemented by languages able to turn builder calls into
+// source code.
+type CodeConverter interface {
+	ToCode(context Context, call BuilderCall) (string, error)
+}
+
+// BuilderCall describes how a value can be produced by a builder: which
+// arguments are given to its constructor and which options are called.
+type BuilderCall struct {
+	Builder ast.Builder
+	Args    []ConvertedValue
+	Options []OptionCall
+}
+
+// OptionCall describes a call to one of the options of a builder.
+type OptionCall struct {
+	Option ast.Option
+	Args   []ConvertedValue
+}
+
+// ConvertedValue is a value given to a builder's constructor or option.
+// Value always holds the value as it was decoded from JSON. Depending on its
+// type, the value is also described as produced by a builder, or as a list
+// of values produced by builders.
+type ConvertedValue struct {
+	Type    ast.Type
+	Value   any
+	Builder *BuilderCall
+	Items   []ConvertedValue
+}
+
+func (value ConvertedValue) IsBuilder() bool {
+	return value.Builder != nil
+}
+
+func (value ConvertedValue) IsBuildersList() bool {
+	return value.Items != nil
+}
+
+// Converter turns values decoded from JSON into BuilderCall describing how
+// to produce them with the builders available in a Context.
+type Converter struct {
+	context  Context
+	warnings []string
+}
+
+func NewConverter(context Context) *Converter {
+	return &Converter{context: context}
+}
+
+// Convert describes how the given value, expected to be an instance of the
+// given object, can be produced by the builders of the context.
+// Parts of the value that can not be produced by any builder option are
+// reported as warnings.
+func (converter *Converter) Convert(objectRef ast.RefType, input any) (BuilderCall, []string, error) {
+	converter.warnings = nil
+
+	builder, err := converter.selectBuilder(converter.buildersForObject(objectRef), input, objectRef.String())
+	if err != nil {
+		return BuilderCall{}, nil, err
+	}
+
+	call, err := converter.convertBuilder(builder, input)
+	if err != nil {
+		return BuilderCall{}, nil, err
+	}
+
+	return call, converter.warnings, nil
+}
+
+func (converter *Converter) warn(format string, args ...any) {
+	warning := fmt.Sprintf(format, args...)
+	for _, existing := range converter.warnings {
+		if existing == warning {
+			return
+		}
+	}
+
+	converter.warnings = append(converter.warnings, warning)
+}
+
+func (converter *Converter) buildersForObject(objectRef ast.RefType) ast.Builders {
+	var candidates ast.Builders
+	for _, builder := range converter.context.Builders {
+		if builder.For.SelfRef.ReferredPkg == objectRef.ReferredPkg && builder.For.SelfRef.ReferredType == objectRef.ReferredType {
+			candidates = append(candidates, builder)
+		}
+	}
+
+	return candidates
+}
+
+func (converter *Converter) buildersForVariant(variant ast.SchemaVariant) ast.Builders {
+	var candidates ast.Builders
+	for _, builder := range converter.context.Builders {
+		if builder.For.Type.ImplementedVariant() == string(variant) {
+			candidates = append(candidates, builder)
+		}
+	}
+
+	return candidates
+}
+
+// selectBuilder picks the builder best suited to produce the given value.
+// Builders setting constant values in their constructor are only suitable
+// for values holding these constants (ex: a builder for "timeseries" panels
+// can only produce a panel with a "timeseries" type).
+func (converter *Converter) selectBuilder(candidates ast.Builders, input any, target string) (ast.Builder, error) {
+	if len(candidates) == 0 {
+		return ast.Builder{}, fmt.Errorf("no builder found for %s", target)
+	}
+
+	type scoredBuilder struct {
+		builder   ast.Builder
+		constants int
+		fields    int
+		canonical bool
+	}
+
+	var best []scoredBuilder
+	for _, builder := range candidates {
+		constants, ok := converter.constructorScore(builder, input)
+		if !ok {
+			continue
+		}
+
+		candidate := scoredBuilder{
+			builder:   builder,
+			constants: constants,
+			fields:    converter.knownFieldsCount(builder.For.Type, input),
+			canonical: builder.Package == builder.For.SelfRef.ReferredPkg && builder.Name == builder.For.Name,
+		}
+
+		if len(best) == 0 || candidate.constants > best[0].constants ||
+			(candidate.constants == best[0].constants && candidate.fields > best[0].fields) {
+			best = []scoredBuilder{candidate}
+			continue
+		}
+
+		if candidate.constants == best[0].constants && candidate.fields == best[0].fields {
+			best = append(best, candidate)
+		}
+	}
+
+	if len(best) == 0 {
+		return ast.Builder{}, fmt.Errorf("no builder for %s matches the given value", target)
+	}
+
+	if len(best) == 1 {
+		return best[0].builder, nil
+	}
+
+	for _, candidate := range best {
+		if candidate.canonical {
+			return candidate.builder, nil
+		}
+	}
+
+	names := make([]string, 0, len(best))
+	for _, candidate := range best {
+		names = append(names, builderName(candidate.builder))
+	}
+	converter.warn("%s: several builders match the value (%s), using %s", target, strings.Join(names, ", "), names[0])
+
+	return best[0].builder, nil
+}
+
+func (converter *Converter) constructorScore(builder ast.Builder, input any) (int, bool) {
+	score := 0
+	for _, assignment := range builder.Constructor.Assignments {
+		if assignment.Value.Constant == nil {
+			continue
+		}
+
+		value, found := converter.valueAt(builder.For.Type, input, assignment.Path)
+		if !found || !valuesEqual(value, assignment.Value.Constant) {
+			return 0, false
+		}
+
+		score++
+	}
+
+	return score, true
+}
+
+func (converter *Converter) knownFieldsCount(def ast.Type, input any) int {
+	resolved := converter.context.ResolveRefs(def)
+	inputMap, ok := input.(map[string]any)
+	if !ok || !resolved.IsStruct() {
+		return 0
+	}
+
+	count := 0
+	for _, field := range resolved.AsStruct().Fields {
+		if _, found := inputMap[field.Name]; found {
+			count++
+		}
+	}
+
+	return count
+}
+
+func (converter *Converter) convertBuilder(builder ast.Builder, input any) (BuilderCall, error) {
+	if builder.For.Type.IsStructGeneratedFromDisjunction() {
+		return converter.convertDisjunctionBuilder(builder, input)
+	}
+
+	inputMap, ok := input.(map[string]any)
+	if !ok {
+		return BuilderCall{}, fmt.Errorf("%s: expected an object, got %T", builderName(builder), input)
+	}
+
+	call := BuilderCall{Builder: builder}
+	covered := make(coveredPaths)
+
+	// constructor
+	args := make(map[string]any)
+	for _, assignment := range builder.Constructor.Assignments {
+		value, found := converter.valueAt(builder.For.Type, input, assignment.Path)
+		_, _ = converter.readAssignment(assignment, value, found, nil, args)
+		covered.add(assignment.Path)
+	}
+
+	for _, arg := range builder.Constructor.Args {
+		converted, err := converter.convertValue(arg.Type, args[arg.Name])
+		if err != nil {
+			return BuilderCall{}, fmt.Errorf("%s: argument '%s': %w", builderName(builder), arg.Name, err)
+		}
+
+		call.Args = append(call.Args, converted)
+	}
+
+	// options
+	for _, group := range groupOptionsByPath(builder.Options) {
+		if covered.covers(group.path) {
+			continue
+		}
+
+		value, found := converter.valueAt(builder.For.Type, input, group.path)
+		if !found {
+			continue
+		}
+
+		warnings := converter.warnings
+		calls, ok := converter.perItemCalls(builder, group.options, value)
+		if !ok {
+			converter.warnings = warnings
+			calls, ok = converter.directCall(builder, group.options, input)
+		}
+		if !ok {
+			continue
+		}
+
+		for _, optCall := range calls {
+			for _, assignment := range optCall.Option.Assignments {
+				covered.add(assignment.Path)
+			}
+
+			if !optCall.isDefault() {
+				call.Options = append(call.Options, optCall)
+			}
+		}
+	}
+
+	keys := make([]string, 0, len(inputMap))
+	for key := range inputMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		if inputMap[key] == nil || covered.touches(key) {
+			continue
+		}
+
+		converter.warn("%s: no option to set '%s'", builderName(builder), key)
+	}
+
+	return call, nil
+}
+
+// convertDisjunctionBuilder describes how a value can be produced by the
+// builder of a struct generated from a disjunction. Such values are not
+// objects in JSON: they are held by one of the struct's fields, set by the
+// option matching the value best.
+func (converter *Converter) convertDisjunctionBuilder(builder ast.Builder, input any) (BuilderCall, error) {
+	calls, ok := converter.directCall(builder, builder.Options, input)
+	if !ok {
+		return BuilderCall{}, fmt.Errorf("%s: value does not match any branch of the disjunction", builderName(builder))
+	}
+
+	return BuilderCall{Builder: builder, Options: calls}, nil
+}
+
+// perItemCalls describes the given value as a series of calls to options
+// setting a single item of a list ("append" assignments) or of a map
+// ("index" assignments).
+func (converter *Converter) perItemCalls(builder ast.Builder, options []ast.Option, value any) ([]OptionCall, bool) {
+	var appendOptions []ast.Option
+	var indexOptions []ast.Option
+	for _, opt := range options {
+		if len(opt.Assignments) != 1 {
+			continue
+		}
+
+		switch opt.Assignments[0].Method {
+		case ast.AppendAssignment:
+			appendOptions = append(appendOptions, opt)
+		case ast.IndexAssignment:
+			if opt.Assignments[0].Index.Argument != nil {
+				indexOptions = append(indexOptions, opt)
+			}
+		default:
+		}
+	}
+
+	if items, ok := value.([]any); ok && len(appendOptions) != 0 {
+		calls := make([]OptionCall, 0, len(items))
+		for _, item := range items {
+			itemCall, found := converter.bestCall(builder, appendOptions, func(opt ast.Option, args map[string]any) (int, bool) {
+				return converter.readAssignment(opt.Assignments[0], item, item != nil, nil, args)
+			})
+			if !found {
+				return nil, false
+			}
+
+			calls = append(calls, itemCall)
+		}
+
+		return calls, true
+	}
+
+	if entries, ok := value.(map[string]any); ok && len(indexOptions) != 0 {
+		keys := make([]string, 0, len(entries))
+		for key := range entries {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		calls := make([]OptionCall, 0, len(entries))
+		for _, key := range keys {
+			entry := entries[key]
+			entryCall, found := converter.bestCall(builder, indexOptions, func(opt ast.Option, args map[string]any) (int, bool) {
+				return converter.readAssignment(opt.Assignments[0], entry, entry != nil, key, args)
+			})
+			if !found {
+				return nil, false
+			}
+
+			calls = append(calls, entryCall)
+		}
+
+		return calls, true
+	}
+
+	return nil, false
+}
+
+// directCall describes the given value as a single call to the most suitable
+// option among the ones given.
+func (converter *Converter) directCall(builder ast.Builder, options []ast.Option, input any) ([]OptionCall, bool) {
+	var candidates []ast.Option
+	for _, opt := range options {
+		direct := true
+		for _, assignment := range opt.Assignments {
+			direct = direct && assignment.Method == ast.DirectAssignment
+		}
+
+		if direct {
+			candidates = append(candidates, opt)
+		}
+	}
+
+	call, found := converter.bestCall(builder, candidates, func(opt ast.Option, args map[string]any) (int, bool) {
+		score := 0
+		for _, assignment := range opt.Assignments {
+			value, found := converter.valueAt(builder.For.Type, input, assignment.Path)
+			assignmentScore, ok := converter.readAssignment(assignment, value, found, nil, args)
+			if !ok {
+				return 0, false
+			}
+
+			score += assignmentScore
+		}
+
+		return score, true
+	})
+	if !found {
+		return nil, false
+	}
+
+	return []OptionCall{call}, true
+}
+
+// bestCall returns a call to the option best matching the input, as read by
+// the given function.
+func (converter *Converter) bestCall(builder ast.Builder, options []ast.Option, read func(opt ast.Option, args map[string]any) (int, bool)) (OptionCall, bool) {
+	var best OptionCall
+	bestScore := -1
+
+	// only the warnings raised while converting the selected call are kept
+	warnings := converter.warnings
+	bestWarnings := warnings
+
+	for _, opt := range options {
+		converter.warnings = append([]string(nil), warnings...)
+
+		args := make(map[string]any)
+		score, ok := read(opt, args)
+		if !ok {
+			continue
+		}
+
+		call, argsScore, ok := converter.optionCall(builder, opt, args)
+		if !ok {
+			continue
+		}
+
+		if score+argsScore > bestScore {
+			best = call
+			bestScore = score + argsScore
+			bestWarnings = converter.warnings
+		}
+	}
+
+	converter.warnings = bestWarnings
+
+	return best, bestScore >= 0
+}
+
+func (converter *Converter) optionCall(builder ast.Builder, opt ast.Option, args map[string]any) (OptionCall, int, bool) {
+	call := OptionCall{Option: opt}
+	score := 0
+
+	for _, arg := range opt.Args {
+		value, found := args[arg.Name]
+		if !found {
+			return OptionCall{}, 0, false
+		}
+
+		argScore, matches := converter.matchScore(value, arg.Type)
+		if !matches {
+			return OptionCall{}, 0, false
+		}
+
+		converted, err := converter.convertValue(arg.Type, value)
+		if err != nil {
+			converter.warn("%s: option '%s' skipped: %s", builderName(builder), opt.Name, err)
+			return OptionCall{}, 0, false
+		}
+
+		score += argScore
+		call.Args = append(call.Args, converted)
+	}
+
+	return call, score, true
+}
+
+// readAssignment matches the value targeted by an assignment against the
+// value it assigns. Values for the arguments used by the assignment are
+// stored in args.
+func (converter *Converter) readAssignment(assignment ast.Assignment, value any, found bool, index any, args map[string]any) (int, bool) {
+	if assignment.Index != nil && assignment.Index.Argument != nil && index != nil {
+		args[assignment.Index.Argument.Name] = index
+	}
+
+	if !found {
+		return 0, false
+	}
+
+	return converter.readAssignmentValue(assignment.Value, value, args)
+}
+
+func (converter *Converter) readAssignmentValue(assignmentValue ast.AssignmentValue, value any, args map[string]any) (int, bool) {
+	if assignmentValue.Argument != nil {
+		args[assignmentValue.Argument.Name] = value
+		return 0, true
+	}
+
+	if assignmentValue.Envelope != nil {
+		score := 0
+		for _, envelopeValue := range assignmentValue.Envelope.Values {
+			fieldValue, found := converter.valueAt(assignmentValue.Envelope.Type, value, envelopeValue.Path)
+			if !found {
+				return 0, false
+			}
+
+			fieldScore, ok := converter.readAssignmentValue(envelopeValue.Value, fieldValue, args)
+			if !ok {
+				return 0, false
+			}
+
+			score += fieldScore
+		}
+
+		return score, true
+	}
+
+	if !valuesEqual(value, assignmentValue.Constant) {
+		return 0, false
+	}
+
+	return 1, true
+}
+
+func (converter *Converter) convertValue(def ast.Type, value any) (ConvertedValue, error) {
+	converted := ConvertedValue{Type: def, Value: value}
+	if value == nil {
+		return converted, nil
+	}
+
+	if def.IsArray() && converter.resolvesToBuilder(def.AsArray().ValueType) {
+		items, ok := value.([]any)
+		if !ok {
+			return ConvertedValue{}, fmt.Errorf("expected a list, got %T", value)
+		}
+
+		converted.Items = make([]ConvertedValue, 0, len(items))
+		for _, item := range items {
+			convertedItem, err := converter.convertValue(def.AsArray().ValueType, item)
+			if err != nil {
+				return ConvertedValue{}, err
+			}
+
+			converted.Items = append(converted.Items, convertedItem)
+		}
+
+		return converted, nil
+	}
+
+	if def.IsDisjunction() && converter.context.ResolveToBuilder(def) {
+		branch, _, found := converter.bestBranch(def.AsDisjunction().Branches, value)
+		if !found {
+			return ConvertedValue{}, fmt.Errorf("value does not match any branch of the disjunction")
+		}
+
+		return converter.convertValue(def.AsDisjunction().Branches[branch], value)
+	}
+
+	var candidates ast.Builders
+	target := ""
+	if def.IsRef() {
+		candidates = converter.buildersForObject(def.AsRef())
+		target = def.AsRef().String()
+	}
+	if slot, found := converter.context.ResolveToComposableSlot(def); found && !def.IsArray() {
+		candidates = converter.buildersForVariant(slot.AsComposableSlot().Variant)
+		target = string(slot.AsComposableSlot().Variant)
+	}
+
+	if len(candidates) == 0 {
+		return converted, nil
+	}
+
+	builder, err := converter.selectBuilder(candidates, value, target)
+	if err != nil {
+		return ConvertedValue{}, err
+	}
+
+	call, err := converter.convertBuilder(builder, value)
+	if err != nil {
+		return ConvertedValue{}, err
+	}
+
+	converted.Builder = &call
+
+	return converted, nil
+}
+
+// MatchingBranch returns the index of the branch best matching the given
+// value, decoded from JSON.
+func MatchingBranch(context Context, branches ast.Types, value any) (int, bool) {
+	index, _, found := NewConverter(context).bestBranch(branches, value)
+
+	return index, found
+}
+
+func (converter *Converter) resolvesToBuilder(def ast.Type) bool {
+	_, isSlot := converter.context.ResolveToComposableSlot(def)
+
+	return isSlot || converter.context.ResolveToBuilder(def)
+}
+
+func (converter *Converter) bestBranch(branches ast.Types, value any) (int, int, bool) {
+	best := -1
+	bestScore := -1
+
+	for i, branch := range branches {
+		score, ok := converter.matchScore(value, branch)
+		if ok && score > bestScore {
+			best = i
+			bestScore = score
+		}
+	}
+
+	return best, bestScore, best >= 0
+}
+
+// valueAt reads the value at the given path, starting from a value of the
+// given type. Null values are considered absent.
+func (converter *Converter) valueAt(rootType ast.Type, value any, path ast.Path) (any, bool) {
+	current := value
+	parentType := rootType
+
+	for _, item := range path {
+		// structs generated from disjunctions are not represented in JSON:
+		// the value is held by one of the fields, as long as its type matches.
+		if converter.context.ResolveRefs(parentType).IsStructGeneratedFromDisjunction() {
+			if _, ok := converter.matchScore(current, item.Type); !ok {
+				return nil, false
+			}
+
+			parentType = item.Type
+			continue
+		}
+
+		currentMap, ok := current.(map[string]any)
+		if !ok {
+			return nil, false
+		}
+
+		current, ok = currentMap[item.Identifier]
+		if !ok || current == nil {
+			return nil, false
+		}
+
+		parentType = item.Type
+		if item.TypeHint != nil {
+			parentType = *item.TypeHint
+		}
+	}
+
+	return current, current != nil
+}
+
+// matchScore tells whether a value can be an instance of the given type.
+// The score reflects how closely they match: the number of constant values
+// found in both the value and the type.
+func (converter *Converter) matchScore(value any, def ast.Type) (int, bool) {
+	if value == nil {
+		return 0, true
+	}
+
+	switch def.Kind {
+	case ast.KindRef:
+		referredObj, found := converter.context.LocateObjectByRef(def.AsRef())
+		if !found {
+			return 0, true
+		}
+
+		return converter.matchScore(value, referredObj.Type)
+	case ast.KindScalar:
+		return matchScalarScore(value, def.AsScalar())
+	case ast.KindEnum:
+		for _, member := range def.AsEnum().Values {
+			if valuesEqual(value, member.Value) {
+				return 1, true
+			}
+		}
+
+		return 0, false
+	case ast.KindArray:
+		items, ok := value.([]any)
+		if !ok {
+			return 0, false
+		}
+
+		for _, item := range items {
+			if _, matches := converter.matchScore(item, def.AsArray().ValueType); !matches {
+				return 0, false
+			}
+		}
+
+		return 0, true
+	case ast.KindMap:
+		entries, ok := value.(map[string]any)
+		if !ok {
+			return 0, false
+		}
+
+		for _, entry := range entries {
+			if _, matches := converter.matchScore(entry, def.AsMap().ValueType); !matches {
+				return 0, false
+			}
+		}
+
+		return 0, true
+	case ast.KindStruct:
+		if def.IsStructGeneratedFromDisjunction() {
+			branches := make(ast.Types, 0, len(def.AsStruct().Fields))
+			for _, field := range def.AsStruct().Fields {
+				branches = append(branches, field.Type)
+			}
+
+			_, score, found := converter.bestBranch(branches, value)
+
+			return score, found
+		}
+
+		return matchStructScore(value, def.AsStruct())
+	case ast.KindDisjunction:
+		_, score, found := converter.bestBranch(def.AsDisjunction().Branches, value)
+
+		return score, found
+	case ast.KindIntersection, ast.KindComposableSlot:
+		_, ok := value.(map[string]any)
+		return 0, ok
+	default:
+		return 0, true
+	}
+}
+
+func matchStructScore(value any, def ast.StructType) (int, bool) {
+	fields, ok := value.(map[string]any)
+	if !ok {
+		return 0, false
+	}
+
+	score := 0
+	for _, field := range def.Fields {
+		if !field.Type.IsConcreteScalar() {
+			continue
+		}
+
+		fieldValue, found := fields[field.Name]
+		if !found {
+			continue
+		}
+
+		if !valuesEqual(fieldValue, field.Type.AsScalar().Value) {
+			return 0, false
+		}
+
+		score++
+	}
+
+	return score, true
+}
+
+func matchScalarScore(value any, def ast.ScalarType) (int, bool) {
+	if def.IsConcrete() {
+		if valuesEqual(value, def.Value) {
+			return 1, true
+		}
+
+		return 0, false
+	}
+
+	switch def.ScalarKind {
+	case ast.KindAny:
+		return 0, true
+	case ast.KindNull:
+		return 0, value == nil
+	case ast.KindString, ast.KindBytes:
+		_, ok := value.(string)
+		return 0, ok
+	case ast.KindBool:
+		_, ok := value.(bool)
+		return 0, ok
+	case ast.KindFloat32, ast.KindFloat64:
+		_, ok := numberValue(value)
+		return 0, ok
+	default:
+		number, ok := numberValue(value)
+		return 0, ok && number == float64(int64(number))
+	}
+}
+
+func numberValue(value any) (float64, bool) {
+	switch number := value.(type) {
+	case json.Number:
+		asFloat, err := number.Float64()
+		return asFloat, err == nil
+	case float64:
+		return number, true
+	case float32:
+		return float64(number), true
+	case int:
+		return float64(number), true
+	case int64:
+		return float64(number), true
+	default:
+		return 0, false
+	}
+}
+
+// EnumMember returns the member of the enum holding the given value, or
+// the first one if none does.
+func EnumMember(enum ast.EnumType, value any) ast.EnumValue {
+	for _, member := range enum.Values {
+		if valuesEqual(member.Value, value) {
+			return member
+		}
+	}
+
+	return enum.Values[0]
+}
+
+// valuesEqual compares values by their JSON representation, since JSON
+// values and constants defined in schemas don't necessarily use the same Go
+// types.
+func valuesEqual(a any, b any) bool {
+	aJSON, aErr := json.Marshal(normalizeNumbers(a))
+	bJSON, bErr := json.Marshal(normalizeNumbers(b))
+
+	return aErr == nil && bErr == nil && bytes.Equal(aJSON, bJSON)
+}
+
+func normalizeNumbers(value any) any {
+	switch typed := value.(type) {
+	case []any:
+		normalized := make([]any, 0, len(typed))
+		for _, item := range typed {
+			normalized = append(normalized, normalizeNumbers(item))
+		}
+
+		return normalized
+	case map[string]any:
+		normalized := make(map[string]any, len(typed))
+		for key, item := range typed {
+			normalized[key] = normalizeNumbers(item)
+		}
+
+		return normalized
+	default:
+		if number, ok := numberValue(value); ok {
+			return number
+		}
+
+		return value
+	}
+}
+
+func (call OptionCall) isDefault() bool {
+	if call.Option.Default == nil || len(call.Option.Default.ArgsValues) != len(call.Args) {
+		return false
+	}
+
+	for i, arg := range call.Args {
+		if !valuesEqual(arg.Value, call.Option.Default.ArgsValues[i]) {
+			return false
+		}
+	}
+
+	return true
+}
+
+type optionsGroup struct {
+	path    ast.Path
+	options []ast.Option
+}
+
+// groupOptionsByPath groups options by the path they assign a value to,
+// preserving the order in which options are defined.
+func groupOptionsByPath(options []ast.Option) []optionsGroup {
+	var groups []optionsGroup
+	positions := make(map[string]int)
+
+	for _, opt := range options {
+		if len(opt.Assignments) == 0 {
+			continue
+		}
+
+		path := opt.Assignments[0].Path
+		position, found := positions[path.String()]
+		if !found {
+			positions[path.String()] = len(groups)
+			groups = append(groups, optionsGroup{path: path, options: []ast.Option{opt}})
+			continue
+		}
+
+		groups[position].options = append(groups[position].options, opt)
+	}
+
+	return groups
+}
+
+// coveredPaths keeps track of paths already set by a builder call.
+type coveredPaths map[string]struct{}
+
+func (paths coveredPaths) add(path ast.Path) {
+	paths[path.String()] = struct{}{}
+}
+
+// covers tells whether the given path or one of its parents is covered.
+func (paths coveredPaths) covers(path ast.Path) bool {
+	for i := range path {
+		if _, found := paths[path[:i+1].String()]; found {
+			return true
+		}
+	}
+
+	return false
+}
+
+// touches tells whether the given field or any path within it is covered.
+func (paths coveredPaths) touches(field string) bool {
+	for path := range paths {
+		if path == field || strings.HasPrefix(path, field+".") {
+			return true
+		}
+	}
+
+	return false
+}
+
+func builderName(builder ast.Builder) string {
+	return builder.Package + "." + builder.Name
+}
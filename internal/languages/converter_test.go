@@ -0,0 +1,156 @@
+package languages
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/ast/compiler"
+	"github.com/grafana/cog/internal/tools"
+	"github.com/stretchr/testify/require"
+)
+
+func converterTestContext(t *testing.T) Context {
+	t.Helper()
+
+	schema := ast.NewSchema("dashboard", ast.SchemaMeta{})
+	schema.AddObject(ast.NewObject("dashboard", "Style", ast.NewEnum([]ast.EnumValue{
+		{Name: "dark", Type: ast.String(), Value: "dark"},
+		{Name: "light", Type: ast.String(), Value: "light"},
+	})))
+	schema.AddObject(ast.NewObject("dashboard", "Link", ast.NewStruct(
+		ast.NewStructField("title", ast.String(), ast.Required()),
+		ast.NewStructField("url", ast.String(ast.Nullable())),
+	)))
+	schema.AddObject(ast.NewObject("dashboard", "Dashboard", ast.NewStruct(
+		ast.NewStructField("title", ast.String(), ast.Required()),
+		ast.NewStructField("revision", ast.NewScalar(ast.KindInt64, ast.Nullable())),
+		ast.NewStructField("style", ast.NewRef("dashboard", "Style", ast.Nullable())),
+		ast.NewStructField("refresh", ast.NewDisjunction(ast.Types{ast.String(), ast.Bool()}, ast.Nullable())),
+		ast.NewStructField("tags", ast.NewArray(ast.String(), ast.Nullable())),
+		ast.NewStructField("labels", ast.NewMap(ast.String(), ast.String(), ast.Nullable())),
+		ast.NewStructField("links", ast.NewArray(ast.NewRef("dashboard", "Link"), ast.Nullable())),
+	)))
+
+	schemas, err := (&compiler.DisjunctionToType{}).Process(ast.Schemas{schema})
+	require.NoError(t, err)
+
+	generator := &ast.BuilderGenerator{}
+
+	return Context{Schemas: schemas, Builders: generator.FromAST(schemas)}
+}
+
+// describeCall formats a builder call in a language-agnostic way.
+func describeCall(call BuilderCall) string {
+	var buffer strings.Builder
+
+	buffer.WriteString(fmt.Sprintf("%s(%s)", builderName(call.Builder), describeValues(call.Args)))
+	for _, opt := range call.Options {
+		buffer.WriteString(fmt.Sprintf(".%s(%s)", opt.Option.Name, describeValues(opt.Args)))
+	}
+
+	return buffer.String()
+}
+
+func describeValues(values []ConvertedValue) string {
+	return strings.Join(tools.Map(values, describeValue), ", ")
+}
+
+func describeValue(value ConvertedValue) string {
+	if value.IsBuilder() {
+		return describeCall(*value.Builder)
+	}
+
+	if value.IsBuildersList() {
+		return "[" + describeValues(value.Items) + "]"
+	}
+
+	encoded, _ := json.Marshal(value.Value)
+
+	return string(encoded)
+}
+
+func TestConverter_Convert(t *testing.T) {
+	testCases := []struct {
+		description string
+		input       string
+		expected    string
+		warnings    []string
+	}{
+		{
+			description: "scalars",
+			input:       `{"title": "Some dashboard", "revision": 3}`,
+			expected:    `dashboard.Dashboard().title("Some dashboard").revision(3)`,
+		},
+		{
+			description: "enums",
+			input:       `{"title": "Some dashboard", "style": "light"}`,
+			expected:    `dashboard.Dashboard().title("Some dashboard").style("light")`,
+		},
+		{
+			description: "references to objects with a builder",
+			input:       `{"title": "Some dashboard", "links": [{"title": "Grafana", "url": "https://grafana.com"}, {"title": "Docs"}]}`,
+			expected:    `dashboard.Dashboard().title("Some dashboard").links([dashboard.Link().title("Grafana").url("https://grafana.com"), dashboard.Link().title("Docs")])`,
+		},
+		{
+			description: "disjunction holding a string",
+			input:       `{"title": "Some dashboard", "refresh": "5m"}`,
+			expected:    `dashboard.Dashboard().title("Some dashboard").refresh(dashboard.StringOrBool().String("5m"))`,
+		},
+		{
+			description: "disjunction holding a boolean",
+			input:       `{"title": "Some dashboard", "refresh": false}`,
+			expected:    `dashboard.Dashboard().title("Some dashboard").refresh(dashboard.StringOrBool().Bool(false))`,
+		},
+		{
+			description: "disjunction holding a value matching none of its branches",
+			input:       `{"title": "Some dashboard", "refresh": 30}`,
+			expected:    `dashboard.Dashboard().title("Some dashboard")`,
+			warnings:    []string{"dashboard.Dashboard: no option to set 'refresh'"},
+		},
+		{
+			description: "maps and arrays",
+			input:       `{"title": "Some dashboard", "tags": ["a", "b"], "labels": {"team": "observability"}}`,
+			expected:    `dashboard.Dashboard().title("Some dashboard").tags(["a","b"]).labels({"team":"observability"})`,
+		},
+		{
+			description: "unknown keys",
+			input:       `{"title": "Some dashboard", "unknown": true, "links": [{"title": "Grafana", "icon": "external"}]}`,
+			expected:    `dashboard.Dashboard().title("Some dashboard").links([dashboard.Link().title("Grafana")])`,
+			warnings: []string{
+				"dashboard.Link: no option to set 'icon'",
+				"dashboard.Dashboard: no option to set 'unknown'",
+			},
+		},
+	}
+
+	context := converterTestContext(t)
+
+	for _, testCase := range testCases {
+		tc := testCase
+		t.Run(tc.description, func(t *testing.T) {
+			req := require.New(t)
+
+			decoder := json.NewDecoder(strings.NewReader(tc.input))
+			decoder.UseNumber()
+
+			var input any
+			req.NoError(decoder.Decode(&input))
+
+			call, warnings, err := NewConverter(context).Convert(ast.RefType{ReferredPkg: "dashboard", ReferredType: "Dashboard"}, input)
+			req.NoError(err)
+
+			req.Equal(tc.expected, describeCall(call))
+			req.Equal(tc.warnings, warnings)
+		})
+	}
+}
+
+func TestConverter_Convert_unknownObject(t *testing.T) {
+	req := require.New(t)
+
+	_, _, err := NewConverter(converterTestContext(t)).Convert(ast.RefType{ReferredPkg: "dashboard", ReferredType: "Panel"}, map[string]any{})
+	req.ErrorContains(err, "no builder found for dashboard.Panel")
+}
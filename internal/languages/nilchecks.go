@@ -34,7 +34,9 @@ func GenerateBuilderNilChecks(language Language, context Context) (Context, erro
 		OnAssignment: func(_ *ast.BuilderVisitor, _ ast.Schemas, b ast.Builder, assignment ast.Assignment) (ast.Assignment, error) {
 			for i, chunk := range assignment.Path {
 				protectArrayAppend := nullableKinds.ProtectArrayAppend && assignment.Method == ast.AppendAssignment
-				if i == len(assignment.Path)-1 && !protectArrayAppend {
+				// values can't be set in a map that wasn't initialized
+				protectIndex := assignment.Method == ast.IndexAssignment
+				if i == len(assignment.Path)-1 && !protectArrayAppend && !protectIndex {
 					continue
 				}
 
@@ -28,6 +28,9 @@ type Config struct {
 	Package        string
 	SchemaMetadata ast.SchemaMeta
 	Validate       bool
+
+	// Operations enables the parsing of the operations described in `paths`.
+	Operations bool
 }
 
 type generator struct {
@@ -45,12 +48,16 @@ func GenerateAST(ctx context.Context, oapi *openapi3.T, cfg Config) (*ast.Schema
 		schema: ast.NewSchema(cfg.Package, cfg.SchemaMetadata),
 	}
 
-	if oapi.Components == nil {
-		return g.schema, nil
+	if oapi.Components != nil {
+		if err := g.declareDefinition(oapi.Components.Schemas); err != nil {
+			return nil, fmt.Errorf("[%s] %w", cfg.Package, err)
+		}
 	}
 
-	if err := g.declareDefinition(oapi.Components.Schemas); err != nil {
-		return nil, fmt.Errorf("[%s] %w", cfg.Package, err)
+	if cfg.Operations {
+		if err := g.declareOperations(oapi.Paths); err != nil {
+			return nil, fmt.Errorf("[%s] %w", cfg.Package, err)
+		}
 	}
 
 	// To ensure a consistent output, since github.com/getkin/kin-openapi/openapi3
@@ -68,9 +75,10 @@ func (g *generator) declareDefinition(schemas openapi3.Schemas) error {
 		}
 
 		g.schema.AddObject(ast.Object{
-			Name:     name,
-			Comments: schemaComments(schemaRef.Value),
-			Type:     def,
+			Name:       name,
+			Comments:   schemaComments(schemaRef.Value),
+			Deprecated: schemaDeprecation(schemaRef),
+			Type:       def,
 			SelfRef: ast.RefType{
 				ReferredPkg:  g.schema.Package,
 				ReferredType: name,
@@ -150,6 +158,7 @@ func (g *generator) walkObject(schema *openapi3.Schema) (ast.Type, error) {
 		}
 
 		field := ast.NewStructField(name, def, ast.Comments(schemaComments(schema)))
+		field.Deprecated = schemaDeprecation(schemaRef)
 		field.Required = tools.ItemInList(name, schema.Required)
 
 		fields = append(fields, field)
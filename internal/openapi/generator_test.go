@@ -28,6 +28,24 @@ func TestGenerateAST(t *testing.T) {
 	})
 }
 
+func TestGenerateAST_withOperations(t *testing.T) {
+	test := testutils.GoldenFilesTestSuite[string]{
+		TestDataRoot: "../../testdata/openapi_operations",
+		Name:         "GenerateAST",
+	}
+
+	test.Run(t, func(tc *testutils.Test[string]) {
+		req := require.New(tc)
+		ctx := context.TODO()
+
+		schemaAst, err := GenerateAST(ctx, getSchemaAsReader(tc), Config{Package: "grafanatest", Operations: true})
+		req.NoError(err)
+		require.NotNil(t, schemaAst)
+
+		tc.WriteJSON(testutils.GeneratorOutputFile, schemaAst)
+	})
+}
+
 func getSchemaAsReader(tc *testutils.Test[string]) *openapi3.T {
 	tc.Helper()
 
@@ -0,0 +1,321 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/tools"
+)
+
+//nolint:gochecknoglobals
+var methodsOrder = []string{
+	http.MethodGet, http.MethodPut, http.MethodPost, http.MethodDelete,
+	http.MethodOptions, http.MethodHead, http.MethodPatch, http.MethodTrace,
+}
+
+func (g *generator) declareOperations(paths *openapi3.Paths) error {
+	if paths == nil {
+		return nil
+	}
+
+	pathItems := paths.Map()
+	sortedPaths := make([]string, 0, len(pathItems))
+	for path := range pathItems {
+		sortedPaths = append(sortedPaths, path)
+	}
+	sort.Strings(sortedPaths)
+
+	for _, path := range sortedPaths {
+		pathItem := pathItems[path]
+		operations := pathItem.Operations()
+
+		for _, method := range methodsOrder {
+			operation, found := operations[method]
+			if !found {
+				continue
+			}
+
+			def, err := g.walkOperation(method, path, pathItem, operation)
+			if err != nil {
+				return fmt.Errorf("%s %s: %w", method, path, err)
+			}
+
+			g.schema.Operations = append(g.schema.Operations, def)
+		}
+	}
+
+	return nil
+}
+
+func (g *generator) walkOperation(method string, path string, pathItem *openapi3.PathItem, operation *openapi3.Operation) (ast.Operation, error) {
+	def := ast.Operation{
+		Name:     operationName(method, path, operation),
+		Method:   method,
+		Path:     path,
+		Comments: operationComments(operation),
+	}
+
+	if operation.Deprecated {
+		def.Deprecated = &ast.Deprecation{}
+	}
+
+	params, err := g.walkOperationParams(def.Name, append(pathItem.Parameters, operation.Parameters...))
+	if err != nil {
+		return ast.Operation{}, err
+	}
+	def.Params = params
+
+	if operation.RequestBody != nil && operation.RequestBody.Value != nil {
+		def.RequestBody, err = g.walkRequestBody(def.Name, operation.RequestBody.Value)
+		if err != nil {
+			return ast.Operation{}, err
+		}
+	}
+
+	if operation.Responses != nil {
+		def.Responses, err = g.walkResponses(def.Name, operation.Responses)
+		if err != nil {
+			return ast.Operation{}, err
+		}
+	}
+
+	return def, nil
+}
+
+func (g *generator) walkOperationParams(operationName string, paramRefs openapi3.Parameters) ([]ast.OperationParam, error) {
+	params := make([]ast.OperationParam, 0, len(paramRefs))
+	indexByName := make(map[string]int, len(paramRefs))
+
+	for _, paramRef := range paramRefs {
+		param := paramRef.Value
+		if param == nil {
+			continue
+		}
+
+		location := ast.ParamLocation(param.In)
+		if location != ast.ParamInPath && location != ast.ParamInQuery && location != ast.ParamInHeader {
+			// cookie parameters are not supported
+			continue
+		}
+
+		paramType := ast.String()
+		if param.Schema != nil {
+			walked, err := g.walkSchemaRef(param.Schema)
+			if err != nil {
+				return nil, fmt.Errorf("parameter '%s': %w", param.Name, err)
+			}
+
+			paramType = g.hoistType(operationName+tools.UpperCamelCase(param.Name), walked, nil)
+		}
+
+		def := ast.OperationParam{
+			Name:     param.Name,
+			In:       location,
+			Type:     paramType,
+			Required: param.Required || location == ast.ParamInPath,
+			Comments: schemaComments(&openapi3.Schema{Description: param.Description}),
+		}
+
+		// parameters defined on an operation override the ones defined on its path
+		key := param.In + "." + param.Name
+		if i, found := indexByName[key]; found {
+			params[i] = def
+			continue
+		}
+
+		indexByName[key] = len(params)
+		params = append(params, def)
+	}
+
+	return params, nil
+}
+
+func (g *generator) walkRequestBody(operationName string, body *openapi3.RequestBody) (*ast.OperationBody, error) {
+	contentType, mediaType := pickContent(body.Content)
+	if mediaType == nil {
+		return nil, nil
+	}
+
+	def := &ast.OperationBody{
+		ContentType: contentType,
+		Type:        ast.String(),
+		Required:    body.Required,
+	}
+
+	// only JSON bodies are typed, others are sent as-is.
+	if !ast.IsJSONContentType(contentType) || mediaType.Schema == nil {
+		return def, nil
+	}
+
+	bodyType, err := g.walkSchemaRef(mediaType.Schema)
+	if err != nil {
+		return nil, fmt.Errorf("request body: %w", err)
+	}
+
+	def.Type = g.hoistType(operationName+"Request", bodyType, schemaComments(&openapi3.Schema{Description: body.Description}))
+
+	return def, nil
+}
+
+func (g *generator) walkResponses(operationName string, responses *openapi3.Responses) ([]ast.OperationResponse, error) {
+	responsesMap := responses.Map()
+
+	statuses := make([]string, 0, len(responsesMap))
+	for status := range responsesMap {
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		// the default response comes last
+		return statusCode(statuses[i]) != 0 && (statusCode(statuses[j]) == 0 || statusCode(statuses[i]) < statusCode(statuses[j]))
+	})
+
+	defs := make([]ast.OperationResponse, 0, len(statuses))
+	successFound := false
+	for _, status := range statuses {
+		response := responsesMap[status].Value
+		if response == nil {
+			continue
+		}
+
+		def := ast.OperationResponse{
+			StatusCode: statusCode(status),
+		}
+		if response.Description != nil {
+			def.Comments = schemaComments(&openapi3.Schema{Description: *response.Description})
+		}
+
+		contentType, mediaType := pickContent(response.Content)
+		def.ContentType = contentType
+
+		if mediaType != nil && mediaType.Schema != nil && ast.IsJSONContentType(contentType) {
+			responseType, err := g.walkSchemaRef(mediaType.Schema)
+			if err != nil {
+				return nil, fmt.Errorf("response '%s': %w", status, err)
+			}
+
+			objectName := operationName + "Response"
+			switch {
+			case def.StatusCode == 0:
+				objectName = operationName + "DefaultResponse"
+			case def.StatusCode >= 200 && def.StatusCode < 300 && !successFound:
+				successFound = true
+			default:
+				objectName += status
+			}
+
+			responseType = g.hoistType(objectName, responseType, def.Comments)
+			def.Type = &responseType
+		}
+
+		defs = append(defs, def)
+	}
+
+	return defs, nil
+}
+
+// hoistType declares non-trivial inline types as objects, and returns a
+// reference to them.
+func (g *generator) hoistType(objectName string, def ast.Type, comments []string) ast.Type {
+	if isTrivialType(def) {
+		return def
+	}
+
+	name := objectName
+	for i := 2; g.schema.Objects.Has(name); i++ {
+		name = fmt.Sprintf("%s%d", objectName, i)
+	}
+
+	object := ast.NewObject(g.schema.Package, name, def)
+	object.Comments = comments
+
+	g.schema.AddObject(object)
+
+	return ast.NewRef(g.schema.Package, name)
+}
+
+func isTrivialType(def ast.Type) bool {
+	switch def.Kind {
+	case ast.KindRef, ast.KindScalar:
+		return true
+	case ast.KindArray:
+		return isTrivialType(def.AsArray().ValueType)
+	case ast.KindMap:
+		return isTrivialType(def.AsMap().ValueType)
+	default:
+		return false
+	}
+}
+
+// pickContent selects the content to use from the ones available for a
+// request body or a response, favoring JSON.
+func pickContent(content openapi3.Content) (string, *openapi3.MediaType) {
+	contentTypes := make([]string, 0, len(content))
+	for contentType := range content {
+		contentTypes = append(contentTypes, contentType)
+	}
+	sort.Strings(contentTypes)
+
+	for _, contentType := range contentTypes {
+		if ast.IsJSONContentType(contentType) {
+			return contentType, content[contentType]
+		}
+	}
+
+	if len(contentTypes) == 0 {
+		return "", nil
+	}
+
+	return contentTypes[0], content[contentTypes[0]]
+}
+
+// statusCode parses response codes, as described in an OpenAPI schema.
+// ie: `200`, `4XX`. The default response is represented by 0.
+func statusCode(status string) int {
+	if strings.EqualFold(status, "default") {
+		return 0
+	}
+
+	code, err := strconv.Atoi(strings.NewReplacer("X", "0", "x", "0").Replace(status))
+	if err != nil {
+		return 0
+	}
+
+	return code
+}
+
+func operationName(method string, path string, operation *openapi3.Operation) string {
+	if operation.OperationID != "" {
+		return tools.UpperCamelCase(operation.OperationID)
+	}
+
+	// ie: `GET /dashboards/uid/{uid}` → `GetDashboardsUidByUid`
+	name := tools.UpperCamelCase(strings.ToLower(method))
+	for _, segment := range strings.Split(path, "/") {
+		if segment == "" {
+			continue
+		}
+
+		if strings.HasPrefix(segment, "{") && strings.HasSuffix(segment, "}") {
+			name += "By" + tools.UpperCamelCase(strings.Trim(segment, "{}"))
+			continue
+		}
+
+		name += tools.UpperCamelCase(segment)
+	}
+
+	return name
+}
+
+func operationComments(operation *openapi3.Operation) []string {
+	description := operation.Summary
+	if operation.Description != "" && operation.Description != operation.Summary {
+		description = strings.TrimSpace(description + "\n" + operation.Description)
+	}
+
+	return schemaComments(&openapi3.Schema{Description: description})
+}
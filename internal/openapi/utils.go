@@ -6,6 +6,7 @@ import (
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/tools"
 )
 
 func schemaComments(schema *openapi3.Schema) []string {
@@ -23,6 +24,15 @@ func schemaComments(schema *openapi3.Schema) []string {
 	return filtered
 }
 
+func schemaDeprecation(schemaRef *openapi3.SchemaRef) *ast.Deprecation {
+	// the deprecation of a referenced schema belongs to the referred object
+	if isRef(schemaRef.Ref) || schemaRef.Value == nil || !schemaRef.Value.Deprecated {
+		return nil
+	}
+
+	return &ast.Deprecation{}
+}
+
 func getEnumType(t string) (ast.Type, error) {
 	switch t {
 	case openapi3.TypeString:
@@ -52,6 +62,14 @@ func getConstraints(schema *openapi3.Schema) []ast.TypeConstraint {
 		})
 	}
 
+	// patterns matching a constant string are handled as constant values
+	if schema.Pattern != "" && !tools.RegexMatchesConstantString(schema.Pattern) {
+		constraints = append(constraints, ast.TypeConstraint{
+			Op:   ast.PatternOp,
+			Args: []any{schema.Pattern},
+		})
+	}
+
 	if schema.MultipleOf != nil {
 		constraints = append(constraints, ast.TypeConstraint{
 			Op:   ast.MultipleOfOp,
@@ -2,6 +2,7 @@ package orderedmap
 
 import (
 	"bytes"
+	"encoding/gob"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -169,6 +170,36 @@ func (orderedMap *Map[K, V]) MarshalJSON() ([]byte, error) {
 	return buffer.Bytes(), nil
 }
 
+// GobEncode encodes the map as its ordered list of pairs.
+func (orderedMap *Map[K, V]) GobEncode() ([]byte, error) {
+	pairs := make([]Pair[K, V], 0, orderedMap.Len())
+	orderedMap.Iterate(func(key K, value V) {
+		pairs = append(pairs, Pair[K, V]{Key: key, Value: value})
+	})
+
+	buffer := bytes.Buffer{}
+	if err := gob.NewEncoder(&buffer).Encode(pairs); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+func (orderedMap *Map[K, V]) GobDecode(raw []byte) error {
+	var pairs []Pair[K, V]
+	if err := gob.NewDecoder(bytes.NewReader(raw)).Decode(&pairs); err != nil {
+		return err
+	}
+
+	orderedMap.records = make(map[K]V, len(pairs))
+	orderedMap.order = nil
+	for _, pair := range pairs {
+		orderedMap.Set(pair.Key, pair.Value)
+	}
+
+	return nil
+}
+
 // FIXME: does not preserve order
 func (orderedMap *Map[K, V]) UnmarshalJSON(raw []byte) error {
 	if orderedMap.records == nil {
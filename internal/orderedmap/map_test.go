@@ -1,6 +1,8 @@
 package orderedmap
 
 import (
+	"bytes"
+	"encoding/gob"
 	"encoding/json"
 	"testing"
 
@@ -99,3 +101,20 @@ func TestMap_UnmarshalJSON_string_to_struct(t *testing.T) {
 	req.Equal("second", orderedMap.At(1).Title)
 	req.Equal("third", orderedMap.At(2).Title)
 }
+
+func TestMap_Gob(t *testing.T) {
+	req := require.New(t)
+	orderedMap := New[string, int64]()
+	orderedMap.Set("foo", 1)
+	orderedMap.Set("bar", 2)
+	orderedMap.Set("aaa", 3)
+
+	buffer := bytes.Buffer{}
+	req.NoError(gob.NewEncoder(&buffer).Encode(orderedMap))
+
+	decoded := New[string, int64]()
+	req.NoError(gob.NewDecoder(&buffer).Decode(decoded))
+
+	req.Equal([]int64{1, 2, 3}, decoded.Values())
+	req.True(orderedMap.Equal(decoded))
+}
@@ -0,0 +1,435 @@
+package protobuf
+
+import (
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"unicode"
+
+	"github.com/emicklei/proto"
+	"github.com/grafana/cog/internal/ast"
+)
+
+type Config struct {
+	// Package name used to generate code into.
+	// If empty, the last component of the protobuf package that isn't a
+	// version is used.
+	// Ex: "dashboard" for `package grafana.dashboard.v1;`
+	Package string
+
+	SchemaMetadata ast.SchemaMeta
+}
+
+// definition describes a message or an enum declared in the parsed file.
+type definition struct {
+	// objectName is the name of the object representing the definition.
+	// Nested definitions are prefixed by the name of their parents.
+	objectName string
+
+	message *proto.Message
+	enum    *proto.Enum
+}
+
+type generator struct {
+	schema       *ast.Schema
+	protoPackage string
+
+	// fully qualified protobuf name -> definition
+	definitions map[string]definition
+	// definitions, in the order in which they are declared
+	declared []string
+}
+
+func GenerateAST(reader io.Reader, cfg Config) (*ast.Schema, error) {
+	parsed, err := proto.NewParser(reader).Parse()
+	if err != nil {
+		return nil, fmt.Errorf("[%s] %w", cfg.Package, err)
+	}
+
+	g := &generator{
+		definitions: make(map[string]definition),
+	}
+
+	for _, element := range parsed.Elements {
+		if pkg, ok := element.(*proto.Package); ok {
+			g.protoPackage = pkg.Name
+		}
+	}
+
+	pkg := cfg.Package
+	if pkg == "" {
+		pkg = packageFromProtobuf(strings.Split(g.protoPackage, "."))
+	}
+	if pkg == "" {
+		return nil, fmt.Errorf("could not determine a package name: no package declared in the protobuf file")
+	}
+
+	g.schema = ast.NewSchema(pkg, cfg.SchemaMetadata)
+
+	g.declareDefinitions(parsed.Elements, "", "")
+
+	for _, fullName := range g.declared {
+		if err := g.walkDefinition(fullName, g.definitions[fullName]); err != nil {
+			return nil, fmt.Errorf("[%s] %w", pkg, err)
+		}
+	}
+
+	return g.schema, nil
+}
+
+// declareDefinitions registers every message and enum (nested ones
+// included), so that references to them can be resolved.
+func (g *generator) declareDefinitions(elements []proto.Visitee, parentFullName string, parentObjectName string) {
+	if parentFullName == "" {
+		parentFullName = g.protoPackage
+	}
+
+	for _, element := range elements {
+		switch def := element.(type) {
+		case *proto.Message:
+			if def.IsExtend {
+				continue
+			}
+
+			fullName := qualifiedName(parentFullName, def.Name)
+			g.declare(fullName, definition{objectName: parentObjectName + def.Name, message: def})
+			g.declareDefinitions(def.Elements, fullName, parentObjectName+def.Name)
+		case *proto.Enum:
+			fullName := qualifiedName(parentFullName, def.Name)
+			g.declare(fullName, definition{objectName: parentObjectName + def.Name, enum: def})
+		}
+	}
+}
+
+func (g *generator) declare(fullName string, def definition) {
+	g.definitions[fullName] = def
+	g.declared = append(g.declared, fullName)
+}
+
+func (g *generator) walkDefinition(fullName string, def definition) error {
+	var objectType ast.Type
+	var comments []string
+	var deprecation *ast.Deprecation
+	var err error
+
+	if def.message != nil {
+		comments = commentLines(def.message.Comment)
+		deprecation = elementsDeprecation(def.message.Elements)
+		objectType, err = g.walkMessage(fullName, def.message)
+	} else {
+		comments = commentLines(def.enum.Comment)
+		deprecation = elementsDeprecation(def.enum.Elements)
+		objectType, err = g.walkEnum(def.enum)
+	}
+	if err != nil {
+		return fmt.Errorf("%s: %w", fullName, err)
+	}
+
+	object := ast.NewObject(g.schema.Package, def.objectName, objectType)
+	object.Comments = comments
+	object.Deprecated = deprecation
+
+	g.schema.AddObject(object)
+
+	return nil
+}
+
+func (g *generator) walkMessage(fullName string, message *proto.Message) (ast.Type, error) {
+	var fields []ast.StructField
+
+	for _, element := range message.Elements {
+		switch field := element.(type) {
+		case *proto.NormalField:
+			fieldType, err := g.walkTypeName(fullName, field.Type)
+			if err != nil {
+				return ast.Type{}, err
+			}
+
+			if field.Repeated {
+				fieldType = ast.NewArray(fieldType)
+			}
+
+			// Fields without explicit presence always have a value: they are
+			// considered required.
+			required := field.Required || field.Repeated || (!field.Optional && !g.isMessage(fullName, field.Type))
+
+			fields = append(fields, g.structField(field.Field, fieldType, required))
+		case *proto.MapField:
+			keyType, err := g.walkTypeName(fullName, field.KeyType)
+			if err != nil {
+				return ast.Type{}, err
+			}
+
+			valueType, err := g.walkTypeName(fullName, field.Type)
+			if err != nil {
+				return ast.Type{}, err
+			}
+
+			fields = append(fields, g.structField(field.Field, ast.NewMap(keyType, valueType), true))
+		case *proto.Oneof:
+			oneofField, err := g.walkOneof(fullName, field)
+			if err != nil {
+				return ast.Type{}, err
+			}
+
+			fields = append(fields, oneofField)
+		case *proto.Group:
+			return ast.Type{}, fmt.Errorf("groups are not supported")
+		}
+	}
+
+	return ast.NewStruct(fields...), nil
+}
+
+// walkOneof represents a oneof as a field named after it, which type is a
+// disjunction of the types of its branches.
+func (g *generator) walkOneof(fullName string, oneof *proto.Oneof) (ast.StructField, error) {
+	var branches ast.Types
+
+	for _, element := range oneof.Elements {
+		branch, ok := element.(*proto.OneOfField)
+		if !ok {
+			continue
+		}
+
+		branchType, err := g.walkTypeName(fullName, branch.Type)
+		if err != nil {
+			return ast.StructField{}, err
+		}
+
+		branches = append(branches, branchType)
+	}
+
+	fieldType := ast.NewDisjunction(branches)
+	if len(branches) == 1 {
+		fieldType = branches[0]
+	}
+
+	field := ast.NewStructField(defaultJSONName(oneof.Name), fieldType)
+	field.Comments = commentLines(oneof.Comment)
+
+	return field, nil
+}
+
+func (g *generator) structField(field *proto.Field, fieldType ast.Type, required bool) ast.StructField {
+	name := defaultJSONName(field.Name)
+	if jsonName, found := optionValue(field.Options, "json_name"); found {
+		name = jsonName
+	}
+
+	structField := ast.NewStructField(name, fieldType)
+	structField.Required = required
+	structField.Comments = append(commentLines(field.Comment), commentLines(field.InlineComment)...)
+
+	if deprecated, found := optionValue(field.Options, "deprecated"); found && deprecated == "true" {
+		structField.Deprecated = &ast.Deprecation{}
+	}
+
+	return structField
+}
+
+// walkEnum represents protobuf enums as string enums, since the JSON
+// representation of an enum value is its name.
+func (g *generator) walkEnum(enum *proto.Enum) (ast.Type, error) {
+	var values []ast.EnumValue
+
+	for _, element := range enum.Elements {
+		field, ok := element.(*proto.EnumField)
+		if !ok {
+			continue
+		}
+
+		values = append(values, ast.EnumValue{
+			Type:  ast.String(),
+			Name:  field.Name,
+			Value: field.Name,
+		})
+	}
+
+	if len(values) == 0 {
+		return ast.Type{}, fmt.Errorf("enum '%s' has no values", enum.Name)
+	}
+
+	return ast.NewEnum(values), nil
+}
+
+func (g *generator) walkTypeName(scope string, typeName string) (ast.Type, error) {
+	if scalar, found := scalarTypes[typeName]; found {
+		return ast.NewScalar(scalar), nil
+	}
+
+	if def, found := g.resolveDefinition(scope, typeName); found {
+		return ast.NewRef(g.schema.Package, def.objectName), nil
+	}
+
+	qualifiedTypeName := strings.TrimPrefix(typeName, ".")
+	if wellKnownType, found := wellKnownTypes[qualifiedTypeName]; found {
+		return wellKnownType(), nil
+	}
+
+	return g.foreignRef(qualifiedTypeName)
+}
+
+// isMessage tells whether the given type name refers to a message. Fields
+// of message types track their presence.
+func (g *generator) isMessage(scope string, typeName string) bool {
+	if _, found := scalarTypes[typeName]; found {
+		return false
+	}
+
+	if def, found := g.resolveDefinition(scope, typeName); found {
+		return def.message != nil
+	}
+
+	// the only well-known type that isn't a message
+	if strings.TrimPrefix(typeName, ".") == "google.protobuf.NullValue" {
+		return false
+	}
+
+	return true
+}
+
+// resolveDefinition resolves a type name following protobuf's scoping
+// rules: the name is searched for in the innermost scope first, then in
+// its parents.
+func (g *generator) resolveDefinition(scope string, typeName string) (definition, bool) {
+	if strings.HasPrefix(typeName, ".") {
+		def, found := g.definitions[typeName[1:]]
+		return def, found
+	}
+
+	for {
+		if def, found := g.definitions[qualifiedName(scope, typeName)]; found {
+			return def, true
+		}
+
+		if scope == "" {
+			return definition{}, false
+		}
+
+		lastDot := strings.LastIndex(scope, ".")
+		if lastDot == -1 {
+			scope = ""
+		} else {
+			scope = scope[:lastDot]
+		}
+	}
+}
+
+// foreignRef builds a reference to a type declared in another protobuf
+// package. Package components are expected to be lowercase, and type names
+// to start with an uppercase letter.
+// Ex: `grafana.common.DataSourceRef` refers to `DataSourceRef` in the `common` package.
+func (g *generator) foreignRef(typeName string) (ast.Type, error) {
+	parts := strings.Split(typeName, ".")
+
+	typeStart := -1
+	for i, part := range parts {
+		if part != "" && unicode.IsUpper(rune(part[0])) {
+			typeStart = i
+			break
+		}
+	}
+
+	if typeStart < 1 {
+		return ast.Type{}, fmt.Errorf("could not resolve type '%s'", typeName)
+	}
+
+	pkg := packageFromProtobuf(parts[:typeStart])
+	if pkg == "" {
+		return ast.Type{}, fmt.Errorf("could not resolve type '%s'", typeName)
+	}
+
+	return ast.NewRef(pkg, strings.Join(parts[typeStart:], "")), nil
+}
+
+var versionRegex = regexp.MustCompile(`^v[0-9]+((alpha|beta)[0-9]*)?$`)
+
+var scalarTypes = map[string]ast.ScalarKind{
+	"double":   ast.KindFloat64,
+	"float":    ast.KindFloat32,
+	"int32":    ast.KindInt32,
+	"sint32":   ast.KindInt32,
+	"sfixed32": ast.KindInt32,
+	"int64":    ast.KindInt64,
+	"sint64":   ast.KindInt64,
+	"sfixed64": ast.KindInt64,
+	"uint32":   ast.KindUint32,
+	"fixed32":  ast.KindUint32,
+	"uint64":   ast.KindUint64,
+	"fixed64":  ast.KindUint64,
+	"bool":     ast.KindBool,
+	"string":   ast.KindString,
+	"bytes":    ast.KindBytes,
+}
+
+// wellKnownTypes maps protobuf's well-known types to the types describing
+// their JSON representation.
+var wellKnownTypes = map[string]func() ast.Type{
+	"google.protobuf.Timestamp": func() ast.Type {
+		return ast.String(ast.Hints(ast.JenniesHints{ast.HintStringFormatDateTime: true}))
+	},
+	"google.protobuf.Duration":  func() ast.Type { return ast.String() },
+	"google.protobuf.FieldMask": func() ast.Type { return ast.String() },
+	"google.protobuf.Empty":     func() ast.Type { return ast.NewStruct() },
+	"google.protobuf.Any":       func() ast.Type { return ast.Any() },
+	"google.protobuf.Value":     func() ast.Type { return ast.Any() },
+	"google.protobuf.NullValue": func() ast.Type { return ast.Null() },
+	"google.protobuf.Struct":    func() ast.Type { return ast.NewMap(ast.String(), ast.Any()) },
+	"google.protobuf.ListValue": func() ast.Type { return ast.NewArray(ast.Any()) },
+
+	"google.protobuf.DoubleValue": func() ast.Type { return ast.NewScalar(ast.KindFloat64, ast.Nullable()) },
+	"google.protobuf.FloatValue":  func() ast.Type { return ast.NewScalar(ast.KindFloat32, ast.Nullable()) },
+	"google.protobuf.Int64Value":  func() ast.Type { return ast.NewScalar(ast.KindInt64, ast.Nullable()) },
+	"google.protobuf.UInt64Value": func() ast.Type { return ast.NewScalar(ast.KindUint64, ast.Nullable()) },
+	"google.protobuf.Int32Value":  func() ast.Type { return ast.NewScalar(ast.KindInt32, ast.Nullable()) },
+	"google.protobuf.UInt32Value": func() ast.Type { return ast.NewScalar(ast.KindUint32, ast.Nullable()) },
+	"google.protobuf.BoolValue":   func() ast.Type { return ast.NewScalar(ast.KindBool, ast.Nullable()) },
+	"google.protobuf.StringValue": func() ast.Type { return ast.NewScalar(ast.KindString, ast.Nullable()) },
+	"google.protobuf.BytesValue":  func() ast.Type { return ast.NewScalar(ast.KindBytes, ast.Nullable()) },
+}
+
+func qualifiedName(scope string, name string) string {
+	if scope == "" {
+		return name
+	}
+
+	return scope + "." + name
+}
+
+// packageFromProtobuf returns the last component of a protobuf package
+// that isn't a version.
+func packageFromProtobuf(components []string) string {
+	for i := len(components) - 1; i >= 0; i-- {
+		if !versionRegex.MatchString(components[i]) {
+			return components[i]
+		}
+	}
+
+	return ""
+}
+
+// defaultJSONName mimics the algorithm used by protoc to derive the JSON
+// name of a field.
+func defaultJSONName(fieldName string) string {
+	var buffer strings.Builder
+	capitalizeNext := false
+
+	for _, char := range fieldName {
+		if char == '_' {
+			capitalizeNext = true
+			continue
+		}
+
+		if capitalizeNext {
+			buffer.WriteRune(unicode.ToUpper(char))
+			capitalizeNext = false
+			continue
+		}
+
+		buffer.WriteRune(char)
+	}
+
+	return buffer.String()
+}
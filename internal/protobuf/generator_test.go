@@ -0,0 +1,25 @@
+package protobuf
+
+import (
+	"testing"
+
+	"github.com/grafana/cog/internal/testutils"
+	"github.com/stretchr/testify/require"
+)
+
+func TestGenerateAST(t *testing.T) {
+	test := testutils.GoldenFilesTestSuite[string]{
+		TestDataRoot: "../../testdata/protobuf",
+		Name:         "GenerateAST",
+	}
+
+	test.Run(t, func(tc *testutils.Test[string]) {
+		req := require.New(tc)
+
+		schemaAst, err := GenerateAST(tc.OpenInput("schema.proto"), Config{})
+		req.NoError(err)
+		req.NotNil(schemaAst)
+
+		tc.WriteJSON(testutils.GeneratorOutputFile, schemaAst)
+	})
+}
@@ -0,0 +1,53 @@
+package protobuf
+
+import (
+	"strings"
+
+	"github.com/emicklei/proto"
+	"github.com/grafana/cog/internal/ast"
+)
+
+func commentLines(comment *proto.Comment) []string {
+	if comment == nil {
+		return nil
+	}
+
+	lines := make([]string, 0, len(comment.Lines))
+	for _, line := range comment.Lines {
+		line = strings.TrimSpace(line)
+		if line == "" {
+			continue
+		}
+
+		lines = append(lines, line)
+	}
+
+	return lines
+}
+
+func optionValue(options []*proto.Option, name string) (string, bool) {
+	for _, option := range options {
+		if option.Name == name {
+			return option.Constant.Source, true
+		}
+	}
+
+	return "", false
+}
+
+// elementsDeprecation looks for a `option deprecated = true;` statement
+// within the given elements of a message or an enum.
+func elementsDeprecation(elements []proto.Visitee) *ast.Deprecation {
+	for _, element := range elements {
+		option, ok := element.(*proto.Option)
+		if !ok || option.Name != "deprecated" {
+			continue
+		}
+
+		if option.Constant.Source == "true" {
+			return &ast.Deprecation{}
+		}
+	}
+
+	return nil
+}
@@ -15,6 +15,7 @@ const cuetsyAnnotationName = "cuetsy"
 const hintKindEnum = "enum"
 const annotationKindFieldName = "kind"
 const enumMembersAttr = "memberNames"
+const deprecatedAnnotationName = "deprecated"
 
 type LibraryInclude struct {
 	FSPath     string // path of the library on the filesystem
@@ -128,6 +129,7 @@ func (g *generator) walkCueSchemaWithEnvelope(envelopeName string, v cue.Value)
 
 		name := selectorLabel(i.Selector())
 		structField := ast.NewStructField(name, nodeType, ast.Comments(commentsFromCueValue(i.Value())))
+		structField.Deprecated = deprecationFromCueValue(i.Value())
 		structField.Required = !i.IsOptional()
 
 		rootObjectFields = append(rootObjectFields, structField)
@@ -143,9 +145,10 @@ func (g *generator) walkCueSchemaWithEnvelope(envelopeName string, v cue.Value)
 	}
 
 	g.schema.AddObject(ast.Object{
-		Name:     envelopeName,
-		Comments: commentsFromCueValue(v),
-		Type:     structType,
+		Name:       envelopeName,
+		Comments:   commentsFromCueValue(v),
+		Deprecated: deprecationFromCueValue(v),
+		Type:       structType,
 		SelfRef: ast.RefType{
 			ReferredPkg:  g.schema.Package,
 			ReferredType: envelopeName,
@@ -194,9 +197,10 @@ func (g *generator) declareObject(name string, v cue.Value) (ast.Object, error)
 	}
 
 	objectDef := ast.Object{
-		Name:     name,
-		Comments: commentsFromCueValue(v),
-		Type:     nodeType,
+		Name:       name,
+		Comments:   commentsFromCueValue(v),
+		Deprecated: deprecationFromCueValue(v),
+		Type:       nodeType,
 		SelfRef: ast.RefType{
 			ReferredPkg:  g.schema.Package,
 			ReferredType: name,
@@ -218,9 +222,10 @@ func (g *generator) declareEnum(name string, v cue.Value) (ast.Object, error) {
 	}
 
 	return ast.Object{
-		Name:     name,
-		Comments: commentsFromCueValue(v),
-		Type:     enumType,
+		Name:       name,
+		Comments:   commentsFromCueValue(v),
+		Deprecated: deprecationFromCueValue(v),
+		Type:       enumType,
 		SelfRef: ast.RefType{
 			ReferredPkg:  g.schema.Package,
 			ReferredType: name,
@@ -317,6 +322,7 @@ func (g *generator) structFields(v cue.Value) ([]ast.StructField, error) {
 		}
 
 		field := ast.NewStructField(fieldLabel, node, ast.Comments(commentsFromCueValue(i.Value())))
+		field.Deprecated = deprecationFromCueValue(i.Value())
 		field.Required = !i.IsOptional()
 
 		fields = append(fields, field)
@@ -650,6 +656,24 @@ func (g *generator) declareStringConstraints(v cue.Value) ([]ast.TypeConstraint,
 	for _, andExpr := range typeAndConstraints {
 		op, args := andExpr.Expr()
 
+		if op == cue.RegexMatchOp || op == cue.NotRegexMatchOp {
+			pattern, err := args[0].String()
+			if err != nil {
+				return nil, errorWithCueRef(andExpr, "could not convert regex pattern to string")
+			}
+
+			patternOp := ast.PatternOp
+			if op == cue.NotRegexMatchOp {
+				patternOp = ast.NotPatternOp
+			}
+
+			constraints = append(constraints, ast.TypeConstraint{
+				Op:   patternOp,
+				Args: []any{pattern},
+			})
+			continue
+		}
+
 		// TODO: support more OPs?
 		if op != cue.CallOp {
 			continue
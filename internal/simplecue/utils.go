@@ -2,6 +2,7 @@ package simplecue
 
 import (
 	"fmt"
+	"strconv"
 	"strings"
 
 	"cuelang.org/go/cue"
@@ -228,6 +229,25 @@ func commentsFromCueValue(v cue.Value) []string {
 	return ret
 }
 
+// deprecationFromCueValue looks for a `@deprecated()` attribute on the given
+// value. The attribute can optionally hold a message: `@deprecated("use X instead")`
+func deprecationFromCueValue(v cue.Value) *ast.Deprecation {
+	for _, a := range v.Attributes(cue.ValueAttr) {
+		if a.Name() != deprecatedAnnotationName {
+			continue
+		}
+
+		message := strings.TrimSpace(a.Contents())
+		if unquoted, err := strconv.Unquote(message); err == nil {
+			message = unquoted
+		}
+
+		return &ast.Deprecation{Message: message}
+	}
+
+	return nil
+}
+
 func isImplicitEnum(v cue.Value) (bool, error) {
 	typeHint, err := getTypeHint(v)
 	if err != nil {
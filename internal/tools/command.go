@@ -0,0 +1,51 @@
+package tools
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+)
+
+// RunJSONCommand runs the given command with the JSON representation of
+// input written on its standard input, and decodes its standard output
+// as JSON into output.
+// The command is killed if the context is done before it exits.
+func RunJSONCommand(ctx context.Context, command string, args []string, input any, output any) error {
+	stdin, err := json.Marshal(input)
+	if err != nil {
+		return err
+	}
+
+	stdout := &bytes.Buffer{}
+	stderr := &bytes.Buffer{}
+
+	cmd := exec.CommandContext(ctx, command, args...)
+	cmd.Stdin = bytes.NewReader(stdin)
+	cmd.Stdout = stdout
+	cmd.Stderr = stderr
+	// children of the command might keep its output open after it was
+	// killed: don't wait for them forever.
+	cmd.WaitDelay = time.Second
+
+	if err := cmd.Run(); err != nil {
+		if ctx.Err() != nil {
+			return fmt.Errorf("command '%s' failed: %w", command, ctx.Err())
+		}
+
+		if details := strings.TrimSpace(stderr.String()); details != "" {
+			return fmt.Errorf("command '%s' failed: %w: %s", command, err, details)
+		}
+
+		return fmt.Errorf("command '%s' failed: %w", command, err)
+	}
+
+	if err := json.Unmarshal(stdout.Bytes(), output); err != nil {
+		return fmt.Errorf("command '%s' returned invalid JSON: %w", command, err)
+	}
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package trace
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/grafana/cog/internal/diff"
+)
+
+func (report Report) WriteJSON(output io.Writer) error {
+	encoder := json.NewEncoder(output)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+
+	return encoder.Encode(report)
+}
+
+func (report Report) WriteMarkdown(output io.Writer) error {
+	var buffer strings.Builder
+
+	buffer.WriteString("# Compiler passes and veneers trace\n")
+
+	currentScope := ""
+	for i, step := range report.Steps {
+		if i == 0 || step.Scope != currentScope {
+			currentScope = step.Scope
+			buffer.WriteString(fmt.Sprintf("\n## %s\n", scopeTitle(step.Scope)))
+		}
+
+		buffer.WriteString(fmt.Sprintf("\n### `%s`\n\n", step.Name))
+
+		if len(step.Unmatched) != 0 {
+			for _, target := range step.Unmatched {
+				buffer.WriteString(fmt.Sprintf("* **unmatched** `%s`\n", target))
+			}
+			buffer.WriteString("\n")
+		}
+
+		if len(step.Changes) == 0 {
+			buffer.WriteString("_No changes._\n")
+			continue
+		}
+
+		for _, change := range step.Changes {
+			buffer.WriteString(formatChange(change))
+		}
+	}
+
+	_, err := io.WriteString(output, buffer.String())
+
+	return err
+}
+
+func scopeTitle(scope string) string {
+	if scope == "" {
+		return "Global"
+	}
+
+	return scope
+}
+
+func formatChange(change diff.Change) string {
+	line := fmt.Sprintf("* **%s** `%s`: %s", change.Kind, change.Path, change.Message)
+
+	switch {
+	case change.Old != "" && change.New != "":
+		line += fmt.Sprintf(" (`%s` → `%s`)", change.Old, change.New)
+	case change.Old != "":
+		line += fmt.Sprintf(" (`%s`)", change.Old)
+	case change.New != "":
+		line += fmt.Sprintf(" (`%s`)", change.New)
+	}
+
+	return line + "\n"
+}
@@ -0,0 +1,190 @@
+package trace
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/diff"
+)
+
+// Step describes the changes made by a single compiler pass or veneer rule.
+type Step struct {
+	// Scope describes where the step was executed.
+	// ie: `go / language passes`
+	Scope   string        `json:"scope,omitempty"`
+	Name    string        `json:"name"`
+	Changes []diff.Change `json:"changes"`
+	// Unmatched lists the targets of the step (references to objects or
+	// fields, selectors, …) that matched nothing.
+	Unmatched []string `json:"unmatched,omitempty"`
+}
+
+// Unmatched describes a target of a step that matched nothing.
+type Unmatched struct {
+	Step   string `json:"step"`
+	Target string `json:"target"`
+}
+
+func (unmatched Unmatched) String() string {
+	return fmt.Sprintf("%s: %s matched nothing", unmatched.Step, unmatched.Target)
+}
+
+type Report struct {
+	Steps []Step `json:"steps"`
+}
+
+// Recorder records the changes made to schemas and builders by compiler
+// passes and veneers.
+// A nil Recorder is valid and doesn't record anything.
+type Recorder struct {
+	scope string
+
+	lock   *sync.Mutex
+	report *Report
+}
+
+func NewRecorder() *Recorder {
+	return &Recorder{
+		lock:   &sync.Mutex{},
+		report: &Report{Steps: []Step{}},
+	}
+}
+
+// Enabled tells whether the recorder actually records something.
+// It can be used to avoid taking costly snapshots for nothing.
+func (recorder *Recorder) Enabled() bool {
+	return recorder != nil
+}
+
+// WithScope returns a recorder sharing the same report, in which steps
+// are recorded under the given scope.
+func (recorder *Recorder) WithScope(scope string) *Recorder {
+	if recorder == nil {
+		return nil
+	}
+
+	if recorder.scope != "" {
+		scope = recorder.scope + " / " + scope
+	}
+
+	return &Recorder{
+		scope:  scope,
+		lock:   recorder.lock,
+		report: recorder.report,
+	}
+}
+
+// RecordSchemas records the changes made to schemas by a step, along with
+// its targets that matched nothing.
+func (recorder *Recorder) RecordSchemas(name string, before ast.Schemas, after ast.Schemas, unmatched ...string) {
+	if recorder == nil {
+		return
+	}
+
+	recorder.record(name, diff.Schemas(before, after), unmatched)
+}
+
+// RecordBuilders records the changes made to builders by a step, along with
+// its targets that matched nothing.
+func (recorder *Recorder) RecordBuilders(name string, before ast.Builders, after ast.Builders, unmatched ...string) {
+	if recorder == nil {
+		return
+	}
+
+	recorder.record(name, diff.Builders(before, after), unmatched)
+}
+
+func (recorder *Recorder) record(name string, report diff.Report, unmatched []string) {
+	changes := report.Changes
+	if changes == nil {
+		changes = []diff.Change{}
+	}
+
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+
+	recorder.report.Steps = append(recorder.report.Steps, Step{
+		Scope:     recorder.scope,
+		Name:      name,
+		Changes:   changes,
+		Unmatched: unmatched,
+	})
+}
+
+// Merge records the steps of the given report, under the recorder's scope.
+func (recorder *Recorder) Merge(report Report) {
+	if recorder == nil {
+		return
+	}
+
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+
+	for _, step := range report.Steps {
+		if recorder.scope != "" {
+			step.Scope = strings.TrimSuffix(recorder.scope+" / "+step.Scope, " / ")
+		}
+
+		recorder.report.Steps = append(recorder.report.Steps, step)
+	}
+}
+
+func (recorder *Recorder) Report() Report {
+	if recorder == nil {
+		return Report{Steps: []Step{}}
+	}
+
+	recorder.lock.Lock()
+	defer recorder.lock.Unlock()
+
+	steps := make([]Step, 0, len(recorder.report.Steps))
+	steps = append(steps, recorder.report.Steps...)
+
+	return Report{Steps: steps}
+}
+
+// Unmatched returns the targets that matched nothing in every step sharing
+// the same name. Steps are usually executed several times (once per
+// language, …): a target matching something at least once is considered
+// matched.
+func (report Report) Unmatched() []Unmatched {
+	executions := make(map[string]int)
+	unmatchedCount := make(map[Unmatched]int)
+	var candidates []Unmatched
+
+	for _, step := range report.Steps {
+		executions[step.Name]++
+
+		for _, target := range step.Unmatched {
+			unmatched := Unmatched{Step: step.Name, Target: target}
+			if unmatchedCount[unmatched] == 0 {
+				candidates = append(candidates, unmatched)
+			}
+
+			unmatchedCount[unmatched]++
+		}
+	}
+
+	result := make([]Unmatched, 0, len(candidates))
+	for _, candidate := range candidates {
+		if unmatchedCount[candidate] == executions[candidate.Step] {
+			result = append(result, candidate)
+		}
+	}
+
+	return result
+}
+
+// Name returns a human-readable name for the given value, usually a compiler pass.
+// Values can describe themselves by implementing a `TraceName() string` method.
+func Name(value any) string {
+	if named, ok := value.(interface{ TraceName() string }); ok {
+		return named.TraceName()
+	}
+
+	name := strings.TrimPrefix(fmt.Sprintf("%T", value), "*")
+
+	return strings.TrimPrefix(name, "compiler.")
+}
@@ -0,0 +1,124 @@
+package trace
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/stretchr/testify/require"
+)
+
+func schemaWith(objects ...ast.Object) ast.Schemas {
+	schema := ast.NewSchema("pkg", ast.SchemaMeta{})
+	schema.AddObjects(objects...)
+
+	return ast.Schemas{schema}
+}
+
+func TestRecorder_nilRecorderIsDisabled(t *testing.T) {
+	req := require.New(t)
+
+	var recorder *Recorder
+
+	recorder.WithScope("go").RecordSchemas("pass", nil, nil)
+
+	req.False(recorder.Enabled())
+	req.Empty(recorder.Report().Steps)
+}
+
+func TestRecorder_recordsStepsWithScopes(t *testing.T) {
+	req := require.New(t)
+
+	before := schemaWith(ast.NewObject("pkg", "Foo", ast.String()))
+	after := schemaWith(ast.NewObject("pkg", "Foo", ast.String()), ast.NewObject("pkg", "Bar", ast.String()))
+
+	recorder := NewRecorder()
+	recorder.WithScope("go").WithScope("language passes").RecordSchemas("AddBar", before, after)
+	recorder.WithScope("go").RecordSchemas("Noop", after, after)
+
+	report := recorder.Report()
+
+	req.Len(report.Steps, 2)
+	req.Equal("go / language passes", report.Steps[0].Scope)
+	req.Equal("AddBar", report.Steps[0].Name)
+	req.Len(report.Steps[0].Changes, 1)
+	req.Equal("pkg.Bar", report.Steps[0].Changes[0].Path)
+	req.Equal("go", report.Steps[1].Scope)
+	req.Empty(report.Steps[1].Changes)
+}
+
+func TestRecorder_Merge(t *testing.T) {
+	req := require.New(t)
+
+	schemas := schemaWith(ast.NewObject("pkg", "Foo", ast.String()))
+
+	languageRecorder := NewRecorder()
+	languageRecorder.WithScope("go").RecordSchemas("Noop", schemas, schemas)
+	languageRecorder.RecordSchemas("Unscoped", schemas, schemas)
+
+	recorder := NewRecorder()
+	recorder.RecordSchemas("Input", schemas, schemas)
+	recorder.Merge(languageRecorder.Report())
+	recorder.WithScope("pipeline").Merge(languageRecorder.Report())
+
+	report := recorder.Report()
+
+	req.Len(report.Steps, 5)
+	req.Equal("", report.Steps[0].Scope)
+	req.Equal("go", report.Steps[1].Scope)
+	req.Equal("", report.Steps[2].Scope)
+	req.Equal("pipeline / go", report.Steps[3].Scope)
+	req.Equal("pipeline", report.Steps[4].Scope)
+}
+
+func TestReport_Unmatched(t *testing.T) {
+	req := require.New(t)
+
+	schemas := schemaWith(ast.NewObject("pkg", "Foo", ast.String()))
+
+	recorder := NewRecorder()
+	recorder.WithScope("go").RecordSchemas("passes.yaml:3: Omit", schemas, schemas, "pkg.Bar", "pkg.Baz")
+	recorder.WithScope("go").RecordSchemas("Noop", schemas, schemas)
+	recorder.WithScope("python").RecordSchemas("passes.yaml:3: Omit", schemas, schemas, "pkg.Bar")
+
+	req.Equal([]Unmatched{
+		{Step: "passes.yaml:3: Omit", Target: "pkg.Bar"},
+	}, recorder.Report().Unmatched())
+	req.Equal("passes.yaml:3: Omit: pkg.Bar matched nothing", recorder.Report().Unmatched()[0].String())
+}
+
+func TestReport_WriteMarkdown(t *testing.T) {
+	req := require.New(t)
+
+	before := schemaWith(ast.NewObject("pkg", "Foo", ast.String()))
+	after := schemaWith(ast.NewObject("pkg", "Foo", ast.NewScalar(ast.KindInt64)))
+
+	recorder := NewRecorder()
+	recorder.WithScope("inputs[0]").RecordSchemas("ChangeType", before, after)
+	recorder.WithScope("inputs[0]").RecordSchemas("Noop", after, after, "pkg.Bar")
+
+	buffer := &bytes.Buffer{}
+	req.NoError(recorder.Report().WriteMarkdown(buffer))
+
+	req.Equal("# Compiler passes and veneers trace\n"+
+		"\n## inputs[0]\n"+
+		"\n### `ChangeType`\n\n"+
+		"* **changed** `pkg.Foo`: type changed (`string` → `int64`)\n"+
+		"\n### `Noop`\n\n"+
+		"* **unmatched** `pkg.Bar`\n\n"+
+		"_No changes._\n", buffer.String())
+}
+
+func TestName(t *testing.T) {
+	req := require.New(t)
+
+	req.Equal("trace.Recorder", Name(&Recorder{}))
+	req.Equal("trace.Step", Name(Step{}))
+	req.Equal("custom", Name(namedValue{}))
+}
+
+type namedValue struct{}
+
+func (namedValue) TraceName() string {
+	return "custom"
+}
@@ -1,32 +1,46 @@
 package builder
 
 import (
+	"context"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/tools"
 	"github.com/grafana/cog/internal/veneers"
 )
 
-type RewriteRule func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error)
+// RewriteAction transforms builders.
+type RewriteAction func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error)
+
+type RewriteRule struct {
+	// Selector describes the builders targeted by the rule.
+	// Rules that don't target specific builders (ie: external ones) have
+	// no selector.
+	Selector Selector
+	Action   RewriteAction
+}
 
 func mapToSelected(selector Selector, mapFunc func(builders ast.Builders, builder ast.Builder) (ast.Builder, error)) RewriteRule {
-	return func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
-		for i, b := range builders {
-			if !selector(schemas, b) {
-				continue
-			}
+	return RewriteRule{
+		Selector: selector,
+		Action: func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
+			for i, b := range builders {
+				if !selector(schemas, b) {
+					continue
+				}
 
-			newBuilder, err := mapFunc(builders, b)
-			if err != nil {
-				return nil, err
-			}
+				newBuilder, err := mapFunc(builders, b)
+				if err != nil {
+					return nil, err
+				}
 
-			builders[i] = newBuilder
-		}
+				builders[i] = newBuilder
+			}
 
-		return builders, nil
+			return builders, nil
+		},
 	}
 }
 
@@ -63,18 +77,21 @@ func mergeOptions(fromBuilder ast.Builder, intoBuilder ast.Builder, underPath as
 }
 
 func Omit(selector Selector) RewriteRule {
-	return func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
-		filteredBuilders := make([]ast.Builder, 0, len(builders))
+	return RewriteRule{
+		Selector: selector,
+		Action: func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
+			filteredBuilders := make([]ast.Builder, 0, len(builders))
 
-		for _, builder := range builders {
-			if selector(schemas, builder) {
-				continue
-			}
+			for _, builder := range builders {
+				if selector(schemas, builder) {
+					continue
+				}
 
-			filteredBuilders = append(filteredBuilders, builder)
-		}
+				filteredBuilders = append(filteredBuilders, builder)
+			}
 
-		return filteredBuilders, nil
+			return filteredBuilders, nil
+		},
 	}
 }
 
@@ -173,128 +190,143 @@ func composePanelType(builders ast.Builders, panelType string, panelBuilder ast.
 }
 
 func ComposeDashboardPanel(selector Selector, panelBuilderName string, panelOptionsToExclude []string) RewriteRule {
-	return func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
-		panelBuilderPkg, panelBuilderNameWithoutPkg, found := strings.Cut(panelBuilderName, ".")
-		if !found {
-			return nil, fmt.Errorf("panelBuilderName '%s' is incorrect: no package found", panelBuilderPkg)
-		}
+	return RewriteRule{
+		Selector: selector,
+		Action: func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
+			panelBuilderPkg, panelBuilderNameWithoutPkg, found := strings.Cut(panelBuilderName, ".")
+			if !found {
+				return nil, fmt.Errorf("panelBuilderName '%s' is incorrect: no package found", panelBuilderPkg)
+			}
 
-		panelBuilder, found := builders.LocateByObject(panelBuilderPkg, panelBuilderNameWithoutPkg)
-		if !found {
-			// We couldn't find the panel builder: let's return the builders untouched.
-			return builders, nil
-		}
+			panelBuilder, found := builders.LocateByObject(panelBuilderPkg, panelBuilderNameWithoutPkg)
+			if !found {
+				// We couldn't find the panel builder: let's return the builders untouched.
+				return builders, nil
+			}
+
+			// - add to newBuilders all the builders that are not composable (ie: don't comply to the selector)
+			// - build a map of composable builders, indexed by panel type
+			// - aggregate the composable builders into a new, composed panel builder
+			// - add the new composed panel builders to newBuilders
 
-		// - add to newBuilders all the builders that are not composable (ie: don't comply to the selector)
-		// - build a map of composable builders, indexed by panel type
-		// - aggregate the composable builders into a new, composed panel builder
-		// - add the new composed panel builders to newBuilders
+			newBuilders := make([]ast.Builder, 0, len(builders))
+			composableBuilders := make(map[string]ast.Builders)
 
-		newBuilders := make([]ast.Builder, 0, len(builders))
-		composableBuilders := make(map[string]ast.Builders)
+			for _, builder := range builders {
+				// the builder is for a composable type
+				if selector(schemas, builder) {
+					schema, found := schemas.Locate(builder.For.SelfRef.ReferredPkg)
+					if !found {
+						continue
+					}
 
-		for _, builder := range builders {
-			// the builder is for a composable type
-			if selector(schemas, builder) {
-				schema, found := schemas.Locate(builder.For.SelfRef.ReferredPkg)
-				if !found {
+					panelType := schema.Metadata.Identifier
+					composableBuilders[panelType] = append(composableBuilders[panelType], builder)
 					continue
 				}
 
-				panelType := schema.Metadata.Identifier
-				composableBuilders[panelType] = append(composableBuilders[panelType], builder)
-				continue
+				newBuilders = append(newBuilders, builder)
 			}
 
-			newBuilders = append(newBuilders, builder)
-		}
-
-		for panelType, buildersForType := range composableBuilders {
-			composedBuilder, err := composePanelType(builders, panelType, panelBuilder, buildersForType, panelOptionsToExclude)
-			if err != nil {
-				return nil, err
-			}
+			for panelType, buildersForType := range composableBuilders {
+				composedBuilder, err := composePanelType(builders, panelType, panelBuilder, buildersForType, panelOptionsToExclude)
+				if err != nil {
+					return nil, err
+				}
 
-			composedBuilder.AddToVeneerTrail("ComposeDashboardPanel")
+				composedBuilder.AddToVeneerTrail("ComposeDashboardPanel")
 
-			newBuilders = append(newBuilders, composedBuilder)
-		}
+				newBuilders = append(newBuilders, composedBuilder)
+			}
 
-		return newBuilders, nil
+			return newBuilders, nil
+		},
 	}
 }
 
 func Rename(selector Selector, newName string) RewriteRule {
-	return func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
-		for i, builder := range builders {
-			if !selector(schemas, builder) {
-				continue
-			}
+	return RewriteRule{
+		Selector: selector,
+		Action: func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
+			for i, builder := range builders {
+				if !selector(schemas, builder) {
+					continue
+				}
 
-			builders[i].Name = newName
-			builders[i].AddToVeneerTrail("Rename")
-		}
+				builders[i].Name = newName
+				builders[i].AddToVeneerTrail("Rename")
+			}
 
-		return builders, nil
+			return builders, nil
+		},
 	}
 }
 
 func VeneerTrailAsComments(selector Selector) RewriteRule {
-	return func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
-		for i, builder := range builders {
-			if !selector(schemas, builder) {
-				continue
-			}
+	return RewriteRule{
+		Selector: selector,
+		Action: func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
+			for i, builder := range builders {
+				if !selector(schemas, builder) {
+					continue
+				}
 
-			veneerTrail := tools.Map(builder.VeneerTrail, func(veneer string) string {
-				return fmt.Sprintf("Modified by veneer '%s'", veneer)
-			})
+				veneerTrail := tools.Map(builder.VeneerTrail, func(veneer string) string {
+					return fmt.Sprintf("Modified by veneer '%s'", veneer)
+				})
 
-			builders[i].For.Comments = append(builders[i].For.Comments, veneerTrail...)
-		}
+				builders[i].For.Comments = append(builders[i].For.Comments, veneerTrail...)
+			}
 
-		return builders, nil
+			return builders, nil
+		},
 	}
 }
 
 func Properties(selector Selector, properties []ast.StructField) RewriteRule {
-	return func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
-		for i, builder := range builders {
-			if !selector(schemas, builder) {
-				continue
-			}
+	return RewriteRule{
+		Selector: selector,
+		Action: func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
+			for i, builder := range builders {
+				if !selector(schemas, builder) {
+					continue
+				}
 
-			builders[i].Properties = append(builders[i].Properties, properties...)
-			builders[i].AddToVeneerTrail("Properties")
-		}
+				builders[i].Properties = append(builders[i].Properties, properties...)
+				builders[i].AddToVeneerTrail("Properties")
+			}
 
-		return builders, nil
+			return builders, nil
+		},
 	}
 }
 
 func Duplicate(selector Selector, duplicateName string, excludeOptions []string) RewriteRule {
-	return func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
-		var newBuilders ast.Builders
+	return RewriteRule{
+		Selector: selector,
+		Action: func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
+			var newBuilders ast.Builders
 
-		for _, builder := range builders {
-			if !selector(schemas, builder) {
-				continue
-			}
+			for _, builder := range builders {
+				if !selector(schemas, builder) {
+					continue
+				}
 
-			duplicatedBuilder := builder.DeepCopy()
-			duplicatedBuilder.Name = duplicateName
-			duplicatedBuilder.AddToVeneerTrail(fmt.Sprintf("Duplicate[%s.%s]", builder.Package, builder.Name))
+				duplicatedBuilder := builder.DeepCopy()
+				duplicatedBuilder.Name = duplicateName
+				duplicatedBuilder.AddToVeneerTrail(fmt.Sprintf("Duplicate[%s.%s]", builder.Package, builder.Name))
 
-			if len(excludeOptions) != 0 {
-				duplicatedBuilder.Options = tools.Filter(duplicatedBuilder.Options, func(option ast.Option) bool {
-					return !tools.StringInListEqualFold(option.Name, excludeOptions)
-				})
-			}
+				if len(excludeOptions) != 0 {
+					duplicatedBuilder.Options = tools.Filter(duplicatedBuilder.Options, func(option ast.Option) bool {
+						return !tools.StringInListEqualFold(option.Name, excludeOptions)
+					})
+				}
 
-			newBuilders = append(newBuilders, duplicatedBuilder)
-		}
+				newBuilders = append(newBuilders, duplicatedBuilder)
+			}
 
-		return append(builders, newBuilders...), nil
+			return append(builders, newBuilders...), nil
+		},
 	}
 }
 
@@ -304,26 +336,29 @@ type Initialization struct {
 }
 
 func Initialize(selector Selector, statements []Initialization) RewriteRule {
-	return func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
-		for i, builder := range builders {
-			if !selector(schemas, builder) {
-				continue
-			}
-
-			veneerDebug := make([]string, 0, len(statements))
-			for _, statement := range statements {
-				path, err := builders[i].MakePath(builders, statement.PropertyPath)
-				if err != nil {
-					return nil, err
+	return RewriteRule{
+		Selector: selector,
+		Action: func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
+			for i, builder := range builders {
+				if !selector(schemas, builder) {
+					continue
 				}
 
-				builders[i].Constructor.Assignments = append(builders[i].Constructor.Assignments, ast.ConstantAssignment(path, statement.Value))
-				veneerDebug = append(veneerDebug, fmt.Sprintf("%s = %v", statement.PropertyPath, statement.Value))
+				veneerDebug := make([]string, 0, len(statements))
+				for _, statement := range statements {
+					path, err := builders[i].MakePath(builders, statement.PropertyPath)
+					if err != nil {
+						return nil, err
+					}
+
+					builders[i].Constructor.Assignments = append(builders[i].Constructor.Assignments, ast.ConstantAssignment(path, statement.Value))
+					veneerDebug = append(veneerDebug, fmt.Sprintf("%s = %v", statement.PropertyPath, statement.Value))
+				}
+				builders[i].AddToVeneerTrail(fmt.Sprintf("Initialize[%s]", strings.Join(veneerDebug, ", ")))
 			}
-			builders[i].AddToVeneerTrail(fmt.Sprintf("Initialize[%s]", strings.Join(veneerDebug, ", ")))
-		}
 
-		return builders, nil
+			return builders, nil
+		},
 	}
 }
 
@@ -331,50 +366,102 @@ func Initialize(selector Selector, statements []Initialization) RewriteRule {
 // parameters. Both arguments and assignments described by the options
 // will be exposed in the builder's constructor.
 func PromoteOptionsToConstructor(selector Selector, optionNames []string) RewriteRule {
-	return func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
-		for i, builder := range builders {
-			if !selector(schemas, builder) {
-				continue
-			}
-
-			for _, optName := range optionNames {
-				opt, ok := builder.OptionByName(optName)
-				if !ok {
+	return RewriteRule{
+		Selector: selector,
+		Action: func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
+			for i, builder := range builders {
+				if !selector(schemas, builder) {
 					continue
 				}
 
-				// TODO: do it for every argument/assignment?
-				arg := opt.Args[0].DeepCopy()
-				arg.Type.Nullable = false
+				for _, optName := range optionNames {
+					opt, ok := builder.OptionByName(optName)
+					if !ok {
+						continue
+					}
+
+					// TODO: do it for every argument/assignment?
+					arg := opt.Args[0].DeepCopy()
+					arg.Type.Nullable = false
 
-				builders[i].Constructor.Args = append(builders[i].Constructor.Args, arg)
-				builders[i].Constructor.Assignments = append(builders[i].Constructor.Assignments, opt.Assignments[0])
+					builders[i].Constructor.Args = append(builders[i].Constructor.Args, arg)
+					builders[i].Constructor.Assignments = append(builders[i].Constructor.Assignments, opt.Assignments[0])
 
-				builders[i].AddToVeneerTrail(fmt.Sprintf("PromoteOptionsToConstructor[%s]", optName))
+					builders[i].AddToVeneerTrail(fmt.Sprintf("PromoteOptionsToConstructor[%s]", optName))
+				}
 			}
-		}
 
-		return builders, nil
+			return builders, nil
+		},
 	}
 }
 
 // AddOption adds a completely new option to the selected builders.
 func AddOption(selector Selector, newOption veneers.Option) RewriteRule {
-	return func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
-		for i, builder := range builders {
-			if !selector(schemas, builder) {
-				continue
+	return RewriteRule{
+		Selector: selector,
+		Action: func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
+			for i, builder := range builders {
+				if !selector(schemas, builder) {
+					continue
+				}
+
+				newOpt, err := newOption.AsIR(builders, builder)
+				if err != nil {
+					return nil, err
+				}
+
+				builders[i].Options = append(builders[i].Options, newOpt)
+				builders[i].AddToVeneerTrail("AddOption")
 			}
 
-			newOpt, err := newOption.AsIR(builders, builder)
-			if err != nil {
+			return builders, nil
+		},
+	}
+}
+
+// External delegates the transformation of builders to an external executable.
+// An object with the schemas and builders is written as JSON on the
+// executable's standard input: `{"Schemas": [...], "Builders": [...]}`.
+// The transformed builders are read as JSON from its standard output, and
+// validated before being used.
+// The command is killed if the given context is done, or if it runs for
+// longer than the given timeout (when it isn't zero).
+func External(ctx context.Context, command string, args []string, timeout time.Duration) RewriteRule {
+	return RewriteRule{
+		Action: func(schemas ast.Schemas, builders ast.Builders) (ast.Builders, error) {
+			commandCtx := ctx
+			if timeout > 0 {
+				var cancel context.CancelFunc
+				commandCtx, cancel = context.WithTimeout(ctx, timeout)
+				defer cancel()
+			}
+
+			input := struct {
+				Schemas  ast.Schemas
+				Builders ast.Builders
+			}{
+				Schemas:  schemas,
+				Builders: builders,
+			}
+
+			var processed ast.Builders
+			if err := tools.RunJSONCommand(commandCtx, command, args, input, &processed); err != nil {
 				return nil, err
 			}
 
-			builders[i].Options = append(builders[i].Options, newOpt)
-			builders[i].AddToVeneerTrail("AddOption")
-		}
+			if err := processed.Validate(schemas); err != nil {
+				return nil, fmt.Errorf("command '%s' returned invalid builders: %w", command, err)
+			}
+
+			// deep copies initialize fields left empty by the JSON encoding,
+			// like hints maps.
+			return tools.Map(processed, func(builder ast.Builder) ast.Builder {
+				clone := builder.DeepCopy()
+				clone.For = builder.For.DeepCopy()
 
-		return builders, nil
+				return clone
+			}), nil
+		},
 	}
 }
@@ -1,7 +1,11 @@
 package builder
 
 import (
+	"context"
+	"os"
+	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/testutils"
@@ -39,7 +43,7 @@ func TestDuplicate(t *testing.T) {
 	}
 
 	rule := Duplicate(ByName("pkg", "Dashboard"), "NewDashboard", nil)
-	updatedBuilders, err := rule(schemas, originalBuilders)
+	updatedBuilders, err := rule.Action(schemas, originalBuilders)
 	req.NoError(err)
 
 	req.Len(updatedBuilders, 2)
@@ -85,7 +89,7 @@ func TestInitialize(t *testing.T) {
 			{PropertyPath: "name", Value: "great name, isn't it?"},
 		},
 	)
-	updatedBuilders, err := rule(schemas, originalBuilders)
+	updatedBuilders, err := rule.Action(schemas, originalBuilders)
 	req.NoError(err)
 
 	expectedAssignments := []ast.Assignment{
@@ -134,7 +138,7 @@ func TestPromoteOptionsToConstructor(t *testing.T) {
 		ByName("pkg", "Dashboard"),
 		[]string{"name"},
 	)
-	updatedBuilders, err := rule(schemas, originalBuilders)
+	updatedBuilders, err := rule.Action(schemas, originalBuilders)
 	req.NoError(err)
 
 	expectedArgs := []ast.Argument{argument}
@@ -144,3 +148,56 @@ func TestPromoteOptionsToConstructor(t *testing.T) {
 	req.Equal(expectedArgs, updatedBuilders[0].Constructor.Args)
 	req.Equal(expectedAssignments, updatedBuilders[0].Constructor.Assignments)
 }
+
+func TestExternal(t *testing.T) {
+	req := require.New(t)
+
+	originalObject := ast.NewObject("pkg", "Dashboard", ast.NewStruct(
+		ast.NewStructField("name", ast.String()),
+	))
+	schemas := ast.Schemas{
+		&ast.Schema{
+			Package: "pkg",
+			Objects: testutils.ObjectsMap(originalObject),
+		},
+	}
+	originalBuilders := ast.Builders{
+		{For: originalObject, Package: "pkg", Name: "Dashboard"},
+	}
+
+	// the script receives both schemas and builders, and only returns builders
+	script := filepath.Join(t.TempDir(), "veneer.sh")
+	req.NoError(os.WriteFile(script, []byte(`#!/bin/sh
+sed -e 's/^.*"Builders"://' -e 's/}$//' -e 's/"Name":"Dashboard","Constructor"/"Name":"NewDashboard","Constructor"/'
+`), 0700))
+
+	updatedBuilders, err := External(context.Background(), script, nil, 0).Action(schemas, originalBuilders)
+	req.NoError(err)
+
+	req.Len(updatedBuilders, 1)
+	req.Equal("NewDashboard", updatedBuilders[0].Name)
+	req.Equal(originalObject, updatedBuilders[0].For)
+
+	// builders are validated
+	invalid := filepath.Join(t.TempDir(), "invalid.sh")
+	req.NoError(os.WriteFile(invalid, []byte("#!/bin/sh\necho '[{\"Package\": \"pkg\"}]'\n"), 0700))
+
+	_, err = External(context.Background(), invalid, nil, 0).Action(schemas, originalBuilders)
+	req.ErrorContains(err, "returned invalid builders")
+}
+
+func TestExternal_withTimeout(t *testing.T) {
+	req := require.New(t)
+
+	script := filepath.Join(t.TempDir(), "slow.sh")
+	req.NoError(os.WriteFile(script, []byte("#!/bin/sh\nexec sleep 5\n"), 0700))
+
+	_, err := External(context.Background(), script, nil, 50*time.Millisecond).Action(nil, nil)
+	req.ErrorIs(err, context.DeadlineExceeded)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, err = External(ctx, script, nil, 0).Action(nil, nil)
+	req.ErrorIs(err, context.Canceled)
+}
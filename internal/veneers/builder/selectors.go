@@ -1,9 +1,8 @@
 package builder
 
 import (
-	"strings"
-
 	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/veneers"
 )
 
 type Selector func(schemas ast.Schemas, builder ast.Builder) bool
@@ -19,18 +18,74 @@ func EveryBuilder() Selector {
 // package and name).
 // Note: the comparison on object name is case-insensitive.
 func ByObjectName(pkg string, objectName string) Selector {
+	return ByObjectMatching(veneers.Exact(pkg), veneers.Exact(objectName))
+}
+
+// ByObjectMatching matches builders for objects whose package and name are
+// accepted by the given matchers.
+func ByObjectMatching(pkg veneers.Matcher, objectName veneers.Matcher) Selector {
 	return func(_ ast.Schemas, builder ast.Builder) bool {
-		return strings.EqualFold(builder.For.SelfRef.ReferredPkg, pkg) &&
-			strings.EqualFold(builder.For.SelfRef.ReferredType, objectName)
+		return pkg(builder.For.SelfRef.ReferredPkg) && objectName(builder.For.SelfRef.ReferredType)
 	}
 }
 
 // ByName matches builders for the given name.
 // Note: the comparison on builder name is case-insensitive.
 func ByName(pkg string, builderName string) Selector {
+	return ByNameMatching(veneers.Exact(pkg), veneers.Exact(builderName))
+}
+
+// ByNameMatching matches builders whose name is accepted by the given
+// matcher, for objects in packages accepted by the given matcher.
+func ByNameMatching(pkg veneers.Matcher, builderName veneers.Matcher) Selector {
 	return func(_ ast.Schemas, builder ast.Builder) bool {
-		return strings.EqualFold(builder.For.SelfRef.ReferredPkg, pkg) &&
-			strings.EqualFold(builder.Name, builderName)
+		return pkg(builder.For.SelfRef.ReferredPkg) && builderName(builder.Name)
+	}
+}
+
+// ByVariant matches builders defined in packages implementing the given
+// schema variant (panelcfg, dataquery, …).
+func ByVariant(variant ast.SchemaVariant) Selector {
+	return func(schemas ast.Schemas, builder ast.Builder) bool {
+		schema, found := schemas.Locate(builder.Package)
+		if !found {
+			return false
+		}
+
+		return schema.Metadata.Variant == variant
+	}
+}
+
+// All matches builders matched by every given selector.
+func All(selectors ...Selector) Selector {
+	return func(schemas ast.Schemas, builder ast.Builder) bool {
+		for _, selector := range selectors {
+			if !selector(schemas, builder) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Any matches builders matched by at least one of the given selectors.
+func Any(selectors ...Selector) Selector {
+	return func(schemas ast.Schemas, builder ast.Builder) bool {
+		for _, selector := range selectors {
+			if selector(schemas, builder) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Not matches builders not matched by the given selector.
+func Not(selector Selector) Selector {
+	return func(schemas ast.Schemas, builder ast.Builder) bool {
+		return !selector(schemas, builder)
 	}
 }
 
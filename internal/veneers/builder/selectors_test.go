@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/veneers"
 	"github.com/stretchr/testify/require"
 )
 
@@ -32,3 +33,60 @@ func TestByBuilder(t *testing.T) {
 	req.True(ByName("dashboard", "emptydashboard")(ast.Schemas{}, dashboardBuilder))
 	req.False(ByName("dashboard", "Dashboard")(ast.Schemas{}, dashboardBuilder))
 }
+
+func TestByObjectMatching(t *testing.T) {
+	req := require.New(t)
+
+	panelBuilder := ast.Builder{
+		Name: "Panel",
+		For:  ast.NewObject("timeseries", "Panel", ast.NewStruct()),
+	}
+
+	req.True(ByObjectMatching(veneers.AnyOf(veneers.Exact("heatmap"), veneers.Exact("timeseries")), veneers.Exact("panel"))(ast.Schemas{}, panelBuilder))
+	req.False(ByObjectMatching(veneers.Exact("heatmap"), veneers.Exact("Panel"))(ast.Schemas{}, panelBuilder))
+}
+
+func TestByVariant(t *testing.T) {
+	req := require.New(t)
+
+	schemas := ast.Schemas{
+		&ast.Schema{Package: "timeseries", Metadata: ast.SchemaMeta{Kind: ast.SchemaKindComposable, Variant: ast.SchemaVariantPanel}},
+		&ast.Schema{Package: "prometheus", Metadata: ast.SchemaMeta{Kind: ast.SchemaKindComposable, Variant: ast.SchemaVariantDataQuery}},
+	}
+
+	panelBuilder := ast.Builder{
+		Name:    "Panel",
+		Package: "timeseries",
+		For:     ast.NewObject("dashboard", "Panel", ast.NewStruct()),
+	}
+	queryBuilder := ast.Builder{
+		Name:    "Dataquery",
+		Package: "prometheus",
+		For:     ast.NewObject("prometheus", "Dataquery", ast.NewStruct()),
+	}
+
+	req.True(ByVariant(ast.SchemaVariantPanel)(schemas, panelBuilder))
+	req.False(ByVariant(ast.SchemaVariantPanel)(schemas, queryBuilder))
+	req.False(ByVariant(ast.SchemaVariantPanel)(ast.Schemas{}, panelBuilder))
+}
+
+func TestCombinators(t *testing.T) {
+	req := require.New(t)
+
+	dashboardBuilder := ast.Builder{
+		Name: "EmptyDashboard",
+		For:  ast.NewObject("dashboard", "Dashboard", ast.NewStruct()),
+	}
+
+	matching := ByObjectName("dashboard", "Dashboard")
+	notMatching := ByName("dashboard", "Dashboard")
+
+	req.True(All(matching, EveryBuilder())(ast.Schemas{}, dashboardBuilder))
+	req.False(All(matching, notMatching)(ast.Schemas{}, dashboardBuilder))
+
+	req.True(Any(notMatching, matching)(ast.Schemas{}, dashboardBuilder))
+	req.False(Any(notMatching)(ast.Schemas{}, dashboardBuilder))
+
+	req.True(Not(notMatching)(ast.Schemas{}, dashboardBuilder))
+	req.False(Not(matching)(ast.Schemas{}, dashboardBuilder))
+}
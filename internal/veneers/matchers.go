@@ -0,0 +1,80 @@
+package veneers
+
+import (
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Matcher tells whether a name (of a package, an object, a builder, …)
+// matches some criteria.
+type Matcher func(name string) bool
+
+// Exact matches names equal to the given one.
+// Note: the comparison is case-insensitive.
+func Exact(expected string) Matcher {
+	return func(name string) bool {
+		return strings.EqualFold(name, expected)
+	}
+}
+
+// Glob matches names against a shell-like pattern (see path.Match).
+// Note: the comparison is case-insensitive.
+func Glob(pattern string) (Matcher, error) {
+	pattern = strings.ToLower(pattern)
+
+	if _, err := path.Match(pattern, ""); err != nil {
+		return nil, fmt.Errorf("invalid glob pattern '%s': %w", pattern, err)
+	}
+
+	return func(name string) bool {
+		matched, _ := path.Match(pattern, strings.ToLower(name))
+		return matched
+	}, nil
+}
+
+// Regex matches names against the given regular expression.
+func Regex(pattern string) (Matcher, error) {
+	regex, err := regexp.Compile(pattern)
+	if err != nil {
+		return nil, fmt.Errorf("invalid regular expression '%s': %w", pattern, err)
+	}
+
+	return regex.MatchString, nil
+}
+
+// AnyOf matches names matched by at least one of the given matchers.
+func AnyOf(matchers ...Matcher) Matcher {
+	return func(name string) bool {
+		for _, matcher := range matchers {
+			if matcher(name) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Pattern parses a pattern written in a veneers file:
+//   - `/regex/` matches names against a regular expression,
+//   - patterns containing `*`, `?` or `[` are globs,
+//   - anything else matches names exactly (case-insensitive).
+func Pattern(pattern string) (Matcher, error) {
+	if IsRegexPattern(pattern) {
+		return Regex(pattern[1 : len(pattern)-1])
+	}
+
+	if strings.ContainsAny(pattern, "*?[") {
+		return Glob(pattern)
+	}
+
+	return Exact(pattern), nil
+}
+
+// IsRegexPattern tells whether the given pattern describes a regular
+// expression.
+func IsRegexPattern(pattern string) bool {
+	return len(pattern) >= 2 && strings.HasPrefix(pattern, "/") && strings.HasSuffix(pattern, "/")
+}
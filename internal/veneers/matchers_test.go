@@ -0,0 +1,59 @@
+package veneers
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPattern(t *testing.T) {
+	testCases := []struct {
+		pattern  string
+		matching []string
+		rejected []string
+	}{
+		{
+			pattern:  "Dashboard",
+			matching: []string{"Dashboard", "dashboard"},
+			rejected: []string{"DashboardLink", "Panel"},
+		},
+		{
+			pattern:  "with*",
+			matching: []string{"withPanel", "WithRow"},
+			rejected: []string{"panels"},
+		},
+		{
+			pattern:  "/^(time|x)series$/",
+			matching: []string{"timeseries", "xseries"},
+			rejected: []string{"Timeseries", "timeseriesbis"},
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+
+		t.Run(tc.pattern, func(t *testing.T) {
+			req := require.New(t)
+
+			matcher, err := Pattern(tc.pattern)
+			req.NoError(err)
+
+			for _, name := range tc.matching {
+				req.True(matcher(name), "expected '%s' to match", name)
+			}
+			for _, name := range tc.rejected {
+				req.False(matcher(name), "expected '%s' not to match", name)
+			}
+		})
+	}
+}
+
+func TestPattern_withInvalidPatterns(t *testing.T) {
+	req := require.New(t)
+
+	_, err := Pattern("/(/")
+	req.Error(err)
+
+	_, err = Pattern("[a-")
+	req.Error(err)
+}
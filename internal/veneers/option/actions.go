@@ -82,6 +82,77 @@ func ArrayToAppendAction() RewriteAction {
 	}
 }
 
+// MapToIndexAction updates the option to set a single entry of a map,
+// using an "index" assignment.
+//
+// Example:
+//
+//	```
+//	func Labels(labels map[string]string) {
+//		this.resource.labels = labels
+//	}
+//	```
+//
+// Will become:
+//
+//	```
+//	func Labels(key string, value string) {
+//		this.resource.labels[key] = value
+//	}
+//	```
+//
+// Only the first argument and the first assignment are transformed, other
+// ones are kept as-is.
+//
+// This action returns the option unchanged if:
+//   - it has no arguments or no assignments
+//   - the first argument is not a map
+//   - the first assignment doesn't directly assign the first argument (ie: it
+//     assigns a constant, or wraps the argument in an envelope)
+func MapToIndexAction() RewriteAction {
+	return func(_ ast.Schemas, _ ast.Builder, option ast.Option) []ast.Option {
+		if len(option.Args) < 1 || !option.Args[0].Type.IsMap() || len(option.Assignments) < 1 {
+			return []ast.Option{option}
+		}
+
+		// the map can only be replaced by a single entry if the argument is
+		// assigned as-is.
+		assignedArg := option.Assignments[0].Value.Argument
+		if assignedArg == nil || assignedArg.Name != option.Args[0].Name {
+			return []ast.Option{option}
+		}
+
+		// Replace the map argument with a key and a value
+		oldArgs := option.Args
+		mapType := option.Args[0].Type.AsMap()
+
+		keyArg := ast.Argument{Name: "key", Type: mapType.IndexType}
+		valueArg := ast.Argument{Name: "value", Type: mapType.ValueType}
+
+		// Update the assignment to set a single entry instead of the whole map
+		oldAssignments := option.Assignments
+
+		newFirstAssignment := option.Assignments[0]
+		newFirstAssignment.Method = ast.IndexAssignment
+		newFirstAssignment.Index = &ast.AssignmentValue{Argument: &keyArg}
+		newFirstAssignment.Value.Argument = &valueArg
+
+		newOpt := option
+		newOpt.Args = []ast.Argument{keyArg, valueArg}
+		newOpt.Assignments = []ast.Assignment{newFirstAssignment}
+		newOpt.AddToVeneerTrail("MapToIndex")
+
+		if len(oldArgs) > 1 {
+			newOpt.Args = append(newOpt.Args, oldArgs[1:]...)
+		}
+		if len(oldAssignments) > 1 {
+			newOpt.Assignments = append(newOpt.Assignments, oldAssignments[1:]...)
+		}
+
+		return []ast.Option{newOpt}
+	}
+}
+
 // OmitAction removes an option.
 func OmitAction() RewriteAction {
 	return func(_ ast.Schemas, _ ast.Builder, _ ast.Option) []ast.Option {
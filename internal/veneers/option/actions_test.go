@@ -279,6 +279,112 @@ func TestArrayToAppendAction_withArrayArgument(t *testing.T) {
 	req.Equal([]ast.Option{expectedOption}, modifiedOpts)
 }
 
+func TestMapToIndexAction_withNonMapArgument(t *testing.T) {
+	req := require.New(t)
+
+	option := ast.Option{
+		Args: []ast.Argument{
+			{Name: "tags", Type: ast.NewArray(ast.String())},
+		},
+		Assignments: []ast.Assignment{
+			ast.ArgumentAssignment(ast.Path{
+				{Identifier: "tags", Type: ast.NewArray(ast.String())},
+			}, ast.Argument{Name: "tags", Type: ast.NewArray(ast.String())}),
+		},
+	}
+	modifiedOpts := MapToIndexAction()(ast.Schemas{}, ast.Builder{}, option)
+
+	req.Equal([]ast.Option{option}, modifiedOpts)
+}
+
+func TestMapToIndexAction_withMapArgument(t *testing.T) {
+	req := require.New(t)
+
+	labelsType := ast.NewMap(ast.String(), ast.String())
+
+	// input
+	option := ast.Option{
+		Name: "labels",
+		Args: []ast.Argument{
+			{Name: "labels", Type: labelsType},
+		},
+		Assignments: []ast.Assignment{
+			ast.ArgumentAssignment(ast.Path{
+				{Identifier: "labels", Type: labelsType},
+			}, ast.Argument{Name: "labels", Type: labelsType}),
+		},
+	}
+
+	// expected output
+	keyArg := ast.Argument{Name: "key", Type: ast.String()}
+	valueArg := ast.Argument{Name: "value", Type: ast.String()}
+	expectedOption := ast.Option{
+		Name: "labels",
+		Args: []ast.Argument{keyArg, valueArg},
+		Assignments: []ast.Assignment{
+			ast.ArgumentAssignment(
+				ast.Path{
+					{Identifier: "labels", Type: labelsType},
+				},
+				valueArg,
+				ast.Index(ast.AssignmentValue{Argument: &keyArg}),
+			),
+		},
+		VeneerTrail: []string{"MapToIndex"},
+	}
+
+	modifiedOpts := MapToIndexAction()(ast.Schemas{}, ast.Builder{}, option)
+
+	req.Equal([]ast.Option{expectedOption}, modifiedOpts)
+}
+
+func TestMapToIndexAction_withoutAssignment(t *testing.T) {
+	req := require.New(t)
+
+	option := ast.Option{
+		Args: []ast.Argument{
+			{Name: "labels", Type: ast.NewMap(ast.String(), ast.String())},
+		},
+	}
+	modifiedOpts := MapToIndexAction()(ast.Schemas{}, ast.Builder{}, option)
+
+	req.Equal([]ast.Option{option}, modifiedOpts)
+}
+
+func TestMapToIndexAction_withEnvelope(t *testing.T) {
+	req := require.New(t)
+
+	labelsType := ast.NewMap(ast.String(), ast.String())
+	labelsArg := ast.Argument{Name: "labels", Type: labelsType}
+
+	option := ast.Option{
+		Name: "labels",
+		Args: []ast.Argument{labelsArg},
+		Assignments: []ast.Assignment{
+			{
+				Path: ast.Path{
+					{Identifier: "metadata", Type: ast.NewRef("pkg", "Metadata")},
+				},
+				Value: ast.AssignmentValue{
+					Envelope: &ast.AssignmentEnvelope{
+						Type: ast.NewRef("pkg", "Metadata"),
+						Values: []ast.EnvelopeFieldValue{
+							{
+								Path:  ast.Path{{Identifier: "labels", Type: labelsType}},
+								Value: ast.AssignmentValue{Argument: &labelsArg},
+							},
+						},
+					},
+				},
+				Method: ast.DirectAssignment,
+			},
+		},
+	}
+	modifiedOpts := MapToIndexAction()(ast.Schemas{}, ast.Builder{}, option)
+
+	req.Equal([]ast.Option{option}, modifiedOpts)
+}
+
 func TestStructFieldsAsArgumentsAction_withNoArgument(t *testing.T) {
 	req := require.New(t)
 
@@ -1,17 +1,16 @@
 package option
 
 import (
-	"strings"
-
 	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/tools"
+	"github.com/grafana/cog/internal/veneers"
 )
 
-type Selector func(builder ast.Builder, option ast.Option) bool
+type Selector func(schemas ast.Schemas, builder ast.Builder, option ast.Option) bool
 
 // EveryOption accepts any given option.
 func EveryOption() Selector {
-	return func(_ ast.Builder, _ ast.Option) bool {
+	return func(_ ast.Schemas, _ ast.Builder, _ ast.Option) bool {
 		return true
 	}
 }
@@ -20,19 +19,107 @@ func EveryOption() Selector {
 // object (referred to by its package and name).
 // Note: the comparison on object and options names is case-insensitive.
 func ByName(pkg string, objectName string, optionNames ...string) Selector {
-	return func(builder ast.Builder, option ast.Option) bool {
-		return builder.For.SelfRef.ReferredPkg == pkg &&
-			strings.EqualFold(builder.For.Name, objectName) &&
-			tools.StringInListEqualFold(option.Name, optionNames)
+	return ByNameMatching(veneers.Exact(pkg), veneers.Exact(objectName), veneers.AnyOf(tools.Map(optionNames, veneers.Exact)...))
+}
+
+// ByNameMatching matches options whose name is accepted by the given
+// matcher, defined for builders for objects whose package and name are
+// accepted by the given matchers.
+func ByNameMatching(pkg veneers.Matcher, objectName veneers.Matcher, optionName veneers.Matcher) Selector {
+	return func(_ ast.Schemas, builder ast.Builder, option ast.Option) bool {
+		return pkg(builder.For.SelfRef.ReferredPkg) &&
+			objectName(builder.For.Name) &&
+			optionName(option.Name)
 	}
 }
 
 // ByBuilder matches options by their name and the name of the builder containing them..
 // Note: the comparison on builder and options names is case-insensitive.
 func ByBuilder(pkg string, builderName string, optionNames ...string) Selector {
-	return func(builder ast.Builder, option ast.Option) bool {
-		return builder.Package == pkg &&
-			strings.EqualFold(builder.Name, builderName) &&
-			tools.StringInListEqualFold(option.Name, optionNames)
+	return ByBuilderMatching(veneers.Exact(pkg), veneers.Exact(builderName), veneers.AnyOf(tools.Map(optionNames, veneers.Exact)...))
+}
+
+// ByBuilderMatching matches options whose name is accepted by the given
+// matcher, defined in builders whose package and name are accepted by the
+// given matchers.
+func ByBuilderMatching(pkg veneers.Matcher, builderName veneers.Matcher, optionName veneers.Matcher) Selector {
+	return func(_ ast.Schemas, builder ast.Builder, option ast.Option) bool {
+		return pkg(builder.Package) &&
+			builderName(builder.Name) &&
+			optionName(option.Name)
+	}
+}
+
+// ByVariant matches options defined in builders from packages implementing
+// the given schema variant (panelcfg, dataquery, …).
+func ByVariant(variant ast.SchemaVariant) Selector {
+	return func(schemas ast.Schemas, builder ast.Builder, _ ast.Option) bool {
+		schema, found := schemas.Locate(builder.Package)
+		if !found {
+			return false
+		}
+
+		return schema.Metadata.Variant == variant
+	}
+}
+
+// ByArgumentKind matches options taking at least one argument of the
+// given scalar kind.
+func ByArgumentKind(kind ast.ScalarKind) Selector {
+	return byArgument(func(argType ast.Type) bool {
+		return argType.IsScalar() && argType.AsScalar().ScalarKind == kind
+	})
+}
+
+// ByArgumentRef matches options taking at least one argument referring to
+// an object whose package and name are accepted by the given matchers.
+func ByArgumentRef(pkg veneers.Matcher, objectName veneers.Matcher) Selector {
+	return byArgument(func(argType ast.Type) bool {
+		return argType.IsRef() && pkg(argType.AsRef().ReferredPkg) && objectName(argType.AsRef().ReferredType)
+	})
+}
+
+func byArgument(predicate func(argType ast.Type) bool) Selector {
+	return func(_ ast.Schemas, _ ast.Builder, option ast.Option) bool {
+		for _, arg := range option.Args {
+			if predicate(arg.Type) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// All matches options matched by every given selector.
+func All(selectors ...Selector) Selector {
+	return func(schemas ast.Schemas, builder ast.Builder, option ast.Option) bool {
+		for _, selector := range selectors {
+			if !selector(schemas, builder, option) {
+				return false
+			}
+		}
+
+		return true
+	}
+}
+
+// Any matches options matched by at least one of the given selectors.
+func Any(selectors ...Selector) Selector {
+	return func(schemas ast.Schemas, builder ast.Builder, option ast.Option) bool {
+		for _, selector := range selectors {
+			if selector(schemas, builder, option) {
+				return true
+			}
+		}
+
+		return false
+	}
+}
+
+// Not matches options not matched by the given selector.
+func Not(selector Selector) Selector {
+	return func(schemas ast.Schemas, builder ast.Builder, option ast.Option) bool {
+		return !selector(schemas, builder, option)
 	}
 }
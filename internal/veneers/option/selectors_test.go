@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/veneers"
 	"github.com/stretchr/testify/require"
 )
 
@@ -92,11 +93,81 @@ func TestByBuilder(t *testing.T) {
 	req.Len(filter(notFoundSelector, dashboardBuilder, options), 0)
 }
 
+func TestByArgumentType(t *testing.T) {
+	req := require.New(t)
+
+	dashboardBuilder := ast.Builder{
+		For: ast.NewObject("dashboard", "Dashboard", ast.NewStruct()),
+	}
+	options := []ast.Option{
+		{Name: "editable", Args: []ast.Argument{{Name: "editable", Type: ast.Bool()}}},
+		{Name: "title", Args: []ast.Argument{{Name: "title", Type: ast.String()}}},
+		{Name: "datasource", Args: []ast.Argument{{Name: "ref", Type: ast.NewRef("dashboard", "DataSourceRef")}}},
+		{Name: "readonly"},
+	}
+
+	selectedBools := filter(ByArgumentKind(ast.KindBool), dashboardBuilder, options)
+	req.Len(selectedBools, 1)
+	req.Equal("editable", selectedBools[0].Name)
+
+	selectedRefs := filter(ByArgumentRef(veneers.Exact("dashboard"), veneers.Exact("DataSourceRef")), dashboardBuilder, options)
+	req.Len(selectedRefs, 1)
+	req.Equal("datasource", selectedRefs[0].Name)
+
+	req.Len(filter(ByArgumentRef(veneers.Exact("common"), veneers.Exact("DataSourceRef")), dashboardBuilder, options), 0)
+}
+
+func TestByVariant(t *testing.T) {
+	req := require.New(t)
+
+	schemas := ast.Schemas{
+		&ast.Schema{Package: "timeseries", Metadata: ast.SchemaMeta{Kind: ast.SchemaKindComposable, Variant: ast.SchemaVariantPanel}},
+	}
+	panelBuilder := ast.Builder{
+		Name:    "Panel",
+		Package: "timeseries",
+		For:     ast.NewObject("dashboard", "Panel", ast.NewStruct()),
+	}
+	dashboardBuilder := ast.Builder{
+		Name:    "Dashboard",
+		Package: "dashboard",
+		For:     ast.NewObject("dashboard", "Dashboard", ast.NewStruct()),
+	}
+	opt := ast.Option{Name: "title"}
+
+	req.True(ByVariant(ast.SchemaVariantPanel)(schemas, panelBuilder, opt))
+	req.False(ByVariant(ast.SchemaVariantPanel)(schemas, dashboardBuilder, opt))
+	req.False(ByVariant(ast.SchemaVariantDataQuery)(schemas, panelBuilder, opt))
+}
+
+func TestCombinators(t *testing.T) {
+	req := require.New(t)
+
+	dashboardBuilder := ast.Builder{
+		For: ast.NewObject("dashboard", "Dashboard", ast.NewStruct()),
+	}
+	options := []ast.Option{
+		{Name: "Editable"},
+		{Name: "Refresh"},
+		{Name: "TimePicker"},
+	}
+
+	notRefresh := Not(ByName("dashboard", "Dashboard", "Refresh"))
+	selected := filter(All(ByName("dashboard", "Dashboard", "Refresh", "TimePicker"), notRefresh), dashboardBuilder, options)
+	req.Len(selected, 1)
+	req.Equal("TimePicker", selected[0].Name)
+
+	selected = filter(Any(ByName("dashboard", "Dashboard", "Editable"), ByName("dashboard", "Dashboard", "Refresh")), dashboardBuilder, options)
+	req.Len(selected, 2)
+	req.Equal("Editable", selected[0].Name)
+	req.Equal("Refresh", selected[1].Name)
+}
+
 func filter(selector Selector, builder ast.Builder, opts []ast.Option) []ast.Option {
 	var selected []ast.Option
 
 	for _, opt := range opts {
-		if selector(builder, opt) {
+		if selector(ast.Schemas{}, builder, opt) {
 			selected = append(selected, opt)
 		}
 	}
@@ -1,8 +1,11 @@
 package rewrite
 
 import (
+	"fmt"
+
 	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/tools"
+	"github.com/grafana/cog/internal/trace"
 	"github.com/grafana/cog/internal/veneers/builder"
 	"github.com/grafana/cog/internal/veneers/option"
 )
@@ -14,27 +17,68 @@ type Config struct {
 }
 
 type LanguageRules struct {
-	Language     string
+	Language string
+	// Source optionally describes where the rules were loaded from.
+	// ie: the path to a veneers file.
+	Source       string
 	BuilderRules []builder.RewriteRule
 	OptionRules  []option.RewriteRule
+	// BuilderRulesLines and OptionRulesLines optionally hold the line at
+	// which each rule is defined in Source.
+	BuilderRulesLines []int
+	OptionRulesLines  []int
+}
+
+// ruleName describes the i-th rule of the given kind, loaded from source.
+func ruleName(source string, lines []int, kind string, i int) string {
+	if i < len(lines) {
+		return fmt.Sprintf("%s:%d: %s[%d]", source, lines[i], kind, i)
+	}
+
+	return fmt.Sprintf("%s: %s[%d]", source, kind, i)
+}
+
+type namedBuilderRule struct {
+	name string
+	rule builder.RewriteRule
+}
+
+type namedOptionRule struct {
+	name string
+	rule option.RewriteRule
 }
 
 type Rewriter struct {
 	config Config
 
 	// Rules applied to `Builder` objects, grouped by language
-	builderRules map[string][]builder.RewriteRule
+	builderRules map[string][]namedBuilderRule
 	// Rules applied to `Option` objects, grouped by language
-	optionRules map[string][]option.RewriteRule
+	optionRules map[string][]namedOptionRule
 }
 
 func NewRewrite(languageRules []LanguageRules, config Config) *Rewriter {
-	builderRules := make(map[string][]builder.RewriteRule)
-	optionRules := make(map[string][]option.RewriteRule)
+	builderRules := make(map[string][]namedBuilderRule)
+	optionRules := make(map[string][]namedOptionRule)
 
 	for _, languageConfig := range languageRules {
-		builderRules[languageConfig.Language] = append(builderRules[languageConfig.Language], languageConfig.BuilderRules...)
-		optionRules[languageConfig.Language] = append(optionRules[languageConfig.Language], languageConfig.OptionRules...)
+		source := languageConfig.Source
+		if source == "" {
+			source = "veneers"
+		}
+
+		for i, rule := range languageConfig.BuilderRules {
+			builderRules[languageConfig.Language] = append(builderRules[languageConfig.Language], namedBuilderRule{
+				name: ruleName(source, languageConfig.BuilderRulesLines, "builders", i),
+				rule: rule,
+			})
+		}
+		for i, rule := range languageConfig.OptionRules {
+			optionRules[languageConfig.Language] = append(optionRules[languageConfig.Language], namedOptionRule{
+				name: ruleName(source, languageConfig.OptionRulesLines, "options", i),
+				rule: rule,
+			})
+		}
 	}
 
 	return &Rewriter{
@@ -45,6 +89,12 @@ func NewRewrite(languageRules []LanguageRules, config Config) *Rewriter {
 }
 
 func (engine *Rewriter) ApplyTo(schemas ast.Schemas, builders []ast.Builder, language string) ([]ast.Builder, error) {
+	return engine.ApplyToWithTrace(schemas, builders, language, nil)
+}
+
+// ApplyToWithTrace behaves like ApplyTo, and records the changes made by
+// each rule with the given recorder.
+func (engine *Rewriter) ApplyToWithTrace(schemas ast.Schemas, builders []ast.Builder, language string, recorder *trace.Recorder) ([]ast.Builder, error) {
 	var err error
 	// TODO: should we deepCopy the builders instead?
 	newBuilders := make([]ast.Builder, 0, len(builders))
@@ -53,72 +103,120 @@ func (engine *Rewriter) ApplyTo(schemas ast.Schemas, builders []ast.Builder, lan
 	// start by applying veneers common to all languages, then
 	// apply language-specific ones.
 	for _, l := range []string{AllLanguages, language} {
-		newBuilders, err = engine.applyBuilderRules(schemas, newBuilders, engine.builderRules[l])
+		newBuilders, err = engine.applyBuilderRules(schemas, newBuilders, engine.builderRules[l], recorder)
 		if err != nil {
 			return nil, err
 		}
 
-		newBuilders = engine.applyOptionRules(schemas, newBuilders, engine.optionRules[l])
+		newBuilders = engine.applyOptionRules(schemas, newBuilders, engine.optionRules[l], recorder)
 	}
 
 	// and optionally, apply "debug" veneers
 	if engine.config.Debug {
-		newBuilders, err = engine.applyBuilderRules(schemas, newBuilders, engine.debugBuilderRules())
+		newBuilders, err = engine.applyBuilderRules(schemas, newBuilders, engine.debugBuilderRules(), recorder)
 		if err != nil {
 			return nil, err
 		}
 
-		newBuilders = engine.applyOptionRules(schemas, newBuilders, engine.debugOptionRules())
+		newBuilders = engine.applyOptionRules(schemas, newBuilders, engine.debugOptionRules(), recorder)
 	}
 
 	return newBuilders, nil
 }
 
-func (engine *Rewriter) applyBuilderRules(schemas ast.Schemas, builders []ast.Builder, rules []builder.RewriteRule) ([]ast.Builder, error) {
+func (engine *Rewriter) applyBuilderRules(schemas ast.Schemas, builders []ast.Builder, rules []namedBuilderRule, recorder *trace.Recorder) ([]ast.Builder, error) {
 	var err error
 
 	for _, rule := range rules {
-		builders, err = rule(schemas, builders)
+		before := snapshotBuilders(recorder, builders)
+
+		// rules without selectors (ie: external ones) are never reported
+		// as having matched nothing.
+		var unmatched []string
+		if recorder.Enabled() && rule.rule.Selector != nil && !anyBuilderSelected(schemas, builders, rule.rule.Selector) {
+			unmatched = []string{"selector"}
+		}
+
+		builders, err = rule.rule.Action(schemas, builders)
 		if err != nil {
 			return nil, err
 		}
+
+		recorder.RecordBuilders(rule.name, before, builders, unmatched...)
 	}
 
 	return builders, nil
 }
 
-func (engine *Rewriter) applyOptionRules(schemas ast.Schemas, builders []ast.Builder, rules []option.RewriteRule) []ast.Builder {
+func anyBuilderSelected(schemas ast.Schemas, builders []ast.Builder, selector builder.Selector) bool {
+	for _, b := range builders {
+		if selector(schemas, b) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (engine *Rewriter) applyOptionRules(schemas ast.Schemas, builders []ast.Builder, rules []namedOptionRule, recorder *trace.Recorder) []ast.Builder {
 	for _, rule := range rules {
+		before := snapshotBuilders(recorder, builders)
+		matched := false
+
 		for i, b := range builders {
 			processedOptions := make([]ast.Option, 0, len(b.Options))
 
 			for _, opt := range b.Options {
-				if !rule.Selector(b, opt) {
+				if !rule.rule.Selector(schemas, b, opt) {
 					processedOptions = append(processedOptions, opt)
 					continue
 				}
 
-				processedOptions = append(processedOptions, rule.Action(schemas, b, opt)...)
+				matched = true
+
+				processedOptions = append(processedOptions, rule.rule.Action(schemas, b, opt)...)
 			}
 
 			builders[i].Options = processedOptions
 		}
+
+		builders = tools.Filter(builders, func(builder ast.Builder) bool {
+			// "no options" means that the builder was dismissed.
+			return len(builder.Options) != 0
+		})
+
+		var unmatched []string
+		if !matched {
+			unmatched = []string{"selector"}
+		}
+
+		recorder.RecordBuilders(rule.name, before, builders, unmatched...)
+	}
+
+	return builders
+}
+
+// snapshotBuilders returns a copy of the given builders if the recorder is enabled.
+// Rules are free to modify the builders they're given: a snapshot is needed
+// to know what changed.
+func snapshotBuilders(recorder *trace.Recorder, builders []ast.Builder) ast.Builders {
+	if !recorder.Enabled() {
+		return nil
 	}
 
-	return tools.Filter(builders, func(builder ast.Builder) bool {
-		// "no options" means that the builder was dismissed.
-		return len(builder.Options) != 0
+	return tools.Map(builders, func(builder ast.Builder) ast.Builder {
+		return builder.DeepCopy()
 	})
 }
 
-func (engine *Rewriter) debugBuilderRules() []builder.RewriteRule {
-	return []builder.RewriteRule{
-		builder.VeneerTrailAsComments(builder.EveryBuilder()),
+func (engine *Rewriter) debugBuilderRules() []namedBuilderRule {
+	return []namedBuilderRule{
+		{name: "debug: veneer trail as comments", rule: builder.VeneerTrailAsComments(builder.EveryBuilder())},
 	}
 }
 
-func (engine *Rewriter) debugOptionRules() []option.RewriteRule {
-	return []option.RewriteRule{
-		option.VeneerTrailAsComments(option.EveryOption()),
+func (engine *Rewriter) debugOptionRules() []namedOptionRule {
+	return []namedOptionRule{
+		{name: "debug: veneer trail as comments", rule: option.VeneerTrailAsComments(option.EveryOption())},
 	}
 }
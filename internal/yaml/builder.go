@@ -1,7 +1,9 @@
 package yaml
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/tools"
@@ -23,13 +25,14 @@ type BuilderRule struct {
 	Initialize               *Initialize               `yaml:"initialize"`
 	PromoteOptsToConstructor *PromoteOptsToConstructor `yaml:"promote_options_to_constructor"`
 	AddOption                *AddOption                `yaml:"add_option"`
+	External                 *ExternalBuilderRule      `yaml:"external"`
 }
 
-func (rule BuilderRule) AsRewriteRule(pkg string) (builder.RewriteRule, error) {
+func (rule BuilderRule) AsRewriteRule(ctx context.Context, pkg string) (builder.RewriteRule, error) {
 	if rule.Omit != nil {
 		selector, err := rule.Omit.AsSelector(pkg)
 		if err != nil {
-			return nil, err
+			return builder.RewriteRule{}, err
 		}
 
 		return builder.Omit(selector), nil
@@ -67,7 +70,11 @@ func (rule BuilderRule) AsRewriteRule(pkg string) (builder.RewriteRule, error) {
 		return rule.AddOption.AsRewriteRule(pkg)
 	}
 
-	return nil, fmt.Errorf("empty rule")
+	if rule.External != nil {
+		return rule.External.AsRewriteRule(ctx)
+	}
+
+	return builder.RewriteRule{}, fmt.Errorf("empty rule")
 }
 
 type RenameBuilder struct {
@@ -79,7 +86,7 @@ type RenameBuilder struct {
 func (rule RenameBuilder) AsRewriteRule(pkg string) (builder.RewriteRule, error) {
 	selector, err := rule.AsSelector(pkg)
 	if err != nil {
-		return nil, err
+		return builder.RewriteRule{}, err
 	}
 
 	return builder.Rename(selector, rule.As), nil
@@ -94,8 +101,13 @@ type MergeInto struct {
 }
 
 func (rule MergeInto) AsRewriteRule(pkg string) (builder.RewriteRule, error) {
+	selector, err := BuilderSelector{ByObject: &rule.Destination}.AsSelector(pkg)
+	if err != nil {
+		return builder.RewriteRule{}, err
+	}
+
 	return builder.MergeInto(
-		builder.ByObjectName(pkg, rule.Destination),
+		selector,
 		rule.Source,
 		rule.UnderPath,
 		rule.ExcludeOptions,
@@ -124,7 +136,7 @@ type Properties struct {
 func (rule Properties) AsRewriteRule(pkg string) (builder.RewriteRule, error) {
 	selector, err := rule.AsSelector(pkg)
 	if err != nil {
-		return nil, err
+		return builder.RewriteRule{}, err
 	}
 
 	return builder.Properties(
@@ -142,7 +154,7 @@ type Duplicate struct {
 func (rule Duplicate) AsRewriteRule(pkg string) (builder.RewriteRule, error) {
 	selector, err := rule.AsSelector(pkg)
 	if err != nil {
-		return nil, err
+		return builder.RewriteRule{}, err
 	}
 
 	return builder.Duplicate(
@@ -165,7 +177,7 @@ type Initialize struct {
 func (rule Initialize) AsRewriteRule(pkg string) (builder.RewriteRule, error) {
 	selector, err := rule.AsSelector(pkg)
 	if err != nil {
-		return nil, err
+		return builder.RewriteRule{}, err
 	}
 
 	return builder.Initialize(
@@ -184,7 +196,7 @@ type PromoteOptsToConstructor struct {
 func (rule PromoteOptsToConstructor) AsRewriteRule(pkg string) (builder.RewriteRule, error) {
 	selector, err := rule.AsSelector(pkg)
 	if err != nil {
-		return nil, err
+		return builder.RewriteRule{}, err
 	}
 
 	return builder.PromoteOptionsToConstructor(selector, rule.Options), nil
@@ -198,35 +210,130 @@ type AddOption struct {
 func (rule AddOption) AsRewriteRule(pkg string) (builder.RewriteRule, error) {
 	selector, err := rule.AsSelector(pkg)
 	if err != nil {
-		return nil, err
+		return builder.RewriteRule{}, err
 	}
 
 	return builder.AddOption(selector, rule.Option), nil
 }
 
+type ExternalBuilderRule struct {
+	Command string        `yaml:"command"`
+	Args    []string      `yaml:"args"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+func (rule ExternalBuilderRule) AsRewriteRule(ctx context.Context) (builder.RewriteRule, error) {
+	if rule.Command == "" {
+		return builder.RewriteRule{}, fmt.Errorf("external: missing command")
+	}
+
+	return builder.External(ctx, rule.Command, rule.Args, rule.Timeout), nil
+}
+
 /******************************************************************************
  * Selectors
  *****************************************************************************/
 
 type BuilderSelector struct {
-	ByObject *string `yaml:"by_object"`
-	ByName   *string `yaml:"by_name"`
+	ByObject  *string `yaml:"by_object"`
+	ByName    *string `yaml:"by_name"`
+	ByVariant *string `yaml:"by_variant"`
 
 	GeneratedFromDisjunction *bool `yaml:"generated_from_disjunction"` // noop?
+
+	All []BuilderSelector `yaml:"all"`
+	Any []BuilderSelector `yaml:"any"`
+	Not *BuilderSelector  `yaml:"not"`
 }
 
+// AsSelector returns a selector matching builders accepted by every
+// criteria set on this BuilderSelector.
 func (selector BuilderSelector) AsSelector(pkg string) (builder.Selector, error) {
+	pkgMatcher, err := veneers.Pattern(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	var selectors []builder.Selector
+
 	if selector.ByObject != nil {
-		return builder.ByObjectName(pkg, *selector.ByObject), nil
+		objectMatcher, err := veneers.Pattern(*selector.ByObject)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, builder.ByObjectMatching(pkgMatcher, objectMatcher))
 	}
 
 	if selector.ByName != nil {
-		return builder.ByName(pkg, *selector.ByName), nil
+		nameMatcher, err := veneers.Pattern(*selector.ByName)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, builder.ByNameMatching(pkgMatcher, nameMatcher))
+	}
+
+	if selector.ByVariant != nil {
+		variant, err := parseSchemaVariant(*selector.ByVariant)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, builder.ByVariant(variant))
 	}
 
 	if selector.GeneratedFromDisjunction != nil {
-		return builder.StructGeneratedFromDisjunction(), nil
+		selectors = append(selectors, builder.StructGeneratedFromDisjunction())
+	}
+
+	if len(selector.All) != 0 {
+		all, err := builderSelectors(pkg, selector.All)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, builder.All(all...))
+	}
+
+	if len(selector.Any) != 0 {
+		anyOf, err := builderSelectors(pkg, selector.Any)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, builder.Any(anyOf...))
+	}
+
+	if selector.Not != nil {
+		not, err := selector.Not.AsSelector(pkg)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, builder.Not(not))
+	}
+
+	switch len(selectors) {
+	case 0:
+		return nil, fmt.Errorf("empty selector")
+	case 1:
+		return selectors[0], nil
+	default:
+		return builder.All(selectors...), nil
+	}
+}
+
+func builderSelectors(pkg string, selectors []BuilderSelector) ([]builder.Selector, error) {
+	result := make([]builder.Selector, 0, len(selectors))
+	for _, selector := range selectors {
+		converted, err := selector.AsSelector(pkg)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, converted)
 	}
 
-	return nil, fmt.Errorf("empty selector")
+	return result, nil
 }
@@ -1,6 +1,9 @@
 package yaml
 
 import (
+	"bytes"
+	"context"
+	"fmt"
 	"io"
 	"os"
 
@@ -13,10 +16,30 @@ type Compiler struct {
 }
 
 type CompilerLoader struct {
+	root string
+	ctx  context.Context
 }
 
 func NewCompilerLoader() *CompilerLoader {
-	return &CompilerLoader{}
+	return &CompilerLoader{
+		ctx: context.Background(),
+	}
+}
+
+// RelativeTo describes loaded files by their path relative to the given
+// root directory, instead of the current working directory.
+func (loader *CompilerLoader) RelativeTo(root string) *CompilerLoader {
+	loader.root = root
+
+	return loader
+}
+
+// WithContext makes the loaded passes that run external commands stop them
+// when the given context is done.
+func (loader *CompilerLoader) WithContext(ctx context.Context) *CompilerLoader {
+	loader.ctx = ctx
+
+	return loader
 }
 
 func (loader *CompilerLoader) PassesFrom(filenames []string) (compiler.Passes, error) {
@@ -51,22 +74,41 @@ func (loader *CompilerLoader) LoadAll(readers []io.Reader) (compiler.Passes, err
 func (loader *CompilerLoader) Load(reader io.Reader) (compiler.Passes, error) {
 	compilerConfig := &Compiler{}
 
-	decoder := yaml.NewDecoder(reader)
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
 	decoder.KnownFields(true)
 
 	if err := decoder.Decode(&compilerConfig); err != nil {
 		return nil, err
 	}
 
+	// lines are only useful when we know which file the passes come from
+	source := readerName(reader, loader.root)
+	var lines []int
+	if source != "" {
+		lines, err = sequenceLines(content, "passes")
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	passes := make(compiler.Passes, 0, len(compilerConfig.Passes))
 
 	// convert compiler passes
-	for _, passConfig := range compilerConfig.Passes {
-		pass, err := passConfig.AsCompilerPass()
+	for i, passConfig := range compilerConfig.Passes {
+		pass, err := passConfig.AsCompilerPass(loader.ctx)
 		if err != nil {
 			return nil, err
 		}
 
+		if i < len(lines) {
+			pass = compiler.WithSource(pass, fmt.Sprintf("%s:%d", source, lines[i]))
+		}
+
 		passes = append(passes, pass)
 	}
 
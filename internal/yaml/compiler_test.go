@@ -0,0 +1,32 @@
+package yaml
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/grafana/cog/internal/ast/compiler"
+	"github.com/stretchr/testify/require"
+)
+
+type contextKey struct{}
+
+func TestCompilerLoader_Load_externalPass(t *testing.T) {
+	req := require.New(t)
+
+	ctx := context.WithValue(context.Background(), contextKey{}, "pipeline")
+	input := `passes:
+  - external: { command: ./pass, args: [--verbose], timeout: 1m30s }`
+
+	passes, err := NewCompilerLoader().WithContext(ctx).Load(strings.NewReader(input))
+	req.NoError(err)
+	req.Len(passes, 1)
+
+	external, ok := compiler.Unwrap(passes[0]).(*compiler.External)
+	req.True(ok)
+	req.Equal("./pass", external.Command)
+	req.Equal([]string{"--verbose"}, external.Args)
+	req.Equal(90*time.Second, external.Timeout)
+	req.Equal("pipeline", external.Context.Value(contextKey{}))
+}
@@ -1,7 +1,9 @@
 package yaml
 
 import (
+	"context"
 	"fmt"
+	"time"
 
 	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/ast/compiler"
@@ -35,9 +37,11 @@ type CompilerPass struct {
 	Cloudwatch            *Cloudwatch            `yaml:"cloudwatch"`
 	GoogleCloudMonitoring *GoogleCloudMonitoring `yaml:"google_cloud_monitoring"`
 	LibraryPanels         *LibraryPanels         `yaml:"library_panels"`
+
+	External *ExternalPass `yaml:"external"`
 }
 
-func (pass CompilerPass) AsCompilerPass() (compiler.Pass, error) {
+func (pass CompilerPass) AsCompilerPass(ctx context.Context) (compiler.Pass, error) {
 	if pass.EntrypointIdentification != nil {
 		return pass.EntrypointIdentification.AsCompilerPass(), nil
 	}
@@ -113,6 +117,10 @@ func (pass CompilerPass) AsCompilerPass() (compiler.Pass, error) {
 		return pass.LibraryPanels.AsCompilerPass(), nil
 	}
 
+	if pass.External != nil {
+		return pass.External.AsCompilerPass(ctx)
+	}
+
 	return nil, fmt.Errorf("empty compiler pass")
 }
 
@@ -394,3 +402,22 @@ type LibraryPanels struct {
 func (pass LibraryPanels) AsCompilerPass() *compiler.LibraryPanels {
 	return &compiler.LibraryPanels{}
 }
+
+type ExternalPass struct {
+	Command string        `yaml:"command"`
+	Args    []string      `yaml:"args"`
+	Timeout time.Duration `yaml:"timeout"`
+}
+
+func (pass ExternalPass) AsCompilerPass(ctx context.Context) (*compiler.External, error) {
+	if pass.Command == "" {
+		return nil, fmt.Errorf("external: missing command")
+	}
+
+	return &compiler.External{
+		Command: pass.Command,
+		Args:    pass.Args,
+		Timeout: pass.Timeout,
+		Context: ctx,
+	}, nil
+}
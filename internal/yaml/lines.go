@@ -0,0 +1,67 @@
+package yaml
+
+import (
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// sequenceLines returns the line at which each item of the sequence defined
+// under the given top-level key starts.
+func sequenceLines(content []byte, key string) ([]int, error) {
+	document := &yaml.Node{}
+	if err := yaml.Unmarshal(content, document); err != nil {
+		return nil, err
+	}
+
+	if len(document.Content) == 0 || document.Content[0].Kind != yaml.MappingNode {
+		return nil, nil
+	}
+
+	mapping := document.Content[0]
+	for i := 0; i+1 < len(mapping.Content); i += 2 {
+		if mapping.Content[i].Value != key {
+			continue
+		}
+
+		lines := make([]int, 0, len(mapping.Content[i+1].Content))
+		for _, item := range mapping.Content[i+1].Content {
+			lines = append(lines, item.Line)
+		}
+
+		return lines, nil
+	}
+
+	return nil, nil
+}
+
+// readerName returns the path of the file read by the given reader, if any.
+// The path is relative to the given root directory, or to the current
+// working directory if root is empty. Paths outside of that directory are
+// returned as they are.
+func readerName(reader io.Reader, root string) string {
+	namedReader, ok := reader.(interface{ Name() string })
+	if !ok {
+		return ""
+	}
+
+	name := namedReader.Name()
+	if root == "" {
+		root, _ = os.Getwd()
+	}
+
+	absoluteName, err := filepath.Abs(name)
+	if err != nil {
+		return name
+	}
+
+	relativeName, err := filepath.Rel(root, absoluteName)
+	if err != nil || strings.HasPrefix(relativeName, "..") {
+		return name
+	}
+
+	return relativeName
+}
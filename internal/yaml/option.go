@@ -2,8 +2,9 @@ package yaml
 
 import (
 	"fmt"
-	"strings"
 
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/tools"
 	"github.com/grafana/cog/internal/veneers"
 	"github.com/grafana/cog/internal/veneers/option"
 )
@@ -19,6 +20,7 @@ type OptionRule struct {
 	StructFieldsAsArguments *StructFieldsAsArguments `yaml:"struct_fields_as_arguments"`
 	StructFieldsAsOptions   *StructFieldsAsOptions   `yaml:"struct_fields_as_options"`
 	ArrayToAppend           *ArrayToAppend           `yaml:"array_to_append"`
+	MapToIndex              *MapToIndex              `yaml:"map_to_index"`
 	DisjunctionAsOptions    *DisjunctionAsOptions    `yaml:"disjunction_as_options"`
 	Duplicate               *DuplicateOption         `yaml:"duplicate"`
 	AddAssignment           *AddAssignment           `yaml:"add_assignment"`
@@ -54,6 +56,10 @@ func (rule OptionRule) AsRewriteRule(pkg string) (option.RewriteRule, error) {
 		return rule.ArrayToAppend.AsRewriteRule(pkg)
 	}
 
+	if rule.MapToIndex != nil {
+		return rule.MapToIndex.AsRewriteRule(pkg)
+	}
+
 	if rule.DisjunctionAsOptions != nil {
 		return rule.DisjunctionAsOptions.AsRewriteRule(pkg)
 	}
@@ -144,6 +150,19 @@ func (rule ArrayToAppend) AsRewriteRule(pkg string) (option.RewriteRule, error)
 	return option.ArrayToAppend(selector), nil
 }
 
+type MapToIndex struct {
+	OptionSelector `yaml:",inline"`
+}
+
+func (rule MapToIndex) AsRewriteRule(pkg string) (option.RewriteRule, error) {
+	selector, err := rule.AsSelector(pkg)
+	if err != nil {
+		return option.RewriteRule{}, err
+	}
+
+	return option.MapToIndex(selector), nil
+}
+
 type DisjunctionAsOptions struct {
 	OptionSelector `yaml:",inline"`
 }
@@ -199,32 +218,150 @@ type OptionSelector struct {
 	ByBuilder *string `yaml:"by_builder"`
 
 	ByNames *ByNamesSelector `yaml:"by_names"`
+
+	ByVariant *string `yaml:"by_variant"`
+
+	// scalar kind (bool, string, …) or object reference (pkg.Object)
+	ByArgumentType *string `yaml:"by_argument_type"`
+
+	All []OptionSelector `yaml:"all"`
+	Any []OptionSelector `yaml:"any"`
+	Not *OptionSelector  `yaml:"not"`
 }
 
+// AsSelector returns a selector matching options accepted by every
+// criteria set on this OptionSelector.
 func (selector OptionSelector) AsSelector(pkg string) (option.Selector, error) {
+	pkgMatcher, err := veneers.Pattern(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	var selectors []option.Selector
+
 	if selector.ByName != nil {
-		objectName, optionName, found := strings.Cut(*selector.ByName, ".")
+		objectName, optionName, found := cutPatterns(*selector.ByName)
 		if !found {
 			return nil, fmt.Errorf("option name '%s' is incorrect: no object name found", *selector.ByName)
 		}
 
-		return option.ByName(pkg, objectName, optionName), nil
+		objectMatcher, optionMatcher, err := patternsPair(objectName, optionName)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, option.ByNameMatching(pkgMatcher, objectMatcher, optionMatcher))
 	}
 
 	if selector.ByBuilder != nil {
-		builderName, optionName, found := strings.Cut(*selector.ByBuilder, ".")
+		builderName, optionName, found := cutPatterns(*selector.ByBuilder)
 		if !found {
 			return nil, fmt.Errorf("option name '%s' is incorrect: no builder name found", *selector.ByBuilder)
 		}
 
-		return option.ByBuilder(pkg, builderName, optionName), nil
+		builderMatcher, optionMatcher, err := patternsPair(builderName, optionName)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, option.ByBuilderMatching(pkgMatcher, builderMatcher, optionMatcher))
 	}
 
 	if selector.ByNames != nil {
-		return selector.ByNames.AsSelector(pkg)
+		byNames, err := selector.ByNames.AsSelector(pkg)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, byNames)
+	}
+
+	if selector.ByVariant != nil {
+		variant, err := parseSchemaVariant(*selector.ByVariant)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, option.ByVariant(variant))
+	}
+
+	if selector.ByArgumentType != nil {
+		byArgument, err := argumentTypeSelector(*selector.ByArgumentType)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, byArgument)
 	}
 
-	return nil, fmt.Errorf("empty or unknown selector")
+	if len(selector.All) != 0 {
+		all, err := optionSelectors(pkg, selector.All)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, option.All(all...))
+	}
+
+	if len(selector.Any) != 0 {
+		anyOf, err := optionSelectors(pkg, selector.Any)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, option.Any(anyOf...))
+	}
+
+	if selector.Not != nil {
+		not, err := selector.Not.AsSelector(pkg)
+		if err != nil {
+			return nil, err
+		}
+
+		selectors = append(selectors, option.Not(not))
+	}
+
+	switch len(selectors) {
+	case 0:
+		return nil, fmt.Errorf("empty or unknown selector")
+	case 1:
+		return selectors[0], nil
+	default:
+		return option.All(selectors...), nil
+	}
+}
+
+func optionSelectors(pkg string, selectors []OptionSelector) ([]option.Selector, error) {
+	result := make([]option.Selector, 0, len(selectors))
+	for _, selector := range selectors {
+		converted, err := selector.AsSelector(pkg)
+		if err != nil {
+			return nil, err
+		}
+
+		result = append(result, converted)
+	}
+
+	return result, nil
+}
+
+func argumentTypeSelector(argType string) (option.Selector, error) {
+	pkg, objectName, found := cutPatterns(argType)
+	if found {
+		pkgMatcher, objectMatcher, err := patternsPair(pkg, objectName)
+		if err != nil {
+			return nil, err
+		}
+
+		return option.ByArgumentRef(pkgMatcher, objectMatcher), nil
+	}
+
+	kind := ast.ScalarKind(argType)
+	if !tools.ItemInList(kind, scalarKinds) {
+		return nil, fmt.Errorf("unknown argument type '%s': expected a scalar kind or a 'pkg.Object' reference", argType)
+	}
+
+	return option.ByArgumentKind(kind), nil
 }
 
 type ByNamesSelector struct {
@@ -237,5 +374,25 @@ func (selector ByNamesSelector) AsSelector(pkg string) (option.Selector, error)
 		return nil, fmt.Errorf("`object` is required")
 	}
 
-	return option.ByName(pkg, selector.Object, selector.Options...), nil
+	pkgMatcher, err := veneers.Pattern(pkg)
+	if err != nil {
+		return nil, err
+	}
+
+	objectMatcher, err := veneers.Pattern(selector.Object)
+	if err != nil {
+		return nil, err
+	}
+
+	optionMatchers := make([]veneers.Matcher, 0, len(selector.Options))
+	for _, optionName := range selector.Options {
+		optionMatcher, err := veneers.Pattern(optionName)
+		if err != nil {
+			return nil, err
+		}
+
+		optionMatchers = append(optionMatchers, optionMatcher)
+	}
+
+	return option.ByNameMatching(pkgMatcher, objectMatcher, veneers.AnyOf(optionMatchers...)), nil
 }
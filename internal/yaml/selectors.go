@@ -0,0 +1,54 @@
+package yaml
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/veneers"
+)
+
+var scalarKinds = []ast.ScalarKind{
+	ast.KindNull, ast.KindAny, ast.KindBytes, ast.KindString, ast.KindBool,
+	ast.KindFloat32, ast.KindFloat64,
+	ast.KindUint8, ast.KindUint16, ast.KindUint32, ast.KindUint64,
+	ast.KindInt8, ast.KindInt16, ast.KindInt32, ast.KindInt64,
+}
+
+// cutPatterns splits inputs written as `first.second`, where both parts are
+// patterns. Regex patterns (`/regex/`) can contain dots.
+func cutPatterns(input string) (string, string, bool) {
+	if !strings.HasPrefix(input, "/") {
+		return strings.Cut(input, ".")
+	}
+
+	end := strings.Index(input[1:], "/")
+	if end == -1 || !strings.HasPrefix(input[end+2:], ".") {
+		return input, "", false
+	}
+
+	return input[:end+2], input[end+3:], true
+}
+
+func patternsPair(first string, second string) (veneers.Matcher, veneers.Matcher, error) {
+	firstMatcher, err := veneers.Pattern(first)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	secondMatcher, err := veneers.Pattern(second)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return firstMatcher, secondMatcher, nil
+}
+
+func parseSchemaVariant(input string) (ast.SchemaVariant, error) {
+	variant := ast.SchemaVariant(input)
+	if variant != ast.SchemaVariantPanel && variant != ast.SchemaVariantDataQuery {
+		return "", fmt.Errorf("unknown schema variant '%s': expected '%s' or '%s'", input, ast.SchemaVariantPanel, ast.SchemaVariantDataQuery)
+	}
+
+	return variant, nil
+}
@@ -1,6 +1,8 @@
 package yaml
 
 import (
+	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"os"
@@ -19,29 +21,54 @@ type Veneers struct {
 }
 
 type VeneersLoader struct {
+	root string
+	ctx  context.Context
 }
 
 func NewVeneersLoader() *VeneersLoader {
-	return &VeneersLoader{}
+	return &VeneersLoader{
+		ctx: context.Background(),
+	}
+}
+
+// RelativeTo describes loaded files by their path relative to the given
+// root directory, instead of the current working directory.
+func (loader *VeneersLoader) RelativeTo(root string) *VeneersLoader {
+	loader.root = root
+
+	return loader
+}
+
+// WithContext makes the loaded rules that run external commands stop them
+// when the given context is done.
+func (loader *VeneersLoader) WithContext(ctx context.Context) *VeneersLoader {
+	loader.ctx = ctx
+
+	return loader
 }
 
 func (loader *VeneersLoader) RewriterFrom(filenames []string, config rewrite.Config) (*rewrite.Rewriter, error) {
+	rules, err := loader.RulesFrom(filenames)
+	if err != nil {
+		return nil, err
+	}
+
+	return rewrite.NewRewrite(rules, config), nil
+}
+
+func (loader *VeneersLoader) RulesFrom(filenames []string) ([]rewrite.LanguageRules, error) {
 	readers := make([]io.Reader, 0, len(filenames))
 	for _, filename := range filenames {
 		reader, err := os.Open(filename)
 		if err != nil {
 			return nil, err
 		}
+		defer func() { _ = reader.Close() }()
 
 		readers = append(readers, reader)
 	}
 
-	rules, err := loader.LoadAll(readers)
-	if err != nil {
-		return nil, err
-	}
-
-	return rewrite.NewRewrite(rules, config), nil
+	return loader.LoadAll(readers)
 }
 
 func (loader *VeneersLoader) LoadAll(readers []io.Reader) ([]rewrite.LanguageRules, error) {
@@ -65,7 +92,12 @@ func (loader *VeneersLoader) Load(reader io.Reader) (rewrite.LanguageRules, erro
 
 	veneers := &Veneers{}
 
-	decoder := yaml.NewDecoder(reader)
+	content, err := io.ReadAll(reader)
+	if err != nil {
+		return rewrite.LanguageRules{}, err
+	}
+
+	decoder := yaml.NewDecoder(bytes.NewReader(content))
 	decoder.KnownFields(true)
 
 	if err := decoder.Decode(&veneers); err != nil {
@@ -81,7 +113,7 @@ func (loader *VeneersLoader) Load(reader io.Reader) (rewrite.LanguageRules, erro
 
 	// convert builder rules
 	for _, rule := range veneers.Builders {
-		builderRule, err := rule.AsRewriteRule(veneers.Package)
+		builderRule, err := rule.AsRewriteRule(loader.ctx, veneers.Package)
 		if err != nil {
 			return rewrite.LanguageRules{}, err
 		}
@@ -99,9 +131,27 @@ func (loader *VeneersLoader) Load(reader io.Reader) (rewrite.LanguageRules, erro
 		optionRules = append(optionRules, optionRule)
 	}
 
+	// lines are only useful when we know which file the rules come from
+	source := readerName(reader, loader.root)
+	var builderRulesLines, optionRulesLines []int
+	if source != "" {
+		builderRulesLines, err = sequenceLines(content, "builders")
+		if err != nil {
+			return rewrite.LanguageRules{}, err
+		}
+
+		optionRulesLines, err = sequenceLines(content, "options")
+		if err != nil {
+			return rewrite.LanguageRules{}, err
+		}
+	}
+
 	return rewrite.LanguageRules{
-		Language:     veneers.Language,
-		BuilderRules: builderRules,
-		OptionRules:  optionRules,
+		Language:          veneers.Language,
+		Source:            source,
+		BuilderRules:      builderRules,
+		OptionRules:       optionRules,
+		BuilderRulesLines: builderRulesLines,
+		OptionRulesLines:  optionRulesLines,
 	}, nil
 }
@@ -4,6 +4,7 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/grafana/cog/internal/ast"
 	"github.com/grafana/cog/internal/veneers/rewrite"
 	"github.com/stretchr/testify/require"
 )
@@ -52,6 +53,48 @@ options:
 				req.Len(rules.OptionRules, 1)
 			},
 		},
+		{
+			desc: "map to index option rule",
+			input: `language: all
+package: dashboard
+options:
+  - map_to_index: { by_name: Dashboard.labels }`,
+			check: func(req *require.Assertions, rules rewrite.LanguageRules) {
+				req.Empty(rules.BuilderRules)
+				req.Len(rules.OptionRules, 1)
+			},
+		},
+		{
+			desc: "combined selectors for every package",
+			input: `language: all
+package: "*"
+builders:
+  - omit:
+      all:
+        - { by_variant: panelcfg }
+        - not: { by_name: "/^Panel$/" }
+options:
+  - unfold_boolean: { by_argument_type: bool, true_as: on, false_as: off }
+  - omit:
+      any:
+        - { by_builder: "Panel.with*" }
+        - { by_argument_type: "dashboard.DataSourceRef" }`,
+			check: func(req *require.Assertions, rules rewrite.LanguageRules) {
+				req.Len(rules.BuilderRules, 1)
+				req.Len(rules.OptionRules, 2)
+			},
+		},
+		{
+			desc: "external builder rule",
+			input: `language: all
+package: dashboard
+builders:
+  - external: { command: ./veneer, args: [--verbose], timeout: 30s }`,
+			check: func(req *require.Assertions, rules rewrite.LanguageRules) {
+				req.Len(rules.BuilderRules, 1)
+				req.Empty(rules.OptionRules)
+			},
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -68,6 +111,91 @@ options:
 	}
 }
 
+func TestLoader_Load_withInvalidSelectors(t *testing.T) {
+	testCases := []struct {
+		desc          string
+		input         string
+		expectedError string
+	}{
+		{
+			desc: "unknown variant",
+			input: `language: all
+package: "*"
+builders:
+  - omit: { by_variant: unknown }`,
+			expectedError: "unknown schema variant 'unknown'",
+		},
+		{
+			desc: "unknown argument type",
+			input: `language: all
+package: dashboard
+options:
+  - omit: { by_argument_type: boolean }`,
+			expectedError: "unknown argument type 'boolean'",
+		},
+		{
+			desc: "invalid regex",
+			input: `language: all
+package: dashboard
+options:
+  - omit: { by_name: "/(/.title" }`,
+			expectedError: "invalid regular expression '('",
+		},
+		{
+			desc: "empty nested selector",
+			input: `language: all
+package: dashboard
+builders:
+  - omit: { not: {} }`,
+			expectedError: "empty selector",
+		},
+	}
+
+	for _, testCase := range testCases {
+		tc := testCase
+
+		t.Run(tc.desc, func(t *testing.T) {
+			req := require.New(t)
+
+			_, err := NewVeneersLoader().Load(strings.NewReader(tc.input))
+			req.Error(err)
+			req.ErrorContains(err, tc.expectedError)
+		})
+	}
+}
+
+func TestOptionSelector_AsSelector_withPatterns(t *testing.T) {
+	req := require.New(t)
+
+	dashboardBuilder := ast.Builder{
+		Name:    "Dashboard",
+		Package: "dashboard",
+		For:     ast.NewObject("dashboard", "Dashboard", ast.NewStruct()),
+	}
+	panelBuilder := ast.Builder{
+		Name:    "Panel",
+		Package: "timeseries",
+		For:     ast.NewObject("dashboard", "Panel", ast.NewStruct()),
+	}
+	withPanel := ast.Option{Name: "withPanel"}
+	title := ast.Option{Name: "title"}
+
+	byRegex := "/^Dash.*$/.with*"
+	selector, err := OptionSelector{ByName: &byRegex}.AsSelector("*")
+	req.NoError(err)
+
+	req.True(selector(nil, dashboardBuilder, withPanel))
+	req.False(selector(nil, dashboardBuilder, title))
+	req.False(selector(nil, panelBuilder, withPanel))
+
+	byBuilder := "Panel.title"
+	selector, err = OptionSelector{ByBuilder: &byBuilder}.AsSelector("time*")
+	req.NoError(err)
+
+	req.True(selector(nil, panelBuilder, title))
+	req.False(selector(nil, dashboardBuilder, title))
+}
+
 func TestLoader_Load_withNoPackage(t *testing.T) {
 	req := require.New(t)
 	input := `language: all
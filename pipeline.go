@@ -0,0 +1,283 @@
+package cog
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+
+	"cuelang.org/go/cue"
+	"github.com/grafana/codejen"
+	"github.com/grafana/cog/internal/ast/compiler"
+	"github.com/grafana/cog/internal/codegen"
+	"github.com/grafana/cog/internal/jennies/golang"
+	"github.com/grafana/cog/internal/jennies/java"
+	"github.com/grafana/cog/internal/jennies/jsonschema"
+	"github.com/grafana/cog/internal/jennies/openapi"
+	"github.com/grafana/cog/internal/jennies/php"
+	"github.com/grafana/cog/internal/jennies/protobuf"
+	"github.com/grafana/cog/internal/jennies/python"
+	"github.com/grafana/cog/internal/jennies/rust"
+	"github.com/grafana/cog/internal/jennies/terraform"
+	"github.com/grafana/cog/internal/jennies/typescript"
+	cogyaml "github.com/grafana/cog/internal/yaml"
+)
+
+// Input describes a source of schemas. Exactly one of its fields must be set.
+type Input = codegen.Input
+
+// InputBase holds options common to all input types.
+type InputBase = codegen.InputBase
+
+// FilesInput holds options common to inputs reading several files.
+type FilesInput = codegen.FilesInput
+
+type CUEInput = codegen.CueInput
+type JSONSchemaInput = codegen.JSONSchemaInput
+type OpenAPIInput = codegen.OpenAPIInput
+type ProtobufInput = codegen.ProtobufInput
+type KindRegistryInput = codegen.KindRegistryInput
+
+// OutputLanguage describes a language to generate code for. Exactly one of
+// its fields must be set.
+type OutputLanguage = codegen.OutputLanguage
+
+type GoConfig = golang.Config
+type JavaConfig = java.Config
+type JSONSchemaConfig = jsonschema.Config
+type OpenAPIConfig = openapi.Config
+type PHPConfig = php.Config
+type ProtobufConfig = protobuf.Config
+type PythonConfig = python.Config
+type RustConfig = rust.Config
+type TerraformConfig = terraform.Config
+type TypescriptConfig = typescript.Config
+
+// Pipeline is the programmatic equivalent of a codegen pipeline described
+// in YAML: it reads any number of inputs, transforms them and generates
+// types and builders for any number of languages.
+type Pipeline struct {
+	debug       bool
+	concurrency int
+
+	inputs []*codegen.Input
+
+	commonPasses compiler.Passes
+	finalPasses  compiler.Passes
+	veneers      []VeneerRules
+
+	output codegen.Output
+
+	// errs holds errors encountered while configuring the pipeline. They
+	// are reported by Run().
+	errs []error
+}
+
+// NewPipeline returns an empty pipeline. By default, code for each language
+// is generated in a directory named after it.
+func NewPipeline() *Pipeline {
+	return &Pipeline{
+		output: codegen.Output{
+			Directory:    "%l",
+			SkipManifest: true,
+		},
+	}
+}
+
+// Debug controls whether debug mode is enabled or not.
+// When enabled, more information is included in the generated output,
+// such as an audit trail of applied transformations.
+func (pipeline *Pipeline) Debug(enabled bool) *Pipeline {
+	pipeline.debug = enabled
+	return pipeline
+}
+
+// Concurrency limits the number of languages generated in parallel.
+// Values lower than 1 default to the number of available CPUs.
+func (pipeline *Pipeline) Concurrency(workers int) *Pipeline {
+	pipeline.concurrency = workers
+	return pipeline
+}
+
+// Run executes the codegen pipeline and returns the generated files.
+// Nothing is written to disk.
+func (pipeline *Pipeline) Run(ctx context.Context) (*codejen.FS, error) {
+	if err := errors.Join(pipeline.errs...); err != nil {
+		return nil, err
+	}
+
+	codegenPipeline, err := pipeline.codegenPipeline()
+	if err != nil {
+		return nil, err
+	}
+
+	return codegenPipeline.Run(ctx)
+}
+
+func (pipeline *Pipeline) codegenPipeline() (*codegen.Pipeline, error) {
+	if len(pipeline.inputs) == 0 {
+		return nil, fmt.Errorf("no input configured")
+	}
+	if len(pipeline.output.Languages) == 0 {
+		return nil, fmt.Errorf("no output language configured")
+	}
+
+	codegenPipeline, err := codegen.NewPipeline()
+	if err != nil {
+		return nil, err
+	}
+
+	codegen.Concurrency(pipeline.concurrency)(codegenPipeline)
+
+	codegenPipeline.Debug = pipeline.debug
+	codegenPipeline.Inputs = pipeline.inputs
+	codegenPipeline.Transforms = codegen.Transforms{
+		CommonPasses: pipeline.commonPasses,
+		FinalPasses:  pipeline.finalPasses,
+		Veneers:      pipeline.veneers,
+	}
+	codegenPipeline.Output = pipeline.output
+
+	return codegenPipeline, nil
+}
+
+/**********
+ * Inputs *
+ **********/
+
+// Inputs adds the given inputs to the pipeline.
+func (pipeline *Pipeline) Inputs(inputs ...Input) *Pipeline {
+	for i := range inputs {
+		input := inputs[i]
+		pipeline.inputs = append(pipeline.inputs, &input)
+	}
+
+	return pipeline
+}
+
+// CUEValue adds the given cue value to the pipeline's inputs.
+func (pipeline *Pipeline) CUEValue(pkgName string, value cue.Value, opts ...CUEOption) *Pipeline {
+	cueInput := &codegen.CueInput{
+		Package: pkgName,
+		Value:   &value,
+	}
+
+	for _, opt := range opts {
+		opt(cueInput)
+	}
+
+	pipeline.inputs = append(pipeline.inputs, &codegen.Input{Cue: cueInput})
+
+	return pipeline
+}
+
+/*******************
+ * Transformations *
+ *******************/
+
+// SchemaTransformations adds the given transformations to the set of
+// transformations applied to all the schemas, before language-specific ones.
+func (pipeline *Pipeline) SchemaTransformations(passes ...compiler.Pass) *Pipeline {
+	pipeline.commonPasses = append(pipeline.commonPasses, passes...)
+	return pipeline
+}
+
+// SchemaTransformationsFromYAML adds the transformations described in the
+// given YAML document to the set of transformations applied to all the
+// schemas, before language-specific ones.
+func (pipeline *Pipeline) SchemaTransformationsFromYAML(content []byte) *Pipeline {
+	passes, err := cogyaml.NewCompilerLoader().Load(bytes.NewReader(content))
+	if err != nil {
+		pipeline.errs = append(pipeline.errs, err)
+		return pipeline
+	}
+
+	return pipeline.SchemaTransformations(passes...)
+}
+
+// FinalSchemaTransformations adds the given transformations to the set of
+// transformations applied to all the schemas, after language-specific ones.
+func (pipeline *Pipeline) FinalSchemaTransformations(passes ...compiler.Pass) *Pipeline {
+	pipeline.finalPasses = append(pipeline.finalPasses, passes...)
+	return pipeline
+}
+
+// Veneers adds the given veneers to the set of veneers applied to builders.
+func (pipeline *Pipeline) Veneers(rules ...VeneerRules) *Pipeline {
+	pipeline.veneers = append(pipeline.veneers, rules...)
+	return pipeline
+}
+
+// VeneersFromYAML adds the veneers described in the given YAML document
+// to the set of veneers applied to builders.
+func (pipeline *Pipeline) VeneersFromYAML(content []byte) *Pipeline {
+	rules, err := cogyaml.NewVeneersLoader().Load(bytes.NewReader(content))
+	if err != nil {
+		pipeline.errs = append(pipeline.errs, err)
+		return pipeline
+	}
+
+	return pipeline.Veneers(rules)
+}
+
+/***********
+ * Outputs *
+ ***********/
+
+// Languages adds the given languages to the pipeline's outputs.
+func (pipeline *Pipeline) Languages(languages ...OutputLanguage) *Pipeline {
+	for i := range languages {
+		language := languages[i]
+		pipeline.output.Languages = append(pipeline.output.Languages, &language)
+	}
+
+	return pipeline
+}
+
+// Types enables the generation of types.
+func (pipeline *Pipeline) Types() *Pipeline {
+	pipeline.output.Types = true
+	return pipeline
+}
+
+// Builders enables the generation of builders.
+func (pipeline *Pipeline) Builders() *Pipeline {
+	pipeline.output.Builders = true
+	return pipeline
+}
+
+// Validation enables the generation of methods validating types against
+// the constraints defined in the schemas.
+// Note: only effective if types are generated.
+func (pipeline *Pipeline) Validation() *Pipeline {
+	pipeline.output.Validation = true
+	return pipeline
+}
+
+// OutputDirectory sets the directory in which generated files are placed,
+// relative to the root of the returned FS.
+// The "%l" placeholder is replaced by the name of each language.
+func (pipeline *Pipeline) OutputDirectory(directory string) *Pipeline {
+	pipeline.output.Directory = directory
+	return pipeline
+}
+
+// PackageTemplates sets the directory containing templates added to the
+// generated code of each language.
+func (pipeline *Pipeline) PackageTemplates(directory string) *Pipeline {
+	pipeline.output.PackageTemplates = directory
+	return pipeline
+}
+
+// RepositoryTemplates sets the directory containing templates added to the
+// root of the generated code.
+func (pipeline *Pipeline) RepositoryTemplates(directory string) *Pipeline {
+	pipeline.output.RepositoryTemplates = directory
+	return pipeline
+}
+
+// TemplatesData sets data injected into package and repository templates.
+func (pipeline *Pipeline) TemplatesData(data map[string]string) *Pipeline {
+	pipeline.output.TemplatesData = data
+	return pipeline
+}
@@ -0,0 +1,78 @@
+package cog
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPipeline_Run(t *testing.T) {
+	req := require.New(t)
+
+	dir := t.TempDir()
+	schemaPath := filepath.Join(dir, "dashboard.json")
+	req.NoError(os.WriteFile(schemaPath, []byte(`{
+  "type": "object",
+  "properties": {
+    "title": {"type": "string"},
+    "tags": {"type": "array", "items": {"type": "string"}}
+  }
+}`), 0600))
+
+	generatedFS, err := NewPipeline().
+		Inputs(Input{JSONSchema: &JSONSchemaInput{Path: schemaPath, Package: "dashboard"}}).
+		SchemaTransformationsFromYAML([]byte(`
+passes:
+  - entrypoint_identification: {}
+`)).
+		Veneers(VeneerRules{
+			Language:    AllLanguages,
+			OptionRules: []OptionRule{RenameOption(OptionByName("dashboard", "dashboard", "title"), "name")},
+		}).
+		VeneersFromYAML([]byte(`
+language: go
+package: dashboard
+options:
+  - array_to_append:
+      by_name: dashboard.tags
+`)).
+		Languages(
+			OutputLanguage{Go: &GoConfig{PackageRoot: "github.com/grafana/cog/generated"}},
+			OutputLanguage{Typescript: &TypescriptConfig{}},
+		).
+		Types().
+		Builders().
+		Run(context.Background())
+	req.NoError(err)
+
+	files := make(map[string]string)
+	for _, file := range generatedFS.AsFiles() {
+		files[file.RelativePath] = string(file.Data)
+	}
+
+	goBuilder := files[filepath.Join("go", "dashboard", "dashboard_builder_gen.go")]
+	req.Contains(goBuilder, "func (builder *DashboardBuilder) Name(title string)")
+	req.Contains(goBuilder, "builder.internal.Tags = append(builder.internal.Tags, tags)")
+
+	tsBuilder := files[filepath.Join("typescript", "src", "dashboard", "dashboardBuilder.gen.ts")]
+	req.Contains(tsBuilder, "name(title: string): this")
+	// veneers defined for go only aren't applied
+	req.Contains(tsBuilder, "this.internal.tags = tags;")
+}
+
+func TestPipeline_Run_reportsConfigurationErrors(t *testing.T) {
+	req := require.New(t)
+
+	_, err := NewPipeline().
+		VeneersFromYAML([]byte(`language: go`)).
+		Run(context.Background())
+	req.ErrorContains(err, "missing 'package' statement")
+
+	_, err = NewPipeline().
+		Languages(OutputLanguage{Go: &GoConfig{}}).
+		Run(context.Background())
+	req.ErrorContains(err, "no input configured")
+}
@@ -0,0 +1,19 @@
+package anonymous_struct
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructToCode(input SomeStruct) string {
+	calls := []string{
+		`anonymous_struct.NewSomeStructBuilder()`,
+	}
+
+	if input.Time != nil {
+		timeArg1 := cog.Dump(*input.Time)
+		calls = append(calls, `Time(`+timeArg1+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
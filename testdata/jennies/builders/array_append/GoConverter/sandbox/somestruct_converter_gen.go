@@ -0,0 +1,19 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructToCode(input SomeStruct) string {
+	calls := []string{
+		`sandbox.NewSomeStructBuilder()`,
+	}
+
+	for _, item1 := range input.Tags {
+		tagsArg2 := cog.Dump(item1)
+		calls = append(calls, `Tags(`+tagsArg2+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
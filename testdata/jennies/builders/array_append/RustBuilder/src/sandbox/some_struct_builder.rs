@@ -0,0 +1,60 @@
+use crate::cog;
+use crate::sandbox;
+
+pub struct SomeStructBuilder {
+    internal: sandbox::SomeStruct,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl SomeStructBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn tags(mut self, tags: String) -> Self {
+        self.internal.tags.push(tags);
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<sandbox::SomeStruct> for SomeStructBuilder {
+    fn from(resource: sandbox::SomeStruct) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<sandbox::SomeStruct> for SomeStructBuilder {
+    fn build(&self) -> Result<sandbox::SomeStruct, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("SomeStruct"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
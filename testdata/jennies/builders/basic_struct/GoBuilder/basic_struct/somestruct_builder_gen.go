@@ -24,6 +24,19 @@ func NewSomeStructBuilder() *SomeStructBuilder {
 	return builder
 }
 
+// NewSomeStructBuilderFrom creates a builder from an existing SomeStruct value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewSomeStructBuilderFrom(resource SomeStruct) *SomeStructBuilder {
+	builder := &SomeStructBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *SomeStructBuilder) Build() (SomeStruct, error) {
 	var errs cog.BuildErrors
 
@@ -32,6 +32,16 @@ public class SomeStruct {
         public Builder() {
             this.internal = new SomeStruct();
         }
+
+        private Builder(SomeStruct resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(SomeStruct resource) {
+            return new Builder(resource);
+        }
     public Builder id(Long id) {
     this.internal.id = id;
         return this;
@@ -0,0 +1,82 @@
+use crate::cog;
+use crate::basic_struct;
+
+/// SomeStruct, to hold data.
+pub struct SomeStructBuilder {
+    internal: basic_struct::SomeStruct,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl SomeStructBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    /// id identifies something. Weird, right?
+    pub fn id(mut self, id: i64) -> Self {
+        self.internal.id = id;
+
+        self
+    }
+
+    pub fn uid(mut self, uid: String) -> Self {
+        self.internal.uid = uid;
+
+        self
+    }
+
+    pub fn tags(mut self, tags: Vec<String>) -> Self {
+        self.internal.tags = tags;
+
+        self
+    }
+
+    /// This thing could be live.
+    /// Or maybe not.
+    pub fn live_now(mut self, live_now: bool) -> Self {
+        self.internal.live_now = live_now;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<basic_struct::SomeStruct> for SomeStructBuilder {
+    fn from(resource: basic_struct::SomeStruct) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<basic_struct::SomeStruct> for SomeStructBuilder {
+    fn build(&self) -> Result<basic_struct::SomeStruct, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("SomeStruct"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
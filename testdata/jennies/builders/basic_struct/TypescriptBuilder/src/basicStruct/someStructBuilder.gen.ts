@@ -9,6 +9,17 @@ export class SomeStructBuilder implements cog.Builder<basicStruct.SomeStruct> {
         this.internal = basicStruct.defaultSomeStruct();
     }
 
+    // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: basicStruct.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): basicStruct.SomeStruct {
         return this.internal;
     }
@@ -0,0 +1,34 @@
+package basic_struct_defaults
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructToCode(input SomeStruct) string {
+	calls := []string{
+		`basic_struct_defaults.NewSomeStructBuilder()`,
+	}
+
+	if input.Id != 42 {
+		idArg1 := cog.Dump(input.Id)
+		calls = append(calls, `Id(`+idArg1+`)`)
+	}
+
+	if input.Uid != "default-uid" {
+		uidArg2 := cog.Dump(input.Uid)
+		calls = append(calls, `Uid(`+uidArg2+`)`)
+	}
+
+	if input.Tags != nil {
+		tagsArg3 := cog.Dump(input.Tags)
+		calls = append(calls, `Tags(`+tagsArg3+`)`)
+	}
+
+	if input.LiveNow != true {
+		liveNowArg4 := cog.Dump(input.LiveNow)
+		calls = append(calls, `LiveNow(`+liveNowArg4+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
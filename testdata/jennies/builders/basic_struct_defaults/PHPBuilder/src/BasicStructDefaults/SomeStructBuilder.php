@@ -14,6 +14,18 @@ class SomeStructBuilder implements \Grafana\Foundation\Cog\Builder
     	$this->internal = new \Grafana\Foundation\BasicStructDefaults\SomeStruct();
     }
 
+    /**
+     * Creates a builder from an existing object, allowing it to be modified with the builder's options.
+     * The object isn't copied: the builder's options modify it in place.
+     */
+    public static function fromObject(\Grafana\Foundation\BasicStructDefaults\SomeStruct $resource): self
+    {
+        $builder = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
+        $builder->internal = $resource;
+
+        return $builder;
+    }
+
     /**
      * @return \Grafana\Foundation\BasicStructDefaults\SomeStruct
      */
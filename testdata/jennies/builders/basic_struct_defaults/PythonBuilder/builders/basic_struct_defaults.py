@@ -9,6 +9,17 @@ class SomeStruct(cogbuilder.Builder[basic_struct_defaults.SomeStruct]):
     def __init__(self):
         self._internal = basic_struct_defaults.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: basic_struct_defaults.SomeStruct) -> typing.Self:
+        """
+        Creates a builder from an existing basic_struct_defaults.SomeStruct object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> basic_struct_defaults.SomeStruct:
         return self._internal    
     
@@ -0,0 +1,82 @@
+use crate::cog;
+use crate::basic_struct_defaults;
+
+pub struct SomeStructBuilder {
+    internal: basic_struct_defaults::SomeStruct,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl SomeStructBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn id(mut self, id: i64) -> Self {
+        self.internal.id = id;
+
+        self
+    }
+
+    pub fn uid(mut self, uid: String) -> Self {
+        self.internal.uid = uid;
+
+        self
+    }
+
+    pub fn tags(mut self, tags: Vec<String>) -> Self {
+        self.internal.tags = tags;
+
+        self
+    }
+
+    pub fn live_now(mut self, live_now: bool) -> Self {
+        self.internal.live_now = live_now;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+            .id(42)
+            .uid("default-uid".to_string())
+            .tags(vec!["generated".to_string(), "cog".to_string()])
+            .live_now(true)
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<basic_struct_defaults::SomeStruct> for SomeStructBuilder {
+    fn from(resource: basic_struct_defaults::SomeStruct) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<basic_struct_defaults::SomeStruct> for SomeStructBuilder {
+    fn build(&self) -> Result<basic_struct_defaults::SomeStruct, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("SomeStruct"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
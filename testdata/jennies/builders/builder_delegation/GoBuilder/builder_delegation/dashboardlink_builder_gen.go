@@ -23,6 +23,19 @@ func NewDashboardLinkBuilder() *DashboardLinkBuilder {
 	return builder
 }
 
+// NewDashboardLinkBuilderFrom creates a builder from an existing DashboardLink value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewDashboardLinkBuilderFrom(resource DashboardLink) *DashboardLinkBuilder {
+	builder := &DashboardLinkBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *DashboardLinkBuilder) Build() (DashboardLink, error) {
 	var errs cog.BuildErrors
 
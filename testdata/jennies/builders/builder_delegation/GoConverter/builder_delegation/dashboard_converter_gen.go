@@ -0,0 +1,49 @@
+package builder_delegation
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// DashboardToCode accepts a `Dashboard` object and generates the Go code to build this object using builders.
+func DashboardToCode(input Dashboard) string {
+	calls := []string{
+		`builder_delegation.NewDashboardBuilder()`,
+	}
+
+	if input.Id != 0 {
+		idArg1 := cog.Dump(input.Id)
+		calls = append(calls, `Id(`+idArg1+`)`)
+	}
+
+	if input.Title != "" {
+		titleArg2 := cog.Dump(input.Title)
+		calls = append(calls, `Title(`+titleArg2+`)`)
+	}
+
+	if input.Links != nil {
+		tmp4 := make([]string, 0, len(input.Links))
+		for _, item3 := range input.Links {
+			tmp4 = append(tmp4, DashboardLinkToCode(item3))
+		}
+		linksArg5 := `[]cog.Builder[builder_delegation.DashboardLink]{` + strings.Join(tmp4, ",\n") + `}`
+		calls = append(calls, `Links(`+linksArg5+`)`)
+	}
+
+	if input.LinksOfLinks != nil {
+		tmp7 := make([]string, 0, len(input.LinksOfLinks))
+		for _, item6 := range input.LinksOfLinks {
+			tmp9 := make([]string, 0, len(item6))
+			for _, item8 := range item6 {
+				tmp9 = append(tmp9, DashboardLinkToCode(item8))
+			}
+			tmp7 = append(tmp7, `[]cog.Builder[builder_delegation.DashboardLink]{`+strings.Join(tmp9, ",\n")+`}`)
+		}
+		linksOfLinksArg10 := `[][]cog.Builder[builder_delegation.DashboardLink]{` + strings.Join(tmp7, ",\n") + `}`
+		calls = append(calls, `LinksOfLinks(`+linksOfLinksArg10+`)`)
+	}
+
+	singleLinkArg11 := DashboardLinkToCode(input.SingleLink)
+	calls = append(calls, `SingleLink(`+singleLinkArg11+`)`)
+
+	return strings.Join(calls, ".\n")
+}
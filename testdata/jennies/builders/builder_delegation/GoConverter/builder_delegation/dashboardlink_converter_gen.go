@@ -0,0 +1,24 @@
+package builder_delegation
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// DashboardLinkToCode accepts a `DashboardLink` object and generates the Go code to build this object using builders.
+func DashboardLinkToCode(input DashboardLink) string {
+	calls := []string{
+		`builder_delegation.NewDashboardLinkBuilder()`,
+	}
+
+	if input.Title != "" {
+		titleArg1 := cog.Dump(input.Title)
+		calls = append(calls, `Title(`+titleArg1+`)`)
+	}
+
+	if input.Url != "" {
+		urlArg2 := cog.Dump(input.Url)
+		calls = append(calls, `Url(`+urlArg2+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
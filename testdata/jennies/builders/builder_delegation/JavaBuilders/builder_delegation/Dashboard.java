@@ -33,6 +33,16 @@ public class Dashboard {
         public Builder() {
             this.internal = new Dashboard();
         }
+
+        private Builder(Dashboard resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing Dashboard object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(Dashboard resource) {
+            return new Builder(resource);
+        }
     public Builder id(Long id) {
     this.internal.id = id;
         return this;
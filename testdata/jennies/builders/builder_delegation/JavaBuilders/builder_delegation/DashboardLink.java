@@ -23,6 +23,16 @@ public class DashboardLink {
         public Builder() {
             this.internal = new DashboardLink();
         }
+
+        private Builder(DashboardLink resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing DashboardLink object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(DashboardLink resource) {
+            return new Builder(resource);
+        }
     public Builder title(String title) {
     this.internal.title = title;
         return this;
@@ -9,6 +9,17 @@ class DashboardLink(cogbuilder.Builder[builder_delegation.DashboardLink]):
     def __init__(self):
         self._internal = builder_delegation.DashboardLink()
 
+    @classmethod
+    def from_object(cls, resource: builder_delegation.DashboardLink) -> typing.Self:
+        """
+        Creates a builder from an existing builder_delegation.DashboardLink object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> builder_delegation.DashboardLink:
         return self._internal    
     
@@ -29,6 +40,17 @@ class Dashboard(cogbuilder.Builder[builder_delegation.Dashboard]):
     def __init__(self):
         self._internal = builder_delegation.Dashboard()
 
+    @classmethod
+    def from_object(cls, resource: builder_delegation.Dashboard) -> typing.Self:
+        """
+        Creates a builder from an existing builder_delegation.Dashboard object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> builder_delegation.Dashboard:
         return self._internal    
     
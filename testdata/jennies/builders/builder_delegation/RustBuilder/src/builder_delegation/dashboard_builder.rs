@@ -0,0 +1,121 @@
+use crate::cog;
+use crate::builder_delegation;
+
+pub struct DashboardBuilder {
+    internal: builder_delegation::Dashboard,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl DashboardBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn id(mut self, id: i64) -> Self {
+        self.internal.id = id;
+
+        self
+    }
+
+    pub fn title(mut self, title: String) -> Self {
+        self.internal.title = title;
+
+        self
+    }
+
+    /// will be expanded to []cog.Builder<DashboardLink>
+    pub fn links(mut self, links: Vec<Box<dyn cog::Builder<builder_delegation::DashboardLink>>>) -> Self {
+        let mut links_resources = Vec::with_capacity(links.len());
+        for r1 in links {
+            let links_depth1 = match cog::Builder::build(&r1) {
+                Ok(resource) => resource,
+                Err(err) => {
+                    self.errors.insert("links".to_string(), err);
+                    return self;
+                }
+            };
+            links_resources.push(links_depth1);
+        }
+        self.internal.links = links_resources;
+
+        self
+    }
+
+    /// will be expanded to [][]cog.Builder<DashboardLink>
+    pub fn links_of_links(mut self, links_of_links: Vec<Vec<Box<dyn cog::Builder<builder_delegation::DashboardLink>>>>) -> Self {
+        let mut links_of_links_resources = Vec::with_capacity(links_of_links.len());
+        for r1 in links_of_links {
+            let mut links_of_links_depth1 = Vec::new();
+            for r2 in r1 {
+                let links_of_links_depth2 = match cog::Builder::build(&r2) {
+                    Ok(resource) => resource,
+                    Err(err) => {
+                        self.errors.insert("linksOfLinks".to_string(), err);
+                        return self;
+                    }
+                };
+                links_of_links_depth1.push(links_of_links_depth2);
+            }
+
+            links_of_links_resources.push(links_of_links_depth1);
+        }
+        self.internal.links_of_links = links_of_links_resources;
+
+        self
+    }
+
+    /// will be expanded to cog.Builder<DashboardLink>
+    pub fn single_link(mut self, single_link: impl cog::Builder<builder_delegation::DashboardLink>) -> Self {
+        let single_link_resource = match cog::Builder::build(&single_link) {
+            Ok(resource) => resource,
+            Err(err) => {
+                self.errors.insert("singleLink".to_string(), err);
+                return self;
+            }
+        };
+        self.internal.single_link = single_link_resource;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<builder_delegation::Dashboard> for DashboardBuilder {
+    fn from(resource: builder_delegation::Dashboard) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<builder_delegation::Dashboard> for DashboardBuilder {
+    fn build(&self) -> Result<builder_delegation::Dashboard, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("Dashboard"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
@@ -0,0 +1,66 @@
+use crate::cog;
+use crate::builder_delegation;
+
+pub struct DashboardLinkBuilder {
+    internal: builder_delegation::DashboardLink,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl DashboardLinkBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn title(mut self, title: String) -> Self {
+        self.internal.title = title;
+
+        self
+    }
+
+    pub fn url(mut self, url: String) -> Self {
+        self.internal.url = url;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<builder_delegation::DashboardLink> for DashboardLinkBuilder {
+    fn from(resource: builder_delegation::DashboardLink) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<builder_delegation::DashboardLink> for DashboardLinkBuilder {
+    fn build(&self) -> Result<builder_delegation::DashboardLink, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("DashboardLink"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
@@ -8,6 +8,17 @@ export class DashboardLinkBuilder implements cog.Builder<builderDelegation.Dashb
         this.internal = builderDelegation.defaultDashboardLink();
     }
 
+    // Creates a builder from an existing DashboardLink object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: builderDelegation.DashboardLink): DashboardLinkBuilder {
+        const builder: DashboardLinkBuilder = Object.create(DashboardLinkBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): builderDelegation.DashboardLink {
         return this.internal;
     }
@@ -28,6 +28,16 @@ public class Dashboard {
         public Builder() {
             this.internal = new Dashboard();
         }
+
+        private Builder(Dashboard resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing Dashboard object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(Dashboard resource) {
+            return new Builder(resource);
+        }
     public Builder singleLinkOrString(cog.Builder<unknown> singleLinkOrString) {
     this.internal.singleLinkOrString = singleLinkOrString.build();
         return this;
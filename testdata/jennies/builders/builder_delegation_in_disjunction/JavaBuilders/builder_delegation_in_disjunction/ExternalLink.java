@@ -21,6 +21,16 @@ public class ExternalLink {
         public Builder() {
             this.internal = new ExternalLink();
         }
+
+        private Builder(ExternalLink resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing ExternalLink object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(ExternalLink resource) {
+            return new Builder(resource);
+        }
     public Builder url(String url) {
     this.internal.url = url;
         return this;
@@ -9,6 +9,17 @@ class DashboardLink(cogbuilder.Builder[builder_delegation_in_disjunction.Dashboa
     def __init__(self):
         self._internal = builder_delegation_in_disjunction.DashboardLink()
 
+    @classmethod
+    def from_object(cls, resource: builder_delegation_in_disjunction.DashboardLink) -> typing.Self:
+        """
+        Creates a builder from an existing builder_delegation_in_disjunction.DashboardLink object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> builder_delegation_in_disjunction.DashboardLink:
         return self._internal    
     
@@ -29,6 +40,17 @@ class ExternalLink(cogbuilder.Builder[builder_delegation_in_disjunction.External
     def __init__(self):
         self._internal = builder_delegation_in_disjunction.ExternalLink()
 
+    @classmethod
+    def from_object(cls, resource: builder_delegation_in_disjunction.ExternalLink) -> typing.Self:
+        """
+        Creates a builder from an existing builder_delegation_in_disjunction.ExternalLink object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> builder_delegation_in_disjunction.ExternalLink:
         return self._internal    
     
@@ -44,6 +66,17 @@ class Dashboard(cogbuilder.Builder[builder_delegation_in_disjunction.Dashboard])
     def __init__(self):
         self._internal = builder_delegation_in_disjunction.Dashboard()
 
+    @classmethod
+    def from_object(cls, resource: builder_delegation_in_disjunction.Dashboard) -> typing.Self:
+        """
+        Creates a builder from an existing builder_delegation_in_disjunction.Dashboard object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> builder_delegation_in_disjunction.Dashboard:
         return self._internal    
     
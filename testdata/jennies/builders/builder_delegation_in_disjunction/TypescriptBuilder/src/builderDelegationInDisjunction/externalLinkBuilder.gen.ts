@@ -8,6 +8,17 @@ export class ExternalLinkBuilder implements cog.Builder<builderDelegationInDisju
         this.internal = builderDelegationInDisjunction.defaultExternalLink();
     }
 
+    // Creates a builder from an existing ExternalLink object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: builderDelegationInDisjunction.ExternalLink): ExternalLinkBuilder {
+        const builder: ExternalLinkBuilder = Object.create(ExternalLinkBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): builderDelegationInDisjunction.ExternalLink {
         return this.internal;
     }
@@ -24,6 +24,19 @@ func NewLokiBuilderBuilder() *LokiBuilderBuilder {
 	return builder
 }
 
+// NewLokiBuilderBuilderFrom creates a builder from an existing Dashboard value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewLokiBuilderBuilderFrom(resource Dashboard) *LokiBuilderBuilder {
+	builder := &LokiBuilderBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *LokiBuilderBuilder) Build() (Dashboard, error) {
 	var errs cog.BuildErrors
 
@@ -0,0 +1,14 @@
+package composable_slot
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// LokiBuilderToCode accepts a `Dashboard` object and generates the Go code to build this object using builders.
+func LokiBuilderToCode(input Dashboard) string {
+	calls := []string{
+		`composable_slot.NewLokiBuilderBuilder()`,
+	}
+
+	return strings.Join(calls, ".\n")
+}
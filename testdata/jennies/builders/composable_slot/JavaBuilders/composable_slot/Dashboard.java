@@ -27,6 +27,16 @@ public class Dashboard {
         public Builder() {
             this.internal = new Dashboard();
         }
+
+        private Builder(Dashboard resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing Dashboard object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(Dashboard resource) {
+            return new Builder(resource);
+        }
     public Builder target(cog.Builder<Dataquery> target) {
     this.internal.target = target.build();
         return this;
@@ -10,6 +10,17 @@ class LokiBuilder(cogbuilder.Builder[composable_slot.Dashboard]):
     def __init__(self):
         self._internal = composable_slot.Dashboard()
 
+    @classmethod
+    def from_object(cls, resource: composable_slot.Dashboard) -> typing.Self:
+        """
+        Creates a builder from an existing composable_slot.Dashboard object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> composable_slot.Dashboard:
         return self._internal    
     
@@ -0,0 +1,84 @@
+use crate::cog;
+use crate::composable_slot;
+
+pub struct LokiBuilderBuilder {
+    internal: composable_slot::Dashboard,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl LokiBuilderBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn target(mut self, target: impl cog::VariantBuilder) -> Self {
+        let target_resource = match cog::VariantBuilder::build_variant(&target) {
+            Ok(resource) => resource,
+            Err(err) => {
+                self.errors.insert("target".to_string(), err);
+                return self;
+            }
+        };
+        self.internal.target = target_resource;
+
+        self
+    }
+
+    pub fn targets(mut self, targets: Vec<Box<dyn cog::VariantBuilder>>) -> Self {
+        let mut targets_resources = Vec::with_capacity(targets.len());
+        for r1 in targets {
+            let targets_depth1 = match cog::VariantBuilder::build_variant(&r1) {
+                Ok(resource) => resource,
+                Err(err) => {
+                    self.errors.insert("targets".to_string(), err);
+                    return self;
+                }
+            };
+            targets_resources.push(targets_depth1);
+        }
+        self.internal.targets = targets_resources;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<composable_slot::Dashboard> for LokiBuilderBuilder {
+    fn from(resource: composable_slot::Dashboard) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<composable_slot::Dashboard> for LokiBuilderBuilder {
+    fn build(&self) -> Result<composable_slot::Dashboard, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("LokiBuilder"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
@@ -8,6 +8,17 @@ export class LokiBuilderBuilder implements cog.Builder<composableSlot.Dashboard>
         this.internal = composableSlot.defaultDashboard();
     }
 
+    // Creates a builder from an existing Dashboard object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: composableSlot.Dashboard): LokiBuilderBuilder {
+        const builder: LokiBuilderBuilder = Object.create(LokiBuilderBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): composableSlot.Dashboard {
         return this.internal;
     }
@@ -0,0 +1,30 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructToCode(input SomeStruct) string {
+	calls := []string{
+		`sandbox.NewSomeStructBuilder()`,
+	}
+
+	if input.Editable == true {
+		calls = append(calls, `Editable()`)
+	}
+
+	if input.Editable == false {
+		calls = append(calls, `Readonly()`)
+	}
+
+	if input.AutoRefresh != nil && *input.AutoRefresh == true {
+		calls = append(calls, `AutoRefresh()`)
+	}
+
+	if input.AutoRefresh != nil && *input.AutoRefresh == false {
+		calls = append(calls, `NoAutoRefresh()`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
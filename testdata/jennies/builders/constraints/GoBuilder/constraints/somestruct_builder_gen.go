@@ -6,6 +6,11 @@ import (
 
 var _ cog.Builder[SomeStruct] = (*SomeStructBuilder)(nil)
 
+var (
+	someStructBuilderPattern1 = regexp.MustCompile("^[a-z0-9-]+$")
+	someStructBuilderPattern2 = regexp.MustCompile("^-")
+)
+
 type SomeStructBuilder struct {
     internal *SomeStruct
     errors map[string]cog.BuildErrors
@@ -23,6 +28,19 @@ func NewSomeStructBuilder() *SomeStructBuilder {
 	return builder
 }
 
+// NewSomeStructBuilderFrom creates a builder from an existing SomeStruct value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewSomeStructBuilderFrom(resource SomeStruct) *SomeStructBuilder {
+	builder := &SomeStructBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *SomeStructBuilder) Build() (SomeStruct, error) {
 	var errs cog.BuildErrors
 
@@ -61,5 +79,19 @@ func (builder *SomeStructBuilder) Title(title string) *SomeStructBuilder {
     return builder
 }
 
+func (builder *SomeStructBuilder) Slug(slug string) *SomeStructBuilder {
+    if !someStructBuilderPattern1.MatchString(slug) {
+        builder.errors["slug"] = cog.MakeBuildErrors("slug", errors.New("slug must match the pattern ^[a-z0-9-]+$"))
+        return builder
+    }
+    if someStructBuilderPattern2.MatchString(slug) {
+        builder.errors["slug"] = cog.MakeBuildErrors("slug", errors.New("slug must not match the pattern ^-"))
+        return builder
+    }
+    builder.internal.Slug = slug
+
+    return builder
+}
+
 func (builder *SomeStructBuilder) applyDefaults() {
 }
@@ -0,0 +1,29 @@
+package constraints
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructToCode(input SomeStruct) string {
+	calls := []string{
+		`constraints.NewSomeStructBuilder()`,
+	}
+
+	if input.Id != 0 {
+		idArg1 := cog.Dump(input.Id)
+		calls = append(calls, `Id(`+idArg1+`)`)
+	}
+
+	if input.Title != "" {
+		titleArg2 := cog.Dump(input.Title)
+		calls = append(calls, `Title(`+titleArg2+`)`)
+	}
+
+	if input.Slug != "" {
+		slugArg3 := cog.Dump(input.Slug)
+		calls = append(calls, `Slug(`+slugArg3+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -9,7 +9,9 @@ public class SomeStruct {
     @JsonProperty("id")
     public Long id; 
     @JsonProperty("title")
-    public String title;
+    public String title; 
+    @JsonProperty("slug")
+    public String slug;
     
     public String toJSON() throws JsonProcessingException {
         ObjectWriter ow = new ObjectMapper().writer().withDefaultPrettyPrinter();
@@ -23,6 +25,16 @@ public class SomeStruct {
         public Builder() {
             this.internal = new SomeStruct();
         }
+
+        private Builder(SomeStruct resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(SomeStruct resource) {
+            return new Builder(resource);
+        }
     public Builder id(Long id) {
         if (!(id >= 5)) {
             throw new IllegalArgumentException("id must be >= 5");
@@ -41,6 +53,17 @@ public class SomeStruct {
     this.internal.title = title;
         return this;
     }
+    
+    public Builder slug(String slug) {
+        if (!java.util.regex.Pattern.compile("^[a-z0-9-]+$").matcher(slug).find()) {
+            throw new IllegalArgumentException("slug must match the pattern ^[a-z0-9-]+$");
+        }
+        if (java.util.regex.Pattern.compile("^-").matcher(slug).find()) {
+            throw new IllegalArgumentException("slug must not match the pattern ^-");
+        }
+    this.internal.slug = slug;
+        return this;
+    }
     public SomeStruct build() {
             return this.internal;
         }
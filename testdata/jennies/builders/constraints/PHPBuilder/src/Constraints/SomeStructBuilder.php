@@ -14,6 +14,18 @@ class SomeStructBuilder implements \Grafana\Foundation\Cog\Builder
     	$this->internal = new \Grafana\Foundation\Constraints\SomeStruct();
     }
 
+    /**
+     * Creates a builder from an existing object, allowing it to be modified with the builder's options.
+     * The object isn't copied: the builder's options modify it in place.
+     */
+    public static function fromObject(\Grafana\Foundation\Constraints\SomeStruct $resource): self
+    {
+        $builder = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
+        $builder->internal = $resource;
+
+        return $builder;
+    }
+
     /**
      * @return \Grafana\Foundation\Constraints\SomeStruct
      */
@@ -43,5 +55,17 @@ class SomeStructBuilder implements \Grafana\Foundation\Cog\Builder
     
         return $this;
     }
+    public function slug(string $slug): static
+    {
+        if (preg_match('~^[a-z0-9-]+$~', $slug) !== 1) {
+            throw new \ValueError('$slug must match the pattern ^[a-z0-9-]+$');
+        }
+        if (preg_match('~^-~', $slug) === 1) {
+            throw new \ValueError('$slug must not match the pattern ^-');
+        }
+        $this->internal->slug = $slug;
+    
+        return $this;
+    }
 
 }
@@ -1,6 +1,7 @@
 import typing
 from ..cog import builder as cogbuilder
 from ..models import constraints
+import re
 
 
 class SomeStruct(cogbuilder.Builder[constraints.SomeStruct]):    
@@ -9,6 +10,17 @@ class SomeStruct(cogbuilder.Builder[constraints.SomeStruct]):
     def __init__(self):
         self._internal = constraints.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: constraints.SomeStruct) -> typing.Self:
+        """
+        Creates a builder from an existing constraints.SomeStruct object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> constraints.SomeStruct:
         return self._internal    
     
@@ -27,4 +39,13 @@ class SomeStruct(cogbuilder.Builder[constraints.SomeStruct]):
         self._internal.title = title
     
         return self
+    
+    def slug(self, slug: str) -> typing.Self:        
+        if not re.search("^[a-z0-9-]+$", slug):
+            raise ValueError("slug must match the pattern ^[a-z0-9-]+$")
+        if re.search("^-", slug):
+            raise ValueError("slug must not match the pattern ^-")
+        self._internal.slug = slug
+    
+        return self
     
\ No newline at end of file
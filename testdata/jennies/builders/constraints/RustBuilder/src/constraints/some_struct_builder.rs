@@ -0,0 +1,98 @@
+use crate::cog;
+use crate::constraints;
+
+pub struct SomeStructBuilder {
+    internal: constraints::SomeStruct,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl SomeStructBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn id(mut self, id: u64) -> Self {
+        if !(id >= 5) {
+            self.errors.insert("id".to_string(), cog::BuildErrors::new("id", "id must be >= 5"));
+            return self;
+        }
+        if !(id < 10) {
+            self.errors.insert("id".to_string(), cog::BuildErrors::new("id", "id must be < 10"));
+            return self;
+        }
+        self.internal.id = id;
+
+        self
+    }
+
+    pub fn title(mut self, title: String) -> Self {
+        if !(title.chars().count() >= 1) {
+            self.errors.insert("title".to_string(), cog::BuildErrors::new("title", "title.chars().count() must be >= 1"));
+            return self;
+        }
+        self.internal.title = title;
+
+        self
+    }
+
+    pub fn slug(mut self, slug: String) -> Self {
+        {
+            static PATTERN: std::sync::LazyLock<regex::Regex> = std::sync::LazyLock::new(|| regex::Regex::new("^[a-z0-9-]+$").unwrap());
+            if !PATTERN.is_match(&slug) {
+                self.errors.insert("slug".to_string(), cog::BuildErrors::new("slug", "slug must match the pattern ^[a-z0-9-]+$"));
+                return self;
+            }
+        }
+        {
+            static PATTERN: std::sync::LazyLock<regex::Regex> = std::sync::LazyLock::new(|| regex::Regex::new("^-").unwrap());
+            if PATTERN.is_match(&slug) {
+                self.errors.insert("slug".to_string(), cog::BuildErrors::new("slug", "slug must not match the pattern ^-"));
+                return self;
+            }
+        }
+        self.internal.slug = slug;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<constraints::SomeStruct> for SomeStructBuilder {
+    fn from(resource: constraints::SomeStruct) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<constraints::SomeStruct> for SomeStructBuilder {
+    fn build(&self) -> Result<constraints::SomeStruct, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("SomeStruct"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
@@ -8,6 +8,17 @@ export class SomeStructBuilder implements cog.Builder<constraints.SomeStruct> {
         this.internal = constraints.defaultSomeStruct();
     }
 
+    // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: constraints.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): constraints.SomeStruct {
         return this.internal;
     }
@@ -30,4 +41,15 @@ export class SomeStructBuilder implements cog.Builder<constraints.SomeStruct> {
         this.internal.title = title;
         return this;
     }
+
+    slug(slug: string): this {
+        if (!new RegExp("^[a-z0-9-]+$").test(slug)) {
+            throw new Error("slug must match the pattern ^[a-z0-9-]+$");
+        }
+        if (new RegExp("^-").test(slug)) {
+            throw new Error("slug must not match the pattern ^-");
+        }
+        this.internal.slug = slug;
+        return this;
+    }
 }
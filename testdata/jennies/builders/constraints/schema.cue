@@ -7,4 +7,5 @@ SomeStruct: {
 	title: strings.MinRunes(1) & {
 		string
 	}
+	slug: string & =~"^[a-z0-9-]+$" & !~"^-"
 }
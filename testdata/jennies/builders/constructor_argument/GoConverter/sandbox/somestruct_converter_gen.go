@@ -0,0 +1,15 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructToCode(input SomeStruct) string {
+	titleArg1 := cog.Dump(input.Title)
+	calls := []string{
+		`sandbox.NewSomeStructBuilder(` + titleArg1 + `)`,
+	}
+
+	return strings.Join(calls, ".\n")
+}
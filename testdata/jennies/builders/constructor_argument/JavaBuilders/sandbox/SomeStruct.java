@@ -22,6 +22,16 @@ public class SomeStruct {
             this.internal = new SomeStruct();
     this.internal.title = title;
         }
+
+        private Builder(SomeStruct resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(SomeStruct resource) {
+            return new Builder(resource);
+        }
     public Builder title(String title) {
     this.internal.title = title;
         return this;
@@ -10,6 +10,17 @@ class SomeStruct(cogbuilder.Builder[sandbox.SomeStruct]):
         self._internal = sandbox.SomeStruct()        
         self._internal.title = title
 
+    @classmethod
+    def from_object(cls, resource: sandbox.SomeStruct) -> typing.Self:
+        """
+        Creates a builder from an existing sandbox.SomeStruct object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> sandbox.SomeStruct:
         return self._internal    
     
@@ -25,6 +25,19 @@ func NewSomePanelBuilder() *SomePanelBuilder {
 	return builder
 }
 
+// NewSomePanelBuilderFrom creates a builder from an existing SomePanel value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewSomePanelBuilderFrom(resource SomePanel) *SomePanelBuilder {
+	builder := &SomePanelBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *SomePanelBuilder) Build() (SomePanel, error) {
 	var errs cog.BuildErrors
 
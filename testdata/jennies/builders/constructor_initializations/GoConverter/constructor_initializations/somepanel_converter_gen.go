@@ -0,0 +1,19 @@
+package constructor_initializations
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomePanelToCode accepts a `SomePanel` object and generates the Go code to build this object using builders.
+func SomePanelToCode(input SomePanel) string {
+	calls := []string{
+		`constructor_initializations.NewSomePanelBuilder()`,
+	}
+
+	if input.Title != "" {
+		titleArg1 := cog.Dump(input.Title)
+		calls = append(calls, `Title(`+titleArg1+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
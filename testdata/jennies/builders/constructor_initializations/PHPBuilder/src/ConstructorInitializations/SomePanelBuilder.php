@@ -16,6 +16,18 @@ class SomePanelBuilder implements \Grafana\Foundation\Cog\Builder
     $this->internal->cursor = \Grafana\Foundation\ConstructorInitializations\CursorMode::tooltip();
     }
 
+    /**
+     * Creates a builder from an existing object, allowing it to be modified with the builder's options.
+     * The object isn't copied: the builder's options modify it in place.
+     */
+    public static function fromObject(\Grafana\Foundation\ConstructorInitializations\SomePanel $resource): self
+    {
+        $builder = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
+        $builder->internal = $resource;
+
+        return $builder;
+    }
+
     /**
      * @return \Grafana\Foundation\ConstructorInitializations\SomePanel
      */
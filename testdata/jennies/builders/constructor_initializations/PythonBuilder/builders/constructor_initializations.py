@@ -11,6 +11,17 @@ class SomePanel(cogbuilder.Builder[constructor_initializations.SomePanel]):
         self._internal.type_val = "panel_type"        
         self._internal.cursor = constructor_initializations.CursorMode.TOOLTIP
 
+    @classmethod
+    def from_object(cls, resource: constructor_initializations.SomePanel) -> typing.Self:
+        """
+        Creates a builder from an existing constructor_initializations.SomePanel object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> constructor_initializations.SomePanel:
         return self._internal    
     
@@ -0,0 +1,62 @@
+use crate::cog;
+use crate::constructor_initializations;
+
+pub struct SomePanelBuilder {
+    internal: constructor_initializations::SomePanel,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl SomePanelBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+        builder.internal.type_ = "panel_type".to_string();
+        builder.internal.cursor = constructor_initializations::CursorMode::Tooltip;
+
+        builder
+    }
+
+    pub fn title(mut self, title: String) -> Self {
+        self.internal.title = title;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<constructor_initializations::SomePanel> for SomePanelBuilder {
+    fn from(resource: constructor_initializations::SomePanel) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<constructor_initializations::SomePanel> for SomePanelBuilder {
+    fn build(&self) -> Result<constructor_initializations::SomePanel, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("SomePanel"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
@@ -10,6 +10,17 @@ export class SomePanelBuilder implements cog.Builder<constructorInitializations.
         this.internal.cursor = constructorInitializations.CursorMode.Tooltip;
     }
 
+    // Creates a builder from an existing SomePanel object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: constructorInitializations.SomePanel): SomePanelBuilder {
+        const builder: SomePanelBuilder = Object.create(SomePanelBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): constructorInitializations.SomePanel {
         return this.internal;
     }
@@ -0,0 +1,69 @@
+<?php
+
+namespace Grafana\Foundation\Dashboard;
+
+/**
+ * @implements \Grafana\Foundation\Cog\Builder<\Grafana\Foundation\Dashboard\Dashboard>
+ */
+class DashboardBuilder implements \Grafana\Foundation\Cog\Builder
+{
+    protected \Grafana\Foundation\Dashboard\Dashboard $internal;
+    private int $currentY;
+    private int $currentX;
+    private int $lastPanelHeight;
+
+    public function __construct()
+    {
+    	$this->internal = new \Grafana\Foundation\Dashboard\Dashboard();
+        $this->currentY = 0;
+        $this->currentX = 0;
+        $this->lastPanelHeight = 0;
+    }
+
+    /**
+     * Creates a builder from an existing object, allowing it to be modified with the builder's options.
+     * The object isn't copied: the builder's options modify it in place.
+     */
+    public static function fromObject(\Grafana\Foundation\Dashboard\Dashboard $resource): self
+    {
+        $builder = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
+        $builder->internal = $resource;
+        $builder->currentY = 0;
+        $builder->currentX = 0;
+        $builder->lastPanelHeight = 0;
+
+        // Place the next panels below the ones already in the dashboard
+        foreach ($resource->panels ?? [] as $panel) {
+            if ($panel->gridPos !== null) {
+                $builder->currentY = max($builder->currentY, $panel->gridPos->y + $panel->gridPos->h);
+            }
+        }
+
+        return $builder;
+    }
+
+    /**
+     * @return \Grafana\Foundation\Dashboard\Dashboard
+     */
+    public function build()
+    {
+        return $this->internal;
+    }
+
+    public function title(string $title): static
+    {
+        $this->internal->title = $title;
+    
+        return $this;
+    }
+    /**
+     * @param array<\Grafana\Foundation\Dashboard\Panel|\Grafana\Foundation\Dashboard\RowPanel> $panels
+     */
+    public function panels(array $panels): static
+    {
+        $this->internal->panels = $panels;
+    
+        return $this;
+    }
+
+}
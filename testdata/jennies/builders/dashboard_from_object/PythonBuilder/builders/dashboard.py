@@ -0,0 +1,43 @@
+import typing
+from ..cog import builder as cogbuilder
+from ..models import dashboard
+
+
+class Dashboard(cogbuilder.Builder[dashboard.Dashboard]):    
+    _internal: dashboard.Dashboard
+    __current_y: int = 0
+    __current_x: int = 0
+    __last_panel_height: int = 0
+
+    def __init__(self):
+        self._internal = dashboard.Dashboard()
+
+    @classmethod
+    def from_object(cls, resource: dashboard.Dashboard) -> typing.Self:
+        """
+        Creates a builder from an existing dashboard.Dashboard object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        # Place the next panels below the ones already in the dashboard
+        for panel in resource.panels or []:
+            if panel.grid_pos is not None:
+                builder.__current_y = max(builder.__current_y, panel.grid_pos.y + panel.grid_pos.h)
+
+        return builder
+
+    def build(self) -> dashboard.Dashboard:
+        return self._internal    
+    
+    def title(self, title: str) -> typing.Self:        
+        self._internal.title = title
+    
+        return self
+    
+    def panels(self, panels: list[typing.Union[dashboard.Panel, dashboard.RowPanel]]) -> typing.Self:        
+        self._internal.panels = panels
+    
+        return self
+    
\ No newline at end of file
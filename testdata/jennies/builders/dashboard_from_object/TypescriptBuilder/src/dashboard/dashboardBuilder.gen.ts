@@ -0,0 +1,48 @@
+import * as cog from '../cog';
+import * as dashboard from '../dashboard';
+
+export class DashboardBuilder implements cog.Builder<dashboard.Dashboard> {
+    protected readonly internal: dashboard.Dashboard;
+    private currentY: number = 0;
+    private currentX: number = 0;
+    private lastPanelHeight: number = 0;
+
+    constructor() {
+        this.internal = dashboard.defaultDashboard();
+    }
+
+    // Creates a builder from an existing Dashboard object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: dashboard.Dashboard): DashboardBuilder {
+        const builder: DashboardBuilder = Object.create(DashboardBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            currentY: 0,
+            currentX: 0,
+            lastPanelHeight: 0,
+        });
+
+        // Place the next panels below the ones already in the dashboard
+        for (const panel of resource.panels ?? []) {
+            if (panel.gridPos) {
+                builder.currentY = Math.max(builder.currentY, panel.gridPos.y + panel.gridPos.h);
+            }
+        }
+
+        return builder;
+    }
+
+    build(): dashboard.Dashboard {
+        return this.internal;
+    }
+
+    title(title: string): this {
+        this.internal.title = title;
+        return this;
+    }
+
+    panels(panels: (dashboard.Panel | dashboard.RowPanel)[]): this {
+        this.internal.panels = panels;
+        return this;
+    }
+}
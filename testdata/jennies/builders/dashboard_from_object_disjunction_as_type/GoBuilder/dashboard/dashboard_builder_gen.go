@@ -0,0 +1,84 @@
+package dashboard
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[Dashboard] = (*DashboardBuilder)(nil)
+
+type DashboardBuilder struct {
+    internal *Dashboard
+    errors map[string]cog.BuildErrors
+    currentY uint32
+    currentX uint32
+    lastPanelHeight uint32
+}
+
+func NewDashboardBuilder() *DashboardBuilder {
+	resource := &Dashboard{}
+	builder := &DashboardBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+
+	return builder
+}
+
+// NewDashboardBuilderFrom creates a builder from an existing Dashboard value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewDashboardBuilderFrom(resource Dashboard) *DashboardBuilder {
+	builder := &DashboardBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	// Place the next panels below the ones already in the dashboard
+	for _, panel := range resource.Panels {
+		var gridPos *GridPos
+		if panel.Panel != nil {
+			gridPos = panel.Panel.GridPos
+		}
+		if panel.RowPanel != nil {
+			gridPos = panel.RowPanel.GridPos
+		}
+
+		if gridPos != nil {
+			builder.currentY = max(builder.currentY, gridPos.Y+gridPos.H)
+		}
+	}
+
+	return builder
+}
+
+func (builder *DashboardBuilder) Build() (Dashboard, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("Dashboard", err)...)
+	}
+
+	if len(errs) != 0 {
+		return Dashboard{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+func (builder *DashboardBuilder) Title(title string) *DashboardBuilder {
+    builder.internal.Title = title
+
+    return builder
+}
+
+func (builder *DashboardBuilder) Panels(panels []PanelOrRowPanel) *DashboardBuilder {
+    builder.internal.Panels = panels
+
+    return builder
+}
+
+func (builder *DashboardBuilder) applyDefaults() {
+}
@@ -0,0 +1,24 @@
+package dashboard
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// DashboardToCode accepts a `Dashboard` object and generates the Go code to build this object using builders.
+func DashboardToCode(input Dashboard) string {
+	calls := []string{
+		`dashboard.NewDashboardBuilder()`,
+	}
+
+	if input.Title != "" {
+		titleArg1 := cog.Dump(input.Title)
+		calls = append(calls, `Title(`+titleArg1+`)`)
+	}
+
+	if input.Panels != nil {
+		panelsArg2 := cog.Dump(input.Panels)
+		calls = append(calls, `Panels(`+panelsArg2+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
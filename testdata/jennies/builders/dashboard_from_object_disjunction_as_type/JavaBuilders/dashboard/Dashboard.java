@@ -0,0 +1,76 @@
+package dashboard;
+
+import java.util.List;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import com.fasterxml.jackson.databind.ObjectWriter;
+
+public class Dashboard { 
+    @JsonProperty("title")
+    public String title; 
+    @JsonProperty("panels")
+    public List<PanelOrRowPanel> panels;
+    
+    public String toJSON() throws JsonProcessingException {
+        ObjectWriter ow = new ObjectMapper().writer().withDefaultPrettyPrinter();
+        return ow.writeValueAsString(this);
+    }
+
+    
+    public static class Builder implements cog.Builder<Dashboard> {
+        private final Dashboard internal;
+        private Integer currentY;
+        private Integer currentX;
+        private Integer lastPanelHeight;
+        
+        public Builder() {
+            this.internal = new Dashboard();
+        this.currentY = 0;
+        this.currentX = 0;
+        this.lastPanelHeight = 0;
+        }
+
+        private Builder(Dashboard resource) {
+            this.internal = resource;
+        this.currentY = 0;
+        this.currentX = 0;
+        this.lastPanelHeight = 0;
+
+        // Place the next panels below the ones already in the dashboard
+        if (resource.panels != null) {
+            for (PanelOrRowPanel panel : resource.panels) {
+                GridPos gridPos = null;
+                if (panel.panel != null) {
+                    gridPos = panel.panel.gridPos;
+                }
+                if (panel.rowPanel != null) {
+                    gridPos = panel.rowPanel.gridPos;
+                }
+
+                if (gridPos != null && gridPos.y != null && gridPos.h != null) {
+                    this.currentY = java.lang.Math.max(this.currentY, gridPos.y + gridPos.h);
+                }
+            }
+        }
+        }
+
+        // Creates a builder from an existing Dashboard object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(Dashboard resource) {
+            return new Builder(resource);
+        }
+    public Builder title(String title) {
+    this.internal.title = title;
+        return this;
+    }
+    
+    public Builder panels(List<PanelOrRowPanel> panels) {
+    this.internal.panels = panels;
+        return this;
+    }
+    public Dashboard build() {
+            return this.internal;
+        }
+    }
+}
@@ -0,0 +1,23 @@
+package dashboard;
+
+import com.fasterxml.jackson.annotation.JsonProperty;
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import com.fasterxml.jackson.databind.ObjectWriter;
+
+public class GridPos { 
+    @JsonProperty("h")
+    public Integer h; 
+    @JsonProperty("w")
+    public Integer w; 
+    @JsonProperty("x")
+    public Integer x; 
+    @JsonProperty("y")
+    public Integer y;
+    
+    public String toJSON() throws JsonProcessingException {
+        ObjectWriter ow = new ObjectMapper().writer().withDefaultPrettyPrinter();
+        return ow.writeValueAsString(this);
+    }
+
+}
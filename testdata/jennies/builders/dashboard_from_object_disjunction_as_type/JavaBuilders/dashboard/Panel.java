@@ -0,0 +1,21 @@
+package dashboard;
+
+import com.fasterxml.jackson.annotation.JsonProperty;
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import com.fasterxml.jackson.databind.ObjectWriter;
+
+public class Panel { 
+    @JsonProperty("type")
+    public String type; 
+    @JsonProperty("title")
+    public String title; 
+    @JsonProperty("gridPos")
+    public GridPos gridPos;
+    
+    public String toJSON() throws JsonProcessingException {
+        ObjectWriter ow = new ObjectMapper().writer().withDefaultPrettyPrinter();
+        return ow.writeValueAsString(this);
+    }
+
+}
@@ -0,0 +1,87 @@
+use crate::cog;
+use crate::dashboard;
+
+pub struct DashboardBuilder {
+    internal: dashboard::Dashboard,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+    current_y: u32,
+    current_x: u32,
+    last_panel_height: u32,
+}
+
+impl DashboardBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+            current_y: Default::default(),
+            current_x: Default::default(),
+            last_panel_height: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn title(mut self, title: String) -> Self {
+        self.internal.title = title;
+
+        self
+    }
+
+    pub fn panels(mut self, panels: Vec<dashboard::PanelOrRowPanel>) -> Self {
+        self.internal.panels = Some(panels);
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<dashboard::Dashboard> for DashboardBuilder {
+    fn from(resource: dashboard::Dashboard) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+            current_y: Default::default(),
+            current_x: Default::default(),
+            last_panel_height: Default::default(),
+        };
+
+        // Place the next panels below the ones already in the dashboard
+        for panel in builder.internal.panels.iter().flatten() {
+            let grid_pos = match panel {
+                dashboard::PanelOrRowPanel::Panel(panel) => panel.grid_pos.as_ref(),
+                dashboard::PanelOrRowPanel::RowPanel(row_panel) => row_panel.grid_pos.as_ref(),
+            };
+
+            if let Some(grid_pos) = grid_pos {
+                builder.current_y = builder.current_y.max(grid_pos.y + grid_pos.h);
+            }
+        }
+
+        builder
+    }
+}
+
+impl cog::Builder<dashboard::Dashboard> for DashboardBuilder {
+    fn build(&self) -> Result<dashboard::Dashboard, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("Dashboard"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
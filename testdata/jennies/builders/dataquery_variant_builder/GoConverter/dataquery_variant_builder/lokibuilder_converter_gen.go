@@ -0,0 +1,19 @@
+package dataquery_variant_builder
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// LokiBuilderToCode accepts a `Loki` object and generates the Go code to build this object using builders.
+func LokiBuilderToCode(input Loki) string {
+	calls := []string{
+		`dataquery_variant_builder.NewLokiBuilderBuilder()`,
+	}
+
+	if input.Expr != "" {
+		exprArg1 := cog.Dump(input.Expr)
+		calls = append(calls, `Expr(`+exprArg1+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
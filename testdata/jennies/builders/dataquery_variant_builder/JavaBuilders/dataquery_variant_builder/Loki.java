@@ -21,6 +21,16 @@ public class Loki implements cog.variants.Dataquery {
         public Builder() {
             this.internal = new Loki();
         }
+
+        private Builder(Loki resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing Loki object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(Loki resource) {
+            return new Builder(resource);
+        }
     public Builder expr(String expr) {
     this.internal.expr = expr;
         return this;
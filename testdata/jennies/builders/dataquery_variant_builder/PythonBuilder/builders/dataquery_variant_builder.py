@@ -9,6 +9,17 @@ class LokiBuilder(cogbuilder.Builder[dataquery_variant_builder.Loki]):
     def __init__(self):
         self._internal = dataquery_variant_builder.Loki()
 
+    @classmethod
+    def from_object(cls, resource: dataquery_variant_builder.Loki) -> typing.Self:
+        """
+        Creates a builder from an existing dataquery_variant_builder.Loki object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> dataquery_variant_builder.Loki:
         return self._internal    
     
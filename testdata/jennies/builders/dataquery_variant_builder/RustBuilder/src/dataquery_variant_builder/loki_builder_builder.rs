@@ -0,0 +1,68 @@
+use crate::cog;
+use crate::dataquery_variant_builder;
+
+pub struct LokiBuilderBuilder {
+    internal: dataquery_variant_builder::Loki,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl LokiBuilderBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn expr(mut self, expr: String) -> Self {
+        self.internal.expr = expr;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<dataquery_variant_builder::Loki> for LokiBuilderBuilder {
+    fn from(resource: dataquery_variant_builder::Loki) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<dataquery_variant_builder::Loki> for LokiBuilderBuilder {
+    fn build(&self) -> Result<dataquery_variant_builder::Loki, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("LokiBuilder"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
+
+impl cog::VariantBuilder for LokiBuilderBuilder {
+    fn build_variant(&self) -> Result<serde_json::Value, cog::BuildErrors> {
+        let resource = cog::Builder::build(self)?;
+
+        serde_json::to_value(resource).map_err(|err| cog::BuildErrors::new("LokiBuilder", err.to_string()))
+    }
+}
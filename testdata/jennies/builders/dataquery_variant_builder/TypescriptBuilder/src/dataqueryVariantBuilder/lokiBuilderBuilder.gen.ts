@@ -8,6 +8,17 @@ export class LokiBuilderBuilder implements cog.Builder<cog.Dataquery> {
         this.internal = dataqueryVariantBuilder.defaultLoki();
     }
 
+    // Creates a builder from an existing Loki object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: dataqueryVariantBuilder.Loki): LokiBuilderBuilder {
+        const builder: LokiBuilderBuilder = Object.create(LokiBuilderBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): dataqueryVariantBuilder.Loki {
         return this.internal;
     }
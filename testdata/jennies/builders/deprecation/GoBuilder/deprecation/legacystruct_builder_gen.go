@@ -0,0 +1,61 @@
+package deprecation
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[LegacyStruct] = (*LegacyStructBuilder)(nil)
+
+// LegacyStruct is kept around for compatibility.
+type LegacyStructBuilder struct {
+    internal *LegacyStruct
+    errors map[string]cog.BuildErrors
+}
+
+func NewLegacyStructBuilder() *LegacyStructBuilder {
+	resource := &LegacyStruct{}
+	builder := &LegacyStructBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+
+	return builder
+}
+
+// NewLegacyStructBuilderFrom creates a builder from an existing LegacyStruct value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewLegacyStructBuilderFrom(resource LegacyStruct) *LegacyStructBuilder {
+	builder := &LegacyStructBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
+func (builder *LegacyStructBuilder) Build() (LegacyStruct, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("LegacyStruct", err)...)
+	}
+
+	if len(errs) != 0 {
+		return LegacyStruct{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+func (builder *LegacyStructBuilder) Foo(foo string) *LegacyStructBuilder {
+    builder.internal.Foo = foo
+
+    return builder
+}
+
+func (builder *LegacyStructBuilder) applyDefaults() {
+}
@@ -0,0 +1,84 @@
+package deprecation
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[SomeStruct] = (*SomeStructBuilder)(nil)
+
+// SomeStruct, to hold data.
+type SomeStructBuilder struct {
+    internal *SomeStruct
+    errors map[string]cog.BuildErrors
+}
+
+func NewSomeStructBuilder() *SomeStructBuilder {
+	resource := &SomeStruct{}
+	builder := &SomeStructBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+
+	return builder
+}
+
+// NewSomeStructBuilderFrom creates a builder from an existing SomeStruct value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewSomeStructBuilderFrom(resource SomeStruct) *SomeStructBuilder {
+	builder := &SomeStructBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
+func (builder *SomeStructBuilder) Build() (SomeStruct, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("SomeStruct", err)...)
+	}
+
+	if len(errs) != 0 {
+		return SomeStruct{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+// id identifies something.
+func (builder *SomeStructBuilder) Id(id int64) *SomeStructBuilder {
+    builder.internal.Id = id
+
+    return builder
+}
+
+// Title of the thing.
+//
+// Deprecated: use label instead
+func (builder *SomeStructBuilder) Title(title string) *SomeStructBuilder {
+    builder.internal.Title = title
+
+    return builder
+}
+
+func (builder *SomeStructBuilder) Label(label string) *SomeStructBuilder {
+    builder.internal.Label = label
+
+    return builder
+}
+
+// Deprecated: this element is deprecated and might be removed in a future version.
+func (builder *SomeStructBuilder) Legacy(legacy bool) *SomeStructBuilder {
+    builder.internal.Legacy = legacy
+
+    return builder
+}
+
+func (builder *SomeStructBuilder) applyDefaults() {
+}
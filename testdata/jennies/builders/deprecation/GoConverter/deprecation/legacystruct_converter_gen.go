@@ -0,0 +1,19 @@
+package deprecation
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// LegacyStructToCode accepts a `LegacyStruct` object and generates the Go code to build this object using builders.
+func LegacyStructToCode(input LegacyStruct) string {
+	calls := []string{
+		`deprecation.NewLegacyStructBuilder()`,
+	}
+
+	if input.Foo != "" {
+		fooArg1 := cog.Dump(input.Foo)
+		calls = append(calls, `Foo(`+fooArg1+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
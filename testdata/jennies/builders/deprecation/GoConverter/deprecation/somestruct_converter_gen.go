@@ -0,0 +1,34 @@
+package deprecation
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructToCode(input SomeStruct) string {
+	calls := []string{
+		`deprecation.NewSomeStructBuilder()`,
+	}
+
+	if input.Id != 0 {
+		idArg1 := cog.Dump(input.Id)
+		calls = append(calls, `Id(`+idArg1+`)`)
+	}
+
+	if input.Title != "" {
+		titleArg2 := cog.Dump(input.Title)
+		calls = append(calls, `Title(`+titleArg2+`)`)
+	}
+
+	if input.Label != "" {
+		labelArg3 := cog.Dump(input.Label)
+		calls = append(calls, `Label(`+labelArg3+`)`)
+	}
+
+	if input.Legacy != false {
+		legacyArg4 := cog.Dump(input.Legacy)
+		calls = append(calls, `Legacy(`+legacyArg4+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -0,0 +1,45 @@
+package deprecation;
+
+import com.fasterxml.jackson.annotation.JsonProperty;
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import com.fasterxml.jackson.databind.ObjectWriter;
+
+// LegacyStruct is kept around for compatibility.
+/** @deprecated use SomeStruct instead */
+@Deprecated
+public class LegacyStruct { 
+    @JsonProperty("foo")
+    public String foo;
+    
+    public String toJSON() throws JsonProcessingException {
+        ObjectWriter ow = new ObjectMapper().writer().withDefaultPrettyPrinter();
+        return ow.writeValueAsString(this);
+    }
+
+    
+    public static class Builder implements cog.Builder<LegacyStruct> {
+        private final LegacyStruct internal;
+        
+        public Builder() {
+            this.internal = new LegacyStruct();
+        }
+
+        private Builder(LegacyStruct resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing LegacyStruct object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(LegacyStruct resource) {
+            return new Builder(resource);
+        }
+    public Builder foo(String foo) {
+    this.internal.foo = foo;
+        return this;
+    }
+    public LegacyStruct build() {
+            return this.internal;
+        }
+    }
+}
@@ -0,0 +1,74 @@
+package deprecation;
+
+import com.fasterxml.jackson.annotation.JsonProperty;
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import com.fasterxml.jackson.databind.ObjectWriter;
+
+// SomeStruct, to hold data.
+public class SomeStruct {
+    // id identifies something. 
+    @JsonProperty("id")
+    public Long id;
+    // Title of the thing.
+    /** @deprecated use label instead */
+    @Deprecated 
+    @JsonProperty("title")
+    public String title; 
+    @JsonProperty("label")
+    public String label;
+    /** @deprecated this element is deprecated and might be removed in a future version. */
+    @Deprecated 
+    @JsonProperty("legacy")
+    public Boolean legacy;
+    
+    public String toJSON() throws JsonProcessingException {
+        ObjectWriter ow = new ObjectMapper().writer().withDefaultPrettyPrinter();
+        return ow.writeValueAsString(this);
+    }
+
+    
+    public static class Builder implements cog.Builder<SomeStruct> {
+        private final SomeStruct internal;
+        
+        public Builder() {
+            this.internal = new SomeStruct();
+        }
+
+        private Builder(SomeStruct resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(SomeStruct resource) {
+            return new Builder(resource);
+        }
+    public Builder id(Long id) {
+    this.internal.id = id;
+        return this;
+    }
+    
+    /** @deprecated use label instead */
+    @Deprecated
+    public Builder title(String title) {
+    this.internal.title = title;
+        return this;
+    }
+    
+    public Builder label(String label) {
+    this.internal.label = label;
+        return this;
+    }
+    
+    /** @deprecated this element is deprecated and might be removed in a future version. */
+    @Deprecated
+    public Builder legacy(Boolean legacy) {
+    this.internal.legacy = legacy;
+        return this;
+    }
+    public SomeStruct build() {
+            return this.internal;
+        }
+    }
+}
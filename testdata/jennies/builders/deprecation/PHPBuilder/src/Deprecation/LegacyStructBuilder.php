@@ -0,0 +1,45 @@
+<?php
+
+namespace Grafana\Foundation\Deprecation;
+
+/**
+ * LegacyStruct is kept around for compatibility.
+ * @implements \Grafana\Foundation\Cog\Builder<\Grafana\Foundation\Deprecation\LegacyStruct>
+ */
+class LegacyStructBuilder implements \Grafana\Foundation\Cog\Builder
+{
+    protected \Grafana\Foundation\Deprecation\LegacyStruct $internal;
+
+    public function __construct()
+    {
+    	$this->internal = new \Grafana\Foundation\Deprecation\LegacyStruct();
+    }
+
+    /**
+     * Creates a builder from an existing object, allowing it to be modified with the builder's options.
+     * The object isn't copied: the builder's options modify it in place.
+     */
+    public static function fromObject(\Grafana\Foundation\Deprecation\LegacyStruct $resource): self
+    {
+        $builder = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
+        $builder->internal = $resource;
+
+        return $builder;
+    }
+
+    /**
+     * @return \Grafana\Foundation\Deprecation\LegacyStruct
+     */
+    public function build()
+    {
+        return $this->internal;
+    }
+
+    public function foo(string $foo): static
+    {
+        $this->internal->foo = $foo;
+    
+        return $this;
+    }
+
+}
@@ -0,0 +1,73 @@
+<?php
+
+namespace Grafana\Foundation\Deprecation;
+
+/**
+ * SomeStruct, to hold data.
+ * @implements \Grafana\Foundation\Cog\Builder<\Grafana\Foundation\Deprecation\SomeStruct>
+ */
+class SomeStructBuilder implements \Grafana\Foundation\Cog\Builder
+{
+    protected \Grafana\Foundation\Deprecation\SomeStruct $internal;
+
+    public function __construct()
+    {
+    	$this->internal = new \Grafana\Foundation\Deprecation\SomeStruct();
+    }
+
+    /**
+     * Creates a builder from an existing object, allowing it to be modified with the builder's options.
+     * The object isn't copied: the builder's options modify it in place.
+     */
+    public static function fromObject(\Grafana\Foundation\Deprecation\SomeStruct $resource): self
+    {
+        $builder = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
+        $builder->internal = $resource;
+
+        return $builder;
+    }
+
+    /**
+     * @return \Grafana\Foundation\Deprecation\SomeStruct
+     */
+    public function build()
+    {
+        return $this->internal;
+    }
+
+    /**
+     * id identifies something.
+     */
+    public function id(int $id): static
+    {
+        $this->internal->id = $id;
+    
+        return $this;
+    }
+    /**
+     * Title of the thing.
+     * @deprecated use label instead
+     */
+    public function title(string $title): static
+    {
+        $this->internal->title = $title;
+    
+        return $this;
+    }
+    public function label(string $label): static
+    {
+        $this->internal->label = $label;
+    
+        return $this;
+    }
+    /**
+     * @deprecated this element is deprecated and might be removed in a future version.
+     */
+    public function legacy(bool $legacy): static
+    {
+        $this->internal->legacy = $legacy;
+    
+        return $this;
+    }
+
+}
@@ -0,0 +1,90 @@
+import typing
+from ..cog import builder as cogbuilder
+from ..models import deprecation
+import typing_extensions
+
+
+class SomeStruct(cogbuilder.Builder[deprecation.SomeStruct]):    
+    """
+    SomeStruct, to hold data.
+    """
+    
+    _internal: deprecation.SomeStruct
+
+    def __init__(self):
+        self._internal = deprecation.SomeStruct()
+
+    @classmethod
+    def from_object(cls, resource: deprecation.SomeStruct) -> typing.Self:
+        """
+        Creates a builder from an existing deprecation.SomeStruct object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> deprecation.SomeStruct:
+        return self._internal    
+    
+    def id_val(self, id_val: int) -> typing.Self:    
+        """
+        id identifies something.
+        """
+            
+        self._internal.id_val = id_val
+    
+        return self
+    
+    @typing_extensions.deprecated("use label instead")
+    def title(self, title: str) -> typing.Self:    
+        """
+        Title of the thing.
+        """
+            
+        self._internal.title = title
+    
+        return self
+    
+    def label(self, label: str) -> typing.Self:        
+        self._internal.label = label
+    
+        return self
+    
+    @typing_extensions.deprecated("this element is deprecated and might be removed in a future version.")
+    def legacy(self, legacy: bool) -> typing.Self:        
+        self._internal.legacy = legacy
+    
+        return self
+    
+
+class LegacyStruct(cogbuilder.Builder[deprecation.LegacyStruct]):    
+    """
+    LegacyStruct is kept around for compatibility.
+    """
+    
+    _internal: deprecation.LegacyStruct
+
+    def __init__(self):
+        self._internal = deprecation.LegacyStruct()
+
+    @classmethod
+    def from_object(cls, resource: deprecation.LegacyStruct) -> typing.Self:
+        """
+        Creates a builder from an existing deprecation.LegacyStruct object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> deprecation.LegacyStruct:
+        return self._internal    
+    
+    def foo(self, foo: str) -> typing.Self:        
+        self._internal.foo = foo
+    
+        return self
+    
\ No newline at end of file
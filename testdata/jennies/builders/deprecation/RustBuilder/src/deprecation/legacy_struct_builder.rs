@@ -0,0 +1,61 @@
+use crate::cog;
+use crate::deprecation;
+
+/// LegacyStruct is kept around for compatibility.
+pub struct LegacyStructBuilder {
+    internal: deprecation::LegacyStruct,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl LegacyStructBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn foo(mut self, foo: String) -> Self {
+        self.internal.foo = foo;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<deprecation::LegacyStruct> for LegacyStructBuilder {
+    fn from(resource: deprecation::LegacyStruct) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<deprecation::LegacyStruct> for LegacyStructBuilder {
+    fn build(&self) -> Result<deprecation::LegacyStruct, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("LegacyStruct"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
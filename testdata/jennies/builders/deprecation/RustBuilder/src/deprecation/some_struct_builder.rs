@@ -0,0 +1,81 @@
+use crate::cog;
+use crate::deprecation;
+
+/// SomeStruct, to hold data.
+pub struct SomeStructBuilder {
+    internal: deprecation::SomeStruct,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl SomeStructBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    /// id identifies something.
+    pub fn id(mut self, id: i64) -> Self {
+        self.internal.id = id;
+
+        self
+    }
+
+    /// Title of the thing.
+    pub fn title(mut self, title: String) -> Self {
+        self.internal.title = title;
+
+        self
+    }
+
+    pub fn label(mut self, label: String) -> Self {
+        self.internal.label = label;
+
+        self
+    }
+
+    pub fn legacy(mut self, legacy: bool) -> Self {
+        self.internal.legacy = legacy;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<deprecation::SomeStruct> for SomeStructBuilder {
+    fn from(resource: deprecation::SomeStruct) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<deprecation::SomeStruct> for SomeStructBuilder {
+    fn build(&self) -> Result<deprecation::SomeStruct, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("SomeStruct"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
@@ -0,0 +1,31 @@
+import * as cog from '../cog';
+import * as deprecation from '../deprecation';
+
+// LegacyStruct is kept around for compatibility.
+export class LegacyStructBuilder implements cog.Builder<deprecation.LegacyStruct> {
+    protected readonly internal: deprecation.LegacyStruct;
+
+    constructor() {
+        this.internal = deprecation.defaultLegacyStruct();
+    }
+
+    // Creates a builder from an existing LegacyStruct object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: deprecation.LegacyStruct): LegacyStructBuilder {
+        const builder: LegacyStructBuilder = Object.create(LegacyStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
+    build(): deprecation.LegacyStruct {
+        return this.internal;
+    }
+
+    foo(foo: string): this {
+        this.internal.foo = foo;
+        return this;
+    }
+}
@@ -0,0 +1,50 @@
+import * as cog from '../cog';
+import * as deprecation from '../deprecation';
+
+// SomeStruct, to hold data.
+export class SomeStructBuilder implements cog.Builder<deprecation.SomeStruct> {
+    protected readonly internal: deprecation.SomeStruct;
+
+    constructor() {
+        this.internal = deprecation.defaultSomeStruct();
+    }
+
+    // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: deprecation.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
+    build(): deprecation.SomeStruct {
+        return this.internal;
+    }
+
+    // id identifies something.
+    id(id: number): this {
+        this.internal.id = id;
+        return this;
+    }
+
+    // Title of the thing.
+    /** @deprecated use label instead */
+    title(title: string): this {
+        this.internal.title = title;
+        return this;
+    }
+
+    label(label: string): this {
+        this.internal.label = label;
+        return this;
+    }
+
+    /** @deprecated this element is deprecated and might be removed in a future version. */
+    legacy(legacy: boolean): this {
+        this.internal.legacy = legacy;
+        return this;
+    }
+}
@@ -0,0 +1,16 @@
+package deprecation
+
+// SomeStruct, to hold data.
+SomeStruct: {
+	// id identifies something.
+	id: int64
+	// Title of the thing.
+	title: string @deprecated("use label instead")
+	label: string
+	legacy?: bool @deprecated()
+}
+
+// LegacyStruct is kept around for compatibility.
+LegacyStruct: {
+	foo: string
+} @deprecated("use SomeStruct instead")
@@ -0,0 +1,71 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[Dashboard] = (*DashboardBuilder)(nil)
+
+type DashboardBuilder struct {
+    internal *Dashboard
+    errors map[string]cog.BuildErrors
+}
+
+func NewDashboardBuilder() *DashboardBuilder {
+	resource := &Dashboard{}
+	builder := &DashboardBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+
+	return builder
+}
+
+// NewDashboardBuilderFrom creates a builder from an existing Dashboard value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewDashboardBuilderFrom(resource Dashboard) *DashboardBuilder {
+	builder := &DashboardBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
+func (builder *DashboardBuilder) Build() (Dashboard, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("Dashboard", err)...)
+	}
+
+	if len(errs) != 0 {
+		return Dashboard{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+func (builder *DashboardBuilder) Title(title string) *DashboardBuilder {
+    builder.internal.Title = title
+
+    return builder
+}
+
+func (builder *DashboardBuilder) Refresh(refresh cog.Builder[StringOrBool]) *DashboardBuilder {
+    refreshResource, err := refresh.Build()
+    if err != nil {
+        builder.errors["refresh"] = err.(cog.BuildErrors)
+        return builder
+    }
+    builder.internal.Refresh = &refreshResource
+
+    return builder
+}
+
+func (builder *DashboardBuilder) applyDefaults() {
+}
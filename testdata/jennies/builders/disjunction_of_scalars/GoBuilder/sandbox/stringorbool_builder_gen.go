@@ -0,0 +1,66 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[StringOrBool] = (*StringOrBoolBuilder)(nil)
+
+type StringOrBoolBuilder struct {
+    internal *StringOrBool
+    errors map[string]cog.BuildErrors
+}
+
+func NewStringOrBoolBuilder() *StringOrBoolBuilder {
+	resource := &StringOrBool{}
+	builder := &StringOrBoolBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+
+	return builder
+}
+
+// NewStringOrBoolBuilderFrom creates a builder from an existing StringOrBool value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewStringOrBoolBuilderFrom(resource StringOrBool) *StringOrBoolBuilder {
+	builder := &StringOrBoolBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
+func (builder *StringOrBoolBuilder) Build() (StringOrBool, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("StringOrBool", err)...)
+	}
+
+	if len(errs) != 0 {
+		return StringOrBool{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+func (builder *StringOrBoolBuilder) String(stringArg string) *StringOrBoolBuilder {
+    builder.internal.String = &stringArg
+
+    return builder
+}
+
+func (builder *StringOrBoolBuilder) Bool(boolArg bool) *StringOrBoolBuilder {
+    builder.internal.Bool = &boolArg
+
+    return builder
+}
+
+func (builder *StringOrBoolBuilder) applyDefaults() {
+}
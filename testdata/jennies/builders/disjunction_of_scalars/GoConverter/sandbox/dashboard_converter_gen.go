@@ -0,0 +1,24 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// DashboardToCode accepts a `Dashboard` object and generates the Go code to build this object using builders.
+func DashboardToCode(input Dashboard) string {
+	calls := []string{
+		`sandbox.NewDashboardBuilder()`,
+	}
+
+	if input.Title != "" {
+		titleArg1 := cog.Dump(input.Title)
+		calls = append(calls, `Title(`+titleArg1+`)`)
+	}
+
+	if input.Refresh != nil {
+		refreshArg2 := StringOrBoolToCode(*input.Refresh)
+		calls = append(calls, `Refresh(`+refreshArg2+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
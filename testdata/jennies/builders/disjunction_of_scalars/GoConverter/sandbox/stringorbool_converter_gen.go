@@ -0,0 +1,24 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// StringOrBoolToCode accepts a `StringOrBool` object and generates the Go code to build this object using builders.
+func StringOrBoolToCode(input StringOrBool) string {
+	calls := []string{
+		`sandbox.NewStringOrBoolBuilder()`,
+	}
+
+	if input.String != nil {
+		stringArgArg1 := cog.Dump(*input.String)
+		calls = append(calls, `String(`+stringArgArg1+`)`)
+	}
+
+	if input.Bool != nil {
+		boolArgArg2 := cog.Dump(*input.Bool)
+		calls = append(calls, `Bool(`+boolArgArg2+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
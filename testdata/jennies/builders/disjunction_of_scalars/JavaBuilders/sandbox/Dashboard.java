@@ -0,0 +1,49 @@
+package sandbox;
+
+import com.fasterxml.jackson.annotation.JsonProperty;
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import com.fasterxml.jackson.databind.ObjectWriter;
+
+public class Dashboard { 
+    @JsonProperty("title")
+    public String title; 
+    @JsonProperty("refresh")
+    public StringOrBool refresh;
+    
+    public String toJSON() throws JsonProcessingException {
+        ObjectWriter ow = new ObjectMapper().writer().withDefaultPrettyPrinter();
+        return ow.writeValueAsString(this);
+    }
+
+    
+    public static class Builder implements cog.Builder<Dashboard> {
+        private final Dashboard internal;
+        
+        public Builder() {
+            this.internal = new Dashboard();
+        }
+
+        private Builder(Dashboard resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing Dashboard object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(Dashboard resource) {
+            return new Builder(resource);
+        }
+    public Builder title(String title) {
+    this.internal.title = title;
+        return this;
+    }
+    
+    public Builder refresh(cog.Builder<StringOrBool> refresh) {
+    this.internal.refresh = refresh.build();
+        return this;
+    }
+    public Dashboard build() {
+            return this.internal;
+        }
+    }
+}
@@ -0,0 +1,61 @@
+package sandbox;
+
+import com.fasterxml.jackson.annotation.JsonUnwrapped;
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import com.fasterxml.jackson.databind.ObjectWriter;
+import com.fasterxml.jackson.databind.annotation.JsonSerialize;
+import com.fasterxml.jackson.databind.annotation.JsonDeserialize;
+
+@JsonDeserialize(using = StringOrBoolDeserializer.class)
+@JsonSerialize(using = StringOrBoolSerializer.class)
+public class StringOrBool { 
+    @JsonUnwrapped
+    public String string; 
+    @JsonUnwrapped
+    public Boolean bool;
+    
+    public String toJSON() throws JsonProcessingException {
+        if (string != null) {
+            ObjectWriter ow = new ObjectMapper().writer().withDefaultPrettyPrinter();
+            return ow.writeValueAsString(string);
+        }
+        if (bool != null) {
+            ObjectWriter ow = new ObjectMapper().writer().withDefaultPrettyPrinter();
+            return ow.writeValueAsString(bool);
+        }
+        
+        return null;
+    }
+
+    
+    public static class Builder implements cog.Builder<StringOrBool> {
+        private final StringOrBool internal;
+        
+        public Builder() {
+            this.internal = new StringOrBool();
+        }
+
+        private Builder(StringOrBool resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing StringOrBool object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(StringOrBool resource) {
+            return new Builder(resource);
+        }
+    public Builder string(String string) {
+    this.internal.string = string;
+        return this;
+    }
+    
+    public Builder bool(Boolean bool) {
+    this.internal.bool = bool;
+        return this;
+    }
+    public StringOrBool build() {
+            return this.internal;
+        }
+    }
+}
@@ -0,0 +1,54 @@
+<?php
+
+namespace Grafana\Foundation\Sandbox;
+
+/**
+ * @implements \Grafana\Foundation\Cog\Builder<\Grafana\Foundation\Sandbox\Dashboard>
+ */
+class DashboardBuilder implements \Grafana\Foundation\Cog\Builder
+{
+    protected \Grafana\Foundation\Sandbox\Dashboard $internal;
+
+    public function __construct()
+    {
+    	$this->internal = new \Grafana\Foundation\Sandbox\Dashboard();
+    }
+
+    /**
+     * Creates a builder from an existing object, allowing it to be modified with the builder's options.
+     * The object isn't copied: the builder's options modify it in place.
+     */
+    public static function fromObject(\Grafana\Foundation\Sandbox\Dashboard $resource): self
+    {
+        $builder = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
+        $builder->internal = $resource;
+
+        return $builder;
+    }
+
+    /**
+     * @return \Grafana\Foundation\Sandbox\Dashboard
+     */
+    public function build()
+    {
+        return $this->internal;
+    }
+
+    public function title(string $title): static
+    {
+        $this->internal->title = $title;
+    
+        return $this;
+    }
+    /**
+     * @param \Grafana\Foundation\Cog\Builder<\Grafana\Foundation\Sandbox\StringOrBool> $refresh
+     */
+    public function refresh(\Grafana\Foundation\Cog\Builder $refresh): static
+    {
+        $refreshResource = $refresh->build();
+        $this->internal->refresh = $refreshResource;
+    
+        return $this;
+    }
+
+}
@@ -0,0 +1,50 @@
+<?php
+
+namespace Grafana\Foundation\Sandbox;
+
+/**
+ * @implements \Grafana\Foundation\Cog\Builder<\Grafana\Foundation\Sandbox\StringOrBool>
+ */
+class StringOrBoolBuilder implements \Grafana\Foundation\Cog\Builder
+{
+    protected \Grafana\Foundation\Sandbox\StringOrBool $internal;
+
+    public function __construct()
+    {
+    	$this->internal = new \Grafana\Foundation\Sandbox\StringOrBool();
+    }
+
+    /**
+     * Creates a builder from an existing object, allowing it to be modified with the builder's options.
+     * The object isn't copied: the builder's options modify it in place.
+     */
+    public static function fromObject(\Grafana\Foundation\Sandbox\StringOrBool $resource): self
+    {
+        $builder = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
+        $builder->internal = $resource;
+
+        return $builder;
+    }
+
+    /**
+     * @return \Grafana\Foundation\Sandbox\StringOrBool
+     */
+    public function build()
+    {
+        return $this->internal;
+    }
+
+    public function string(string $string): static
+    {
+        $this->internal->string = $string;
+    
+        return $this;
+    }
+    public function bool(bool $bool): static
+    {
+        $this->internal->bool = $bool;
+    
+        return $this;
+    }
+
+}
@@ -0,0 +1,67 @@
+import typing
+from ..cog import builder as cogbuilder
+from ..models import sandbox
+
+
+class Dashboard(cogbuilder.Builder[sandbox.Dashboard]):    
+    _internal: sandbox.Dashboard
+
+    def __init__(self):
+        self._internal = sandbox.Dashboard()
+
+    @classmethod
+    def from_object(cls, resource: sandbox.Dashboard) -> typing.Self:
+        """
+        Creates a builder from an existing sandbox.Dashboard object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> sandbox.Dashboard:
+        return self._internal    
+    
+    def title(self, title: str) -> typing.Self:        
+        self._internal.title = title
+    
+        return self
+    
+    def refresh(self, refresh: cogbuilder.Builder[sandbox.StringOrBool]) -> typing.Self:        
+        refresh_resource = refresh.build()
+        self._internal.refresh = refresh_resource
+    
+        return self
+    
+
+class StringOrBool(cogbuilder.Builder[sandbox.StringOrBool]):    
+    _internal: sandbox.StringOrBool
+
+    def __init__(self):
+        self._internal = sandbox.StringOrBool()
+
+    @classmethod
+    def from_object(cls, resource: sandbox.StringOrBool) -> typing.Self:
+        """
+        Creates a builder from an existing sandbox.StringOrBool object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> sandbox.StringOrBool:
+        return self._internal    
+    
+    def string(self, string: str) -> typing.Self:        
+        self._internal.string = string
+    
+        return self
+    
+    def bool(self, bool: bool) -> typing.Self:        
+        self._internal.bool = bool
+    
+        return self
+    
\ No newline at end of file
@@ -0,0 +1,73 @@
+use crate::cog;
+use crate::sandbox;
+
+pub struct DashboardBuilder {
+    internal: sandbox::Dashboard,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl DashboardBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn title(mut self, title: String) -> Self {
+        self.internal.title = title;
+
+        self
+    }
+
+    pub fn refresh(mut self, refresh: impl cog::Builder<sandbox::StringOrBool>) -> Self {
+        let refresh_resource = match cog::Builder::build(&refresh) {
+            Ok(resource) => resource,
+            Err(err) => {
+                self.errors.insert("refresh".to_string(), err);
+                return self;
+            }
+        };
+        self.internal.refresh = Some(refresh_resource);
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<sandbox::Dashboard> for DashboardBuilder {
+    fn from(resource: sandbox::Dashboard) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<sandbox::Dashboard> for DashboardBuilder {
+    fn build(&self) -> Result<sandbox::Dashboard, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("Dashboard"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
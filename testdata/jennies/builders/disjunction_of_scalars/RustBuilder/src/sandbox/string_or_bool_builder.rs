@@ -0,0 +1,66 @@
+use crate::cog;
+use crate::sandbox;
+
+pub struct StringOrBoolBuilder {
+    internal: sandbox::StringOrBool,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl StringOrBoolBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn string(mut self, string: String) -> Self {
+        self.internal = sandbox::StringOrBool::String(string);
+
+        self
+    }
+
+    pub fn bool(mut self, bool: bool) -> Self {
+        self.internal = sandbox::StringOrBool::Bool(bool);
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<sandbox::StringOrBool> for StringOrBoolBuilder {
+    fn from(resource: sandbox::StringOrBool) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<sandbox::StringOrBool> for StringOrBoolBuilder {
+    fn build(&self) -> Result<sandbox::StringOrBool, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("StringOrBool"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
@@ -0,0 +1,36 @@
+import * as cog from '../cog';
+import * as sandbox from '../sandbox';
+
+export class DashboardBuilder implements cog.Builder<sandbox.Dashboard> {
+    protected readonly internal: sandbox.Dashboard;
+
+    constructor() {
+        this.internal = sandbox.defaultDashboard();
+    }
+
+    // Creates a builder from an existing Dashboard object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: sandbox.Dashboard): DashboardBuilder {
+        const builder: DashboardBuilder = Object.create(DashboardBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
+    build(): sandbox.Dashboard {
+        return this.internal;
+    }
+
+    title(title: string): this {
+        this.internal.title = title;
+        return this;
+    }
+
+    refresh(refresh: cog.Builder<sandbox.StringOrBool>): this {
+        const refreshResource = refresh.build();
+        this.internal.refresh = refreshResource;
+        return this;
+    }
+}
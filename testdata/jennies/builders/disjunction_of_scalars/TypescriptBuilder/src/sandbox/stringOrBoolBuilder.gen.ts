@@ -0,0 +1,35 @@
+import * as cog from '../cog';
+import * as sandbox from '../sandbox';
+
+export class StringOrBoolBuilder implements cog.Builder<sandbox.StringOrBool> {
+    protected readonly internal: sandbox.StringOrBool;
+
+    constructor() {
+        this.internal = sandbox.defaultStringOrBool();
+    }
+
+    // Creates a builder from an existing StringOrBool object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: sandbox.StringOrBool): StringOrBoolBuilder {
+        const builder: StringOrBoolBuilder = Object.create(StringOrBoolBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
+    build(): sandbox.StringOrBool {
+        return this.internal;
+    }
+
+    string(string: string): this {
+        this.internal.String = string;
+        return this;
+    }
+
+    bool(bool: boolean): this {
+        this.internal.Bool = bool;
+        return this;
+    }
+}
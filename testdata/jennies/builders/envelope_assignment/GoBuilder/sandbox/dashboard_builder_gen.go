@@ -23,6 +23,19 @@ func NewDashboardBuilder() *DashboardBuilder {
 	return builder
 }
 
+// NewDashboardBuilderFrom creates a builder from an existing Dashboard value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewDashboardBuilderFrom(resource Dashboard) *DashboardBuilder {
+	builder := &DashboardBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *DashboardBuilder) Build() (Dashboard, error) {
 	var errs cog.BuildErrors
 
@@ -0,0 +1,20 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// DashboardToCode accepts a `Dashboard` object and generates the Go code to build this object using builders.
+func DashboardToCode(input Dashboard) string {
+	calls := []string{
+		`sandbox.NewDashboardBuilder()`,
+	}
+
+	for _, item1 := range input.Variables {
+		nameArg2 := cog.Dump(item1.Name)
+		valueArg3 := cog.Dump(item1.Value)
+		calls = append(calls, `WithVariable(`+nameArg2+", "+valueArg3+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
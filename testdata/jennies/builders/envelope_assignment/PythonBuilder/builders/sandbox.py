@@ -9,6 +9,17 @@ class Dashboard(cogbuilder.Builder[sandbox.Dashboard]):
     def __init__(self):
         self._internal = sandbox.Dashboard()
 
+    @classmethod
+    def from_object(cls, resource: sandbox.Dashboard) -> typing.Self:
+        """
+        Creates a builder from an existing sandbox.Dashboard object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> sandbox.Dashboard:
         return self._internal    
     
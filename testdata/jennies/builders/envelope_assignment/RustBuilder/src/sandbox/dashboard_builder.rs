@@ -0,0 +1,64 @@
+use crate::cog;
+use crate::sandbox;
+
+pub struct DashboardBuilder {
+    internal: sandbox::Dashboard,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl DashboardBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn with_variable(mut self, name: String, value: String) -> Self {
+        self.internal.variables.push(sandbox::Variable {
+            name: name,
+            value: value,
+            ..Default::default()
+        });
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<sandbox::Dashboard> for DashboardBuilder {
+    fn from(resource: sandbox::Dashboard) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<sandbox::Dashboard> for DashboardBuilder {
+    fn build(&self) -> Result<sandbox::Dashboard, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("Dashboard"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
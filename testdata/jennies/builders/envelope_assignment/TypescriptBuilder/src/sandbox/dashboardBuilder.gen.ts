@@ -8,6 +8,17 @@ export class DashboardBuilder implements cog.Builder<sandbox.Dashboard> {
         this.internal = sandbox.defaultDashboard();
     }
 
+    // Creates a builder from an existing Dashboard object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: sandbox.Dashboard): DashboardBuilder {
+        const builder: DashboardBuilder = Object.create(DashboardBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): sandbox.Dashboard {
         return this.internal;
     }
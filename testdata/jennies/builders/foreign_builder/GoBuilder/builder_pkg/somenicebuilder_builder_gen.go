@@ -24,6 +24,19 @@ func NewSomeNiceBuilderBuilder() *SomeNiceBuilderBuilder {
 	return builder
 }
 
+// NewSomeNiceBuilderBuilderFrom creates a builder from an existing some_pkg.SomeStruct value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewSomeNiceBuilderBuilderFrom(resource some_pkg.SomeStruct) *SomeNiceBuilderBuilder {
+	builder := &SomeNiceBuilderBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *SomeNiceBuilderBuilder) Build() (some_pkg.SomeStruct, error) {
 	var errs cog.BuildErrors
 
@@ -0,0 +1,20 @@
+package builder_pkg
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+	some_pkg "github.com/grafana/cog/generated/some_pkg"
+)
+
+// SomeNiceBuilderToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeNiceBuilderToCode(input some_pkg.SomeStruct) string {
+	calls := []string{
+		`builder_pkg.NewSomeNiceBuilderBuilder()`,
+	}
+
+	if input.Title != "" {
+		titleArg1 := cog.Dump(input.Title)
+		calls = append(calls, `Title(`+titleArg1+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -9,6 +9,17 @@ class SomeNiceBuilder(cogbuilder.Builder[some_pkg.SomeStruct]):
     def __init__(self):
         self._internal = some_pkg.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: some_pkg.SomeStruct) -> typing.Self:
+        """
+        Creates a builder from an existing some_pkg.SomeStruct object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> some_pkg.SomeStruct:
         return self._internal    
     
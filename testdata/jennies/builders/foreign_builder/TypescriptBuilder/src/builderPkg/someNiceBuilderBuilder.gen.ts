@@ -8,6 +8,17 @@ export class SomeNiceBuilderBuilder implements cog.Builder<somePkg.SomeStruct> {
         this.internal = somePkg.defaultSomeStruct();
     }
 
+    // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: somePkg.SomeStruct): SomeNiceBuilderBuilder {
+        const builder: SomeNiceBuilderBuilder = Object.create(SomeNiceBuilderBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): somePkg.SomeStruct {
         return this.internal;
     }
@@ -0,0 +1,26 @@
+package initialization_safeguards
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomePanelToCode accepts a `SomePanel` object and generates the Go code to build this object using builders.
+func SomePanelToCode(input SomePanel) string {
+	calls := []string{
+		`initialization_safeguards.NewSomePanelBuilder()`,
+	}
+
+	if input.Title != "" {
+		titleArg1 := cog.Dump(input.Title)
+		calls = append(calls, `Title(`+titleArg1+`)`)
+	}
+
+	if input.Options != nil {
+		if input.Options.Legend.Show != false {
+			showArg2 := cog.Dump(input.Options.Legend.Show)
+			calls = append(calls, `ShowLegend(`+showArg2+`)`)
+		}
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -23,6 +23,16 @@ public class SomePanel {
         public Builder() {
             this.internal = new SomePanel();
         }
+
+        private Builder(SomePanel resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing SomePanel object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(SomePanel resource) {
+            return new Builder(resource);
+        }
     public Builder title(String title) {
     this.internal.title = title;
         return this;
@@ -9,6 +9,17 @@ class SomePanel(cogbuilder.Builder[initialization_safeguards.SomePanel]):
     def __init__(self):
         self._internal = initialization_safeguards.SomePanel()
 
+    @classmethod
+    def from_object(cls, resource: initialization_safeguards.SomePanel) -> typing.Self:
+        """
+        Creates a builder from an existing initialization_safeguards.SomePanel object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> initialization_safeguards.SomePanel:
         return self._internal    
     
@@ -0,0 +1,69 @@
+use crate::cog;
+use crate::initialization_safeguards;
+
+pub struct SomePanelBuilder {
+    internal: initialization_safeguards::SomePanel,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl SomePanelBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn title(mut self, title: String) -> Self {
+        self.internal.title = title;
+
+        self
+    }
+
+    pub fn show_legend(mut self, show: bool) -> Self {
+        if self.internal.options.is_none() {
+            self.internal.options = Some(Default::default());
+        }
+        self.internal.options.as_mut().unwrap().legend.show = show;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<initialization_safeguards::SomePanel> for SomePanelBuilder {
+    fn from(resource: initialization_safeguards::SomePanel) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<initialization_safeguards::SomePanel> for SomePanelBuilder {
+    fn build(&self) -> Result<initialization_safeguards::SomePanel, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("SomePanel"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
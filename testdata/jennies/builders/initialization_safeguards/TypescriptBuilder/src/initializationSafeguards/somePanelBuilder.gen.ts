@@ -8,6 +8,17 @@ export class SomePanelBuilder implements cog.Builder<initializationSafeguards.So
         this.internal = initializationSafeguards.defaultSomePanel();
     }
 
+    // Creates a builder from an existing SomePanel object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: initializationSafeguards.SomePanel): SomePanelBuilder {
+        const builder: SomePanelBuilder = Object.create(SomePanelBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): initializationSafeguards.SomePanel {
         return this.internal;
     }
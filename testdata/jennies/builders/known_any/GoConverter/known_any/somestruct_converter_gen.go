@@ -0,0 +1,21 @@
+package known_any
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructToCode(input SomeStruct) string {
+	calls := []string{
+		`known_any.NewSomeStructBuilder()`,
+	}
+
+	if cast1, ok := input.Config.(*Config); ok {
+		if cast1.Title != "" {
+			titleArg2 := cog.Dump(cast1.Title)
+			calls = append(calls, `Title(`+titleArg2+`)`)
+		}
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -9,6 +9,17 @@ class SomeStruct(cogbuilder.Builder[known_any.SomeStruct]):
     def __init__(self):
         self._internal = known_any.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: known_any.SomeStruct) -> typing.Self:
+        """
+        Creates a builder from an existing known_any.SomeStruct object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> known_any.SomeStruct:
         return self._internal    
     
@@ -8,6 +8,17 @@ export class SomeStructBuilder implements cog.Builder<knownAny.SomeStruct> {
         this.internal = knownAny.defaultSomeStruct();
     }
 
+    // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: knownAny.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): knownAny.SomeStruct {
         return this.internal;
     }
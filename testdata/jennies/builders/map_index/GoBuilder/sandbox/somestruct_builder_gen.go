@@ -0,0 +1,81 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var _ cog.Builder[SomeStruct] = (*SomeStructBuilder)(nil)
+
+type SomeStructBuilder struct {
+    internal *SomeStruct
+    errors map[string]cog.BuildErrors
+}
+
+func NewSomeStructBuilder() *SomeStructBuilder {
+	resource := &SomeStruct{}
+	builder := &SomeStructBuilder{
+		internal: resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	builder.applyDefaults()
+
+	return builder
+}
+
+// NewSomeStructBuilderFrom creates a builder from an existing SomeStruct value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewSomeStructBuilderFrom(resource SomeStruct) *SomeStructBuilder {
+	builder := &SomeStructBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
+func (builder *SomeStructBuilder) Build() (SomeStruct, error) {
+	var errs cog.BuildErrors
+
+	for _, err := range builder.errors {
+		errs = append(errs, cog.MakeBuildErrors("SomeStruct", err)...)
+	}
+
+	if len(errs) != 0 {
+		return SomeStruct{}, errs
+	}
+
+	return *builder.internal, nil
+}
+
+func (builder *SomeStructBuilder) Label(key string,value string) *SomeStructBuilder {
+    if builder.internal.Labels == nil {
+        builder.internal.Labels = map[string]string{}
+    }
+    builder.internal.Labels[key] = value
+
+    return builder
+}
+
+func (builder *SomeStructBuilder) Annotation(key string,value string) *SomeStructBuilder {
+    if builder.internal.Annotations == nil {
+        builder.internal.Annotations = map[string]string{}
+    }
+    builder.internal.Annotations[key] = value
+
+    return builder
+}
+
+func (builder *SomeStructBuilder) Team(value string) *SomeStructBuilder {
+    if builder.internal.Labels == nil {
+        builder.internal.Labels = map[string]string{}
+    }
+    builder.internal.Labels["team"] = value
+
+    return builder
+}
+
+func (builder *SomeStructBuilder) applyDefaults() {
+}
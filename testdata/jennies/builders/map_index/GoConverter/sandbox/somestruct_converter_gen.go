@@ -0,0 +1,26 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructToCode(input SomeStruct) string {
+	calls := []string{
+		`sandbox.NewSomeStructBuilder()`,
+	}
+
+	for key1, item2 := range input.Labels {
+		keyArg3 := cog.Dump(key1)
+		valueArg4 := cog.Dump(item2)
+		calls = append(calls, `Label(`+keyArg3+", "+valueArg4+`)`)
+	}
+
+	for key5, item6 := range input.Annotations {
+		keyArg7 := cog.Dump(key5)
+		valueArg8 := cog.Dump(item6)
+		calls = append(calls, `Annotation(`+keyArg7+", "+valueArg8+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
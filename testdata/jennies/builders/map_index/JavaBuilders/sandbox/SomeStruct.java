@@ -0,0 +1,65 @@
+package sandbox;
+
+import java.util.Map;
+import com.fasterxml.jackson.annotation.JsonProperty;
+import com.fasterxml.jackson.core.JsonProcessingException;
+import com.fasterxml.jackson.databind.ObjectMapper;
+import com.fasterxml.jackson.databind.ObjectWriter;
+import java.util.HashMap;
+
+public class SomeStruct { 
+    @JsonProperty("labels")
+    public Map<String, String> labels; 
+    @JsonProperty("annotations")
+    public Map<String, String> annotations;
+    
+    public String toJSON() throws JsonProcessingException {
+        ObjectWriter ow = new ObjectMapper().writer().withDefaultPrettyPrinter();
+        return ow.writeValueAsString(this);
+    }
+
+    
+    public static class Builder implements cog.Builder<SomeStruct> {
+        private final SomeStruct internal;
+        
+        public Builder() {
+            this.internal = new SomeStruct();
+        }
+
+        private Builder(SomeStruct resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(SomeStruct resource) {
+            return new Builder(resource);
+        }
+    public Builder label(String key,String value) {
+		if (this.internal.labels == null) {
+			this.internal.labels = new HashMap<>();
+		}
+    this.internal.labels.put(key, value);
+        return this;
+    }
+    
+    public Builder annotation(String key,String value) {
+		if (this.internal.annotations == null) {
+			this.internal.annotations = new HashMap<>();
+		}
+    this.internal.annotations.put(key, value);
+        return this;
+    }
+    
+    public Builder team(String value) {
+		if (this.internal.labels == null) {
+			this.internal.labels = new HashMap<>();
+		}
+    this.internal.labels.put("team", value);
+        return this;
+    }
+    public SomeStruct build() {
+            return this.internal;
+        }
+    }
+}
@@ -0,0 +1,60 @@
+<?php
+
+namespace Grafana\Foundation\Sandbox;
+
+/**
+ * @implements \Grafana\Foundation\Cog\Builder<\Grafana\Foundation\Sandbox\SomeStruct>
+ */
+class SomeStructBuilder implements \Grafana\Foundation\Cog\Builder
+{
+    protected \Grafana\Foundation\Sandbox\SomeStruct $internal;
+
+    public function __construct()
+    {
+    	$this->internal = new \Grafana\Foundation\Sandbox\SomeStruct();
+    }
+
+    /**
+     * Creates a builder from an existing object, allowing it to be modified with the builder's options.
+     * The object isn't copied: the builder's options modify it in place.
+     */
+    public static function fromObject(\Grafana\Foundation\Sandbox\SomeStruct $resource): self
+    {
+        $builder = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
+        $builder->internal = $resource;
+
+        return $builder;
+    }
+
+    /**
+     * @return \Grafana\Foundation\Sandbox\SomeStruct
+     */
+    public function build()
+    {
+        return $this->internal;
+    }
+
+    public function label(string $key,string $value): static
+    {
+        $this->internal->labels[$key] = $value;
+    
+        return $this;
+    }
+    public function annotation(string $key,string $value): static
+    {    
+        if ($this->internal->annotations === null) {
+            $this->internal->annotations = [];
+        }
+        
+        $this->internal->annotations[$key] = $value;
+    
+        return $this;
+    }
+    public function team(string $value): static
+    {
+        $this->internal->labels["team"] = $value;
+    
+        return $this;
+    }
+
+}
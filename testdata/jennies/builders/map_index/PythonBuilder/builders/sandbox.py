@@ -0,0 +1,49 @@
+import typing
+from ..cog import builder as cogbuilder
+from ..models import sandbox
+
+
+class SomeStruct(cogbuilder.Builder[sandbox.SomeStruct]):    
+    _internal: sandbox.SomeStruct
+
+    def __init__(self):
+        self._internal = sandbox.SomeStruct()
+
+    @classmethod
+    def from_object(cls, resource: sandbox.SomeStruct) -> typing.Self:
+        """
+        Creates a builder from an existing sandbox.SomeStruct object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
+    def build(self) -> sandbox.SomeStruct:
+        return self._internal    
+    
+    def label(self, key: str, value: str) -> typing.Self:        
+        if self._internal.labels is None:
+            self._internal.labels = {}
+        
+        self._internal.labels[key] = value
+    
+        return self
+    
+    def annotation(self, key: str, value: str) -> typing.Self:        
+        if self._internal.annotations is None:
+            self._internal.annotations = {}
+        
+        self._internal.annotations[key] = value
+    
+        return self
+    
+    def team(self, value: str) -> typing.Self:        
+        if self._internal.labels is None:
+            self._internal.labels = {}
+        
+        self._internal.labels["team"] = value
+    
+        return self
+    
\ No newline at end of file
@@ -0,0 +1,49 @@
+import * as cog from '../cog';
+import * as sandbox from '../sandbox';
+
+export class SomeStructBuilder implements cog.Builder<sandbox.SomeStruct> {
+    protected readonly internal: sandbox.SomeStruct;
+
+    constructor() {
+        this.internal = sandbox.defaultSomeStruct();
+    }
+
+    // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: sandbox.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
+    build(): sandbox.SomeStruct {
+        return this.internal;
+    }
+
+    label(key: string,value: string): this {
+        if (!this.internal.labels) {
+            this.internal.labels = {};
+        }
+        this.internal.labels[key] = value;
+        return this;
+    }
+
+    annotation(key: string,value: string): this {
+        if (!this.internal.annotations) {
+            this.internal.annotations = {};
+        }
+        this.internal.annotations[key] = value;
+        return this;
+    }
+
+    team(value: string): this {
+        if (!this.internal.labels) {
+            this.internal.labels = {};
+        }
+        this.internal.labels["team"] = value;
+        return this;
+    }
+}
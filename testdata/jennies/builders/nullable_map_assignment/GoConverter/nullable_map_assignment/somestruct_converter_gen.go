@@ -0,0 +1,19 @@
+package nullable_map_assignment
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructToCode(input SomeStruct) string {
+	calls := []string{
+		`nullable_map_assignment.NewSomeStructBuilder()`,
+	}
+
+	if input.Config != nil {
+		configArg1 := cog.Dump(input.Config)
+		calls = append(calls, `Config(`+configArg1+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -9,6 +9,17 @@ class SomeStruct(cogbuilder.Builder[nullable_map_assignment.SomeStruct]):
     def __init__(self):
         self._internal = nullable_map_assignment.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: nullable_map_assignment.SomeStruct) -> typing.Self:
+        """
+        Creates a builder from an existing nullable_map_assignment.SomeStruct object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> nullable_map_assignment.SomeStruct:
         return self._internal    
     
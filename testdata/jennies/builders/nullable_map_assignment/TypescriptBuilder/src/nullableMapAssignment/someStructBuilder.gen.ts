@@ -8,6 +8,17 @@ export class SomeStructBuilder implements cog.Builder<nullableMapAssignment.Some
         this.internal = nullableMapAssignment.defaultSomeStruct();
     }
 
+    // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: nullableMapAssignment.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): nullableMapAssignment.SomeStruct {
         return this.internal;
     }
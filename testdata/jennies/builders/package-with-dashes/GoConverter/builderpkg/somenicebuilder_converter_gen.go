@@ -0,0 +1,20 @@
+package builderpkg
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+	withdashes "github.com/grafana/cog/generated/with-dashes"
+)
+
+// SomeNiceBuilderToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeNiceBuilderToCode(input withdashes.SomeStruct) string {
+	calls := []string{
+		`builderpkg.NewSomeNiceBuilderBuilder()`,
+	}
+
+	if input.Title != "" {
+		titleArg1 := cog.Dump(input.Title)
+		calls = append(calls, `Title(`+titleArg1+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
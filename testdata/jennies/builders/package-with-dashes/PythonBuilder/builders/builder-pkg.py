@@ -9,6 +9,17 @@ class SomeNiceBuilder(cogbuilder.Builder[with-dashes.SomeStruct]):
     def __init__(self):
         self._internal = with-dashes.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: with-dashes.SomeStruct) -> typing.Self:
+        """
+        Creates a builder from an existing with-dashes.SomeStruct object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> with-dashes.SomeStruct:
         return self._internal    
     
@@ -8,6 +8,17 @@ export class SomeNiceBuilderBuilder implements cog.Builder<withDashes.SomeStruct
         this.internal = withDashes.defaultSomeStruct();
     }
 
+    // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: withDashes.SomeStruct): SomeNiceBuilderBuilder {
+        const builder: SomeNiceBuilderBuilder = Object.create(SomeNiceBuilderBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): withDashes.SomeStruct {
         return this.internal;
     }
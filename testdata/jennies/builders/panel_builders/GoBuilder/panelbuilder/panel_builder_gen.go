@@ -23,6 +23,19 @@ func NewPanelBuilder() *PanelBuilder {
 	return builder
 }
 
+// NewPanelBuilderFrom creates a builder from an existing Panel value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewPanelBuilderFrom(resource Panel) *PanelBuilder {
+	builder := &PanelBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *PanelBuilder) Build() (Panel, error) {
 	var errs cog.BuildErrors
 
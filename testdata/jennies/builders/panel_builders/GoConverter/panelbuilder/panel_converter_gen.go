@@ -0,0 +1,64 @@
+package panelbuilder
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// PanelToCode accepts a `Panel` object and generates the Go code to build this object using builders.
+func PanelToCode(input Panel) string {
+	calls := []string{
+		`panelbuilder.NewPanelBuilder()`,
+	}
+
+	if input.OnlyFromThisDashboard != false {
+		onlyFromThisDashboardArg1 := cog.Dump(input.OnlyFromThisDashboard)
+		calls = append(calls, `OnlyFromThisDashboard(`+onlyFromThisDashboardArg1+`)`)
+	}
+
+	if input.OnlyInTimeRange != false {
+		onlyInTimeRangeArg2 := cog.Dump(input.OnlyInTimeRange)
+		calls = append(calls, `OnlyInTimeRange(`+onlyInTimeRangeArg2+`)`)
+	}
+
+	if input.Tags != nil {
+		tagsArg3 := cog.Dump(input.Tags)
+		calls = append(calls, `Tags(`+tagsArg3+`)`)
+	}
+
+	if input.Limit != 10 {
+		limitArg4 := cog.Dump(input.Limit)
+		calls = append(calls, `Limit(`+limitArg4+`)`)
+	}
+
+	if input.ShowUser != true {
+		showUserArg5 := cog.Dump(input.ShowUser)
+		calls = append(calls, `ShowUser(`+showUserArg5+`)`)
+	}
+
+	if input.ShowTime != true {
+		showTimeArg6 := cog.Dump(input.ShowTime)
+		calls = append(calls, `ShowTime(`+showTimeArg6+`)`)
+	}
+
+	if input.ShowTags != true {
+		showTagsArg7 := cog.Dump(input.ShowTags)
+		calls = append(calls, `ShowTags(`+showTagsArg7+`)`)
+	}
+
+	if input.NavigateToPanel != true {
+		navigateToPanelArg8 := cog.Dump(input.NavigateToPanel)
+		calls = append(calls, `NavigateToPanel(`+navigateToPanelArg8+`)`)
+	}
+
+	if input.NavigateBefore != "10m" {
+		navigateBeforeArg9 := cog.Dump(input.NavigateBefore)
+		calls = append(calls, `NavigateBefore(`+navigateBeforeArg9+`)`)
+	}
+
+	if input.NavigateAfter != "10m" {
+		navigateAfterArg10 := cog.Dump(input.NavigateAfter)
+		calls = append(calls, `NavigateAfter(`+navigateAfterArg10+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
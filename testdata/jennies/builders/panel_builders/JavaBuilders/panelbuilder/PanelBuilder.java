@@ -22,6 +22,16 @@ public class PanelBuilder implements cog.Builder<Panel> {
         this.navigateBefore("10m");
         this.navigateAfter("10m");
     }
+
+    private PanelBuilder(Panel resource) {
+        this.internal = resource;
+    }
+
+    // Creates a builder from an existing Panel object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    public static PanelBuilder fromObject(Panel resource) {
+        return new PanelBuilder(resource);
+    }
     public PanelBuilder onlyFromThisDashboard(Boolean onlyFromThisDashboard) {
     this.internal.onlyFromThisDashboard = onlyFromThisDashboard;
         return this;
@@ -9,6 +9,17 @@ class Panel(cogbuilder.Builder[panelbuilder.Panel]):
     def __init__(self):
         self._internal = panelbuilder.Panel()
 
+    @classmethod
+    def from_object(cls, resource: panelbuilder.Panel) -> typing.Self:
+        """
+        Creates a builder from an existing panelbuilder.Panel object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> panelbuilder.Panel:
         return self._internal    
     
@@ -0,0 +1,123 @@
+use crate::cog;
+use crate::panelbuilder;
+
+pub struct PanelBuilder {
+    internal: panelbuilder::Panel,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl PanelBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn only_from_this_dashboard(mut self, only_from_this_dashboard: bool) -> Self {
+        self.internal.only_from_this_dashboard = only_from_this_dashboard;
+
+        self
+    }
+
+    pub fn only_in_time_range(mut self, only_in_time_range: bool) -> Self {
+        self.internal.only_in_time_range = only_in_time_range;
+
+        self
+    }
+
+    pub fn tags(mut self, tags: Vec<String>) -> Self {
+        self.internal.tags = tags;
+
+        self
+    }
+
+    pub fn limit(mut self, limit: u32) -> Self {
+        self.internal.limit = limit;
+
+        self
+    }
+
+    pub fn show_user(mut self, show_user: bool) -> Self {
+        self.internal.show_user = show_user;
+
+        self
+    }
+
+    pub fn show_time(mut self, show_time: bool) -> Self {
+        self.internal.show_time = show_time;
+
+        self
+    }
+
+    pub fn show_tags(mut self, show_tags: bool) -> Self {
+        self.internal.show_tags = show_tags;
+
+        self
+    }
+
+    pub fn navigate_to_panel(mut self, navigate_to_panel: bool) -> Self {
+        self.internal.navigate_to_panel = navigate_to_panel;
+
+        self
+    }
+
+    pub fn navigate_before(mut self, navigate_before: String) -> Self {
+        self.internal.navigate_before = navigate_before;
+
+        self
+    }
+
+    pub fn navigate_after(mut self, navigate_after: String) -> Self {
+        self.internal.navigate_after = navigate_after;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+            .only_from_this_dashboard(false)
+            .only_in_time_range(false)
+            .limit(10)
+            .show_user(true)
+            .show_time(true)
+            .show_tags(true)
+            .navigate_to_panel(true)
+            .navigate_before("10m".to_string())
+            .navigate_after("10m".to_string())
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<panelbuilder::Panel> for PanelBuilder {
+    fn from(resource: panelbuilder::Panel) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<panelbuilder::Panel> for PanelBuilder {
+    fn build(&self) -> Result<panelbuilder::Panel, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("Panel"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
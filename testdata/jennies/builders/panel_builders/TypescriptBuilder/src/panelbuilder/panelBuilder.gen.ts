@@ -8,6 +8,17 @@ export class PanelBuilder implements cog.Builder<panelbuilder.Panel> {
         this.internal = panelbuilder.defaultPanel();
     }
 
+    // Creates a builder from an existing Panel object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: panelbuilder.Panel): PanelBuilder {
+        const builder: PanelBuilder = Object.create(PanelBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): panelbuilder.Panel {
         return this.internal;
     }
@@ -0,0 +1,19 @@
+package properties
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructToCode(input SomeStruct) string {
+	calls := []string{
+		`properties.NewSomeStructBuilder()`,
+	}
+
+	if input.Id != 0 {
+		idArg1 := cog.Dump(input.Id)
+		calls = append(calls, `Id(`+idArg1+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -16,6 +16,19 @@ class SomeStructBuilder implements \Grafana\Foundation\Cog\Builder
         $this->someBuilderProperty = "";
     }
 
+    /**
+     * Creates a builder from an existing object, allowing it to be modified with the builder's options.
+     * The object isn't copied: the builder's options modify it in place.
+     */
+    public static function fromObject(\Grafana\Foundation\Properties\SomeStruct $resource): self
+    {
+        $builder = (new \ReflectionClass(self::class))->newInstanceWithoutConstructor();
+        $builder->internal = $resource;
+        $builder->someBuilderProperty = "";
+
+        return $builder;
+    }
+
     /**
      * @return \Grafana\Foundation\Properties\SomeStruct
      */
@@ -10,6 +10,17 @@ class SomeStruct(cogbuilder.Builder[properties.SomeStruct]):
     def __init__(self):
         self._internal = properties.SomeStruct()
 
+    @classmethod
+    def from_object(cls, resource: properties.SomeStruct) -> typing.Self:
+        """
+        Creates a builder from an existing properties.SomeStruct object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> properties.SomeStruct:
         return self._internal    
     
@@ -0,0 +1,63 @@
+use crate::cog;
+use crate::properties;
+
+pub struct SomeStructBuilder {
+    internal: properties::SomeStruct,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+    some_builder_property: String,
+}
+
+impl SomeStructBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+            some_builder_property: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn id(mut self, id: i64) -> Self {
+        self.internal.id = id;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<properties::SomeStruct> for SomeStructBuilder {
+    fn from(resource: properties::SomeStruct) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+            some_builder_property: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<properties::SomeStruct> for SomeStructBuilder {
+    fn build(&self) -> Result<properties::SomeStruct, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("SomeStruct"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
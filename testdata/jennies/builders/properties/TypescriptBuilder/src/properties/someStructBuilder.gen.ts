@@ -9,6 +9,18 @@ export class SomeStructBuilder implements cog.Builder<properties.SomeStruct> {
         this.internal = properties.defaultSomeStruct();
     }
 
+    // Creates a builder from an existing SomeStruct object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: properties.SomeStruct): SomeStructBuilder {
+        const builder: SomeStructBuilder = Object.create(SomeStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+            someBuilderProperty: "",
+        });
+
+        return builder;
+    }
+
     build(): properties.SomeStruct {
         return this.internal;
     }
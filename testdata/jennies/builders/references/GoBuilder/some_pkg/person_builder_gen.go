@@ -24,6 +24,19 @@ func NewPersonBuilder() *PersonBuilder {
 	return builder
 }
 
+// NewPersonBuilderFrom creates a builder from an existing Person value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewPersonBuilderFrom(resource Person) *PersonBuilder {
+	builder := &PersonBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *PersonBuilder) Build() (Person, error) {
 	var errs cog.BuildErrors
 
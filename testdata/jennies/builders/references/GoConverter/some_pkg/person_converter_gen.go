@@ -0,0 +1,17 @@
+package some_pkg
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// PersonToCode accepts a `Person` object and generates the Go code to build this object using builders.
+func PersonToCode(input Person) string {
+	calls := []string{
+		`some_pkg.NewPersonBuilder()`,
+	}
+
+	nameArg1 := cog.Dump(input.Name)
+	calls = append(calls, `Name(`+nameArg1+`)`)
+
+	return strings.Join(calls, ".\n")
+}
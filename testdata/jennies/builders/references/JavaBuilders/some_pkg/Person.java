@@ -22,6 +22,16 @@ public class Person {
         public Builder() {
             this.internal = new Person();
         }
+
+        private Builder(Person resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing Person object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(Person resource) {
+            return new Builder(resource);
+        }
     public Builder name(Name name) {
     this.internal.name = name;
         return this;
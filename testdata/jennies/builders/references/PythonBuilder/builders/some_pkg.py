@@ -10,6 +10,17 @@ class Person(cogbuilder.Builder[some_pkg.Person]):
     def __init__(self):
         self._internal = some_pkg.Person()
 
+    @classmethod
+    def from_object(cls, resource: some_pkg.Person) -> typing.Self:
+        """
+        Creates a builder from an existing some_pkg.Person object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> some_pkg.Person:
         return self._internal    
     
@@ -0,0 +1,61 @@
+use crate::cog;
+use crate::some_pkg;
+use crate::other_pkg;
+
+pub struct PersonBuilder {
+    internal: some_pkg::Person,
+    errors: std::collections::BTreeMap<String, cog::BuildErrors>,
+}
+
+impl PersonBuilder {
+    pub fn new() -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: Default::default(),
+            errors: Default::default(),
+        }
+        .apply_defaults();
+
+        builder
+    }
+
+    pub fn name(mut self, name: other_pkg::Name) -> Self {
+        self.internal.name = name;
+
+        self
+    }
+
+    fn apply_defaults(self) -> Self {
+        self
+    }
+}
+
+/// Creates a builder from an existing value, allowing it to be modified with the builder's options.
+/// The builder takes ownership of the value: clone it beforehand to keep the original untouched.
+impl From<some_pkg::Person> for PersonBuilder {
+    fn from(resource: some_pkg::Person) -> Self {
+        #[allow(unused_mut)]
+        let mut builder = Self {
+            internal: resource,
+            errors: Default::default(),
+        };
+
+        builder
+    }
+}
+
+impl cog::Builder<some_pkg::Person> for PersonBuilder {
+    fn build(&self) -> Result<some_pkg::Person, cog::BuildErrors> {
+        let mut errs = cog::BuildErrors::default();
+
+        for err in self.errors.values() {
+            errs.extend(err.clone().with_root("Person"));
+        }
+
+        if !errs.is_empty() {
+            return Err(errs);
+        }
+
+        Ok(self.internal.clone())
+    }
+}
@@ -9,6 +9,17 @@ export class PersonBuilder implements cog.Builder<somePkg.Person> {
         this.internal = somePkg.defaultPerson();
     }
 
+    // Creates a builder from an existing Person object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: somePkg.Person): PersonBuilder {
+        const builder: PersonBuilder = Object.create(PersonBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): somePkg.Person {
         return this.internal;
     }
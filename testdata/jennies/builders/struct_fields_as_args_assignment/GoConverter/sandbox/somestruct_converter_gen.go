@@ -0,0 +1,20 @@
+package sandbox
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// SomeStructToCode accepts a `SomeStruct` object and generates the Go code to build this object using builders.
+func SomeStructToCode(input SomeStruct) string {
+	calls := []string{
+		`sandbox.NewSomeStructBuilder()`,
+	}
+
+	if input.Time != nil {
+		fromArg1 := cog.Dump(input.Time.From)
+		toArg2 := cog.Dump(input.Time.To)
+		calls = append(calls, `Time(`+fromArg1+", "+toArg2+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
@@ -23,6 +23,19 @@ func NewNestedStructBuilder() *NestedStructBuilder {
 	return builder
 }
 
+// NewNestedStructBuilderFrom creates a builder from an existing NestedStruct value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewNestedStructBuilderFrom(resource NestedStruct) *NestedStructBuilder {
+	builder := &NestedStructBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *NestedStructBuilder) Build() (NestedStruct, error) {
 	var errs cog.BuildErrors
 
@@ -23,6 +23,19 @@ func NewStructBuilder() *StructBuilder {
 	return builder
 }
 
+// NewStructBuilderFrom creates a builder from an existing Struct value,
+// allowing it to be modified with the builder's options.
+// Only the value itself is copied: the slices, maps and pointers it holds are
+// shared with the builder, and its options may modify what they point to.
+func NewStructBuilderFrom(resource Struct) *StructBuilder {
+	builder := &StructBuilder{
+		internal: &resource,
+		errors: make(map[string]cog.BuildErrors),
+	}
+
+	return builder
+}
+
 func (builder *StructBuilder) Build() (Struct, error) {
 	var errs cog.BuildErrors
 
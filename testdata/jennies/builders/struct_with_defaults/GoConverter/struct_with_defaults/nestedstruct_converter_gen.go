@@ -0,0 +1,24 @@
+package struct_with_defaults
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// NestedStructToCode accepts a `NestedStruct` object and generates the Go code to build this object using builders.
+func NestedStructToCode(input NestedStruct) string {
+	calls := []string{
+		`struct_with_defaults.NewNestedStructBuilder()`,
+	}
+
+	if input.StringVal != "" {
+		stringValArg1 := cog.Dump(input.StringVal)
+		calls = append(calls, `StringVal(`+stringValArg1+`)`)
+	}
+
+	if input.IntVal != 0 {
+		intValArg2 := cog.Dump(input.IntVal)
+		calls = append(calls, `IntVal(`+intValArg2+`)`)
+	}
+
+	return strings.Join(calls, ".\n")
+}
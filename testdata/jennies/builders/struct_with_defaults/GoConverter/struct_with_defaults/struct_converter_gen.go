@@ -0,0 +1,29 @@
+package struct_with_defaults
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+// StructToCode accepts a `Struct` object and generates the Go code to build this object using builders.
+func StructToCode(input Struct) string {
+	calls := []string{
+		`struct_with_defaults.NewStructBuilder()`,
+	}
+
+	allFieldsArg1 := NestedStructToCode(input.AllFields)
+	calls = append(calls, `AllFields(`+allFieldsArg1+`)`)
+
+	partialFieldsArg2 := NestedStructToCode(input.PartialFields)
+	calls = append(calls, `PartialFields(`+partialFieldsArg2+`)`)
+
+	emptyFieldsArg3 := NestedStructToCode(input.EmptyFields)
+	calls = append(calls, `EmptyFields(`+emptyFieldsArg3+`)`)
+
+	complexFieldArg4 := cog.Dump(input.ComplexField)
+	calls = append(calls, `ComplexField(`+complexFieldArg4+`)`)
+
+	partialComplexFieldArg5 := cog.Dump(input.PartialComplexField)
+	calls = append(calls, `PartialComplexField(`+partialComplexFieldArg5+`)`)
+
+	return strings.Join(calls, ".\n")
+}
@@ -23,6 +23,16 @@ public class NestedStruct {
         public Builder() {
             this.internal = new NestedStruct();
         }
+
+        private Builder(NestedStruct resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing NestedStruct object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(NestedStruct resource) {
+            return new Builder(resource);
+        }
     public Builder stringVal(String stringVal) {
     this.internal.stringVal = stringVal;
         return this;
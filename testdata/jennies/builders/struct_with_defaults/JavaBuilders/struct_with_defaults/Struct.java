@@ -38,6 +38,16 @@ public class Struct {
         this.complexField(new Object());
         this.partialComplexField(new Object());
         }
+
+        private Builder(Struct resource) {
+            this.internal = resource;
+        }
+
+        // Creates a builder from an existing Struct object, allowing it to be modified with the builder's options.
+        // The object isn't copied: the builder's options modify it in place.
+        public static Builder fromObject(Struct resource) {
+            return new Builder(resource);
+        }
     public Builder allFields(cog.Builder<NestedStruct> allFields) {
     this.internal.allFields = allFields.build();
         return this;
@@ -9,6 +9,17 @@ class NestedStruct(cogbuilder.Builder[struct_with_defaults.NestedStruct]):
     def __init__(self):
         self._internal = struct_with_defaults.NestedStruct()
 
+    @classmethod
+    def from_object(cls, resource: struct_with_defaults.NestedStruct) -> typing.Self:
+        """
+        Creates a builder from an existing struct_with_defaults.NestedStruct object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> struct_with_defaults.NestedStruct:
         return self._internal    
     
@@ -29,6 +40,17 @@ class Struct(cogbuilder.Builder[struct_with_defaults.Struct]):
     def __init__(self):
         self._internal = struct_with_defaults.Struct()
 
+    @classmethod
+    def from_object(cls, resource: struct_with_defaults.Struct) -> typing.Self:
+        """
+        Creates a builder from an existing struct_with_defaults.Struct object, allowing it to be modified with the builder's options.
+        The object isn't copied: the builder's options modify it in place.
+        """
+        builder = cls.__new__(cls)
+        builder._internal = resource
+
+        return builder
+
     def build(self) -> struct_with_defaults.Struct:
         return self._internal    
     
@@ -8,6 +8,17 @@ export class NestedStructBuilder implements cog.Builder<structWithDefaults.Neste
         this.internal = structWithDefaults.defaultNestedStruct();
     }
 
+    // Creates a builder from an existing NestedStruct object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: structWithDefaults.NestedStruct): NestedStructBuilder {
+        const builder: NestedStructBuilder = Object.create(NestedStructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): structWithDefaults.NestedStruct {
         return this.internal;
     }
@@ -8,6 +8,17 @@ export class StructBuilder implements cog.Builder<structWithDefaults.Struct> {
         this.internal = structWithDefaults.defaultStruct();
     }
 
+    // Creates a builder from an existing Struct object, allowing it to be modified with the builder's options.
+    // The object isn't copied: the builder's options modify it in place.
+    static fromObject(resource: structWithDefaults.Struct): StructBuilder {
+        const builder: StructBuilder = Object.create(StructBuilder.prototype);
+        Object.assign(builder, {
+            internal: resource,
+        });
+
+        return builder;
+    }
+
     build(): structWithDefaults.Struct {
         return this.internal;
     }
@@ -0,0 +1,200 @@
+package grafanatest
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+// APIError is returned when the API responds with a non-2XX status code.
+type APIError struct {
+	StatusCode int
+	Body       []byte
+}
+
+func (err *APIError) Error() string {
+	return fmt.Sprintf("unexpected status code %d: %s", err.StatusCode, string(err.Body))
+}
+
+// RequestEditor is called on every request before it is sent.
+// It can be used to set authentication headers, for example.
+type RequestEditor func(ctx context.Context, req *http.Request) error
+
+type ClientOption func(client *Client)
+
+// WithHTTPClient configures the HTTP client used to send requests.
+func WithHTTPClient(httpClient *http.Client) ClientOption {
+	return func(client *Client) {
+		client.httpClient = httpClient
+	}
+}
+
+// WithRequestEditor registers a function called on every request before it is sent.
+func WithRequestEditor(editor RequestEditor) ClientOption {
+	return func(client *Client) {
+		client.requestEditors = append(client.requestEditors, editor)
+	}
+}
+
+type Client struct {
+	baseURL        string
+	httpClient     *http.Client
+	requestEditors []RequestEditor
+}
+
+func NewClient(baseURL string, options ...ClientOption) *Client {
+	client := &Client{
+		baseURL:    strings.TrimSuffix(baseURL, "/"),
+		httpClient: http.DefaultClient,
+	}
+
+	for _, option := range options {
+		option(client)
+	}
+
+	return client
+}
+
+// SearchDashboardsParams holds the query and header parameters accepted by SearchDashboards.
+type SearchDashboardsParams struct {
+	// Search query.
+	Query *string
+	Limit *int64
+	Sort *SearchDashboardsSort
+	XOrgId *int64
+}
+
+// Search dashboards.
+func (client *Client) SearchDashboards(ctx context.Context, params SearchDashboardsParams) ([]Dashboard, error) {
+	var response []Dashboard
+
+	path := "/dashboards"
+	query := url.Values{}
+	if params.Query != nil {
+		query.Add("query", fmt.Sprint(*params.Query))
+	}
+	if params.Limit != nil {
+		query.Add("limit", fmt.Sprint(*params.Limit))
+	}
+	if params.Sort != nil {
+		query.Add("sort", fmt.Sprint(*params.Sort))
+	}
+
+	req, err := client.newRequest(ctx, "GET", path, query, nil, "")
+	if err != nil {
+		return response, err
+	}
+	if params.XOrgId != nil {
+		req.Header.Add("X-Org-Id", fmt.Sprint(*params.XOrgId))
+	}
+
+	err = client.do(req, &response)
+	return response, err
+}
+
+// CreateDashboard calls `POST /dashboards`.
+func (client *Client) CreateDashboard(ctx context.Context, body CreateDashboardRequest) (CreateDashboardResponse, error) {
+	var response CreateDashboardResponse
+
+	path := "/dashboards"
+	query := url.Values{}
+
+	req, err := client.newRequest(ctx, "POST", path, query, body, "application/json")
+	if err != nil {
+		return response, err
+	}
+
+	err = client.do(req, &response)
+	return response, err
+}
+
+// Get a dashboard by its UID.
+func (client *Client) GetDashboardsUidByUid(ctx context.Context, uid string) (Dashboard, error) {
+	var response Dashboard
+
+	path := "/dashboards/uid/" + url.PathEscape(fmt.Sprint(uid))
+	query := url.Values{}
+
+	req, err := client.newRequest(ctx, "GET", path, query, nil, "")
+	if err != nil {
+		return response, err
+	}
+
+	err = client.do(req, &response)
+	return response, err
+}
+
+// DeleteDashboardByUID calls `DELETE /dashboards/uid/{uid}`.
+//
+// Deprecated: this element is deprecated and might be removed in a future version.
+func (client *Client) DeleteDashboardByUID(ctx context.Context, uid string) error {
+	path := "/dashboards/uid/" + url.PathEscape(fmt.Sprint(uid))
+	query := url.Values{}
+
+	req, err := client.newRequest(ctx, "DELETE", path, query, nil, "")
+	if err != nil {
+		return err
+	}
+
+	return client.do(req, nil)
+}
+
+func (client *Client) newRequest(ctx context.Context, method string, path string, query url.Values, body any, contentType string) (*http.Request, error) {
+	target := client.baseURL + path
+	if len(query) != 0 {
+		target += "?" + query.Encode()
+	}
+
+	var bodyReader io.Reader
+	if reader, ok := body.(io.Reader); ok {
+		bodyReader = reader
+	} else if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return nil, err
+		}
+		bodyReader = bytes.NewReader(encoded)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, target, bodyReader)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	if contentType != "" {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	for _, editor := range client.requestEditors {
+		if err := editor(ctx, req); err != nil {
+			return nil, err
+		}
+	}
+
+	return req, nil
+}
+
+func (client *Client) do(req *http.Request, response any) error {
+	resp, err := client.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		body, _ := io.ReadAll(resp.Body)
+		return &APIError{StatusCode: resp.StatusCode, Body: body}
+	}
+
+	if response == nil || resp.StatusCode == http.StatusNoContent {
+		return nil
+	}
+
+	return json.NewDecoder(resp.Body).Decode(response)
+}
@@ -0,0 +1,113 @@
+import enum
+import json
+import typing
+import urllib.error
+import urllib.parse
+import urllib.request
+from ..cog import encoder as cogencoder
+from ..models import grafanatest
+import typing_extensions
+
+
+def _format_param(value: typing.Any) -> str:
+    if isinstance(value, bool):
+        return "true" if value else "false"
+    if isinstance(value, enum.Enum):
+        return str(value.value)
+    return str(value)
+
+
+class APIError(Exception):
+    """
+    Raised when the API responds with a non-2XX status code.
+    """
+
+    def __init__(self, status: int, body: str):
+        super().__init__(f"unexpected status code {status}: {body}")
+        self.status = status
+        self.body = body
+
+
+class Client:
+    """
+    base_url: base URL of the API. ie: https://example.com/api
+    headers: headers sent with every request. ie: authentication headers.
+    """
+
+    def __init__(self, base_url: str, headers: typing.Optional[dict[str, str]] = None, timeout: float = 60.0):
+        self.base_url = base_url.rstrip("/")
+        self.headers = headers or {}
+        self.timeout = timeout
+
+    def search_dashboards(self, *, query: typing.Optional[str] = None, limit: typing.Optional[int] = None, sort: typing.Optional[grafanatest.SearchDashboardsSort] = None, x_org_id: typing.Optional[int] = None) -> list[grafanatest.Dashboard]:
+        """
+        Search dashboards.
+        """
+
+        query_values: list[tuple[str, str]] = []
+        if query is not None:
+            query_values.append(("query", _format_param(query)))
+        if limit is not None:
+            query_values.append(("limit", _format_param(limit)))
+        if sort is not None:
+            query_values.append(("sort", _format_param(sort)))
+        header_values: dict[str, str] = {}
+        if x_org_id is not None:
+            header_values["X-Org-Id"] = _format_param(x_org_id)
+
+        data = self._request("GET", "/dashboards", query_values, header_values, None, None)
+        return [grafanatest.Dashboard.from_json(item) for item in data]
+
+    def create_dashboard(self, body: grafanatest.CreateDashboardRequest) -> grafanatest.CreateDashboardResponse:
+        """
+        Calls `POST /dashboards`.
+        """
+
+        query_values: list[tuple[str, str]] = []
+        header_values: dict[str, str] = {}
+
+        data = self._request("POST", "/dashboards", query_values, header_values, json.dumps(body, cls=cogencoder.JSONEncoder).encode("utf-8"), "application/json")
+        return grafanatest.CreateDashboardResponse.from_json(data)
+
+    def get_dashboards_uid_by_uid(self, uid: str) -> grafanatest.Dashboard:
+        """
+        Get a dashboard by its UID.
+        """
+
+        query_values: list[tuple[str, str]] = []
+        header_values: dict[str, str] = {}
+
+        data = self._request("GET", "/dashboards/uid/" + urllib.parse.quote(_format_param(uid), safe=""), query_values, header_values, None, None)
+        return grafanatest.Dashboard.from_json(data)
+
+    @typing_extensions.deprecated("this element is deprecated and might be removed in a future version.")
+    def delete_dashboard_by_uid(self, uid: str) -> None:
+        """
+        Calls `DELETE /dashboards/uid/{uid}`.
+        """
+
+        query_values: list[tuple[str, str]] = []
+        header_values: dict[str, str] = {}
+
+        self._request("DELETE", "/dashboards/uid/" + urllib.parse.quote(_format_param(uid), safe=""), query_values, header_values, None, None)
+
+    def _request(self, method: str, path: str, query: list[tuple[str, str]], headers: dict[str, str], body: typing.Optional[bytes], content_type: typing.Optional[str]) -> typing.Any:
+        url = self.base_url + path
+        if query:
+            url += "?" + urllib.parse.urlencode(query)
+
+        request_headers = {"Accept": "application/json", **self.headers, **headers}
+        if content_type is not None:
+            request_headers["Content-Type"] = content_type
+
+        request = urllib.request.Request(url, data=body, headers=request_headers, method=method)
+        try:
+            with urllib.request.urlopen(request, timeout=self.timeout) as response:
+                payload = response.read()
+        except urllib.error.HTTPError as error:
+            raise APIError(error.code, error.read().decode("utf-8", errors="replace")) from error
+
+        if not payload:
+            return None
+
+        return json.loads(payload)
@@ -0,0 +1,107 @@
+import * as types from './types.gen';
+
+// APIError is thrown when the API responds with a non-2XX status code.
+export class APIError extends Error {
+	readonly status: number;
+	readonly body: string;
+
+	constructor(status: number, body: string) {
+		super(`unexpected status code ${status}: ${body}`);
+		this.name = 'APIError';
+		this.status = status;
+		this.body = body;
+	}
+}
+
+export interface ClientOptions {
+	// Base URL of the API. ie: https://example.com/api
+	baseURL: string;
+	// fetch implementation to use. Defaults to the global one.
+	fetch?: typeof fetch;
+	// Headers sent with every request. ie: authentication headers.
+	headers?: Record<string, string>;
+}
+
+// Query and header parameters accepted by `searchDashboards()`.
+export interface SearchDashboardsParams {
+	// Search query.
+	query?: string;
+	limit?: number;
+	sort?: types.SearchDashboardsSort;
+	xOrgId?: number;
+}
+
+export class Client {
+	private readonly baseURL: string;
+	private readonly fetch: typeof fetch;
+	private readonly headers: Record<string, string>;
+
+	constructor(options: ClientOptions) {
+		this.baseURL = options.baseURL.replace(/\/+$/, '');
+		this.fetch = options.fetch ?? globalThis.fetch.bind(globalThis);
+		this.headers = options.headers ?? {};
+	}
+
+	// Search dashboards.
+	async searchDashboards(params: SearchDashboardsParams = {}): Promise<types.Dashboard[]> {
+		const query = new URLSearchParams();
+		if (params.query !== undefined) {
+			query.append("query", String(params.query));
+		}
+		if (params.limit !== undefined) {
+			query.append("limit", String(params.limit));
+		}
+		if (params.sort !== undefined) {
+			query.append("sort", String(params.sort));
+		}
+		const headers: Record<string, string> = {};
+		if (params.xOrgId !== undefined) {
+			headers["X-Org-Id"] = String(params.xOrgId);
+		}
+
+		return this.request<types.Dashboard[]>("GET", `/dashboards`, query, headers, undefined, undefined);
+	}
+
+	// Calls `POST /dashboards`.
+	async createDashboard(body: types.CreateDashboardRequest): Promise<types.CreateDashboardResponse> {
+		const query = new URLSearchParams();
+		const headers: Record<string, string> = {};
+
+		return this.request<types.CreateDashboardResponse>("POST", `/dashboards`, query, headers, JSON.stringify(body), "application/json");
+	}
+
+	// Get a dashboard by its UID.
+	async getDashboardsUidByUid(uid: string): Promise<types.Dashboard> {
+		const query = new URLSearchParams();
+		const headers: Record<string, string> = {};
+
+		return this.request<types.Dashboard>("GET", `/dashboards/uid/${encodeURIComponent(String(uid))}`, query, headers, undefined, undefined);
+	}
+
+	// Calls `DELETE /dashboards/uid/{uid}`.
+	/** @deprecated this element is deprecated and might be removed in a future version. */
+	async deleteDashboardByUID(uid: string): Promise<void> {
+		const query = new URLSearchParams();
+		const headers: Record<string, string> = {};
+
+		return this.request<void>("DELETE", `/dashboards/uid/${encodeURIComponent(String(uid))}`, query, headers, undefined, undefined);
+	}
+
+	private async request<T>(method: string, path: string, query: URLSearchParams, headers: Record<string, string>, body: BodyInit | undefined, contentType: string | undefined): Promise<T> {
+		const queryString = query.toString();
+		const url = this.baseURL + path + (queryString !== '' ? '?' + queryString : '');
+
+		const requestHeaders: Record<string, string> = { 'Accept': 'application/json', ...this.headers, ...headers };
+		if (contentType !== undefined) {
+			requestHeaders['Content-Type'] = contentType;
+		}
+
+		const response = await this.fetch(url, { method, headers: requestHeaders, body });
+		const text = await response.text();
+		if (!response.ok) {
+			throw new APIError(response.status, text);
+		}
+
+		return (text === '' ? undefined : JSON.parse(text)) as T;
+	}
+}
@@ -0,0 +1,39 @@
+import com.grafana.foundation.dashboard.Dashboard;
+import java.util.List;
+import com.grafana.foundation.dashboard.DashboardLink;
+import com.grafana.foundation.dashboard.Panel;
+import com.grafana.foundation.dashboard.GridPos;
+import com.grafana.foundation.dashboard.Target;
+import java.util.Map;
+import com.grafana.foundation.dashboard.RowPanel;
+
+new Dashboard.Builder("Some dashboard")
+    .readonly()
+    .style("light")
+    .tags(List.of(
+        "generated",
+        "from",
+        "json"
+    ))
+    .refresh("5m")
+    .time("now-1h", "now")
+    .link(new DashboardLink.Builder("Grafana")
+        .url("https://grafana.com"))
+    .withPanel(new Panel.Builder()
+        .type("timeseries")
+        .title("Requests")
+        .gridPos(new GridPos() {{
+            h = 8;
+            w = 12;
+            x = 0;
+            y = 0;
+        }})
+        .withTarget(new Target.Builder()
+            .refId("A")
+            .expr("rate(requests_total[5m])"))
+        .options(Map.ofEntries(
+            Map.entry("legend", Map.ofEntries(
+                Map.entry("showLegend", true)
+            ))
+        )))
+    .withRow(new RowPanel.Builder("Details"));
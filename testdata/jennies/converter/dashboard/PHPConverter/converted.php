@@ -0,0 +1,30 @@
+(new \Grafana\Foundation\Dashboard\DashboardBuilder('Some dashboard'))
+    ->readonly()
+    ->style('light')
+    ->tags([
+        'generated',
+        'from',
+        'json',
+    ])
+    ->refresh('5m')
+    ->time('now-1h', 'now')
+    ->link((new \Grafana\Foundation\Dashboard\DashboardLinkBuilder('Grafana'))
+        ->url('https://grafana.com'))
+    ->withPanel((new \Grafana\Foundation\Dashboard\PanelBuilder())
+        ->type('timeseries')
+        ->title('Requests')
+        ->gridPos(new \Grafana\Foundation\Dashboard\GridPos(
+            h: 8,
+            w: 12,
+            x: 0,
+            y: 0,
+        ))
+        ->withTarget((new \Grafana\Foundation\Dashboard\TargetBuilder())
+            ->refId('A')
+            ->expr('rate(requests_total[5m])'))
+        ->options([
+            'legend' => [
+                'showLegend' => true,
+            ],
+        ]))
+    ->withRow((new \Grafana\Foundation\Dashboard\RowBuilder('Details')));
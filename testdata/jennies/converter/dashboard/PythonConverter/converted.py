@@ -0,0 +1,35 @@
+from grafana_foundation_sdk.builders import dashboard
+from grafana_foundation_sdk.models import dashboard as dashboard_models
+
+(
+    dashboard.Dashboard("Some dashboard")
+    .readonly()
+    .style("light")
+    .tags([
+        "generated",
+        "from",
+        "json",
+    ])
+    .refresh("5m")
+    .time("now-1h", "now")
+    .link(dashboard.DashboardLink("Grafana")
+        .url("https://grafana.com"))
+    .with_panel(dashboard.Panel()
+        .type_val("timeseries")
+        .title("Requests")
+        .grid_pos(dashboard_models.GridPos(
+            h=8,
+            w=12,
+            x=0,
+            y=0,
+        ))
+        .with_target(dashboard.Target()
+            .ref_id("A")
+            .expr("rate(requests_total[5m])"))
+        .options({
+            "legend": {
+                "showLegend": True,
+            },
+        }))
+    .with_row(dashboard.Row("Details"))
+)
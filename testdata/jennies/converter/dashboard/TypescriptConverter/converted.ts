@@ -0,0 +1,32 @@
+import * as dashboard from './dashboard';
+
+new dashboard.DashboardBuilder("Some dashboard")
+    .readonly()
+    .style("light")
+    .tags([
+        "generated",
+        "from",
+        "json",
+    ])
+    .refresh("5m")
+    .time("now-1h", "now")
+    .link(new dashboard.DashboardLinkBuilder("Grafana")
+        .url("https://grafana.com"))
+    .withPanel(new dashboard.PanelBuilder()
+        .type("timeseries")
+        .title("Requests")
+        .gridPos({
+            h: 8,
+            w: 12,
+            x: 0,
+            y: 0,
+        })
+        .withTarget(new dashboard.TargetBuilder()
+            .refId("A")
+            .expr("rate(requests_total[5m])"))
+        .options({
+            legend: {
+                showLegend: true,
+            },
+        }))
+    .withRow(new dashboard.RowBuilder("Details"));
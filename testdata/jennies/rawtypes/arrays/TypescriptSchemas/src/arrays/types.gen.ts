@@ -0,0 +1,58 @@
+import * as cog from '../cog';
+
+
+// List of tags, maybe?
+export type ArrayOfStrings = string[];
+
+export const defaultArrayOfStrings = (): ArrayOfStrings => ([]);
+
+export const ArrayOfStringsSchema: cog.schema.Schema<ArrayOfStrings> = cog.schema.array(cog.schema.string());
+
+// parseArrayOfStrings checks that the given input is a valid `ArrayOfStrings` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseArrayOfStrings = (input: unknown): ArrayOfStrings => cog.schema.parse(ArrayOfStringsSchema, input);
+
+// isArrayOfStrings checks whether the given input is a valid `ArrayOfStrings`.
+export const isArrayOfStrings = (input: unknown): input is ArrayOfStrings => cog.schema.is(ArrayOfStringsSchema, input);
+
+export interface someStruct {
+	FieldAny: any;
+}
+
+export const defaultSomeStruct = (): someStruct => ({
+	FieldAny: {},
+});
+
+export const someStructSchema: cog.schema.Schema<someStruct> = cog.schema.object({
+	FieldAny: cog.schema.any(),
+});
+
+// parseSomeStruct checks that the given input is a valid `someStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeStruct = (input: unknown): someStruct => cog.schema.parse(someStructSchema, input);
+
+// isSomeStruct checks whether the given input is a valid `someStruct`.
+export const isSomeStruct = (input: unknown): input is someStruct => cog.schema.is(someStructSchema, input);
+
+export type ArrayOfRefs = someStruct[];
+
+export const defaultArrayOfRefs = (): ArrayOfRefs => ([]);
+
+export const ArrayOfRefsSchema: cog.schema.Schema<ArrayOfRefs> = cog.schema.array(cog.schema.lazy(() => someStructSchema));
+
+// parseArrayOfRefs checks that the given input is a valid `ArrayOfRefs` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseArrayOfRefs = (input: unknown): ArrayOfRefs => cog.schema.parse(ArrayOfRefsSchema, input);
+
+// isArrayOfRefs checks whether the given input is a valid `ArrayOfRefs`.
+export const isArrayOfRefs = (input: unknown): input is ArrayOfRefs => cog.schema.is(ArrayOfRefsSchema, input);
+
+export type ArrayOfArrayOfNumbers = number[][];
+
+export const defaultArrayOfArrayOfNumbers = (): ArrayOfArrayOfNumbers => ([]);
+
+export const ArrayOfArrayOfNumbersSchema: cog.schema.Schema<ArrayOfArrayOfNumbers> = cog.schema.array(cog.schema.array(cog.schema.integer()));
+
+// parseArrayOfArrayOfNumbers checks that the given input is a valid `ArrayOfArrayOfNumbers` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseArrayOfArrayOfNumbers = (input: unknown): ArrayOfArrayOfNumbers => cog.schema.parse(ArrayOfArrayOfNumbersSchema, input);
+
+// isArrayOfArrayOfNumbers checks whether the given input is a valid `ArrayOfArrayOfNumbers`.
+export const isArrayOfArrayOfNumbers = (input: unknown): input is ArrayOfArrayOfNumbers => cog.schema.is(ArrayOfArrayOfNumbersSchema, input);
+
@@ -0,0 +1,8 @@
+package constraints
+
+type SomeStruct struct {
+	Id uint64 `json:"id"`
+	Title string `json:"title"`
+	Slug string `json:"slug"`
+}
+
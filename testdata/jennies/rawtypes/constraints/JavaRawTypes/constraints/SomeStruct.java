@@ -0,0 +1,8 @@
+package constraints;
+
+
+public class SomeStruct {
+    public Long id;
+    public String title;
+    public String slug;
+}
@@ -0,0 +1,51 @@
+<?php
+
+namespace Grafana\Foundation\Constraints;
+
+class SomeStruct implements \JsonSerializable
+{
+    public int $id;
+
+    public string $title;
+
+    public string $slug;
+
+    /**
+     * @param int|null $id
+     * @param string|null $title
+     * @param string|null $slug
+     */
+    public function __construct(?int $id = null, ?string $title = null, ?string $slug = null)
+    {
+        $this->id = $id ?: 0;
+        $this->title = $title ?: "";
+        $this->slug = $slug ?: "";
+    }
+
+    /**
+     * @param array<string, mixed> $inputData
+     */
+    public static function fromArray(array $inputData): self
+    {
+        /** @var array{id?: int, title?: string, slug?: string} $inputData */
+        $data = $inputData;
+        return new self(
+            id: $data["id"] ?? null,
+            title: $data["title"] ?? null,
+            slug: $data["slug"] ?? null,
+        );
+    }
+
+    /**
+     * @return array<string, mixed>
+     */
+    public function jsonSerialize(): array
+    {
+        $data = [
+            "id" => $this->id,
+            "title" => $this->title,
+            "slug" => $this->slug,
+        ];
+        return $data;
+    }
+}
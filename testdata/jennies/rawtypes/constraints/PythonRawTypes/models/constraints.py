@@ -0,0 +1,33 @@
+import typing
+
+
+class SomeStruct:
+    id_val: int
+    title: str
+    slug: str
+
+    def __init__(self, id_val: int = 0, title: str = "", slug: str = ""):
+        self.id_val = id_val
+        self.title = title
+        self.slug = slug
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "id": self.id_val,
+            "title": self.title,
+            "slug": self.slug,
+        }
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "id" in data:
+            args["id_val"] = data["id"]
+        if "title" in data:
+            args["title"] = data["title"]
+        if "slug" in data:
+            args["slug"] = data["slug"]        
+
+        return cls(**args)
@@ -0,0 +1,9 @@
+use serde::{Deserialize, Serialize};
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct SomeStruct {
+    pub id: u64,
+    pub title: String,
+    pub slug: String,
+}
+
@@ -0,0 +1,14 @@
+package constraints
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+
+type SomeStructDataSourceModel struct {
+     Id types.Int64 `tfsdk:"id"`
+     Title types.String `tfsdk:"title"`
+     Slug types.String `tfsdk:"slug"`
+  TemporaryScalarPlaceholder types.Bool // @TODO Remove this once non-scalars are implemented
+}
+
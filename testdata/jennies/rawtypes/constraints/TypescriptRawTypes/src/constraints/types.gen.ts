@@ -0,0 +1,12 @@
+export interface SomeStruct {
+	id: number;
+	title: string;
+	slug: string;
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+	id: 0,
+	title: "",
+	slug: "",
+});
+
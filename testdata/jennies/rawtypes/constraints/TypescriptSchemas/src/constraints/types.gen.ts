@@ -0,0 +1,27 @@
+import * as cog from '../cog';
+
+
+export interface SomeStruct {
+	id: number;
+	title: string;
+	slug: string;
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+	id: 0,
+	title: "",
+	slug: "",
+});
+
+export const SomeStructSchema: cog.schema.Schema<SomeStruct> = cog.schema.object({
+	id: cog.schema.integer().refine((value) => value >= 5, "must be >= 5").refine((value) => value < 10, "must be < 10"),
+	title: cog.schema.string().refine((value) => value.length >= 1, "length must be >= 1").refine((value) => value.length <= 64, "length must be <= 64"),
+	slug: cog.schema.string().refine((value) => new RegExp("^[a-z0-9-]+$").test(value), "must match the pattern ^[a-z0-9-]+$").refine((value) => !new RegExp("^-").test(value), "must not match the pattern ^-"),
+}, ["id", "title", "slug"]);
+
+// parseSomeStruct checks that the given input is a valid `SomeStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeStruct = (input: unknown): SomeStruct => cog.schema.parse(SomeStructSchema, input);
+
+// isSomeStruct checks whether the given input is a valid `SomeStruct`.
+export const isSomeStruct = (input: unknown): input is SomeStruct => cog.schema.is(SomeStructSchema, input);
+
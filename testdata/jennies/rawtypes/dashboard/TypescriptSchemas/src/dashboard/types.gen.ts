@@ -0,0 +1,107 @@
+import * as cog from '../cog';
+
+
+export interface Dashboard {
+	title: string;
+	panels?: Panel[];
+}
+
+export const defaultDashboard = (): Dashboard => ({
+	title: "",
+});
+
+export const DashboardSchema: cog.schema.Schema<Dashboard> = cog.schema.object({
+	title: cog.schema.string(),
+	panels: cog.schema.array(cog.schema.lazy(() => PanelSchema)),
+}, ["title"]);
+
+// parseDashboard checks that the given input is a valid `Dashboard` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseDashboard = (input: unknown): Dashboard => cog.schema.parse(DashboardSchema, input);
+
+// isDashboard checks whether the given input is a valid `Dashboard`.
+export const isDashboard = (input: unknown): input is Dashboard => cog.schema.is(DashboardSchema, input);
+
+export interface DataSourceRef {
+	type?: string;
+	uid?: string;
+}
+
+export const defaultDataSourceRef = (): DataSourceRef => ({
+});
+
+export const DataSourceRefSchema: cog.schema.Schema<DataSourceRef> = cog.schema.object({
+	type: cog.schema.string(),
+	uid: cog.schema.string(),
+});
+
+// parseDataSourceRef checks that the given input is a valid `DataSourceRef` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseDataSourceRef = (input: unknown): DataSourceRef => cog.schema.parse(DataSourceRefSchema, input);
+
+// isDataSourceRef checks whether the given input is a valid `DataSourceRef`.
+export const isDataSourceRef = (input: unknown): input is DataSourceRef => cog.schema.is(DataSourceRefSchema, input);
+
+export interface FieldConfigSource {
+	defaults?: FieldConfig;
+}
+
+export const defaultFieldConfigSource = (): FieldConfigSource => ({
+});
+
+export const FieldConfigSourceSchema: cog.schema.Schema<FieldConfigSource> = cog.schema.object({
+	defaults: cog.schema.lazy(() => FieldConfigSchema),
+});
+
+// parseFieldConfigSource checks that the given input is a valid `FieldConfigSource` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseFieldConfigSource = (input: unknown): FieldConfigSource => cog.schema.parse(FieldConfigSourceSchema, input);
+
+// isFieldConfigSource checks whether the given input is a valid `FieldConfigSource`.
+export const isFieldConfigSource = (input: unknown): input is FieldConfigSource => cog.schema.is(FieldConfigSourceSchema, input);
+
+export interface FieldConfig {
+	unit?: string;
+	custom?: any;
+}
+
+export const defaultFieldConfig = (): FieldConfig => ({
+});
+
+export const FieldConfigSchema: cog.schema.Schema<FieldConfig> = cog.schema.object({
+	unit: cog.schema.string(),
+	custom: cog.schema.any(),
+});
+
+// parseFieldConfig checks that the given input is a valid `FieldConfig` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseFieldConfig = (input: unknown): FieldConfig => cog.schema.parse(FieldConfigSchema, input);
+
+// isFieldConfig checks whether the given input is a valid `FieldConfig`.
+export const isFieldConfig = (input: unknown): input is FieldConfig => cog.schema.is(FieldConfigSchema, input);
+
+export interface Panel {
+	title: string;
+	type: string;
+	datasource?: DataSourceRef;
+	options?: any;
+	targets?: cog.Dataquery[];
+	fieldConfig?: FieldConfigSource;
+}
+
+export const defaultPanel = (): Panel => ({
+	title: "",
+	type: "",
+});
+
+export const PanelSchema: cog.schema.Schema<Panel> = cog.schema.object({
+	title: cog.schema.string(),
+	type: cog.schema.string(),
+	datasource: cog.schema.lazy(() => DataSourceRefSchema),
+	options: cog.schema.any(),
+	targets: cog.schema.array(cog.schema.variant("dataquery")),
+	fieldConfig: cog.schema.lazy(() => FieldConfigSourceSchema),
+}, ["title", "type"]);
+
+// parsePanel checks that the given input is a valid `Panel` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parsePanel = (input: unknown): Panel => cog.schema.parse(PanelSchema, input);
+
+// isPanel checks whether the given input is a valid `Panel`.
+export const isPanel = (input: unknown): input is Panel => cog.schema.is(PanelSchema, input);
+
@@ -0,0 +1,33 @@
+package deprecation
+
+// SomeStruct, to hold data.
+type SomeStruct struct {
+	// id identifies something.
+Id int64 `json:"id"`
+	// Title of the thing.
+//
+// Deprecated: use label instead
+Title string `json:"title"`
+	Label string `json:"label"`
+	// Deprecated: this element is deprecated and might be removed in a future version.
+Legacy *bool `json:"legacy,omitempty"`
+}
+
+// LegacyStruct is kept around for compatibility.
+//
+// Deprecated: use SomeStruct instead
+type LegacyStruct struct {
+	Foo string `json:"foo"`
+}
+
+// Deprecated: this element is deprecated and might be removed in a future version.
+type LegacyStatus string
+const (
+	LegacyStatusOn LegacyStatus = "on"
+	LegacyStatusOff LegacyStatus = "off"
+)
+
+
+// Deprecated: use SomeStruct instead
+type LegacyAlias = SomeStruct
+
@@ -0,0 +1,17 @@
+package deprecation;
+
+
+/** @deprecated use SomeStruct instead */
+@Deprecated
+public class LegacyAlias {
+    // id identifies something.
+    public Long id;
+    // Title of the thing.
+    /** @deprecated use label instead */
+    @Deprecated
+    public String title;
+    public String label;
+    /** @deprecated this element is deprecated and might be removed in a future version. */
+    @Deprecated
+    public Boolean legacy;
+}
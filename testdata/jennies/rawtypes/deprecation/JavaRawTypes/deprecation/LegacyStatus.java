@@ -0,0 +1,25 @@
+package deprecation;
+
+import com.fasterxml.jackson.annotation.JsonFormat;
+import com.fasterxml.jackson.annotation.JsonValue;
+
+
+/** @deprecated this element is deprecated and might be removed in a future version. */
+@Deprecated
+@JsonFormat(shape = JsonFormat.Shape.OBJECT)
+public enum LegacyStatus {
+    ON("on"),
+    OFF("off"),
+    _EMPTY("");
+
+    private final String value;
+
+    private LegacyStatus(String value) {
+        this.value = value;
+    }
+
+    @JsonValue
+    public String Value() {
+        return value;
+    }
+}
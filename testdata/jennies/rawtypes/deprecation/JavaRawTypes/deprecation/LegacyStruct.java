@@ -0,0 +1,9 @@
+package deprecation;
+
+
+// LegacyStruct is kept around for compatibility.
+/** @deprecated use SomeStruct instead */
+@Deprecated
+public class LegacyStruct {
+    public String foo;
+}
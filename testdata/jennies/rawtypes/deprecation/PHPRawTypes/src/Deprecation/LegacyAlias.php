@@ -0,0 +1,8 @@
+<?php
+
+namespace Grafana\Foundation\Deprecation;
+
+/**
+ * @deprecated use SomeStruct instead
+ */
+class LegacyAlias extends \Grafana\Foundation\Deprecation\SomeStruct {}
@@ -0,0 +1,65 @@
+<?php
+
+namespace Grafana\Foundation\Deprecation;
+
+/**
+ * @deprecated this element is deprecated and might be removed in a future version.
+ */
+final class LegacyStatus implements \JsonSerializable, \Stringable {
+    /**
+     * @var string
+     */
+    private $value;
+
+    /**
+     * @var array<string, LegacyStatus>
+     */
+    private static $instances = [];
+
+    private function __construct(string $value)
+    {
+        $this->value = $value;
+    }
+
+    public static function on(): self
+    {
+        if (!isset(self::$instances["on"])) {
+            self::$instances["on"] = new self("on");
+        }
+
+        return self::$instances["on"];
+    }
+
+    public static function off(): self
+    {
+        if (!isset(self::$instances["off"])) {
+            self::$instances["off"] = new self("off");
+        }
+
+        return self::$instances["off"];
+    }
+
+    public static function fromValue(string $value): self
+    {
+        if ($value === "on") {
+            return self::on();
+        }
+
+        if ($value === "off") {
+            return self::off();
+        }
+
+        throw new \UnexpectedValueException("Value '$value' is not part of the enum LegacyStatus");
+    }
+
+    public function jsonSerialize(): string
+    {
+        return $this->value;
+    }
+
+    public function __toString(): string
+    {
+        return $this->value;
+    }
+}
+
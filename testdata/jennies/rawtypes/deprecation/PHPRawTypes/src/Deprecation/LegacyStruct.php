@@ -0,0 +1,43 @@
+<?php
+
+namespace Grafana\Foundation\Deprecation;
+
+/**
+ * LegacyStruct is kept around for compatibility.
+ * @deprecated use SomeStruct instead
+ */
+class LegacyStruct implements \JsonSerializable
+{
+    public string $foo;
+
+    /**
+     * @param string|null $foo
+     */
+    public function __construct(?string $foo = null)
+    {
+        $this->foo = $foo ?: "";
+    }
+
+    /**
+     * @param array<string, mixed> $inputData
+     */
+    public static function fromArray(array $inputData): self
+    {
+        /** @var array{foo?: string} $inputData */
+        $data = $inputData;
+        return new self(
+            foo: $data["foo"] ?? null,
+        );
+    }
+
+    /**
+     * @return array<string, mixed>
+     */
+    public function jsonSerialize(): array
+    {
+        $data = [
+            "foo" => $this->foo,
+        ];
+        return $data;
+    }
+}
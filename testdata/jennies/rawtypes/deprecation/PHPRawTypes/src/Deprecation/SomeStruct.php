@@ -0,0 +1,72 @@
+<?php
+
+namespace Grafana\Foundation\Deprecation;
+
+/**
+ * SomeStruct, to hold data.
+ */
+class SomeStruct implements \JsonSerializable
+{
+    /**
+     * id identifies something.
+     */
+    public int $id;
+
+    /**
+     * Title of the thing.
+     * @deprecated use label instead
+     */
+    public string $title;
+
+    public string $label;
+
+    /**
+     * @deprecated this element is deprecated and might be removed in a future version.
+     */
+    public ?bool $legacy;
+
+    /**
+     * @param int|null $id
+     * @param string|null $title
+     * @param string|null $label
+     * @param bool|null $legacy
+     */
+    public function __construct(?int $id = null, ?string $title = null, ?string $label = null, ?bool $legacy = null)
+    {
+        $this->id = $id ?: 0;
+        $this->title = $title ?: "";
+        $this->label = $label ?: "";
+        $this->legacy = $legacy;
+    }
+
+    /**
+     * @param array<string, mixed> $inputData
+     */
+    public static function fromArray(array $inputData): self
+    {
+        /** @var array{id?: int, title?: string, label?: string, legacy?: bool} $inputData */
+        $data = $inputData;
+        return new self(
+            id: $data["id"] ?? null,
+            title: $data["title"] ?? null,
+            label: $data["label"] ?? null,
+            legacy: $data["legacy"] ?? null,
+        );
+    }
+
+    /**
+     * @return array<string, mixed>
+     */
+    public function jsonSerialize(): array
+    {
+        $data = [
+            "id" => $this->id,
+            "title" => $this->title,
+            "label" => $this->label,
+        ];
+        if (isset($this->legacy)) {
+            $data["legacy"] = $this->legacy;
+        }
+        return $data;
+    }
+}
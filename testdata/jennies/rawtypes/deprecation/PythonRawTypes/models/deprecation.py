@@ -0,0 +1,89 @@
+import typing
+import typing_extensions
+import enum
+
+
+class SomeStruct:
+    """
+    SomeStruct, to hold data.
+    """
+
+    # id identifies something.
+    id_val: int
+    # Title of the thing.
+    # Deprecated: use label instead
+    title: str
+    label: str
+    # Deprecated: this element is deprecated and might be removed in a future version.
+    legacy: typing.Optional[bool]
+
+    def __init__(self, id_val: int = 0, title: str = "", label: str = "", legacy: typing.Optional[bool] = None):
+        self.id_val = id_val
+        self.title = title
+        self.label = label
+        self.legacy = legacy
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "id": self.id_val,
+            "title": self.title,
+            "label": self.label,
+        }
+        if self.legacy is not None:
+            payload["legacy"] = self.legacy
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "id" in data:
+            args["id_val"] = data["id"]
+        if "title" in data:
+            args["title"] = data["title"]
+        if "label" in data:
+            args["label"] = data["label"]
+        if "legacy" in data:
+            args["legacy"] = data["legacy"]        
+
+        return cls(**args)
+
+
+@typing_extensions.deprecated("use SomeStruct instead")
+class LegacyStruct:
+    """
+    LegacyStruct is kept around for compatibility.
+    """
+
+    foo: str
+
+    def __init__(self, foo: str = ""):
+        self.foo = foo
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "foo": self.foo,
+        }
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "foo" in data:
+            args["foo"] = data["foo"]        
+
+        return cls(**args)
+
+
+# Deprecated: this element is deprecated and might be removed in a future version.
+class LegacyStatus(enum.StrEnum):
+    ON = "on"
+    OFF = "off"
+
+
+# Deprecated: use SomeStruct instead
+LegacyAlias: typing.TypeAlias = 'SomeStruct'
+
+
+
@@ -0,0 +1,31 @@
+use serde::{Deserialize, Serialize};
+
+/// SomeStruct, to hold data.
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct SomeStruct {
+    /// id identifies something.
+    pub id: i64,
+    /// Title of the thing.
+    pub title: String,
+    pub label: String,
+    #[serde(default, skip_serializing_if = "Option::is_none")]
+    pub legacy: Option<bool>,
+}
+
+/// LegacyStruct is kept around for compatibility.
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct LegacyStruct {
+    pub foo: String,
+}
+
+#[derive(Clone, Copy, Debug, Default, PartialEq, Eq, Hash, Serialize, Deserialize)]
+pub enum LegacyStatus {
+    #[default]
+    #[serde(rename = "on")]
+    On,
+    #[serde(rename = "off")]
+    Off,
+}
+
+pub type LegacyAlias = SomeStruct;
+
@@ -0,0 +1,20 @@
+package deprecation
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+
+type SomeStructDataSourceModel struct {
+     Id types.Int64 `tfsdk:"id"`
+     Title types.String `tfsdk:"title"`
+     Label types.String `tfsdk:"label"`
+     Legacy types.Bool `tfsdk:"legacy"`
+  TemporaryScalarPlaceholder types.Bool // @TODO Remove this once non-scalars are implemented
+}
+
+type LegacyStructDataSourceModel struct {
+     Foo types.String `tfsdk:"foo"`
+  TemporaryScalarPlaceholder types.Bool // @TODO Remove this once non-scalars are implemented
+}
+
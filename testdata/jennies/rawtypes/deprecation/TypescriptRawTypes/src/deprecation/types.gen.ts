@@ -0,0 +1,41 @@
+// SomeStruct, to hold data.
+export interface SomeStruct {
+	// id identifies something.
+	id: number;
+	// Title of the thing.
+	/** @deprecated use label instead */
+	title: string;
+	label: string;
+	/** @deprecated this element is deprecated and might be removed in a future version. */
+	legacy?: boolean;
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+	id: 0,
+	title: "",
+	label: "",
+});
+
+// LegacyStruct is kept around for compatibility.
+/** @deprecated use SomeStruct instead */
+export interface LegacyStruct {
+	foo: string;
+}
+
+export const defaultLegacyStruct = (): LegacyStruct => ({
+	foo: "",
+});
+
+/** @deprecated this element is deprecated and might be removed in a future version. */
+export enum LegacyStatus {
+	On = "on",
+	Off = "off",
+}
+
+export const defaultLegacyStatus = (): LegacyStatus => (LegacyStatus.On);
+
+/** @deprecated use SomeStruct instead */
+export type LegacyAlias = SomeStruct;
+
+export const defaultLegacyAlias = (): LegacyAlias => (defaultSomeStruct());
+
@@ -0,0 +1,83 @@
+import * as cog from '../cog';
+
+
+// SomeStruct, to hold data.
+export interface SomeStruct {
+	// id identifies something.
+	id: number;
+	// Title of the thing.
+	/** @deprecated use label instead */
+	title: string;
+	label: string;
+	/** @deprecated this element is deprecated and might be removed in a future version. */
+	legacy?: boolean;
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+	id: 0,
+	title: "",
+	label: "",
+});
+
+export const SomeStructSchema: cog.schema.Schema<SomeStruct> = cog.schema.object({
+	id: cog.schema.integer(),
+	title: cog.schema.string(),
+	label: cog.schema.string(),
+	legacy: cog.schema.boolean(),
+}, ["id", "title", "label"]);
+
+// parseSomeStruct checks that the given input is a valid `SomeStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeStruct = (input: unknown): SomeStruct => cog.schema.parse(SomeStructSchema, input);
+
+// isSomeStruct checks whether the given input is a valid `SomeStruct`.
+export const isSomeStruct = (input: unknown): input is SomeStruct => cog.schema.is(SomeStructSchema, input);
+
+// LegacyStruct is kept around for compatibility.
+/** @deprecated use SomeStruct instead */
+export interface LegacyStruct {
+	foo: string;
+}
+
+export const defaultLegacyStruct = (): LegacyStruct => ({
+	foo: "",
+});
+
+export const LegacyStructSchema: cog.schema.Schema<LegacyStruct> = cog.schema.object({
+	foo: cog.schema.string(),
+}, ["foo"]);
+
+// parseLegacyStruct checks that the given input is a valid `LegacyStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseLegacyStruct = (input: unknown): LegacyStruct => cog.schema.parse(LegacyStructSchema, input);
+
+// isLegacyStruct checks whether the given input is a valid `LegacyStruct`.
+export const isLegacyStruct = (input: unknown): input is LegacyStruct => cog.schema.is(LegacyStructSchema, input);
+
+/** @deprecated this element is deprecated and might be removed in a future version. */
+export enum LegacyStatus {
+	On = "on",
+	Off = "off",
+}
+
+export const defaultLegacyStatus = (): LegacyStatus => (LegacyStatus.On);
+
+export const LegacyStatusSchema: cog.schema.Schema<LegacyStatus> = cog.schema.enumOf(["on", "off"]);
+
+// parseLegacyStatus checks that the given input is a valid `LegacyStatus` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseLegacyStatus = (input: unknown): LegacyStatus => cog.schema.parse(LegacyStatusSchema, input);
+
+// isLegacyStatus checks whether the given input is a valid `LegacyStatus`.
+export const isLegacyStatus = (input: unknown): input is LegacyStatus => cog.schema.is(LegacyStatusSchema, input);
+
+/** @deprecated use SomeStruct instead */
+export type LegacyAlias = SomeStruct;
+
+export const defaultLegacyAlias = (): LegacyAlias => (defaultSomeStruct());
+
+export const LegacyAliasSchema: cog.schema.Schema<LegacyAlias> = cog.schema.lazy(() => SomeStructSchema);
+
+// parseLegacyAlias checks that the given input is a valid `LegacyAlias` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseLegacyAlias = (input: unknown): LegacyAlias => cog.schema.parse(LegacyAliasSchema, input);
+
+// isLegacyAlias checks whether the given input is a valid `LegacyAlias`.
+export const isLegacyAlias = (input: unknown): input is LegacyAlias => cog.schema.is(LegacyAliasSchema, input);
+
@@ -0,0 +1,91 @@
+use serde::{Deserialize, Serialize};
+
+/// Refresh rate or disabled.
+pub type RefreshRate = StringOrBool;
+
+pub type StringOrNull = Option<String>;
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct SomeStruct {
+    #[serde(rename = "Type")]
+    pub type_: String,
+    #[serde(rename = "FieldAny")]
+    pub field_any: serde_json::Value,
+}
+
+pub type BoolOrRef = BoolOrSomeStruct;
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct SomeOtherStruct {
+    #[serde(rename = "Type")]
+    pub type_: String,
+    #[serde(rename = "Foo")]
+    pub foo: Vec<u8>,
+}
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct YetAnotherStruct {
+    #[serde(rename = "Type")]
+    pub type_: String,
+    #[serde(rename = "Bar")]
+    pub bar: u8,
+}
+
+pub type SeveralRefs = SomeStructOrSomeOtherStructOrYetAnotherStruct;
+
+#[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
+#[serde(untagged)]
+pub enum StringOrBool {
+    String(String),
+    Bool(bool),
+}
+
+impl Default for StringOrBool {
+    fn default() -> Self {
+        Self::String(Default::default())
+    }
+}
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct BoolOrSomeStruct {
+    #[serde(rename = "Bool", default, skip_serializing_if = "Option::is_none")]
+    pub bool: Option<bool>,
+    #[serde(rename = "SomeStruct", default, skip_serializing_if = "Option::is_none")]
+    pub some_struct: Option<SomeStruct>,
+}
+
+#[derive(Clone, Debug, PartialEq, Serialize)]
+#[serde(untagged)]
+pub enum SomeStructOrSomeOtherStructOrYetAnotherStruct {
+    SomeStruct(SomeStruct),
+    SomeOtherStruct(SomeOtherStruct),
+    YetAnotherStruct(YetAnotherStruct),
+}
+
+impl Default for SomeStructOrSomeOtherStructOrYetAnotherStruct {
+    fn default() -> Self {
+        Self::SomeStruct(Default::default())
+    }
+}
+
+impl<'de> Deserialize<'de> for SomeStructOrSomeOtherStructOrYetAnotherStruct {
+    fn deserialize<D>(deserializer: D) -> Result<Self, D::Error>
+    where
+        D: serde::Deserializer<'de>,
+    {
+        let raw = serde_json::Value::deserialize(deserializer)?;
+        let discriminator = raw
+            .get("Type")
+            .and_then(serde_json::Value::as_str)
+            .ok_or_else(|| serde::de::Error::custom("discriminator field 'Type' not found in payload"))?
+            .to_string();
+
+        match discriminator.as_str() {
+            "some-other-struct" => serde_json::from_value(raw).map(Self::SomeOtherStruct).map_err(serde::de::Error::custom),
+            "some-struct" => serde_json::from_value(raw).map(Self::SomeStruct).map_err(serde::de::Error::custom),
+            "yet-another-struct" => serde_json::from_value(raw).map(Self::YetAnotherStruct).map_err(serde::de::Error::custom),
+            _ => Err(serde::de::Error::custom(format!("could not unmarshal resource with `Type = {}`", discriminator))),
+        }
+    }
+}
+
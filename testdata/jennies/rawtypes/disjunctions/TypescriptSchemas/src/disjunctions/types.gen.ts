@@ -0,0 +1,119 @@
+import * as cog from '../cog';
+
+
+// Refresh rate or disabled.
+export type RefreshRate = string | boolean;
+
+export const defaultRefreshRate = (): RefreshRate => ("");
+
+export const RefreshRateSchema: cog.schema.Schema<RefreshRate> = cog.schema.union([cog.schema.string(), cog.schema.boolean()]);
+
+// parseRefreshRate checks that the given input is a valid `RefreshRate` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseRefreshRate = (input: unknown): RefreshRate => cog.schema.parse(RefreshRateSchema, input);
+
+// isRefreshRate checks whether the given input is a valid `RefreshRate`.
+export const isRefreshRate = (input: unknown): input is RefreshRate => cog.schema.is(RefreshRateSchema, input);
+
+export type StringOrNull = string | null;
+
+export const defaultStringOrNull = (): StringOrNull => ("");
+
+export const StringOrNullSchema: cog.schema.Schema<StringOrNull> = cog.schema.union([cog.schema.string(), cog.schema.nullValue()]);
+
+// parseStringOrNull checks that the given input is a valid `StringOrNull` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseStringOrNull = (input: unknown): StringOrNull => cog.schema.parse(StringOrNullSchema, input);
+
+// isStringOrNull checks whether the given input is a valid `StringOrNull`.
+export const isStringOrNull = (input: unknown): input is StringOrNull => cog.schema.is(StringOrNullSchema, input);
+
+export interface SomeStruct {
+	Type: "some-struct";
+	FieldAny: any;
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+	Type: "some-struct",
+	FieldAny: {},
+});
+
+export const SomeStructSchema: cog.schema.Schema<SomeStruct> = cog.schema.object({
+	Type: cog.schema.literal("some-struct"),
+	FieldAny: cog.schema.any(),
+}, ["Type"]);
+
+// parseSomeStruct checks that the given input is a valid `SomeStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeStruct = (input: unknown): SomeStruct => cog.schema.parse(SomeStructSchema, input);
+
+// isSomeStruct checks whether the given input is a valid `SomeStruct`.
+export const isSomeStruct = (input: unknown): input is SomeStruct => cog.schema.is(SomeStructSchema, input);
+
+export type BoolOrRef = boolean | SomeStruct;
+
+export const defaultBoolOrRef = (): BoolOrRef => (false);
+
+export const BoolOrRefSchema: cog.schema.Schema<BoolOrRef> = cog.schema.union([cog.schema.boolean(), cog.schema.lazy(() => SomeStructSchema)]);
+
+// parseBoolOrRef checks that the given input is a valid `BoolOrRef` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseBoolOrRef = (input: unknown): BoolOrRef => cog.schema.parse(BoolOrRefSchema, input);
+
+// isBoolOrRef checks whether the given input is a valid `BoolOrRef`.
+export const isBoolOrRef = (input: unknown): input is BoolOrRef => cog.schema.is(BoolOrRefSchema, input);
+
+export interface SomeOtherStruct {
+	Type: "some-other-struct";
+	Foo: string;
+}
+
+export const defaultSomeOtherStruct = (): SomeOtherStruct => ({
+	Type: "some-other-struct",
+	Foo: "",
+});
+
+export const SomeOtherStructSchema: cog.schema.Schema<SomeOtherStruct> = cog.schema.object({
+	Type: cog.schema.literal("some-other-struct"),
+	Foo: cog.schema.string(),
+}, ["Type", "Foo"]);
+
+// parseSomeOtherStruct checks that the given input is a valid `SomeOtherStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeOtherStruct = (input: unknown): SomeOtherStruct => cog.schema.parse(SomeOtherStructSchema, input);
+
+// isSomeOtherStruct checks whether the given input is a valid `SomeOtherStruct`.
+export const isSomeOtherStruct = (input: unknown): input is SomeOtherStruct => cog.schema.is(SomeOtherStructSchema, input);
+
+export interface YetAnotherStruct {
+	Type: "yet-another-struct";
+	Bar: number;
+}
+
+export const defaultYetAnotherStruct = (): YetAnotherStruct => ({
+	Type: "yet-another-struct",
+	Bar: 0,
+});
+
+export const YetAnotherStructSchema: cog.schema.Schema<YetAnotherStruct> = cog.schema.object({
+	Type: cog.schema.literal("yet-another-struct"),
+	Bar: cog.schema.integer(),
+}, ["Type", "Bar"]);
+
+// parseYetAnotherStruct checks that the given input is a valid `YetAnotherStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseYetAnotherStruct = (input: unknown): YetAnotherStruct => cog.schema.parse(YetAnotherStructSchema, input);
+
+// isYetAnotherStruct checks whether the given input is a valid `YetAnotherStruct`.
+export const isYetAnotherStruct = (input: unknown): input is YetAnotherStruct => cog.schema.is(YetAnotherStructSchema, input);
+
+export type SeveralRefs = SomeStruct | SomeOtherStruct | YetAnotherStruct;
+
+export const defaultSeveralRefs = (): SeveralRefs => (defaultSomeStruct());
+
+export const SeveralRefsSchema: cog.schema.Schema<SeveralRefs> = cog.schema.discriminatedUnion("Type", {
+	"some-other-struct": cog.schema.lazy(() => SomeOtherStructSchema),
+	"some-struct": cog.schema.lazy(() => SomeStructSchema),
+	"yet-another-struct": cog.schema.lazy(() => YetAnotherStructSchema),
+});
+
+// parseSeveralRefs checks that the given input is a valid `SeveralRefs` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSeveralRefs = (input: unknown): SeveralRefs => cog.schema.parse(SeveralRefsSchema, input);
+
+// isSeveralRefs checks whether the given input is a valid `SeveralRefs`.
+export const isSeveralRefs = (input: unknown): input is SeveralRefs => cog.schema.is(SeveralRefsSchema, input);
+
@@ -0,0 +1,43 @@
+use serde::{Deserialize, Serialize};
+use serde_repr::{Deserialize_repr, Serialize_repr};
+
+/// This is a very interesting string enum.
+#[derive(Clone, Copy, Debug, Default, PartialEq, Eq, Hash, Serialize, Deserialize)]
+pub enum Operator {
+    #[default]
+    #[serde(rename = ">")]
+    GreaterThan,
+    #[serde(rename = "<")]
+    LessThan,
+}
+
+#[derive(Clone, Copy, Debug, Default, PartialEq, Eq, Hash, Serialize, Deserialize)]
+pub enum TableSortOrder {
+    #[default]
+    #[serde(rename = "asc")]
+    Asc,
+    #[serde(rename = "desc")]
+    Desc,
+}
+
+#[derive(Clone, Copy, Debug, Default, PartialEq, Eq, Hash, Serialize, Deserialize)]
+pub enum LogsSortOrder {
+    #[default]
+    #[serde(rename = "time_asc")]
+    Asc,
+    #[serde(rename = "time_desc")]
+    Desc,
+}
+
+/// 0 for no shared crosshair or tooltip (default).
+/// 1 for shared crosshair.
+/// 2 for shared crosshair AND shared tooltip.
+#[derive(Clone, Copy, Debug, Default, PartialEq, Eq, Hash, Serialize_repr, Deserialize_repr)]
+#[repr(i8)]
+pub enum DashboardCursorSync {
+    #[default]
+    Off = 0,
+    Crosshair = 1,
+    Tooltip = 2,
+}
+
@@ -0,0 +1,68 @@
+import * as cog from '../cog';
+
+
+// This is a very interesting string enum.
+export enum Operator {
+	GreaterThan = ">",
+	LessThan = "<",
+}
+
+export const defaultOperator = (): Operator => (Operator.GreaterThan);
+
+export const OperatorSchema: cog.schema.Schema<Operator> = cog.schema.enumOf([">", "<"]);
+
+// parseOperator checks that the given input is a valid `Operator` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseOperator = (input: unknown): Operator => cog.schema.parse(OperatorSchema, input);
+
+// isOperator checks whether the given input is a valid `Operator`.
+export const isOperator = (input: unknown): input is Operator => cog.schema.is(OperatorSchema, input);
+
+export enum TableSortOrder {
+	Asc = "asc",
+	Desc = "desc",
+}
+
+export const defaultTableSortOrder = (): TableSortOrder => (TableSortOrder.Asc);
+
+export const TableSortOrderSchema: cog.schema.Schema<TableSortOrder> = cog.schema.enumOf(["asc", "desc"]);
+
+// parseTableSortOrder checks that the given input is a valid `TableSortOrder` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseTableSortOrder = (input: unknown): TableSortOrder => cog.schema.parse(TableSortOrderSchema, input);
+
+// isTableSortOrder checks whether the given input is a valid `TableSortOrder`.
+export const isTableSortOrder = (input: unknown): input is TableSortOrder => cog.schema.is(TableSortOrderSchema, input);
+
+export enum LogsSortOrder {
+	Asc = "time_asc",
+	Desc = "time_desc",
+}
+
+export const defaultLogsSortOrder = (): LogsSortOrder => (LogsSortOrder.Asc);
+
+export const LogsSortOrderSchema: cog.schema.Schema<LogsSortOrder> = cog.schema.enumOf(["time_asc", "time_desc"]);
+
+// parseLogsSortOrder checks that the given input is a valid `LogsSortOrder` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseLogsSortOrder = (input: unknown): LogsSortOrder => cog.schema.parse(LogsSortOrderSchema, input);
+
+// isLogsSortOrder checks whether the given input is a valid `LogsSortOrder`.
+export const isLogsSortOrder = (input: unknown): input is LogsSortOrder => cog.schema.is(LogsSortOrderSchema, input);
+
+// 0 for no shared crosshair or tooltip (default).
+// 1 for shared crosshair.
+// 2 for shared crosshair AND shared tooltip.
+export enum DashboardCursorSync {
+	Off = 0,
+	Crosshair = 1,
+	Tooltip = 2,
+}
+
+export const defaultDashboardCursorSync = (): DashboardCursorSync => (DashboardCursorSync.Off);
+
+export const DashboardCursorSyncSchema: cog.schema.Schema<DashboardCursorSync> = cog.schema.enumOf([0, 1, 2]);
+
+// parseDashboardCursorSync checks that the given input is a valid `DashboardCursorSync` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseDashboardCursorSync = (input: unknown): DashboardCursorSync => cog.schema.parse(DashboardCursorSyncSchema, input);
+
+// isDashboardCursorSync checks whether the given input is a valid `DashboardCursorSync`.
+export const isDashboardCursorSync = (input: unknown): input is DashboardCursorSync => cog.schema.is(DashboardCursorSyncSchema, input);
+
@@ -0,0 +1,44 @@
+use serde::{Deserialize, Serialize};
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct NestedStruct {
+    #[serde(rename = "stringVal")]
+    pub string_val: String,
+    #[serde(rename = "intVal")]
+    pub int_val: i64,
+}
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct Struct {
+    #[serde(rename = "allFields")]
+    pub all_fields: NestedStruct,
+    #[serde(rename = "partialFields")]
+    pub partial_fields: NestedStruct,
+    #[serde(rename = "emptyFields")]
+    pub empty_fields: NestedStruct,
+    #[serde(rename = "complexField")]
+    pub complex_field: DefaultsStructComplexField,
+    #[serde(rename = "partialComplexField")]
+    pub partial_complex_field: DefaultsStructPartialComplexField,
+}
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct DefaultsStructComplexFieldNested {
+    #[serde(rename = "nestedVal")]
+    pub nested_val: String,
+}
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct DefaultsStructComplexField {
+    pub uid: String,
+    pub nested: DefaultsStructComplexFieldNested,
+    pub array: Vec<String>,
+}
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct DefaultsStructPartialComplexField {
+    pub uid: String,
+    #[serde(rename = "intVal")]
+    pub int_val: i64,
+}
+
@@ -0,0 +1,74 @@
+import * as cog from '../cog';
+
+
+export interface NestedStruct {
+	stringVal: string;
+	intVal: number;
+}
+
+export const defaultNestedStruct = (): NestedStruct => ({
+	stringVal: "",
+	intVal: 0,
+});
+
+export const NestedStructSchema: cog.schema.Schema<NestedStruct> = cog.schema.object({
+	stringVal: cog.schema.string(),
+	intVal: cog.schema.integer(),
+}, ["stringVal", "intVal"]);
+
+// parseNestedStruct checks that the given input is a valid `NestedStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseNestedStruct = (input: unknown): NestedStruct => cog.schema.parse(NestedStructSchema, input);
+
+// isNestedStruct checks whether the given input is a valid `NestedStruct`.
+export const isNestedStruct = (input: unknown): input is NestedStruct => cog.schema.is(NestedStructSchema, input);
+
+export interface Struct {
+	allFields: NestedStruct;
+	partialFields: NestedStruct;
+	emptyFields: NestedStruct;
+	complexField: {
+		uid: string;
+		nested: {
+			nestedVal: string;
+		};
+		array: string[];
+	};
+	partialComplexField: {
+		uid: string;
+		intVal: number;
+	};
+}
+
+export const defaultStruct = (): Struct => ({
+	allFields: { stringVal: "hello", intVal: 3, },
+	partialFields: { stringVal: "", intVal: 3, },
+	emptyFields: defaultNestedStruct(),
+	complexField: { uid: "myUID", nested: { nestedVal: "nested", }, array: [
+"hello",
+], },
+	partialComplexField: { uid: "", intVal: 0, },
+});
+
+export const StructSchema: cog.schema.Schema<Struct> = cog.schema.object({
+	allFields: cog.schema.lazy(() => NestedStructSchema),
+	partialFields: cog.schema.lazy(() => NestedStructSchema),
+	emptyFields: cog.schema.lazy(() => NestedStructSchema),
+	complexField: cog.schema.object({
+		uid: cog.schema.string(),
+		nested: cog.schema.object({
+			nestedVal: cog.schema.string(),
+		}, ["nestedVal"]),
+		array: cog.schema.array(cog.schema.string()),
+	}, ["uid", "nested", "array"]),
+	partialComplexField: cog.schema.object({
+		uid: cog.schema.string(),
+		intVal: cog.schema.integer(),
+	}, ["uid", "intVal"]),
+}, ["allFields", "partialFields", "emptyFields", "complexField", "partialComplexField"]);
+
+// parseStruct checks that the given input is a valid `Struct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseStruct = (input: unknown): Struct => cog.schema.parse(StructSchema, input);
+
+// isStruct checks whether the given input is a valid `Struct`.
+export const isStruct = (input: unknown): input is Struct => cog.schema.is(StructSchema, input);
+
@@ -0,0 +1,21 @@
+use serde::{Deserialize, Serialize};
+use crate::externalpkg;
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct Intersections {
+    #[serde(flatten)]
+    pub some_struct: SomeStruct,
+    #[serde(flatten)]
+    pub another_struct: externalpkg::AnotherStruct,
+    #[serde(rename = "fieldString")]
+    pub field_string: String,
+    #[serde(rename = "fieldInteger")]
+    pub field_integer: i32,
+}
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct SomeStruct {
+    #[serde(rename = "fieldBool")]
+    pub field_bool: bool,
+}
+
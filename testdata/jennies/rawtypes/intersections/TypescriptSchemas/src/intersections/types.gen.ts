@@ -0,0 +1,44 @@
+import * as externalPkg from '../externalPkg';
+import * as cog from '../cog';
+
+
+export interface Intersections extends SomeStruct, externalPkg.AnotherStruct {
+	fieldString: string;
+	fieldInteger: number;
+}
+
+export const defaultIntersections = (): Intersections => ({
+	fieldString: "hello",
+	fieldInteger: 32,
+});
+
+export const IntersectionsSchema: cog.schema.Schema<Intersections> = cog.schema.intersection([cog.schema.lazy(() => SomeStructSchema), cog.schema.any(), cog.schema.object({
+	fieldString: cog.schema.string(),
+}, ["fieldString"]), cog.schema.object({
+	fieldInteger: cog.schema.integer(),
+}, ["fieldInteger"])]);
+
+// parseIntersections checks that the given input is a valid `Intersections` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseIntersections = (input: unknown): Intersections => cog.schema.parse(IntersectionsSchema, input);
+
+// isIntersections checks whether the given input is a valid `Intersections`.
+export const isIntersections = (input: unknown): input is Intersections => cog.schema.is(IntersectionsSchema, input);
+
+export interface SomeStruct {
+	fieldBool: boolean;
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+	fieldBool: true,
+});
+
+export const SomeStructSchema: cog.schema.Schema<SomeStruct> = cog.schema.object({
+	fieldBool: cog.schema.boolean(),
+}, ["fieldBool"]);
+
+// parseSomeStruct checks that the given input is a valid `SomeStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeStruct = (input: unknown): SomeStruct => cog.schema.parse(SomeStructSchema, input);
+
+// isSomeStruct checks whether the given input is a valid `SomeStruct`.
+export const isSomeStruct = (input: unknown): input is SomeStruct => cog.schema.is(SomeStructSchema, input);
+
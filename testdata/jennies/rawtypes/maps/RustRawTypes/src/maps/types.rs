@@ -0,0 +1,17 @@
+use serde::{Deserialize, Serialize};
+
+/// String to... something.
+pub type MapOfStringToAny = std::collections::HashMap<String, serde_json::Value>;
+
+pub type MapOfStringToString = std::collections::HashMap<String, String>;
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct SomeStruct {
+    #[serde(rename = "FieldAny")]
+    pub field_any: serde_json::Value,
+}
+
+pub type MapOfStringToRef = std::collections::HashMap<String, SomeStruct>;
+
+pub type MapOfStringToMapOfStringToBool = std::collections::HashMap<String, std::collections::HashMap<String, bool>>;
+
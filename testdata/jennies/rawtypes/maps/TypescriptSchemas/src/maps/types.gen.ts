@@ -0,0 +1,70 @@
+import * as cog from '../cog';
+
+
+// String to... something.
+export type MapOfStringToAny = Record<string, any>;
+
+export const defaultMapOfStringToAny = (): MapOfStringToAny => ({});
+
+export const MapOfStringToAnySchema: cog.schema.Schema<MapOfStringToAny> = cog.schema.record(cog.schema.any());
+
+// parseMapOfStringToAny checks that the given input is a valid `MapOfStringToAny` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseMapOfStringToAny = (input: unknown): MapOfStringToAny => cog.schema.parse(MapOfStringToAnySchema, input);
+
+// isMapOfStringToAny checks whether the given input is a valid `MapOfStringToAny`.
+export const isMapOfStringToAny = (input: unknown): input is MapOfStringToAny => cog.schema.is(MapOfStringToAnySchema, input);
+
+export type MapOfStringToString = Record<string, string>;
+
+export const defaultMapOfStringToString = (): MapOfStringToString => ({});
+
+export const MapOfStringToStringSchema: cog.schema.Schema<MapOfStringToString> = cog.schema.record(cog.schema.string());
+
+// parseMapOfStringToString checks that the given input is a valid `MapOfStringToString` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseMapOfStringToString = (input: unknown): MapOfStringToString => cog.schema.parse(MapOfStringToStringSchema, input);
+
+// isMapOfStringToString checks whether the given input is a valid `MapOfStringToString`.
+export const isMapOfStringToString = (input: unknown): input is MapOfStringToString => cog.schema.is(MapOfStringToStringSchema, input);
+
+export interface SomeStruct {
+	FieldAny: any;
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+	FieldAny: {},
+});
+
+export const SomeStructSchema: cog.schema.Schema<SomeStruct> = cog.schema.object({
+	FieldAny: cog.schema.any(),
+});
+
+// parseSomeStruct checks that the given input is a valid `SomeStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeStruct = (input: unknown): SomeStruct => cog.schema.parse(SomeStructSchema, input);
+
+// isSomeStruct checks whether the given input is a valid `SomeStruct`.
+export const isSomeStruct = (input: unknown): input is SomeStruct => cog.schema.is(SomeStructSchema, input);
+
+export type MapOfStringToRef = Record<string, SomeStruct>;
+
+export const defaultMapOfStringToRef = (): MapOfStringToRef => ({});
+
+export const MapOfStringToRefSchema: cog.schema.Schema<MapOfStringToRef> = cog.schema.record(cog.schema.lazy(() => SomeStructSchema));
+
+// parseMapOfStringToRef checks that the given input is a valid `MapOfStringToRef` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseMapOfStringToRef = (input: unknown): MapOfStringToRef => cog.schema.parse(MapOfStringToRefSchema, input);
+
+// isMapOfStringToRef checks whether the given input is a valid `MapOfStringToRef`.
+export const isMapOfStringToRef = (input: unknown): input is MapOfStringToRef => cog.schema.is(MapOfStringToRefSchema, input);
+
+export type MapOfStringToMapOfStringToBool = Record<string, Record<string, boolean>>;
+
+export const defaultMapOfStringToMapOfStringToBool = (): MapOfStringToMapOfStringToBool => ({});
+
+export const MapOfStringToMapOfStringToBoolSchema: cog.schema.Schema<MapOfStringToMapOfStringToBool> = cog.schema.record(cog.schema.record(cog.schema.boolean()));
+
+// parseMapOfStringToMapOfStringToBool checks that the given input is a valid `MapOfStringToMapOfStringToBool` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseMapOfStringToMapOfStringToBool = (input: unknown): MapOfStringToMapOfStringToBool => cog.schema.parse(MapOfStringToMapOfStringToBoolSchema, input);
+
+// isMapOfStringToMapOfStringToBool checks whether the given input is a valid `MapOfStringToMapOfStringToBool`.
+export const isMapOfStringToMapOfStringToBool = (input: unknown): input is MapOfStringToMapOfStringToBool => cog.schema.is(MapOfStringToMapOfStringToBoolSchema, input);
+
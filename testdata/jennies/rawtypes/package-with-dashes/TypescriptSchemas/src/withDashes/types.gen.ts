@@ -0,0 +1,34 @@
+import * as cog from '../cog';
+
+
+export interface someStruct {
+	FieldAny: any;
+}
+
+export const defaultSomeStruct = (): someStruct => ({
+	FieldAny: {},
+});
+
+export const someStructSchema: cog.schema.Schema<someStruct> = cog.schema.object({
+	FieldAny: cog.schema.any(),
+});
+
+// parseSomeStruct checks that the given input is a valid `someStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeStruct = (input: unknown): someStruct => cog.schema.parse(someStructSchema, input);
+
+// isSomeStruct checks whether the given input is a valid `someStruct`.
+export const isSomeStruct = (input: unknown): input is someStruct => cog.schema.is(someStructSchema, input);
+
+// Refresh rate or disabled.
+export type RefreshRate = string | boolean;
+
+export const defaultRefreshRate = (): RefreshRate => ("");
+
+export const RefreshRateSchema: cog.schema.Schema<RefreshRate> = cog.schema.union([cog.schema.string(), cog.schema.boolean()]);
+
+// parseRefreshRate checks that the given input is a valid `RefreshRate` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseRefreshRate = (input: unknown): RefreshRate => cog.schema.parse(RefreshRateSchema, input);
+
+// isRefreshRate checks whether the given input is a valid `RefreshRate`.
+export const isRefreshRate = (input: unknown): input is RefreshRate => cog.schema.is(RefreshRateSchema, input);
+
@@ -0,0 +1,13 @@
+use serde::{Deserialize, Serialize};
+use crate::otherpkg;
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct SomeStruct {
+    #[serde(rename = "FieldAny")]
+    pub field_any: serde_json::Value,
+}
+
+pub type RefToSomeStruct = SomeStruct;
+
+pub type RefToSomeStructFromOtherPackage = otherpkg::SomeDistantStruct;
+
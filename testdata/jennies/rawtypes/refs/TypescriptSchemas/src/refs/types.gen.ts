@@ -0,0 +1,46 @@
+import * as cog from '../cog';
+import * as otherpkg from '../otherpkg';
+
+
+export interface SomeStruct {
+	FieldAny: any;
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+	FieldAny: {},
+});
+
+export const SomeStructSchema: cog.schema.Schema<SomeStruct> = cog.schema.object({
+	FieldAny: cog.schema.any(),
+});
+
+// parseSomeStruct checks that the given input is a valid `SomeStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeStruct = (input: unknown): SomeStruct => cog.schema.parse(SomeStructSchema, input);
+
+// isSomeStruct checks whether the given input is a valid `SomeStruct`.
+export const isSomeStruct = (input: unknown): input is SomeStruct => cog.schema.is(SomeStructSchema, input);
+
+export type RefToSomeStruct = SomeStruct;
+
+export const defaultRefToSomeStruct = (): RefToSomeStruct => (defaultSomeStruct());
+
+export const RefToSomeStructSchema: cog.schema.Schema<RefToSomeStruct> = cog.schema.lazy(() => SomeStructSchema);
+
+// parseRefToSomeStruct checks that the given input is a valid `RefToSomeStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseRefToSomeStruct = (input: unknown): RefToSomeStruct => cog.schema.parse(RefToSomeStructSchema, input);
+
+// isRefToSomeStruct checks whether the given input is a valid `RefToSomeStruct`.
+export const isRefToSomeStruct = (input: unknown): input is RefToSomeStruct => cog.schema.is(RefToSomeStructSchema, input);
+
+export type RefToSomeStructFromOtherPackage = otherpkg.SomeDistantStruct;
+
+export const defaultRefToSomeStructFromOtherPackage = (): RefToSomeStructFromOtherPackage => (otherpkg.default());
+
+export const RefToSomeStructFromOtherPackageSchema: cog.schema.Schema<RefToSomeStructFromOtherPackage> = cog.schema.any();
+
+// parseRefToSomeStructFromOtherPackage checks that the given input is a valid `RefToSomeStructFromOtherPackage` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseRefToSomeStructFromOtherPackage = (input: unknown): RefToSomeStructFromOtherPackage => cog.schema.parse(RefToSomeStructFromOtherPackageSchema, input);
+
+// isRefToSomeStructFromOtherPackage checks whether the given input is a valid `RefToSomeStructFromOtherPackage`.
+export const isRefToSomeStructFromOtherPackage = (input: unknown): input is RefToSomeStructFromOtherPackage => cog.schema.is(RefToSomeStructFromOtherPackageSchema, input);
+
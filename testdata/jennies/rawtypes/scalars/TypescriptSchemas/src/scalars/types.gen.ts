@@ -0,0 +1,173 @@
+import * as cog from '../cog';
+
+
+export const constTypeString = "foo";
+
+export type scalarTypeAny = any;
+
+export const defaultScalarTypeAny = (): scalarTypeAny => ({});
+
+export const scalarTypeAnySchema: cog.schema.Schema<scalarTypeAny> = cog.schema.any();
+
+// parseScalarTypeAny checks that the given input is a valid `scalarTypeAny` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeAny = (input: unknown): scalarTypeAny => cog.schema.parse(scalarTypeAnySchema, input);
+
+// isScalarTypeAny checks whether the given input is a valid `scalarTypeAny`.
+export const isScalarTypeAny = (input: unknown): input is scalarTypeAny => cog.schema.is(scalarTypeAnySchema, input);
+
+export type ScalarTypeBool = boolean;
+
+export const defaultScalarTypeBool = (): ScalarTypeBool => (false);
+
+export const ScalarTypeBoolSchema: cog.schema.Schema<ScalarTypeBool> = cog.schema.boolean();
+
+// parseScalarTypeBool checks that the given input is a valid `ScalarTypeBool` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeBool = (input: unknown): ScalarTypeBool => cog.schema.parse(ScalarTypeBoolSchema, input);
+
+// isScalarTypeBool checks whether the given input is a valid `ScalarTypeBool`.
+export const isScalarTypeBool = (input: unknown): input is ScalarTypeBool => cog.schema.is(ScalarTypeBoolSchema, input);
+
+export type ScalarTypeBytes = string;
+
+export const defaultScalarTypeBytes = (): ScalarTypeBytes => ("");
+
+export const ScalarTypeBytesSchema: cog.schema.Schema<ScalarTypeBytes> = cog.schema.string();
+
+// parseScalarTypeBytes checks that the given input is a valid `ScalarTypeBytes` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeBytes = (input: unknown): ScalarTypeBytes => cog.schema.parse(ScalarTypeBytesSchema, input);
+
+// isScalarTypeBytes checks whether the given input is a valid `ScalarTypeBytes`.
+export const isScalarTypeBytes = (input: unknown): input is ScalarTypeBytes => cog.schema.is(ScalarTypeBytesSchema, input);
+
+export type ScalarTypeString = string;
+
+export const defaultScalarTypeString = (): ScalarTypeString => ("");
+
+export const ScalarTypeStringSchema: cog.schema.Schema<ScalarTypeString> = cog.schema.string();
+
+// parseScalarTypeString checks that the given input is a valid `ScalarTypeString` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeString = (input: unknown): ScalarTypeString => cog.schema.parse(ScalarTypeStringSchema, input);
+
+// isScalarTypeString checks whether the given input is a valid `ScalarTypeString`.
+export const isScalarTypeString = (input: unknown): input is ScalarTypeString => cog.schema.is(ScalarTypeStringSchema, input);
+
+export type ScalarTypeFloat32 = number;
+
+export const defaultScalarTypeFloat32 = (): ScalarTypeFloat32 => (0);
+
+export const ScalarTypeFloat32Schema: cog.schema.Schema<ScalarTypeFloat32> = cog.schema.number();
+
+// parseScalarTypeFloat32 checks that the given input is a valid `ScalarTypeFloat32` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeFloat32 = (input: unknown): ScalarTypeFloat32 => cog.schema.parse(ScalarTypeFloat32Schema, input);
+
+// isScalarTypeFloat32 checks whether the given input is a valid `ScalarTypeFloat32`.
+export const isScalarTypeFloat32 = (input: unknown): input is ScalarTypeFloat32 => cog.schema.is(ScalarTypeFloat32Schema, input);
+
+export type ScalarTypeFloat64 = number;
+
+export const defaultScalarTypeFloat64 = (): ScalarTypeFloat64 => (0);
+
+export const ScalarTypeFloat64Schema: cog.schema.Schema<ScalarTypeFloat64> = cog.schema.number();
+
+// parseScalarTypeFloat64 checks that the given input is a valid `ScalarTypeFloat64` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeFloat64 = (input: unknown): ScalarTypeFloat64 => cog.schema.parse(ScalarTypeFloat64Schema, input);
+
+// isScalarTypeFloat64 checks whether the given input is a valid `ScalarTypeFloat64`.
+export const isScalarTypeFloat64 = (input: unknown): input is ScalarTypeFloat64 => cog.schema.is(ScalarTypeFloat64Schema, input);
+
+export type ScalarTypeUint8 = number;
+
+export const defaultScalarTypeUint8 = (): ScalarTypeUint8 => (0);
+
+export const ScalarTypeUint8Schema: cog.schema.Schema<ScalarTypeUint8> = cog.schema.integer();
+
+// parseScalarTypeUint8 checks that the given input is a valid `ScalarTypeUint8` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeUint8 = (input: unknown): ScalarTypeUint8 => cog.schema.parse(ScalarTypeUint8Schema, input);
+
+// isScalarTypeUint8 checks whether the given input is a valid `ScalarTypeUint8`.
+export const isScalarTypeUint8 = (input: unknown): input is ScalarTypeUint8 => cog.schema.is(ScalarTypeUint8Schema, input);
+
+export type ScalarTypeUint16 = number;
+
+export const defaultScalarTypeUint16 = (): ScalarTypeUint16 => (0);
+
+export const ScalarTypeUint16Schema: cog.schema.Schema<ScalarTypeUint16> = cog.schema.integer();
+
+// parseScalarTypeUint16 checks that the given input is a valid `ScalarTypeUint16` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeUint16 = (input: unknown): ScalarTypeUint16 => cog.schema.parse(ScalarTypeUint16Schema, input);
+
+// isScalarTypeUint16 checks whether the given input is a valid `ScalarTypeUint16`.
+export const isScalarTypeUint16 = (input: unknown): input is ScalarTypeUint16 => cog.schema.is(ScalarTypeUint16Schema, input);
+
+export type ScalarTypeUint32 = number;
+
+export const defaultScalarTypeUint32 = (): ScalarTypeUint32 => (0);
+
+export const ScalarTypeUint32Schema: cog.schema.Schema<ScalarTypeUint32> = cog.schema.integer();
+
+// parseScalarTypeUint32 checks that the given input is a valid `ScalarTypeUint32` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeUint32 = (input: unknown): ScalarTypeUint32 => cog.schema.parse(ScalarTypeUint32Schema, input);
+
+// isScalarTypeUint32 checks whether the given input is a valid `ScalarTypeUint32`.
+export const isScalarTypeUint32 = (input: unknown): input is ScalarTypeUint32 => cog.schema.is(ScalarTypeUint32Schema, input);
+
+export type ScalarTypeUint64 = number;
+
+export const defaultScalarTypeUint64 = (): ScalarTypeUint64 => (0);
+
+export const ScalarTypeUint64Schema: cog.schema.Schema<ScalarTypeUint64> = cog.schema.integer();
+
+// parseScalarTypeUint64 checks that the given input is a valid `ScalarTypeUint64` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeUint64 = (input: unknown): ScalarTypeUint64 => cog.schema.parse(ScalarTypeUint64Schema, input);
+
+// isScalarTypeUint64 checks whether the given input is a valid `ScalarTypeUint64`.
+export const isScalarTypeUint64 = (input: unknown): input is ScalarTypeUint64 => cog.schema.is(ScalarTypeUint64Schema, input);
+
+export type ScalarTypeInt8 = number;
+
+export const defaultScalarTypeInt8 = (): ScalarTypeInt8 => (0);
+
+export const ScalarTypeInt8Schema: cog.schema.Schema<ScalarTypeInt8> = cog.schema.integer();
+
+// parseScalarTypeInt8 checks that the given input is a valid `ScalarTypeInt8` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeInt8 = (input: unknown): ScalarTypeInt8 => cog.schema.parse(ScalarTypeInt8Schema, input);
+
+// isScalarTypeInt8 checks whether the given input is a valid `ScalarTypeInt8`.
+export const isScalarTypeInt8 = (input: unknown): input is ScalarTypeInt8 => cog.schema.is(ScalarTypeInt8Schema, input);
+
+export type ScalarTypeInt16 = number;
+
+export const defaultScalarTypeInt16 = (): ScalarTypeInt16 => (0);
+
+export const ScalarTypeInt16Schema: cog.schema.Schema<ScalarTypeInt16> = cog.schema.integer();
+
+// parseScalarTypeInt16 checks that the given input is a valid `ScalarTypeInt16` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeInt16 = (input: unknown): ScalarTypeInt16 => cog.schema.parse(ScalarTypeInt16Schema, input);
+
+// isScalarTypeInt16 checks whether the given input is a valid `ScalarTypeInt16`.
+export const isScalarTypeInt16 = (input: unknown): input is ScalarTypeInt16 => cog.schema.is(ScalarTypeInt16Schema, input);
+
+export type ScalarTypeInt32 = number;
+
+export const defaultScalarTypeInt32 = (): ScalarTypeInt32 => (0);
+
+export const ScalarTypeInt32Schema: cog.schema.Schema<ScalarTypeInt32> = cog.schema.integer();
+
+// parseScalarTypeInt32 checks that the given input is a valid `ScalarTypeInt32` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeInt32 = (input: unknown): ScalarTypeInt32 => cog.schema.parse(ScalarTypeInt32Schema, input);
+
+// isScalarTypeInt32 checks whether the given input is a valid `ScalarTypeInt32`.
+export const isScalarTypeInt32 = (input: unknown): input is ScalarTypeInt32 => cog.schema.is(ScalarTypeInt32Schema, input);
+
+export type ScalarTypeInt64 = number;
+
+export const defaultScalarTypeInt64 = (): ScalarTypeInt64 => (0);
+
+export const ScalarTypeInt64Schema: cog.schema.Schema<ScalarTypeInt64> = cog.schema.integer();
+
+// parseScalarTypeInt64 checks that the given input is a valid `ScalarTypeInt64` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseScalarTypeInt64 = (input: unknown): ScalarTypeInt64 => cog.schema.parse(ScalarTypeInt64Schema, input);
+
+// isScalarTypeInt64 checks whether the given input is a valid `ScalarTypeInt64`.
+export const isScalarTypeInt64 = (input: unknown): input is ScalarTypeInt64 => cog.schema.is(ScalarTypeInt64Schema, input);
+
@@ -0,0 +1,69 @@
+use serde::{Deserialize, Serialize};
+
+/// This struct does things.
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct SomeStruct {
+    #[serde(rename = "FieldRef")]
+    pub field_ref: SomeOtherStruct,
+    #[serde(rename = "FieldDisjunctionOfScalars")]
+    pub field_disjunction_of_scalars: StringOrBool,
+    #[serde(rename = "FieldMixedDisjunction")]
+    pub field_mixed_disjunction: StringOrSomeOtherStruct,
+    #[serde(rename = "FieldDisjunctionWithNull", default, skip_serializing_if = "Option::is_none")]
+    pub field_disjunction_with_null: Option<String>,
+    #[serde(rename = "Operator")]
+    pub operator: SomeStructOperator,
+    #[serde(rename = "FieldArrayOfStrings")]
+    pub field_array_of_strings: Vec<String>,
+    #[serde(rename = "FieldMapOfStringToString")]
+    pub field_map_of_string_to_string: std::collections::HashMap<String, String>,
+    #[serde(rename = "FieldAnonymousStruct")]
+    pub field_anonymous_struct: StructComplexFieldsSomeStructFieldAnonymousStruct,
+    #[serde(rename = "fieldRefToConstant")]
+    pub field_ref_to_constant: String,
+}
+
+pub const CONNECTION_PATH: &str = "straight";
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct SomeOtherStruct {
+    #[serde(rename = "FieldAny")]
+    pub field_any: serde_json::Value,
+}
+
+#[derive(Clone, Copy, Debug, Default, PartialEq, Eq, Hash, Serialize, Deserialize)]
+pub enum SomeStructOperator {
+    #[default]
+    #[serde(rename = ">")]
+    GreaterThan,
+    #[serde(rename = "<")]
+    LessThan,
+}
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct StructComplexFieldsSomeStructFieldAnonymousStruct {
+    #[serde(rename = "FieldAny")]
+    pub field_any: serde_json::Value,
+}
+
+#[derive(Clone, Debug, PartialEq, Serialize, Deserialize)]
+#[serde(untagged)]
+pub enum StringOrBool {
+    String(String),
+    Bool(bool),
+}
+
+impl Default for StringOrBool {
+    fn default() -> Self {
+        Self::String(Default::default())
+    }
+}
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct StringOrSomeOtherStruct {
+    #[serde(rename = "String", default, skip_serializing_if = "Option::is_none")]
+    pub string: Option<String>,
+    #[serde(rename = "SomeOtherStruct", default, skip_serializing_if = "Option::is_none")]
+    pub some_other_struct: Option<SomeOtherStruct>,
+}
+
@@ -0,0 +1,72 @@
+import * as cog from '../cog';
+
+
+// This struct does things.
+export interface SomeStruct {
+	FieldRef: SomeOtherStruct;
+	FieldDisjunctionOfScalars: string | boolean;
+	FieldMixedDisjunction: string | SomeOtherStruct;
+	FieldDisjunctionWithNull: string | null;
+	Operator: ">" | "<";
+	FieldArrayOfStrings: string[];
+	FieldMapOfStringToString: Record<string, string>;
+	FieldAnonymousStruct: {
+		FieldAny: any;
+	};
+	fieldRefToConstant: "straight";
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+	FieldRef: defaultSomeOtherStruct(),
+	FieldDisjunctionOfScalars: "",
+	FieldMixedDisjunction: "",
+	FieldDisjunctionWithNull: "",
+	Operator: ">",
+	FieldArrayOfStrings: [],
+	FieldMapOfStringToString: {},
+	FieldAnonymousStruct: {
+	FieldAny: {},
+},
+	fieldRefToConstant: ConnectionPath,
+});
+
+export const SomeStructSchema: cog.schema.Schema<SomeStruct> = cog.schema.object({
+	FieldRef: cog.schema.lazy(() => SomeOtherStructSchema),
+	FieldDisjunctionOfScalars: cog.schema.union([cog.schema.string(), cog.schema.boolean()]),
+	FieldMixedDisjunction: cog.schema.union([cog.schema.string(), cog.schema.lazy(() => SomeOtherStructSchema)]),
+	FieldDisjunctionWithNull: cog.schema.union([cog.schema.string(), cog.schema.nullValue()]),
+	Operator: cog.schema.enumOf([">", "<"]),
+	FieldArrayOfStrings: cog.schema.array(cog.schema.string()),
+	FieldMapOfStringToString: cog.schema.record(cog.schema.string()),
+	FieldAnonymousStruct: cog.schema.object({
+		FieldAny: cog.schema.any(),
+	}),
+	fieldRefToConstant: cog.schema.literal(ConnectionPath),
+}, ["FieldRef", "FieldDisjunctionOfScalars", "FieldMixedDisjunction", "FieldDisjunctionWithNull", "Operator", "FieldArrayOfStrings", "FieldMapOfStringToString", "FieldAnonymousStruct", "fieldRefToConstant"]);
+
+// parseSomeStruct checks that the given input is a valid `SomeStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeStruct = (input: unknown): SomeStruct => cog.schema.parse(SomeStructSchema, input);
+
+// isSomeStruct checks whether the given input is a valid `SomeStruct`.
+export const isSomeStruct = (input: unknown): input is SomeStruct => cog.schema.is(SomeStructSchema, input);
+
+export const ConnectionPath = "straight";
+
+export interface SomeOtherStruct {
+	FieldAny: any;
+}
+
+export const defaultSomeOtherStruct = (): SomeOtherStruct => ({
+	FieldAny: {},
+});
+
+export const SomeOtherStructSchema: cog.schema.Schema<SomeOtherStruct> = cog.schema.object({
+	FieldAny: cog.schema.any(),
+});
+
+// parseSomeOtherStruct checks that the given input is a valid `SomeOtherStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeOtherStruct = (input: unknown): SomeOtherStruct => cog.schema.parse(SomeOtherStructSchema, input);
+
+// isSomeOtherStruct checks whether the given input is a valid `SomeOtherStruct`.
+export const isSomeOtherStruct = (input: unknown): input is SomeOtherStruct => cog.schema.is(SomeOtherStructSchema, input);
+
@@ -0,0 +1,33 @@
+import * as cog from '../cog';
+
+
+export interface SomeStruct {
+	fieldBool: boolean;
+	fieldString: string;
+	FieldStringWithConstantValue: "auto";
+	FieldFloat32: number;
+	FieldInt32: number;
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+	fieldBool: true,
+	fieldString: "foo",
+	FieldStringWithConstantValue: "auto",
+	FieldFloat32: 42.42,
+	FieldInt32: 42,
+});
+
+export const SomeStructSchema: cog.schema.Schema<SomeStruct> = cog.schema.object({
+	fieldBool: cog.schema.boolean(),
+	fieldString: cog.schema.string(),
+	FieldStringWithConstantValue: cog.schema.literal("auto"),
+	FieldFloat32: cog.schema.number(),
+	FieldInt32: cog.schema.integer(),
+}, ["fieldBool", "fieldString", "FieldStringWithConstantValue", "FieldFloat32", "FieldInt32"]);
+
+// parseSomeStruct checks that the given input is a valid `SomeStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeStruct = (input: unknown): SomeStruct => cog.schema.parse(SomeStructSchema, input);
+
+// isSomeStruct checks whether the given input is a valid `SomeStruct`.
+export const isSomeStruct = (input: unknown): input is SomeStruct => cog.schema.is(SomeStructSchema, input);
+
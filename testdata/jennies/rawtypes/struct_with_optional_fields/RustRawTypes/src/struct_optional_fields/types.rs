@@ -0,0 +1,37 @@
+use serde::{Deserialize, Serialize};
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct SomeStruct {
+    #[serde(rename = "FieldRef", default, skip_serializing_if = "Option::is_none")]
+    pub field_ref: Option<SomeOtherStruct>,
+    #[serde(rename = "FieldString", default, skip_serializing_if = "Option::is_none")]
+    pub field_string: Option<String>,
+    #[serde(rename = "Operator", default, skip_serializing_if = "Option::is_none")]
+    pub operator: Option<SomeStructOperator>,
+    #[serde(rename = "FieldArrayOfStrings", default, skip_serializing_if = "Option::is_none")]
+    pub field_array_of_strings: Option<Vec<String>>,
+    #[serde(rename = "FieldAnonymousStruct", default, skip_serializing_if = "Option::is_none")]
+    pub field_anonymous_struct: Option<StructOptionalFieldsSomeStructFieldAnonymousStruct>,
+}
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct SomeOtherStruct {
+    #[serde(rename = "FieldAny")]
+    pub field_any: serde_json::Value,
+}
+
+#[derive(Clone, Copy, Debug, Default, PartialEq, Eq, Hash, Serialize, Deserialize)]
+pub enum SomeStructOperator {
+    #[default]
+    #[serde(rename = ">")]
+    GreaterThan,
+    #[serde(rename = "<")]
+    LessThan,
+}
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct StructOptionalFieldsSomeStructFieldAnonymousStruct {
+    #[serde(rename = "FieldAny")]
+    pub field_any: serde_json::Value,
+}
+
@@ -0,0 +1,50 @@
+import * as cog from '../cog';
+
+
+export interface SomeStruct {
+	FieldRef?: SomeOtherStruct;
+	FieldString?: string;
+	Operator?: ">" | "<";
+	FieldArrayOfStrings?: string[];
+	FieldAnonymousStruct?: {
+		FieldAny: any;
+	};
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+});
+
+export const SomeStructSchema: cog.schema.Schema<SomeStruct> = cog.schema.object({
+	FieldRef: cog.schema.lazy(() => SomeOtherStructSchema),
+	FieldString: cog.schema.string(),
+	Operator: cog.schema.enumOf([">", "<"]),
+	FieldArrayOfStrings: cog.schema.array(cog.schema.string()),
+	FieldAnonymousStruct: cog.schema.object({
+		FieldAny: cog.schema.any(),
+	}),
+});
+
+// parseSomeStruct checks that the given input is a valid `SomeStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeStruct = (input: unknown): SomeStruct => cog.schema.parse(SomeStructSchema, input);
+
+// isSomeStruct checks whether the given input is a valid `SomeStruct`.
+export const isSomeStruct = (input: unknown): input is SomeStruct => cog.schema.is(SomeStructSchema, input);
+
+export interface SomeOtherStruct {
+	FieldAny: any;
+}
+
+export const defaultSomeOtherStruct = (): SomeOtherStruct => ({
+	FieldAny: {},
+});
+
+export const SomeOtherStructSchema: cog.schema.Schema<SomeOtherStruct> = cog.schema.object({
+	FieldAny: cog.schema.any(),
+});
+
+// parseSomeOtherStruct checks that the given input is a valid `SomeOtherStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeOtherStruct = (input: unknown): SomeOtherStruct => cog.schema.parse(SomeOtherStructSchema, input);
+
+// isSomeOtherStruct checks whether the given input is a valid `SomeOtherStruct`.
+export const isSomeOtherStruct = (input: unknown): input is SomeOtherStruct => cog.schema.is(SomeOtherStructSchema, input);
+
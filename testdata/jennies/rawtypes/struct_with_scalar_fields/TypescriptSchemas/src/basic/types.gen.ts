@@ -0,0 +1,69 @@
+import * as cog from '../cog';
+
+
+// This
+// is
+// a
+// comment
+export interface SomeStruct {
+	// Anything can go in there.
+	// Really, anything.
+	FieldAny: any;
+	FieldBool: boolean;
+	FieldBytes: string;
+	FieldString: string;
+	FieldStringWithConstantValue: "auto";
+	FieldFloat32: number;
+	FieldFloat64: number;
+	FieldUint8: number;
+	FieldUint16: number;
+	FieldUint32: number;
+	FieldUint64: number;
+	FieldInt8: number;
+	FieldInt16: number;
+	FieldInt32: number;
+	FieldInt64: number;
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+	FieldAny: {},
+	FieldBool: false,
+	FieldBytes: "",
+	FieldString: "",
+	FieldStringWithConstantValue: "auto",
+	FieldFloat32: 0,
+	FieldFloat64: 0,
+	FieldUint8: 0,
+	FieldUint16: 0,
+	FieldUint32: 0,
+	FieldUint64: 0,
+	FieldInt8: 0,
+	FieldInt16: 0,
+	FieldInt32: 0,
+	FieldInt64: 0,
+});
+
+export const SomeStructSchema: cog.schema.Schema<SomeStruct> = cog.schema.object({
+	FieldAny: cog.schema.any(),
+	FieldBool: cog.schema.boolean(),
+	FieldBytes: cog.schema.string(),
+	FieldString: cog.schema.string(),
+	FieldStringWithConstantValue: cog.schema.literal("auto"),
+	FieldFloat32: cog.schema.number(),
+	FieldFloat64: cog.schema.number(),
+	FieldUint8: cog.schema.integer(),
+	FieldUint16: cog.schema.integer(),
+	FieldUint32: cog.schema.integer(),
+	FieldUint64: cog.schema.integer(),
+	FieldInt8: cog.schema.integer(),
+	FieldInt16: cog.schema.integer(),
+	FieldInt32: cog.schema.integer(),
+	FieldInt64: cog.schema.integer(),
+}, ["FieldBool", "FieldBytes", "FieldString", "FieldStringWithConstantValue", "FieldFloat32", "FieldFloat64", "FieldUint8", "FieldUint16", "FieldUint32", "FieldUint64", "FieldInt8", "FieldInt16", "FieldInt32", "FieldInt64"]);
+
+// parseSomeStruct checks that the given input is a valid `SomeStruct` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseSomeStruct = (input: unknown): SomeStruct => cog.schema.parse(SomeStructSchema, input);
+
+// isSomeStruct checks whether the given input is a valid `SomeStruct`.
+export const isSomeStruct = (input: unknown): input is SomeStruct => cog.schema.is(SomeStructSchema, input);
+
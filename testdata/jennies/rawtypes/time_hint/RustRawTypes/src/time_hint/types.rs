@@ -0,0 +1,10 @@
+use serde::{Deserialize, Serialize};
+
+pub type ObjTime = String;
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct ObjWithTimeField {
+    #[serde(rename = "registeredAt")]
+    pub registered_at: String,
+}
+
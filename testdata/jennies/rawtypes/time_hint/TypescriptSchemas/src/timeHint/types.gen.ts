@@ -0,0 +1,33 @@
+import * as cog from '../cog';
+
+
+export type objTime = string;
+
+export const defaultObjTime = (): objTime => ("");
+
+export const objTimeSchema: cog.schema.Schema<objTime> = cog.schema.string();
+
+// parseObjTime checks that the given input is a valid `objTime` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseObjTime = (input: unknown): objTime => cog.schema.parse(objTimeSchema, input);
+
+// isObjTime checks whether the given input is a valid `objTime`.
+export const isObjTime = (input: unknown): input is objTime => cog.schema.is(objTimeSchema, input);
+
+export interface objWithTimeField {
+	registeredAt: string;
+}
+
+export const defaultObjWithTimeField = (): objWithTimeField => ({
+	registeredAt: "",
+});
+
+export const objWithTimeFieldSchema: cog.schema.Schema<objWithTimeField> = cog.schema.object({
+	registeredAt: cog.schema.string(),
+}, ["registeredAt"]);
+
+// parseObjWithTimeField checks that the given input is a valid `objWithTimeField` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseObjWithTimeField = (input: unknown): objWithTimeField => cog.schema.parse(objWithTimeFieldSchema, input);
+
+// isObjWithTimeField checks whether the given input is a valid `objWithTimeField`.
+export const isObjWithTimeField = (input: unknown): input is objWithTimeField => cog.schema.is(objWithTimeFieldSchema, input);
+
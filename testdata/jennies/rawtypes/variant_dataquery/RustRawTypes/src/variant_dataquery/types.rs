@@ -0,0 +1,9 @@
+use serde::{Deserialize, Serialize};
+
+#[derive(Clone, Debug, Default, PartialEq, Serialize, Deserialize)]
+pub struct Query {
+    pub expr: String,
+    #[serde(default, skip_serializing_if = "Option::is_none")]
+    pub instant: Option<bool>,
+}
+
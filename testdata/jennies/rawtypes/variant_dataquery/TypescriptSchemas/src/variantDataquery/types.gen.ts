@@ -0,0 +1,26 @@
+import * as cog from '../cog';
+
+
+export interface Query {
+	expr: string;
+	instant?: boolean;
+	_implementsDataqueryVariant(): void;
+}
+
+export const defaultQuery = (): Query => ({
+	expr: "",
+	_implementsDataqueryVariant: () => {},
+});
+
+export const QuerySchema: cog.schema.Schema<Omit<Query, "_implementsDataqueryVariant">> = cog.schema.object({
+	expr: cog.schema.string(),
+	instant: cog.schema.boolean(),
+}, ["expr"]);
+cog.schema.registerVariant("dataquery", "prometheus", QuerySchema);
+
+// parseQuery checks that the given input is a valid `Query` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseQuery = (input: unknown): Omit<Query, "_implementsDataqueryVariant"> => cog.schema.parse(QuerySchema, input);
+
+// isQuery checks whether the given input is a valid `Query`.
+export const isQuery = (input: unknown): input is Omit<Query, "_implementsDataqueryVariant"> => cog.schema.is(QuerySchema, input);
+
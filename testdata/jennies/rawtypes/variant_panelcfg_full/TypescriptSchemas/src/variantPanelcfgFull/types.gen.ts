@@ -0,0 +1,39 @@
+import * as cog from '../cog';
+
+
+export interface Options {
+	timeseries_option: string;
+}
+
+export const defaultOptions = (): Options => ({
+	timeseries_option: "",
+});
+
+export const OptionsSchema: cog.schema.Schema<Options> = cog.schema.object({
+	timeseries_option: cog.schema.string(),
+}, ["timeseries_option"]);
+
+// parseOptions checks that the given input is a valid `Options` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseOptions = (input: unknown): Options => cog.schema.parse(OptionsSchema, input);
+
+// isOptions checks whether the given input is a valid `Options`.
+export const isOptions = (input: unknown): input is Options => cog.schema.is(OptionsSchema, input);
+
+export interface FieldConfig {
+	timeseries_field_config_option: string;
+}
+
+export const defaultFieldConfig = (): FieldConfig => ({
+	timeseries_field_config_option: "",
+});
+
+export const FieldConfigSchema: cog.schema.Schema<FieldConfig> = cog.schema.object({
+	timeseries_field_config_option: cog.schema.string(),
+}, ["timeseries_field_config_option"]);
+
+// parseFieldConfig checks that the given input is a valid `FieldConfig` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseFieldConfig = (input: unknown): FieldConfig => cog.schema.parse(FieldConfigSchema, input);
+
+// isFieldConfig checks whether the given input is a valid `FieldConfig`.
+export const isFieldConfig = (input: unknown): input is FieldConfig => cog.schema.is(FieldConfigSchema, input);
+
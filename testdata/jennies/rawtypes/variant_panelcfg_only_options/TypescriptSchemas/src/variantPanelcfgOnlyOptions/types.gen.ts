@@ -0,0 +1,21 @@
+import * as cog from '../cog';
+
+
+export interface Options {
+	content: string;
+}
+
+export const defaultOptions = (): Options => ({
+	content: "",
+});
+
+export const OptionsSchema: cog.schema.Schema<Options> = cog.schema.object({
+	content: cog.schema.string(),
+}, ["content"]);
+
+// parseOptions checks that the given input is a valid `Options` and returns it. A `cog.schema.ParseError` is thrown otherwise.
+export const parseOptions = (input: unknown): Options => cog.schema.parse(OptionsSchema, input);
+
+// isOptions checks whether the given input is a valid `Options`.
+export const isOptions = (input: unknown): input is Options => cog.schema.is(OptionsSchema, input);
+
@@ -0,0 +1,141 @@
+package constraints
+
+import (
+	cog "github.com/grafana/cog/generated/cog"
+)
+
+var (
+	validationPattern1 = regexp.MustCompile("^[a-z0-9-]+$")
+	validationPattern2 = regexp.MustCompile("^-")
+)
+
+type SomeStruct struct {
+	Id uint64 `json:"id"`
+	MaybeId *uint64 `json:"maybeId,omitempty"`
+	Title string `json:"title"`
+	Ratio float64 `json:"ratio"`
+	Step int64 `json:"step"`
+	Status Status `json:"status"`
+	Ref RefStruct `json:"ref"`
+	MaybeRef *RefStruct `json:"maybeRef,omitempty"`
+	Tags []string `json:"tags"`
+	Children []RefStruct `json:"children"`
+	Labels map[string]string `json:"labels,omitempty"`
+	Slug string `json:"slug"`
+	NotValidated string `json:"notValidated"`
+}
+
+// Validate checks all the validation constraints that may be defined on `SomeStruct` fields for violations and returns them.
+func (resource SomeStruct) Validate() error {
+	var errs cog.BuildErrors
+
+	if !(resource.Id >= 5) {
+		errs = append(errs, cog.MakeBuildErrors("id", errors.New("must be >= 5"))...)
+	}
+	if !(resource.Id < 10) {
+		errs = append(errs, cog.MakeBuildErrors("id", errors.New("must be < 10"))...)
+	}
+	if resource.MaybeId != nil {
+		if !(*resource.MaybeId >= 5) {
+			errs = append(errs, cog.MakeBuildErrors("maybeId", errors.New("must be >= 5"))...)
+		}
+		if !(*resource.MaybeId < 10) {
+			errs = append(errs, cog.MakeBuildErrors("maybeId", errors.New("must be < 10"))...)
+		}
+	}
+	if !(len([]rune(resource.Title)) >= 1) {
+		errs = append(errs, cog.MakeBuildErrors("title", errors.New("length must be >= 1"))...)
+	}
+	if !(resource.Ratio > 0) {
+		errs = append(errs, cog.MakeBuildErrors("ratio", errors.New("must be > 0"))...)
+	}
+	if !(resource.Ratio <= 1) {
+		errs = append(errs, cog.MakeBuildErrors("ratio", errors.New("must be <= 1"))...)
+	}
+	if !(resource.Step%5 == 0) {
+		errs = append(errs, cog.MakeBuildErrors("step", errors.New("must be a multiple of 5"))...)
+	}
+	if resource.Status != "active" && resource.Status != "paused" {
+		errs = append(errs, cog.MakeBuildErrors("status", errors.New("must be one of: active, paused"))...)
+	}
+	if err := resource.Ref.Validate(); err != nil {
+		errs = append(errs, cog.MakeBuildErrors("ref", err)...)
+	}
+	if resource.MaybeRef != nil {
+		if err := resource.MaybeRef.Validate(); err != nil {
+			errs = append(errs, cog.MakeBuildErrors("maybeRef", err)...)
+		}
+	}
+	for i1 := range resource.Tags {
+		if !(len([]rune(resource.Tags[i1])) >= 1) {
+			errs = append(errs, cog.MakeBuildErrors(fmt.Sprintf("tags[%v]", i1), errors.New("length must be >= 1"))...)
+		}
+		if !(len([]rune(resource.Tags[i1])) <= 20) {
+			errs = append(errs, cog.MakeBuildErrors(fmt.Sprintf("tags[%v]", i1), errors.New("length must be <= 20"))...)
+		}
+	}
+	for i1 := range resource.Children {
+		if err := resource.Children[i1].Validate(); err != nil {
+			errs = append(errs, cog.MakeBuildErrors(fmt.Sprintf("children[%v]", i1), err)...)
+		}
+	}
+	for key1 := range resource.Labels {
+		if !(len([]rune(resource.Labels[key1])) <= 10) {
+			errs = append(errs, cog.MakeBuildErrors(fmt.Sprintf("labels[%v]", key1), errors.New("length must be <= 10"))...)
+		}
+	}
+	if !validationPattern1.MatchString(resource.Slug) {
+		errs = append(errs, cog.MakeBuildErrors("slug", errors.New("must match the pattern ^[a-z0-9-]+$"))...)
+	}
+	if validationPattern2.MatchString(resource.Slug) {
+		errs = append(errs, cog.MakeBuildErrors("slug", errors.New("must not match the pattern ^-"))...)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+type RefStruct struct {
+	Name string `json:"name"`
+	Port Port `json:"port"`
+}
+
+// Validate checks all the validation constraints that may be defined on `RefStruct` fields for violations and returns them.
+func (resource RefStruct) Validate() error {
+	var errs cog.BuildErrors
+
+	if !(resource.Port >= 1) {
+		errs = append(errs, cog.MakeBuildErrors("port", errors.New("must be >= 1"))...)
+	}
+	if !(resource.Port <= 65535) {
+		errs = append(errs, cog.MakeBuildErrors("port", errors.New("must be <= 65535"))...)
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+
+	return errs
+}
+
+type Port int64
+
+type Status string
+const (
+	StatusActive Status = "active"
+	StatusPaused Status = "paused"
+)
+
+
+type NothingToValidate struct {
+	Name *string `json:"name,omitempty"`
+}
+
+// Validate checks all the validation constraints that may be defined on `NothingToValidate` fields for violations and returns them.
+func (resource NothingToValidate) Validate() error {
+	return nil
+}
+
@@ -0,0 +1,16 @@
+package constraints;
+
+import java.util.List;
+import java.util.LinkedList;
+import cog.ValidationError;
+
+public class NothingToValidate {
+    public String name;
+
+    // validate checks all the validation constraints that may be defined on `NothingToValidate` fields for violations and returns them.
+    public List<ValidationError> validate() {
+        List<ValidationError> errors = new LinkedList<>();
+
+        return errors;
+    }
+}
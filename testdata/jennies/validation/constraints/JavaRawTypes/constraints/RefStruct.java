@@ -0,0 +1,31 @@
+package constraints;
+
+import java.util.List;
+import java.util.LinkedList;
+import cog.ValidationError;
+
+public class RefStruct {
+    public String name;
+    public Long port;
+
+    // validate checks all the validation constraints that may be defined on `RefStruct` fields for violations and returns them.
+    public List<ValidationError> validate() {
+        List<ValidationError> errors = new LinkedList<>();
+
+        if (this.name == null) {
+            errors.add(new ValidationError("name", "is required"));
+        }
+        if (this.port == null) {
+            errors.add(new ValidationError("port", "is required"));
+        } else {
+            if (!(this.port >= 1)) {
+                errors.add(new ValidationError("port", "must be >= 1"));
+            }
+            if (!(this.port <= 65535)) {
+                errors.add(new ValidationError("port", "must be <= 65535"));
+            }
+        }
+
+        return errors;
+    }
+}
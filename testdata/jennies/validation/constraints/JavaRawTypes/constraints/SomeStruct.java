@@ -0,0 +1,122 @@
+package constraints;
+
+import java.util.List;
+import java.util.Map;
+import java.util.LinkedList;
+import cog.ValidationError;
+
+public class SomeStruct {
+    public Long id;
+    public Long maybeId;
+    public String title;
+    public Double ratio;
+    public Long step;
+    public Status status;
+    public RefStruct ref;
+    public RefStruct maybeRef;
+    public List<String> tags;
+    public List<RefStruct> children;
+    public Map<String, String> labels;
+    public String slug;
+    public String notValidated;
+
+    // validate checks all the validation constraints that may be defined on `SomeStruct` fields for violations and returns them.
+    public List<ValidationError> validate() {
+        List<ValidationError> errors = new LinkedList<>();
+
+        if (this.id == null) {
+            errors.add(new ValidationError("id", "is required"));
+        } else {
+            if (!(this.id >= 5)) {
+                errors.add(new ValidationError("id", "must be >= 5"));
+            }
+            if (!(this.id < 10)) {
+                errors.add(new ValidationError("id", "must be < 10"));
+            }
+        }
+        if (this.maybeId != null) {
+            if (!(this.maybeId >= 5)) {
+                errors.add(new ValidationError("maybeId", "must be >= 5"));
+            }
+            if (!(this.maybeId < 10)) {
+                errors.add(new ValidationError("maybeId", "must be < 10"));
+            }
+        }
+        if (this.title == null) {
+            errors.add(new ValidationError("title", "is required"));
+        } else {
+            if (!(this.title.length() >= 1)) {
+                errors.add(new ValidationError("title", "length must be >= 1"));
+            }
+        }
+        if (this.ratio == null) {
+            errors.add(new ValidationError("ratio", "is required"));
+        } else {
+            if (!(this.ratio > 0)) {
+                errors.add(new ValidationError("ratio", "must be > 0"));
+            }
+            if (!(this.ratio <= 1)) {
+                errors.add(new ValidationError("ratio", "must be <= 1"));
+            }
+        }
+        if (this.step == null) {
+            errors.add(new ValidationError("step", "is required"));
+        } else {
+            if (!(this.step % 5 == 0)) {
+                errors.add(new ValidationError("step", "must be a multiple of 5"));
+            }
+        }
+        if (this.status == null) {
+            errors.add(new ValidationError("status", "is required"));
+        }
+        if (this.ref == null) {
+            errors.add(new ValidationError("ref", "is required"));
+        } else {
+            errors.addAll(ValidationError.prefix("ref", this.ref.validate()));
+        }
+        if (this.maybeRef != null) {
+            errors.addAll(ValidationError.prefix("maybeRef", this.maybeRef.validate()));
+        }
+        if (this.tags == null) {
+            errors.add(new ValidationError("tags", "is required"));
+        } else {
+            for (int i1 = 0; i1 < this.tags.size(); i1++) {
+                if (!(this.tags.get(i1).length() >= 1)) {
+                    errors.add(new ValidationError("tags[" + i1 + "]", "length must be >= 1"));
+                }
+                if (!(this.tags.get(i1).length() <= 20)) {
+                    errors.add(new ValidationError("tags[" + i1 + "]", "length must be <= 20"));
+                }
+            }
+        }
+        if (this.children == null) {
+            errors.add(new ValidationError("children", "is required"));
+        } else {
+            for (int i1 = 0; i1 < this.children.size(); i1++) {
+                errors.addAll(ValidationError.prefix("children[" + i1 + "]", this.children.get(i1).validate()));
+            }
+        }
+        if (this.labels != null) {
+            for (String key1 : this.labels.keySet()) {
+                if (!(this.labels.get(key1).length() <= 10)) {
+                    errors.add(new ValidationError("labels[" + key1 + "]", "length must be <= 10"));
+                }
+            }
+        }
+        if (this.slug == null) {
+            errors.add(new ValidationError("slug", "is required"));
+        } else {
+            if (!java.util.regex.Pattern.compile("^[a-z0-9-]+$").matcher(this.slug).find()) {
+                errors.add(new ValidationError("slug", "must match the pattern ^[a-z0-9-]+$"));
+            }
+            if (java.util.regex.Pattern.compile("^-").matcher(this.slug).find()) {
+                errors.add(new ValidationError("slug", "must not match the pattern ^-"));
+            }
+        }
+        if (this.notValidated == null) {
+            errors.add(new ValidationError("notValidated", "is required"));
+        }
+
+        return errors;
+    }
+}
@@ -0,0 +1,23 @@
+package constraints;
+
+import com.fasterxml.jackson.annotation.JsonFormat;
+import com.fasterxml.jackson.annotation.JsonValue;
+
+
+@JsonFormat(shape = JsonFormat.Shape.OBJECT)
+public enum Status {
+    ACTIVE("active"),
+    PAUSED("paused"),
+    _EMPTY("");
+
+    private final String value;
+
+    private Status(String value) {
+        this.value = value;
+    }
+
+    @JsonValue
+    public String Value() {
+        return value;
+    }
+}
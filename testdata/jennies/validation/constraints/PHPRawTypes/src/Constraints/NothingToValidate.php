@@ -0,0 +1,51 @@
+<?php
+
+namespace Grafana\Foundation\Constraints;
+
+class NothingToValidate implements \JsonSerializable
+{
+    public ?string $name;
+
+    /**
+     * @param string|null $name
+     */
+    public function __construct(?string $name = null)
+    {
+        $this->name = $name;
+    }
+
+    /**
+     * @param array<string, mixed> $inputData
+     */
+    public static function fromArray(array $inputData): self
+    {
+        /** @var array{name?: string} $inputData */
+        $data = $inputData;
+        return new self(
+            name: $data["name"] ?? null,
+        );
+    }
+
+    /**
+     * @return array<string, mixed>
+     */
+    public function jsonSerialize(): array
+    {
+        $data = [
+        ];
+        if (isset($this->name)) {
+            $data["name"] = $this->name;
+        }
+        return $data;
+    }
+
+    /**
+     * Checks all the validation constraints that may be defined on the fields of this object for violations and returns them.
+     *
+     * @return array<\Grafana\Foundation\Cog\ValidationError>
+     */
+    public function validate(): array
+    {
+        return [];
+    }
+}
@@ -0,0 +1,64 @@
+<?php
+
+namespace Grafana\Foundation\Constraints;
+
+class RefStruct implements \JsonSerializable
+{
+    public string $name;
+
+    public int $port;
+
+    /**
+     * @param string|null $name
+     * @param int|null $port
+     */
+    public function __construct(?string $name = null, ?int $port = null)
+    {
+        $this->name = $name ?: "";
+        $this->port = $port ?: 0;
+    }
+
+    /**
+     * @param array<string, mixed> $inputData
+     */
+    public static function fromArray(array $inputData): self
+    {
+        /** @var array{name?: string, port?: int} $inputData */
+        $data = $inputData;
+        return new self(
+            name: $data["name"] ?? null,
+            port: $data["port"] ?? null,
+        );
+    }
+
+    /**
+     * @return array<string, mixed>
+     */
+    public function jsonSerialize(): array
+    {
+        $data = [
+            "name" => $this->name,
+            "port" => $this->port,
+        ];
+        return $data;
+    }
+
+    /**
+     * Checks all the validation constraints that may be defined on the fields of this object for violations and returns them.
+     *
+     * @return array<\Grafana\Foundation\Cog\ValidationError>
+     */
+    public function validate(): array
+    {
+        $errors = [];
+
+        if (!($this->port >= 1)) {
+            $errors[] = new \Grafana\Foundation\Cog\ValidationError('port', 'must be >= 1');
+        }
+        if (!($this->port <= 65535)) {
+            $errors[] = new \Grafana\Foundation\Cog\ValidationError('port', 'must be <= 65535');
+        }
+
+        return $errors;
+    }
+}
@@ -0,0 +1,205 @@
+<?php
+
+namespace Grafana\Foundation\Constraints;
+
+class SomeStruct implements \JsonSerializable
+{
+    public int $id;
+
+    public ?int $maybeId;
+
+    public string $title;
+
+    public float $ratio;
+
+    public int $step;
+
+    public \Grafana\Foundation\Constraints\Status $status;
+
+    public \Grafana\Foundation\Constraints\RefStruct $ref;
+
+    public ?\Grafana\Foundation\Constraints\RefStruct $maybeRef;
+
+    /**
+     * @var array<string>
+     */
+    public array $tags;
+
+    /**
+     * @var array<\Grafana\Foundation\Constraints\RefStruct>
+     */
+    public array $children;
+
+    /**
+     * @var array<string, string>|null
+     */
+    public ?array $labels;
+
+    public string $slug;
+
+    public string $notValidated;
+
+    /**
+     * @param int|null $id
+     * @param int|null $maybeId
+     * @param string|null $title
+     * @param float|null $ratio
+     * @param int|null $step
+     * @param \Grafana\Foundation\Constraints\Status|null $status
+     * @param \Grafana\Foundation\Constraints\RefStruct|null $ref
+     * @param \Grafana\Foundation\Constraints\RefStruct|null $maybeRef
+     * @param array<string>|null $tags
+     * @param array<\Grafana\Foundation\Constraints\RefStruct>|null $children
+     * @param array<string, string>|null $labels
+     * @param string|null $slug
+     * @param string|null $notValidated
+     */
+    public function __construct(?int $id = null, ?int $maybeId = null, ?string $title = null, ?float $ratio = null, ?int $step = null, ?\Grafana\Foundation\Constraints\Status $status = null, ?\Grafana\Foundation\Constraints\RefStruct $ref = null, ?\Grafana\Foundation\Constraints\RefStruct $maybeRef = null, ?array $tags = null, ?array $children = null, ?array $labels = null, ?string $slug = null, ?string $notValidated = null)
+    {
+        $this->id = $id ?: 0;
+        $this->maybeId = $maybeId;
+        $this->title = $title ?: "";
+        $this->ratio = $ratio ?: 0;
+        $this->step = $step ?: 0;
+        $this->status = $status ?: \Grafana\Foundation\Constraints\Status::Active();
+        $this->ref = $ref ?: new \Grafana\Foundation\Constraints\RefStruct();
+        $this->maybeRef = $maybeRef;
+        $this->tags = $tags ?: [];
+        $this->children = $children ?: [];
+        $this->labels = $labels;
+        $this->slug = $slug ?: "";
+        $this->notValidated = $notValidated ?: "";
+    }
+
+    /**
+     * @param array<string, mixed> $inputData
+     */
+    public static function fromArray(array $inputData): self
+    {
+        /** @var array{id?: int, maybeId?: int, title?: string, ratio?: float, step?: int, status?: string, ref?: mixed, maybeRef?: mixed, tags?: array<string>, children?: array<mixed>, labels?: array<string, string>, slug?: string, notValidated?: string} $inputData */
+        $data = $inputData;
+        return new self(
+            id: $data["id"] ?? null,
+            maybeId: $data["maybeId"] ?? null,
+            title: $data["title"] ?? null,
+            ratio: $data["ratio"] ?? null,
+            step: $data["step"] ?? null,
+            status: isset($data["status"]) ? (function($input) { return \Grafana\Foundation\Constraints\Status::fromValue($input); })($data["status"]) : null,
+            ref: isset($data["ref"]) ? (function($input) {
+    	/** @var array{name?: string, port?: int} */
+    $val = $input;
+    	return \Grafana\Foundation\Constraints\RefStruct::fromArray($val);
+    })($data["ref"]) : null,
+            maybeRef: isset($data["maybeRef"]) ? (function($input) {
+    	/** @var array{name?: string, port?: int} */
+    $val = $input;
+    	return \Grafana\Foundation\Constraints\RefStruct::fromArray($val);
+    })($data["maybeRef"]) : null,
+            tags: $data["tags"] ?? null,
+            children: array_filter(array_map((function($input) {
+    	/** @var array{name?: string, port?: int} */
+    $val = $input;
+    	return \Grafana\Foundation\Constraints\RefStruct::fromArray($val);
+    }), $data["children"] ?? [])),
+            labels: $data["labels"] ?? null,
+            slug: $data["slug"] ?? null,
+            notValidated: $data["notValidated"] ?? null,
+        );
+    }
+
+    /**
+     * @return array<string, mixed>
+     */
+    public function jsonSerialize(): array
+    {
+        $data = [
+            "id" => $this->id,
+            "title" => $this->title,
+            "ratio" => $this->ratio,
+            "step" => $this->step,
+            "status" => $this->status,
+            "ref" => $this->ref,
+            "tags" => $this->tags,
+            "children" => $this->children,
+            "slug" => $this->slug,
+            "notValidated" => $this->notValidated,
+        ];
+        if (isset($this->maybeId)) {
+            $data["maybeId"] = $this->maybeId;
+        }
+        if (isset($this->maybeRef)) {
+            $data["maybeRef"] = $this->maybeRef;
+        }
+        if (isset($this->labels)) {
+            $data["labels"] = $this->labels;
+        }
+        return $data;
+    }
+
+    /**
+     * Checks all the validation constraints that may be defined on the fields of this object for violations and returns them.
+     *
+     * @return array<\Grafana\Foundation\Cog\ValidationError>
+     */
+    public function validate(): array
+    {
+        $errors = [];
+
+        if (!($this->id >= 5)) {
+            $errors[] = new \Grafana\Foundation\Cog\ValidationError('id', 'must be >= 5');
+        }
+        if (!($this->id < 10)) {
+            $errors[] = new \Grafana\Foundation\Cog\ValidationError('id', 'must be < 10');
+        }
+        if ($this->maybeId !== null) {
+            if (!($this->maybeId >= 5)) {
+                $errors[] = new \Grafana\Foundation\Cog\ValidationError('maybeId', 'must be >= 5');
+            }
+            if (!($this->maybeId < 10)) {
+                $errors[] = new \Grafana\Foundation\Cog\ValidationError('maybeId', 'must be < 10');
+            }
+        }
+        if (!(strlen($this->title) >= 1)) {
+            $errors[] = new \Grafana\Foundation\Cog\ValidationError('title', 'length must be >= 1');
+        }
+        if (!($this->ratio > 0)) {
+            $errors[] = new \Grafana\Foundation\Cog\ValidationError('ratio', 'must be > 0');
+        }
+        if (!($this->ratio <= 1)) {
+            $errors[] = new \Grafana\Foundation\Cog\ValidationError('ratio', 'must be <= 1');
+        }
+        if (!($this->step % 5 == 0)) {
+            $errors[] = new \Grafana\Foundation\Cog\ValidationError('step', 'must be a multiple of 5');
+        }
+        $errors = array_merge($errors, \Grafana\Foundation\Cog\ValidationError::prefix('ref', $this->ref->validate()));
+        if ($this->maybeRef !== null) {
+            $errors = array_merge($errors, \Grafana\Foundation\Cog\ValidationError::prefix('maybeRef', $this->maybeRef->validate()));
+        }
+        foreach ($this->tags as $i1 => $item1) {
+            if (!(strlen($item1) >= 1)) {
+                $errors[] = new \Grafana\Foundation\Cog\ValidationError("tags[{$i1}]", 'length must be >= 1');
+            }
+            if (!(strlen($item1) <= 20)) {
+                $errors[] = new \Grafana\Foundation\Cog\ValidationError("tags[{$i1}]", 'length must be <= 20');
+            }
+        }
+        foreach ($this->children as $i1 => $item1) {
+            $errors = array_merge($errors, \Grafana\Foundation\Cog\ValidationError::prefix("children[{$i1}]", $item1->validate()));
+        }
+        if ($this->labels !== null) {
+            foreach ($this->labels as $key1 => $item1) {
+                if (!(strlen($item1) <= 10)) {
+                    $errors[] = new \Grafana\Foundation\Cog\ValidationError("labels[{$key1}]", 'length must be <= 10');
+                }
+            }
+        }
+        if (preg_match('~^[a-z0-9-]+$~', $this->slug) !== 1) {
+            $errors[] = new \Grafana\Foundation\Cog\ValidationError('slug', 'must match the pattern ^[a-z0-9-]+$');
+        }
+        if (preg_match('~^-~', $this->slug) === 1) {
+            $errors[] = new \Grafana\Foundation\Cog\ValidationError('slug', 'must not match the pattern ^-');
+        }
+
+        return $errors;
+    }
+}
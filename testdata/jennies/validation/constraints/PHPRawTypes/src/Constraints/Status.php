@@ -0,0 +1,62 @@
+<?php
+
+namespace Grafana\Foundation\Constraints;
+
+final class Status implements \JsonSerializable, \Stringable {
+    /**
+     * @var string
+     */
+    private $value;
+
+    /**
+     * @var array<string, Status>
+     */
+    private static $instances = [];
+
+    private function __construct(string $value)
+    {
+        $this->value = $value;
+    }
+
+    public static function active(): self
+    {
+        if (!isset(self::$instances["Active"])) {
+            self::$instances["Active"] = new self("active");
+        }
+
+        return self::$instances["Active"];
+    }
+
+    public static function paused(): self
+    {
+        if (!isset(self::$instances["Paused"])) {
+            self::$instances["Paused"] = new self("paused");
+        }
+
+        return self::$instances["Paused"];
+    }
+
+    public static function fromValue(string $value): self
+    {
+        if ($value === "active") {
+            return self::active();
+        }
+
+        if ($value === "paused") {
+            return self::paused();
+        }
+
+        throw new \UnexpectedValueException("Value '$value' is not part of the enum Status");
+    }
+
+    public function jsonSerialize(): string
+    {
+        return $this->value;
+    }
+
+    public function __toString(): string
+    {
+        return $this->value;
+    }
+}
+
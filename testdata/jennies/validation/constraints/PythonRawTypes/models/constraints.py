@@ -0,0 +1,251 @@
+import typing
+from ..cog import validation as cogvalidation
+import re
+import enum
+
+
+class SomeStruct:
+    id_val: int
+    maybe_id: typing.Optional[int]
+    title: str
+    ratio: float
+    step: int
+    status: 'Status'
+    ref: 'RefStruct'
+    maybe_ref: typing.Optional['RefStruct']
+    tags: list[str]
+    children: list['RefStruct']
+    labels: typing.Optional[dict[str, str]]
+    slug: str
+    not_validated: str
+
+    def __init__(self, id_val: int = 0, maybe_id: typing.Optional[int] = None, title: str = "", ratio: float = 0, step: int = 0, status: typing.Optional['Status'] = None, ref: typing.Optional['RefStruct'] = None, maybe_ref: typing.Optional['RefStruct'] = None, tags: typing.Optional[list[str]] = None, children: typing.Optional[list['RefStruct']] = None, labels: typing.Optional[dict[str, str]] = None, slug: str = "", not_validated: str = ""):
+        self.id_val = id_val
+        self.maybe_id = maybe_id
+        self.title = title
+        self.ratio = ratio
+        self.step = step
+        self.status = status if status is not None else Status.ACTIVE
+        self.ref = ref if ref is not None else RefStruct()
+        self.maybe_ref = maybe_ref
+        self.tags = tags if tags is not None else []
+        self.children = children if children is not None else []
+        self.labels = labels
+        self.slug = slug
+        self.not_validated = not_validated
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "id": self.id_val,
+            "title": self.title,
+            "ratio": self.ratio,
+            "step": self.step,
+            "status": self.status,
+            "ref": self.ref,
+            "tags": self.tags,
+            "children": self.children,
+            "slug": self.slug,
+            "notValidated": self.not_validated,
+        }
+        if self.maybe_id is not None:
+            payload["maybeId"] = self.maybe_id
+        if self.maybe_ref is not None:
+            payload["maybeRef"] = self.maybe_ref
+        if self.labels is not None:
+            payload["labels"] = self.labels
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "id" in data:
+            args["id_val"] = data["id"]
+        if "maybeId" in data:
+            args["maybe_id"] = data["maybeId"]
+        if "title" in data:
+            args["title"] = data["title"]
+        if "ratio" in data:
+            args["ratio"] = data["ratio"]
+        if "step" in data:
+            args["step"] = data["step"]
+        if "status" in data:
+            args["status"] = data["status"]
+        if "ref" in data:
+            args["ref"] = RefStruct.from_json(data["ref"])
+        if "maybeRef" in data:
+            args["maybe_ref"] = RefStruct.from_json(data["maybeRef"])
+        if "tags" in data:
+            args["tags"] = data["tags"]
+        if "children" in data:
+            args["children"] = data["children"]
+        if "labels" in data:
+            args["labels"] = data["labels"]
+        if "slug" in data:
+            args["slug"] = data["slug"]
+        if "notValidated" in data:
+            args["not_validated"] = data["notValidated"]        
+
+        return cls(**args)
+
+    def validate(self) -> list[cogvalidation.ValidationError]:
+        """
+        Checks all the validation constraints that may be defined on the fields of this object for violations and returns them.
+        """
+
+        errors: list[cogvalidation.ValidationError] = []
+        if self.id_val is None:
+            errors.append(cogvalidation.ValidationError("id", "is required"))
+        else:
+            if not (self.id_val >= 5):
+                errors.append(cogvalidation.ValidationError("id", "must be >= 5"))
+            if not (self.id_val < 10):
+                errors.append(cogvalidation.ValidationError("id", "must be < 10"))
+        if self.maybe_id is not None:
+            if not (self.maybe_id >= 5):
+                errors.append(cogvalidation.ValidationError("maybeId", "must be >= 5"))
+            if not (self.maybe_id < 10):
+                errors.append(cogvalidation.ValidationError("maybeId", "must be < 10"))
+        if self.title is None:
+            errors.append(cogvalidation.ValidationError("title", "is required"))
+        else:
+            if not (len(self.title) >= 1):
+                errors.append(cogvalidation.ValidationError("title", "length must be >= 1"))
+        if self.ratio is None:
+            errors.append(cogvalidation.ValidationError("ratio", "is required"))
+        else:
+            if not (self.ratio > 0):
+                errors.append(cogvalidation.ValidationError("ratio", "must be > 0"))
+            if not (self.ratio <= 1):
+                errors.append(cogvalidation.ValidationError("ratio", "must be <= 1"))
+        if self.step is None:
+            errors.append(cogvalidation.ValidationError("step", "is required"))
+        else:
+            if not (self.step % 5 == 0):
+                errors.append(cogvalidation.ValidationError("step", "must be a multiple of 5"))
+        if self.status is None:
+            errors.append(cogvalidation.ValidationError("status", "is required"))
+        else:
+            if self.status not in ["active", "paused"]:
+                errors.append(cogvalidation.ValidationError("status", "must be one of: active, paused"))
+        if self.ref is None:
+            errors.append(cogvalidation.ValidationError("ref", "is required"))
+        else:
+            if isinstance(self.ref, RefStruct):
+                errors.extend(cogvalidation.prefix_errors("ref", self.ref.validate()))
+        if self.maybe_ref is not None:
+            if isinstance(self.maybe_ref, RefStruct):
+                errors.extend(cogvalidation.prefix_errors("maybeRef", self.maybe_ref.validate()))
+        if self.tags is None:
+            errors.append(cogvalidation.ValidationError("tags", "is required"))
+        else:
+            for i1 in range(len(self.tags)):
+                if not (len(self.tags[i1]) >= 1):
+                    errors.append(cogvalidation.ValidationError(f"tags[{i1}]", "length must be >= 1"))
+                if not (len(self.tags[i1]) <= 20):
+                    errors.append(cogvalidation.ValidationError(f"tags[{i1}]", "length must be <= 20"))
+        if self.children is None:
+            errors.append(cogvalidation.ValidationError("children", "is required"))
+        else:
+            for i1 in range(len(self.children)):
+                if isinstance(self.children[i1], RefStruct):
+                    errors.extend(cogvalidation.prefix_errors(f"children[{i1}]", self.children[i1].validate()))
+        if self.labels is not None:
+            for key1 in self.labels:
+                if not (len(self.labels[key1]) <= 10):
+                    errors.append(cogvalidation.ValidationError(f"labels[{key1}]", "length must be <= 10"))
+        if self.slug is None:
+            errors.append(cogvalidation.ValidationError("slug", "is required"))
+        else:
+            if not re.search("^[a-z0-9-]+$", self.slug):
+                errors.append(cogvalidation.ValidationError("slug", "must match the pattern ^[a-z0-9-]+$"))
+            if re.search("^-", self.slug):
+                errors.append(cogvalidation.ValidationError("slug", "must not match the pattern ^-"))
+        if self.not_validated is None:
+            errors.append(cogvalidation.ValidationError("notValidated", "is required"))
+        return errors
+
+
+class RefStruct:
+    name: str
+    port: 'Port'
+
+    def __init__(self, name: str = "", port: typing.Optional['Port'] = None):
+        self.name = name
+        self.port = port if port is not None else Port()
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+            "name": self.name,
+            "port": self.port,
+        }
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "name" in data:
+            args["name"] = data["name"]
+        if "port" in data:
+            args["port"] = data["port"]        
+
+        return cls(**args)
+
+    def validate(self) -> list[cogvalidation.ValidationError]:
+        """
+        Checks all the validation constraints that may be defined on the fields of this object for violations and returns them.
+        """
+
+        errors: list[cogvalidation.ValidationError] = []
+        if self.name is None:
+            errors.append(cogvalidation.ValidationError("name", "is required"))
+        if self.port is None:
+            errors.append(cogvalidation.ValidationError("port", "is required"))
+        else:
+            if not (self.port >= 1):
+                errors.append(cogvalidation.ValidationError("port", "must be >= 1"))
+            if not (self.port <= 65535):
+                errors.append(cogvalidation.ValidationError("port", "must be <= 65535"))
+        return errors
+
+
+Port: typing.TypeAlias = int
+
+
+class Status(enum.StrEnum):
+    ACTIVE = "active"
+    PAUSED = "paused"
+
+
+class NothingToValidate:
+    name: typing.Optional[str]
+
+    def __init__(self, name: typing.Optional[str] = None):
+        self.name = name
+
+    def to_json(self) -> dict[str, object]:
+        payload: dict[str, object] = {
+        }
+        if self.name is not None:
+            payload["name"] = self.name
+        return payload
+
+    @classmethod
+    def from_json(cls, data: dict[str, typing.Any]) -> typing.Self:
+        args: dict[str, typing.Any] = {}
+        
+        if "name" in data:
+            args["name"] = data["name"]        
+
+        return cls(**args)
+
+    def validate(self) -> list[cogvalidation.ValidationError]:
+        """
+        Checks all the validation constraints that may be defined on the fields of this object for violations and returns them.
+        """
+
+        return []
+
+
+
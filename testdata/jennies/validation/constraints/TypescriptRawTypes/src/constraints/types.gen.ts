@@ -0,0 +1,190 @@
+import * as cog from '../cog';
+
+
+export interface SomeStruct {
+	id: number;
+	maybeId?: number;
+	title: string;
+	ratio: number;
+	step: number;
+	status: Status;
+	ref: RefStruct;
+	maybeRef?: RefStruct;
+	tags: string[];
+	children: RefStruct[];
+	labels?: Record<string, string>;
+	slug: string;
+	notValidated: string;
+}
+
+export const defaultSomeStruct = (): SomeStruct => ({
+	id: 0,
+	title: "",
+	ratio: 0,
+	step: 0,
+	status: Status.Active,
+	ref: defaultRefStruct(),
+	tags: [],
+	children: [],
+	slug: "",
+	notValidated: "",
+});
+
+// validateSomeStruct checks all the validation constraints that may be defined on `SomeStruct` fields for violations and returns them.
+export const validateSomeStruct = (input: SomeStruct): cog.ValidationError[] => {
+	const errors: cog.ValidationError[] = [];
+
+	if (input.id === undefined || input.id === null) {
+		errors.push({ path: "id", message: "is required" });
+	} else {
+		if (!(input.id >= 5)) {
+			errors.push({ path: "id", message: "must be >= 5" });
+		}
+		if (!(input.id < 10)) {
+			errors.push({ path: "id", message: "must be < 10" });
+		}
+	}
+	if (input.maybeId !== undefined && input.maybeId !== null) {
+		if (!(input.maybeId >= 5)) {
+			errors.push({ path: "maybeId", message: "must be >= 5" });
+		}
+		if (!(input.maybeId < 10)) {
+			errors.push({ path: "maybeId", message: "must be < 10" });
+		}
+	}
+	if (input.title === undefined || input.title === null) {
+		errors.push({ path: "title", message: "is required" });
+	} else {
+		if (!(input.title.length >= 1)) {
+			errors.push({ path: "title", message: "length must be >= 1" });
+		}
+	}
+	if (input.ratio === undefined || input.ratio === null) {
+		errors.push({ path: "ratio", message: "is required" });
+	} else {
+		if (!(input.ratio > 0)) {
+			errors.push({ path: "ratio", message: "must be > 0" });
+		}
+		if (!(input.ratio <= 1)) {
+			errors.push({ path: "ratio", message: "must be <= 1" });
+		}
+	}
+	if (input.step === undefined || input.step === null) {
+		errors.push({ path: "step", message: "is required" });
+	} else {
+		if (!(input.step % 5 === 0)) {
+			errors.push({ path: "step", message: "must be a multiple of 5" });
+		}
+	}
+	if (input.status === undefined || input.status === null) {
+		errors.push({ path: "status", message: "is required" });
+	} else {
+		if (!["active", "paused"].includes(input.status)) {
+			errors.push({ path: "status", message: "must be one of: active, paused" });
+		}
+	}
+	if (input.ref === undefined || input.ref === null) {
+		errors.push({ path: "ref", message: "is required" });
+	} else {
+		errors.push(...cog.prefixValidationErrors("ref", validateRefStruct(input.ref)));
+	}
+	if (input.maybeRef !== undefined && input.maybeRef !== null) {
+		errors.push(...cog.prefixValidationErrors("maybeRef", validateRefStruct(input.maybeRef)));
+	}
+	if (input.tags === undefined || input.tags === null) {
+		errors.push({ path: "tags", message: "is required" });
+	} else {
+		for (let i1 = 0; i1 < input.tags.length; i1++) {
+			if (!(input.tags[i1].length >= 1)) {
+				errors.push({ path: `tags[${i1}]`, message: "length must be >= 1" });
+			}
+			if (!(input.tags[i1].length <= 20)) {
+				errors.push({ path: `tags[${i1}]`, message: "length must be <= 20" });
+			}
+		}
+	}
+	if (input.children === undefined || input.children === null) {
+		errors.push({ path: "children", message: "is required" });
+	} else {
+		for (let i1 = 0; i1 < input.children.length; i1++) {
+			errors.push(...cog.prefixValidationErrors(`children[${i1}]`, validateRefStruct(input.children[i1])));
+		}
+	}
+	if (input.labels !== undefined && input.labels !== null) {
+		for (const key1 of Object.keys(input.labels)) {
+			if (!(input.labels[key1].length <= 10)) {
+				errors.push({ path: `labels[${key1}]`, message: "length must be <= 10" });
+			}
+		}
+	}
+	if (input.slug === undefined || input.slug === null) {
+		errors.push({ path: "slug", message: "is required" });
+	} else {
+		if (!new RegExp("^[a-z0-9-]+$").test(input.slug)) {
+			errors.push({ path: "slug", message: "must match the pattern ^[a-z0-9-]+$" });
+		}
+		if (new RegExp("^-").test(input.slug)) {
+			errors.push({ path: "slug", message: "must not match the pattern ^-" });
+		}
+	}
+	if (input.notValidated === undefined || input.notValidated === null) {
+		errors.push({ path: "notValidated", message: "is required" });
+	}
+
+	return errors;
+};
+
+export interface RefStruct {
+	name: string;
+	port: Port;
+}
+
+export const defaultRefStruct = (): RefStruct => ({
+	name: "",
+	port: defaultPort(),
+});
+
+// validateRefStruct checks all the validation constraints that may be defined on `RefStruct` fields for violations and returns them.
+export const validateRefStruct = (input: RefStruct): cog.ValidationError[] => {
+	const errors: cog.ValidationError[] = [];
+
+	if (input.name === undefined || input.name === null) {
+		errors.push({ path: "name", message: "is required" });
+	}
+	if (input.port === undefined || input.port === null) {
+		errors.push({ path: "port", message: "is required" });
+	} else {
+		if (!(input.port >= 1)) {
+			errors.push({ path: "port", message: "must be >= 1" });
+		}
+		if (!(input.port <= 65535)) {
+			errors.push({ path: "port", message: "must be <= 65535" });
+		}
+	}
+
+	return errors;
+};
+
+export type Port = number;
+
+export const defaultPort = (): Port => (0);
+
+export enum Status {
+	Active = "active",
+	Paused = "paused",
+}
+
+export const defaultStatus = (): Status => (Status.Active);
+
+export interface NothingToValidate {
+	name?: string;
+}
+
+export const defaultNothingToValidate = (): NothingToValidate => ({
+});
+
+// validateNothingToValidate checks all the validation constraints that may be defined on `NothingToValidate` fields for violations and returns them.
+export const validateNothingToValidate = (_input: NothingToValidate): cog.ValidationError[] => {
+	return [];
+};
+
@@ -0,0 +1,10 @@
+container: {
+    // Not deprecated.
+    current: string
+    legacy: string @deprecated()
+    oldName: string @deprecated("use newName instead")
+    newName: string
+    #LegacyStruct: {
+        foo: string
+    } @deprecated("use #Container instead")
+}
@@ -4,4 +4,7 @@ container: {
     minLengthConstraints: string & strings.MinRunes(1)
     maxLengthConstraints: string & strings.MaxRunes(64)
     minMaxLengthConstraints: string & strings.MinRunes(2) & strings.MaxRunes(8)
+    patternConstraint: string & =~"^[a-z]+$"
+    notPatternConstraint: string & !~"^\\s"
+    patternAndLengthConstraints: string & =~"^[a-z]+$" & strings.MaxRunes(8)
 }
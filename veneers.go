@@ -0,0 +1,262 @@
+package cog
+
+import (
+	"context"
+
+	"github.com/grafana/cog/internal/ast"
+	"github.com/grafana/cog/internal/veneers"
+	"github.com/grafana/cog/internal/veneers/builder"
+	"github.com/grafana/cog/internal/veneers/option"
+	"github.com/grafana/cog/internal/veneers/rewrite"
+)
+
+// AllLanguages can be used as VeneerRules.Language to apply rules to
+// builders of every language.
+const AllLanguages = rewrite.AllLanguages
+
+// VeneerRules groups rules applied to builders and options generated for a
+// given language.
+type VeneerRules = rewrite.LanguageRules
+
+type BuilderRule = builder.RewriteRule
+type BuilderSelector = builder.Selector
+
+type OptionRule = option.RewriteRule
+type OptionSelector = option.Selector
+
+// NameMatcher tells whether a name (of a package, an object, a builder, …)
+// matches some criteria.
+type NameMatcher = veneers.Matcher
+
+// NamePattern parses a name pattern: `/regex/` for regular expressions,
+// globs when the pattern contains `*`, `?` or `[`, exact and case-insensitive
+// names otherwise.
+func NamePattern(pattern string) (NameMatcher, error) {
+	return veneers.Pattern(pattern)
+}
+
+// SchemaVariant identifies the kind of plugin a composable schema describes.
+type SchemaVariant = ast.SchemaVariant
+
+const (
+	SchemaVariantPanel     = ast.SchemaVariantPanel
+	SchemaVariantDataQuery = ast.SchemaVariantDataQuery
+)
+
+// BooleanUnfold describes the options replacing a boolean option.
+type BooleanUnfold = option.BooleanUnfold
+
+/*********************
+ * Builder selectors *
+ *********************/
+
+// EveryBuilder accepts any given builder.
+func EveryBuilder() BuilderSelector {
+	return builder.EveryBuilder()
+}
+
+// BuilderByObjectName matches builders for the given the object (referred
+// to by its package and name).
+func BuilderByObjectName(pkg string, objectName string) BuilderSelector {
+	return builder.ByObjectName(pkg, objectName)
+}
+
+// BuilderByName matches builders for the given name.
+func BuilderByName(pkg string, builderName string) BuilderSelector {
+	return builder.ByName(pkg, builderName)
+}
+
+// BuilderByObjectMatching matches builders for objects whose package and
+// name are accepted by the given matchers.
+func BuilderByObjectMatching(pkg NameMatcher, objectName NameMatcher) BuilderSelector {
+	return builder.ByObjectMatching(pkg, objectName)
+}
+
+// BuilderByNameMatching matches builders whose name is accepted by the
+// given matcher, for objects in packages accepted by the given matcher.
+func BuilderByNameMatching(pkg NameMatcher, builderName NameMatcher) BuilderSelector {
+	return builder.ByNameMatching(pkg, builderName)
+}
+
+// BuilderByVariant matches builders defined in packages implementing the
+// given schema variant.
+func BuilderByVariant(variant SchemaVariant) BuilderSelector {
+	return builder.ByVariant(variant)
+}
+
+// AllBuilders matches builders matched by every given selector.
+func AllBuilders(selectors ...BuilderSelector) BuilderSelector {
+	return builder.All(selectors...)
+}
+
+// AnyBuilder matches builders matched by at least one of the given selectors.
+func AnyBuilder(selectors ...BuilderSelector) BuilderSelector {
+	return builder.Any(selectors...)
+}
+
+// NotBuilder matches builders not matched by the given selector.
+func NotBuilder(selector BuilderSelector) BuilderSelector {
+	return builder.Not(selector)
+}
+
+/*****************
+ * Builder rules *
+ *****************/
+
+// OmitBuilder removes the selected builders.
+func OmitBuilder(selector BuilderSelector) BuilderRule {
+	return builder.Omit(selector)
+}
+
+// RenameBuilder renames the selected builders.
+func RenameBuilder(selector BuilderSelector, newName string) BuilderRule {
+	return builder.Rename(selector, newName)
+}
+
+// MergeBuilderInto merges the options of the builder whose name is given
+// into the selected builders, under the given path.
+func MergeBuilderInto(selector BuilderSelector, sourceBuilderName string, underPath string, excludeOptions []string, renameOptions map[string]string) BuilderRule {
+	return builder.MergeInto(selector, sourceBuilderName, underPath, excludeOptions, renameOptions)
+}
+
+// DuplicateBuilder adds a copy of the selected builders, with the given name.
+func DuplicateBuilder(selector BuilderSelector, duplicateName string, excludeOptions []string) BuilderRule {
+	return builder.Duplicate(selector, duplicateName, excludeOptions)
+}
+
+// PromoteOptionsToConstructor turns the given options of the selected
+// builders into constructor arguments.
+func PromoteOptionsToConstructor(selector BuilderSelector, optionNames []string) BuilderRule {
+	return builder.PromoteOptionsToConstructor(selector, optionNames)
+}
+
+// ExternalBuilderRule delegates the transformation of builders to the given
+// executable. Schemas and builders are given as JSON on its standard input,
+// and the transformed builders are expected as JSON on its standard output.
+func ExternalBuilderRule(command string, args ...string) BuilderRule {
+	return builder.External(context.Background(), command, args, 0)
+}
+
+/********************
+ * Option selectors *
+ ********************/
+
+// EveryOption accepts any given option.
+func EveryOption() OptionSelector {
+	return option.EveryOption()
+}
+
+// OptionByName matches options by their name and the object they are
+// defined on (referred to by its package and name).
+func OptionByName(pkg string, objectName string, optionNames ...string) OptionSelector {
+	return option.ByName(pkg, objectName, optionNames...)
+}
+
+// OptionByBuilder matches options by their name and the builder they are
+// defined on (referred to by its package and name).
+func OptionByBuilder(pkg string, builderName string, optionNames ...string) OptionSelector {
+	return option.ByBuilder(pkg, builderName, optionNames...)
+}
+
+// OptionByNameMatching matches options whose name is accepted by the given
+// matcher, defined for objects whose package and name are accepted by the
+// given matchers.
+func OptionByNameMatching(pkg NameMatcher, objectName NameMatcher, optionName NameMatcher) OptionSelector {
+	return option.ByNameMatching(pkg, objectName, optionName)
+}
+
+// OptionByBuilderMatching matches options whose name is accepted by the
+// given matcher, defined on builders whose package and name are accepted
+// by the given matchers.
+func OptionByBuilderMatching(pkg NameMatcher, builderName NameMatcher, optionName NameMatcher) OptionSelector {
+	return option.ByBuilderMatching(pkg, builderName, optionName)
+}
+
+// OptionByVariant matches options defined on builders from packages
+// implementing the given schema variant.
+func OptionByVariant(variant SchemaVariant) OptionSelector {
+	return option.ByVariant(variant)
+}
+
+// OptionByArgumentKind matches options taking at least one argument of the
+// given scalar kind (bool, string, …).
+func OptionByArgumentKind(kind string) OptionSelector {
+	return option.ByArgumentKind(ast.ScalarKind(kind))
+}
+
+// OptionByArgumentRef matches options taking at least one argument
+// referring to an object whose package and name are accepted by the given
+// matchers.
+func OptionByArgumentRef(pkg NameMatcher, objectName NameMatcher) OptionSelector {
+	return option.ByArgumentRef(pkg, objectName)
+}
+
+// AllOptions matches options matched by every given selector.
+func AllOptions(selectors ...OptionSelector) OptionSelector {
+	return option.All(selectors...)
+}
+
+// AnyOption matches options matched by at least one of the given selectors.
+func AnyOption(selectors ...OptionSelector) OptionSelector {
+	return option.Any(selectors...)
+}
+
+// NotOption matches options not matched by the given selector.
+func NotOption(selector OptionSelector) OptionSelector {
+	return option.Not(selector)
+}
+
+/****************
+ * Option rules *
+ ****************/
+
+// OmitOption removes the selected options.
+func OmitOption(selector OptionSelector) OptionRule {
+	return option.Omit(selector)
+}
+
+// RenameOption renames the selected options.
+func RenameOption(selector OptionSelector, newName string) OptionRule {
+	return option.Rename(selector, newName)
+}
+
+// DuplicateOption adds a copy of the selected options, with the given name.
+func DuplicateOption(selector OptionSelector, duplicateName string) OptionRule {
+	return option.Duplicate(selector, duplicateName)
+}
+
+// ArrayToAppend turns the selected array options into options appending a
+// single item to the array.
+func ArrayToAppend(selector OptionSelector) OptionRule {
+	return option.ArrayToAppend(selector)
+}
+
+// MapToIndex turns the selected map options into options setting a single
+// entry of the map.
+func MapToIndex(selector OptionSelector) OptionRule {
+	return option.MapToIndex(selector)
+}
+
+// UnfoldBoolean replaces the selected boolean options by two options
+// without arguments.
+func UnfoldBoolean(selector OptionSelector, unfoldOpts BooleanUnfold) OptionRule {
+	return option.UnfoldBoolean(selector, unfoldOpts)
+}
+
+// StructFieldsAsArguments turns the fields of the struct taken by the
+// selected options into arguments.
+func StructFieldsAsArguments(selector OptionSelector, explicitFields ...string) OptionRule {
+	return option.StructFieldsAsArguments(selector, explicitFields...)
+}
+
+// StructFieldsAsOptions replaces the selected options by one option per
+// field of the struct they take.
+func StructFieldsAsOptions(selector OptionSelector, explicitFields ...string) OptionRule {
+	return option.StructFieldsAsOptions(selector, explicitFields...)
+}
+
+// DisjunctionAsOptions replaces the selected options by one option per
+// branch of the disjunction they take.
+func DisjunctionAsOptions(selector OptionSelector) OptionRule {
+	return option.DisjunctionAsOptions(selector)
+}